@@ -110,6 +110,7 @@ import (
 	// starname imports
 	burnertypes "github.com/iov-one/starnamed/x/burner/types"
 	"github.com/iov-one/starnamed/x/configuration"
+	configurationclient "github.com/iov-one/starnamed/x/configuration/client"
 	"github.com/iov-one/starnamed/x/escrow"
 	escrowkeeper "github.com/iov-one/starnamed/x/escrow/keeper"
 	escrowtypes "github.com/iov-one/starnamed/x/escrow/types"
@@ -199,6 +200,7 @@ var (
 				upgradeclient.CancelProposalHandler,
 				ibcclientclient.UpdateClientProposalHandler,
 				ibcclientclient.UpgradeProposalHandler,
+				configurationclient.ReservedNamesProposalHandler,
 			)...,
 		),
 		params.AppModuleBasic{},
@@ -476,7 +478,6 @@ func NewWasmApp(
 		app.getSubspace(escrowtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
-		app.configKeeper,
 		app.ModuleAccountAddrs(),
 	)
 	// starname keeper
@@ -515,7 +516,8 @@ func NewWasmApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(configuration.RouterKey, configuration.NewReservedNamesProposalHandler(app.configKeeper))
 
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -666,7 +668,7 @@ func NewWasmApp(
 		// starname: #dont remove - app.mm
 		configuration.NewAppModule(app.configKeeper),
 		starname.NewAppModule(app.starnameKeeper),
-		escrow.NewAppModule(appCodec, app.escrowKeeper),
+		escrow.NewAppModule(appCodec, app.escrowKeeper, escrowParamsSource{keeper: app.configKeeper}),
 		burner.NewAppModule(app.BankKeeper, app.AccountKeeper),
 	)
 
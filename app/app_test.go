@@ -4,14 +4,21 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/iov-one/starnamed/pkg/utils"
+	escrowtypes "github.com/iov-one/starnamed/x/escrow/types"
+	starnametypes "github.com/iov-one/starnamed/x/starname/types"
 	"github.com/iov-one/starnamed/x/wasm"
 )
 
@@ -117,3 +124,245 @@ func setGenesis(gapp *WasmApp) error {
 	gapp.Commit()
 	return nil
 }
+
+func TestStarnameV12Upgrade(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewWasmApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyBaseAppOptions{}, emptyWasmOpts)
+	require.NoError(t, setGenesis(gapp))
+	ctx := gapp.BaseApp.NewContext(true, tmproto.Header{})
+
+	// The escrow and starname modules are at their version of starname-version-11
+	vm := gapp.mm.GetVersionMap()
+	vm[escrowtypes.ModuleName] = 1
+	vm[starnametypes.ModuleName] = 1
+
+	upgrade := getStarnameV12UpgradeHandler(gapp)
+	migrated, err := upgrade.handler(ctx, upgradetypes.Plan{Name: upgrade.name}, vm)
+	require.NoError(t, err)
+	assert.Equal(t, gapp.mm.GetVersionMap(), migrated)
+
+	// The escrow parameters are read from the configuration
+	config := gapp.configKeeper.GetConfiguration(ctx)
+	params := gapp.escrowKeeper.GetParams(ctx)
+	assert.Equal(t, config.EscrowBroker, params.Broker)
+	assert.Equal(t, config.EscrowMaxPeriod, params.MaxPeriod)
+}
+
+func TestStarnameV12UpgradeRenamesEscrowedNames(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewWasmApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyBaseAppOptions{}, emptyWasmOpts)
+	require.NoError(t, setGenesis(gapp))
+	ctx := gapp.BaseApp.NewContext(true, tmproto.Header{Time: time.Now()})
+	params := gapp.escrowKeeper.GetParams(ctx)
+	params.ModuleEnabled = true
+	gapp.escrowKeeper.SetParams(ctx, params)
+
+	// A domain registered before names were normalized is sold in an open escrow, which holds it
+	seller := createRandomAccounts(1)[0]
+	id := gapp.escrowKeeper.FetchNextId(ctx)
+	escrowAddress := gapp.escrowKeeper.GetEscrowAddress(id)
+	domain := starnametypes.Domain{
+		Name:       "OLD",
+		Admin:      escrowAddress,
+		Type:       starnametypes.ClosedDomain,
+		ValidUntil: ctx.BlockTime().Add(time.Hour).Unix(),
+	}
+	require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Create(&domain))
+	require.NoError(t, gapp.starnameKeeper.AccountStore(ctx).Create(&starnametypes.Account{
+		Domain:     domain.Name,
+		Name:       utils.StrPtr(starnametypes.EmptyAccountName),
+		Owner:      escrowAddress,
+		ValidUntil: domain.ValidUntil,
+	}))
+	price := sdk.NewCoins(sdk.NewInt64Coin(params.PriceDenom, 10))
+	escrow := escrowtypes.NewEscrow(id, seller, price, &domain, uint64(ctx.BlockTime().Unix())+100, params.Broker, params.Commission)
+	gapp.escrowKeeper.SaveEscrow(ctx, escrow)
+	gapp.escrowKeeper.NextId(ctx)
+
+	vm := gapp.mm.GetVersionMap()
+	vm[starnametypes.ModuleName] = 1
+	upgrade := getStarnameV12UpgradeHandler(gapp)
+	_, err := upgrade.handler(ctx, upgradetypes.Plan{Name: upgrade.name}, vm)
+	require.NoError(t, err)
+
+	// The escrow refunds the renamed domain
+	require.NoError(t, gapp.escrowKeeper.RefundEscrow(ctx, seller, id))
+	var refunded starnametypes.Domain
+	require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Read((&starnametypes.Domain{Name: "old"}).PrimaryKey(), &refunded))
+	assert.Equal(t, seller, refunded.Admin)
+}
+
+func TestBundleOfClosedDomainAndAccounts(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewWasmApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyBaseAppOptions{}, emptyWasmOpts)
+	require.NoError(t, setGenesis(gapp))
+	ctx := gapp.BaseApp.NewContext(true, tmproto.Header{Time: time.Now()})
+	params := gapp.escrowKeeper.GetParams(ctx)
+	accounts := createRandomAccounts(3)
+	seller, buyer := accounts[0], accounts[1]
+	params.ModuleEnabled = true
+	params.Broker = accounts[2].String()
+	gapp.escrowKeeper.SetParams(ctx, params)
+
+	// The seller owns a closed domain and one of its accounts, whose transfers are only allowed to the domain admin
+	domain := starnametypes.Domain{
+		Name:       "portfolio",
+		Admin:      seller,
+		Type:       starnametypes.ClosedDomain,
+		ValidUntil: ctx.BlockTime().Add(time.Hour).Unix(),
+	}
+	require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Create(&domain))
+	for _, name := range []string{starnametypes.EmptyAccountName, "premium"} {
+		require.NoError(t, gapp.starnameKeeper.AccountStore(ctx).Create(&starnametypes.Account{
+			Domain:     domain.Name,
+			Name:       utils.StrPtr(name),
+			Owner:      seller,
+			ValidUntil: domain.ValidUntil,
+		}))
+	}
+	read := func() (*starnametypes.Domain, *starnametypes.Account) {
+		d := starnametypes.Domain{Name: domain.Name}
+		require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Read(d.PrimaryKey(), &d))
+		a := starnametypes.Account{Domain: domain.Name, Name: utils.StrPtr("premium")}
+		require.NoError(t, gapp.starnameKeeper.AccountStore(ctx).Read(a.PrimaryKey(), &a))
+		return &d, &a
+	}
+	owners := func() (sdk.AccAddress, sdk.AccAddress) {
+		d, a := read()
+		return d.Admin, a.Owner
+	}
+	newBundle := func() *escrowtypes.ObjectBundle {
+		// The domain is listed first but the account must be transferred while the domain admin can still transfer it
+		d, a := read()
+		bundle, err := escrowtypes.NewObjectBundle(d, a)
+		require.NoError(t, err)
+		return bundle
+	}
+	price := sdk.NewCoins(sdk.NewInt64Coin(params.PriceDenom, 11))
+	deadline := uint64(ctx.BlockTime().Unix()) + 100
+
+	// The bundle is escrowed and refunded
+	id, err := gapp.escrowKeeper.CreateEscrow(ctx, seller, price, newBundle(), deadline)
+	require.NoError(t, err)
+	escrowAddress := gapp.escrowKeeper.GetEscrowAddress(id)
+	admin, owner := owners()
+	assert.Equal(t, escrowAddress, admin)
+	assert.Equal(t, escrowAddress, owner)
+	require.NoError(t, gapp.escrowKeeper.RefundEscrow(ctx, seller, id))
+	admin, owner = owners()
+	assert.Equal(t, seller, admin)
+	assert.Equal(t, seller, owner)
+
+	// The bundle is escrowed and sold
+	id, err = gapp.escrowKeeper.CreateEscrow(ctx, seller, price, newBundle(), deadline)
+	require.NoError(t, err)
+	require.NoError(t, FundAccount(gapp.BankKeeper, ctx, buyer, price))
+	require.NoError(t, gapp.escrowKeeper.TransferToEscrow(ctx, buyer, id, price))
+	admin, owner = owners()
+	assert.Equal(t, buyer, admin)
+	assert.Equal(t, buyer, owner)
+
+	// Each name records its share of the price
+	for starname, share := range map[string]int64{domain.Name: 6, "premium*" + domain.Name: 5} {
+		entries, total := gapp.starnameKeeper.GetProvenance(ctx, starname, 0, 100)
+		require.NotZero(t, total)
+		var sales []starnametypes.ProvenanceEntry
+		for _, entry := range entries {
+			if entry.Event == starnametypes.ProvenanceEvent_Sale {
+				sales = append(sales, entry)
+			}
+		}
+		require.Len(t, sales, 1, starname)
+		assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.PriceDenom, share)), sales[0].Price, starname)
+	}
+}
+
+func TestDomainSaleClearsRoyalty(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewWasmApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyBaseAppOptions{}, emptyWasmOpts)
+	require.NoError(t, setGenesis(gapp))
+	ctx := gapp.BaseApp.NewContext(true, tmproto.Header{Time: time.Now()})
+	params := gapp.escrowKeeper.GetParams(ctx)
+	accounts := createRandomAccounts(5)
+	seller, buyer, owner, recipient := accounts[0], accounts[1], accounts[2], accounts[3]
+	params.ModuleEnabled = true
+	params.Broker = accounts[4].String()
+	gapp.escrowKeeper.SetParams(ctx, params)
+	conf := gapp.configKeeper.GetConfiguration(ctx)
+	conf.EscrowRoyaltyMax = sdk.NewDecWithPrec(1, 1)
+	gapp.configKeeper.SetConfig(ctx, conf)
+
+	// The seller owns an open domain whose account resales pay a royalty to the recipient
+	domain := starnametypes.Domain{
+		Name:       "shop",
+		Admin:      seller,
+		Type:       starnametypes.OpenDomain,
+		ValidUntil: ctx.BlockTime().Add(time.Hour).Unix(),
+	}
+	require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Create(&domain))
+	for name, accountOwner := range map[string]sdk.AccAddress{starnametypes.EmptyAccountName: seller, "alice": owner} {
+		require.NoError(t, gapp.starnameKeeper.AccountStore(ctx).Create(&starnametypes.Account{
+			Domain:     domain.Name,
+			Name:       utils.StrPtr(name),
+			Owner:      accountOwner,
+			ValidUntil: domain.ValidUntil,
+		}))
+	}
+	gapp.starnameKeeper.SetDomainPolicy(ctx, starnametypes.DomainPolicy{
+		Domain:           domain.Name,
+		RoyaltyRecipient: recipient,
+		RoyaltyRate:      sdk.NewDecWithPrec(1, 1),
+	})
+	price := sdk.NewCoins(sdk.NewInt64Coin(params.PriceDenom, 100))
+	deadline := uint64(ctx.BlockTime().Unix()) + 100
+	sell := func(object escrowtypes.TransferableObject, seller, buyer sdk.AccAddress) {
+		id, err := gapp.escrowKeeper.CreateEscrow(ctx, seller, price, object, deadline)
+		require.NoError(t, err)
+		require.NoError(t, FundAccount(gapp.BankKeeper, ctx, buyer, price))
+		require.NoError(t, gapp.escrowKeeper.TransferToEscrow(ctx, buyer, id, price))
+	}
+
+	// The domain is sold through an escrow, the policy of the previous admin is cleared
+	d := starnametypes.Domain{Name: domain.Name}
+	require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Read(d.PrimaryKey(), &d))
+	sell(&d, seller, buyer)
+	require.NoError(t, gapp.starnameKeeper.DomainStore(ctx).Read(d.PrimaryKey(), &d))
+	require.Equal(t, buyer, d.Admin)
+	_, found := gapp.starnameKeeper.GetDomainPolicy(ctx, domain.Name)
+	assert.False(t, found)
+
+	// The resale of an account of the domain pays no royalty to the recipient chosen by the previous admin
+	a := starnametypes.Account{Domain: domain.Name, Name: utils.StrPtr("alice")}
+	require.NoError(t, gapp.starnameKeeper.AccountStore(ctx).Read(a.PrimaryKey(), &a))
+	sell(&a, owner, accounts[4])
+	assert.True(t, gapp.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+}
+
+func TestEscrowParamsSource(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewWasmApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyBaseAppOptions{}, emptyWasmOpts)
+	require.NoError(t, setGenesis(gapp))
+	ctx := gapp.BaseApp.NewContext(true, tmproto.Header{})
+	conf := gapp.configKeeper.GetConfiguration(ctx)
+	conf.EscrowBroker = createRandomAccounts(1)[0].String()
+	conf.EscrowCommission = sdk.NewDecWithPrec(2, 2)
+	conf.EscrowMaxPeriod = time.Hour
+	gapp.configKeeper.SetConfig(ctx, conf)
+	fees := gapp.configKeeper.GetFees(ctx)
+	fees.FeeCoinPrice = sdk.NewDecWithPrec(5, 1)
+	fees.FeeDefault = sdk.NewDec(10)
+	fees.CreateEscrow = sdk.NewDec(20)
+	fees.UpdateEscrow = sdk.NewDec(1)
+	gapp.configKeeper.SetFees(ctx, fees)
+
+	source := escrowParamsSource{keeper: gapp.configKeeper}
+	assert.Equal(t, conf.EscrowBroker, source.GetEscrowBroker(ctx))
+	assert.Equal(t, conf.EscrowCommission, source.GetEscrowCommission(ctx))
+	assert.Equal(t, time.Hour, source.GetEscrowMaxPeriod(ctx))
+	assert.Equal(t, fees.FeeCoinDenom, source.GetEscrowPriceDenom(ctx))
+	// the fees are converted with the fee coin price, the default fee being the minimum
+	escrowFees := source.GetEscrowFees(ctx)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fees.FeeCoinDenom, 40)), escrowFees.CreateEscrow)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fees.FeeCoinDenom, 20)), escrowFees.UpdateEscrow)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fees.FeeCoinDenom, 20)), escrowFees.RefundEscrow)
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/x/configuration"
+	escrowtypes "github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// escrowParamsSource adapts the configuration keeper, which held the escrow settings before version 3 of the escrow
+// module, to the source of the escrow parameters migration
+type escrowParamsSource struct {
+	keeper configuration.Keeper
+}
+
+func (s escrowParamsSource) GetEscrowBroker(ctx sdk.Context) string {
+	return s.keeper.GetConfiguration(ctx).EscrowBroker
+}
+
+func (s escrowParamsSource) GetEscrowCommission(ctx sdk.Context) sdk.Dec {
+	return s.keeper.GetConfiguration(ctx).EscrowCommission
+}
+
+func (s escrowParamsSource) GetEscrowMaxPeriod(ctx sdk.Context) time.Duration {
+	return s.keeper.GetConfiguration(ctx).EscrowMaxPeriod
+}
+
+func (s escrowParamsSource) GetEscrowPriceDenom(ctx sdk.Context) string {
+	return s.keeper.GetFees(ctx).FeeCoinDenom
+}
+
+// GetEscrowFees converts the escrow fees of the configuration to coins, as they were charged in version 2
+func (s escrowParamsSource) GetEscrowFees(ctx sdk.Context) escrowtypes.Fees {
+	fees := s.keeper.GetFees(ctx)
+	return escrowtypes.Fees{
+		CreateEscrow:     toEscrowFee(fees, fees.CreateEscrow),
+		UpdateEscrow:     toEscrowFee(fees, fees.UpdateEscrow),
+		TransferToEscrow: toEscrowFee(fees, fees.TransferToEscrow),
+		RefundEscrow:     toEscrowFee(fees, fees.RefundEscrow),
+	}
+}
+
+// toEscrowFee converts a fee of the configuration to coins, the default fee being the minimum fee
+func toEscrowFee(fees *configuration.Fees, fee sdk.Dec) sdk.Coins {
+	if fee.LT(fees.FeeDefault) {
+		fee = fees.FeeDefault
+	}
+	return sdk.NewCoins(sdk.NewCoin(fees.FeeCoinDenom, fee.Quo(fees.FeeCoinPrice).TruncateInt()))
+}
@@ -49,6 +49,7 @@ func (app *WasmApp) RegisterUpgradeHandlers() {
 	upgrades := []upgradeData{
 		getIOVMainnetIBC2UpgradeHandler(app),
 		getCosmosSDKv44UpgradeHandler(app),
+		getStarnameV12UpgradeHandler(app),
 	}
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -309,3 +310,17 @@ func getCosmosSDKv44UpgradeHandler(app *WasmApp) upgradeData {
 		storeLoaderRegisterer: setStoreLoader,
 	}
 }
+
+func getStarnameV12UpgradeHandler(app *WasmApp) upgradeData {
+	const planName = "starname-version-12"
+	handler := func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// Run the migrations of the modules whose consensus version changed since the stored version map:
+		// configuration is migrated first, then escrow (version 1 to 3, which reads the escrow settings from the
+		// configuration into its parameters) and starname (version 1 to 2, which indexes and normalizes the names and
+		// renames the escrowed objects, so it needs the escrows of version 2)
+		return app.mm.RunMigrations(ctx, app.configurator, vm)
+	}
+
+	// No store is added nor removed
+	return upgradeData{name: planName, handler: handler}
+}
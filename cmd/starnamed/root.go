@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/iov-one/starnamed/app"
 	"github.com/iov-one/starnamed/app/params"
+	starnamecli "github.com/iov-one/starnamed/x/starname/client/cli"
 	"github.com/iov-one/starnamed/x/wasm"
 	wasmkeeper "github.com/iov-one/starnamed/x/wasm/keeper"
 	wasmtypes "github.com/iov-one/starnamed/x/wasm/types"
@@ -99,7 +101,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
-		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
+		ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		AddGenesisWasmMsgCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
@@ -174,6 +176,17 @@ func txCommand() *cobra.Command {
 
 	app.ModuleBasics.AddTxCommands(cmd)
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
+	// accept starnames as the recipient of a send and as the contract of an execution
+	for path, args := range map[string][]int{"bank send": {1}, "wasm execute": {0}} {
+		if sub, _, err := cmd.Find(strings.Fields(path)); err == nil && sub != cmd {
+			starnamecli.MarkStarnameAddressArgs(sub, args...)
+		}
+	}
+	// accept starnames as the broker, seller and buyer addresses of the escrow commands
+	if sub, _, err := cmd.Find([]string{"escrow"}); err == nil && sub != cmd {
+		starnamecli.MarkEscrowAddressFlags(sub)
+	}
+	starnamecli.AddStarnameResolver(cmd)
 
 	return cmd
 }
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	configurationtypes "github.com/iov-one/starnamed/x/configuration/types"
+	"github.com/iov-one/starnamed/x/starname"
+	starnametypes "github.com/iov-one/starnamed/x/starname/types"
+)
+
+// ValidateGenesisCmd returns the validate-genesis cobra Command. On top of the validation of every module genesis,
+// it validates the starname genesis against the configuration of the same genesis, which a module can not do on its own.
+func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			cdc := clientCtx.Codec
+
+			// Load default if passed no args, otherwise load passed file
+			genesis := serverCtx.Config.GenesisFile()
+			if len(args) != 0 {
+				genesis = args[0]
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genesis)
+			if err != nil {
+				return err
+			}
+
+			var genState map[string]json.RawMessage
+			if err = json.Unmarshal(genDoc.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
+			}
+
+			if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			var configurationGenesis configurationtypes.GenesisState
+			if err = cdc.UnmarshalJSON(genState[configurationtypes.ModuleName], &configurationGenesis); err != nil {
+				return fmt.Errorf("error unmarshalling %s genesis state: %s", configurationtypes.ModuleName, err.Error())
+			}
+			var starnameGenesis starnametypes.GenesisState
+			if err = cdc.UnmarshalJSON(genState[starnametypes.ModuleName], &starnameGenesis); err != nil {
+				return fmt.Errorf("error unmarshalling %s genesis state: %s", starnametypes.ModuleName, err.Error())
+			}
+			if err = starname.ValidateGenesisWithConfig(starnameGenesis, configurationGenesis.Config); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			fmt.Printf("File at %s is a valid genesis file\n", genesis)
+			return nil
+		},
+	}
+}
@@ -1,24 +1,40 @@
 package mock
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	escrowtypes "github.com/iov-one/starnamed/x/escrow/types"
 )
 
 type EscrowKeeper interface {
 	RegisterCustomData(id escrowtypes.TypeID, data escrowtypes.CustomData)
+	UpdateEscrowedObjects(ctx sdk.Context, update func(obj escrowtypes.TransferableObject) bool) error
 }
 
 type escrowKeeper struct {
+	objects []escrowtypes.TransferableObject
 }
 
 func (s escrowKeeper) RegisterCustomData(id escrowtypes.TypeID, data escrowtypes.CustomData) {
 
 }
 
+func (s escrowKeeper) UpdateEscrowedObjects(_ sdk.Context, update func(obj escrowtypes.TransferableObject) bool) error {
+	for _, obj := range s.objects {
+		update(obj)
+	}
+	return nil
+}
+
 type EscrowKeeperMock struct {
 	e *escrowKeeper
 }
 
+// SetEscrowedObjects sets the objects held by the escrows, which UpdateEscrowedObjects updates in place
+func (e *EscrowKeeperMock) SetEscrowedObjects(objects ...escrowtypes.TransferableObject) {
+	e.e.objects = objects
+}
+
 func (e *EscrowKeeperMock) Mock() EscrowKeeper {
 	return e.e
 }
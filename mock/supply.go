@@ -9,12 +9,14 @@ import (
 type SupplyKeeper interface {
 	SendCoinsFromAccountToModule(sdk.Context, sdk.AccAddress, string, sdk.Coins) error
 	SendCoinsFromModuleToAccount(sdk.Context, string, sdk.AccAddress, sdk.Coins) error
+	SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
 	GetAllBalances(sdk.Context, sdk.AccAddress) sdk.Coins
 }
 
 type supplyKeeper struct {
 	sendCoinsFromAccountToModule func(sdk.Context, sdk.AccAddress, string, sdk.Coins) error
 	sendCoinsFromModuleToAccount func(sdk.Context, string, sdk.AccAddress, sdk.Coins) error
+	sendCoins                    func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
 	getAllBalances               func(ctx sdk.Context, address sdk.AccAddress) sdk.Coins
 }
 
@@ -26,6 +28,10 @@ func (s *supplyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, moduleName
 	return s.sendCoinsFromModuleToAccount(ctx, moduleName, addr, coins)
 }
 
+func (s *supplyKeeper) SendCoins(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	return s.sendCoins(ctx, from, to, coins)
+}
+
 func (s *supplyKeeper) GetAllBalances(ctx sdk.Context, address sdk.AccAddress) sdk.Coins {
 	return s.getAllBalances(ctx, address)
 }
@@ -41,6 +47,11 @@ func (s *SupplyKeeperMock) SetSendCoinsFromAccountToModule(f func(sdk.Context, s
 func (s *SupplyKeeperMock) SetSendCoinsFromModuleToAccount(f func(sdk.Context, string, sdk.AccAddress, sdk.Coins) error) {
 	s.s.sendCoinsFromModuleToAccount = f
 }
+
+func (s *SupplyKeeperMock) SetSendCoins(f func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error) {
+	s.s.sendCoins = f
+}
+
 func (s *SupplyKeeperMock) SetGetAllBalances(f func(ctx sdk.Context, address sdk.AccAddress) sdk.Coins) {
 	s.s.getAllBalances = f
 }
@@ -70,6 +81,10 @@ func (s *SupplyKeeperMock) WithDefaultsBalances(balances map[string]sdk.Coins) *
 		return send(authtypes.NewModuleAddress(moduleName), addr, coins)
 	})
 
+	s.SetSendCoins(func(_ sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+		return send(from, to, coins)
+	})
+
 	s.SetGetAllBalances(func(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
 		return balances[addr.String()]
 	})
@@ -87,6 +102,10 @@ func NewSupplyKeeper() *SupplyKeeperMock {
 		return nil
 	})
 
+	mock.SetSendCoins(func(_ sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+		return nil
+	})
+
 	mock.SetGetAllBalances(func(sdk.Context, sdk.AccAddress) sdk.Coins { return nil })
 	return mock
 }
@@ -8,6 +8,8 @@ type (
 	Config = types.Config
 	// Fees aliases types.Fees
 	Fees = types.Fees
+	// ReservedName aliases types.ReservedName
+	ReservedName = types.ReservedName
 )
 
 // alias for consts
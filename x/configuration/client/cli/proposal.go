@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/iov-one/starnamed/x/configuration/types"
+)
+
+// NewCmdSubmitReservedNamesProposal returns the command used to submit a reserved names governance proposal
+func NewCmdSubmitReservedNamesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved-names [reserved-names-file]",
+		Short: "submit a proposal to add, update or remove reserved names",
+		Long: `submit a proposal to add, update or remove reserved names, the file has the same format of
+the one used by the update-reserved-names command`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("unable to get context: %s", err)
+			}
+			reserved, err := readReservedNamesFile(args[0])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			rawDeposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(rawDeposit)
+			if err != nil {
+				return err
+			}
+			content := &types.ReservedNamesProposal{
+				Title:       title,
+				Description: description,
+				Upsert:      reserved.Upsert,
+				Remove:      reserved.Remove,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid tx: %w", err)
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of the proposal")
+	return cmd
+}
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 	configQueryCmd.AddCommand(
 		getCmdQueryConfig(),
 		getCmdQueryFees(),
+		getCmdQueryReservedNames(),
+		getCmdQueryReservedName(),
 	)
 	// return cmd list
 	return configQueryCmd
@@ -74,3 +76,52 @@ func getCmdQueryFees() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func getCmdQueryReservedNames() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved-names",
+		Short: "gets the reserved names and patterns",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.ReservedNames(cmd.Context(), &types.QueryReservedNamesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reserved-names")
+	return cmd
+}
+
+func getCmdQueryReservedName() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved-name [name]",
+		Short: "checks if a domain or account name is reserved",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.ReservedName(cmd.Context(), &types.QueryReservedNameRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
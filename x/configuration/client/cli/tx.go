@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 	configTxCmd.AddCommand(
 		getCmdUpdateConfig(),
 		getCmdUpdateFees(),
+		getCmdUpdateReservedNames(),
 	)
 	return configTxCmd
 }
@@ -80,6 +81,70 @@ func getCmdUpdateFees() *cobra.Command {
 	return cmd
 }
 
+// reservedNamesFile is the json file used to update the reserved names
+type reservedNamesFile struct {
+	Upsert []types.ReservedName `json:"upsert"`
+	Remove []types.ReservedName `json:"remove"`
+}
+
+func getCmdUpdateReservedNames() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-reserved-names",
+		Short: "add, update or remove reserved names using a file",
+		Long: `add, update or remove reserved names using a file, example:
+{
+  "upsert": [
+    {"name": "iov", "claimant": "star1..."},
+    {"name": "^bank.*$", "pattern": true}
+  ],
+  "remove": [
+    {"name": "test"}
+  ]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("unable to get context: %s", err)
+			}
+			// get reserved names file
+			reservedFile, err := cmd.Flags().GetString("reserved-names-file")
+			if err != nil {
+				return err
+			}
+			reserved, err := readReservedNamesFile(reservedFile)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgUpdateReservedNames{
+				Signer: cliCtx.GetFromAddress().String(),
+				Upsert: reserved.Upsert,
+				Remove: reserved.Remove,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid tx: %w", err)
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String("reserved-names-file", "reserved-names.json", "reserved names file in json format")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readReservedNamesFile decodes the reserved names to update from the provided json file
+func readReservedNamesFile(path string) (*reservedNamesFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open reserved names file: %s", err)
+	}
+	defer f.Close()
+	reserved := new(reservedNamesFile)
+	if err := json.NewDecoder(f).Decode(reserved); err != nil {
+		return nil, err
+	}
+	return reserved, nil
+}
+
 func getCmdUpdateConfig() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-config",
@@ -221,31 +286,42 @@ func getCmdUpdateConfig() *cobra.Command {
 				config.MetadataSizeMax = metadataSizeMax
 			}
 
-			escrowBroker, err := cmd.Flags().GetString("escrow-broker")
+			escrowRoyaltyMax, err := cmd.Flags().GetString("escrow-royalty-max")
 			if err != nil {
 				return err
 			}
-			if escrowBroker != defaultString {
-				config.EscrowBroker = escrowBroker
+			if escrowRoyaltyMax != defaultString {
+				config.EscrowRoyaltyMax, err = sdk.NewDecFromStr(escrowRoyaltyMax)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid escrow royalty maximum")
+				}
 			}
 
-			escrowCommission, err := cmd.Flags().GetString("escrow-commission")
+			if cmd.Flags().Changed("commit-reveal-enabled") {
+				config.CommitRevealEnabled, err = cmd.Flags().GetBool("commit-reveal-enabled")
+				if err != nil {
+					return err
+				}
+			}
+			commitmentMinDelay, err := cmd.Flags().GetDuration("commitment-min-delay")
 			if err != nil {
 				return err
 			}
-			if escrowCommission != defaultString {
-				config.EscrowCommission, err = sdk.NewDecFromStr(escrowCommission)
-				if err != nil {
-					return sdkerrors.Wrap(err, "invalid escrow commission")
-				}
+			if commitmentMinDelay != defaultDuration {
+				config.CommitmentMinDelay = commitmentMinDelay
 			}
-
-			escrowMaxPeriod, err := cmd.Flags().GetDuration("escrow-max-period")
+			commitmentMaxWindow, err := cmd.Flags().GetDuration("commitment-max-window")
 			if err != nil {
 				return err
 			}
-			if escrowMaxPeriod != defaultNumber {
-				config.EscrowMaxPeriod = escrowMaxPeriod
+			if commitmentMaxWindow != defaultDuration {
+				config.CommitmentMaxWindow = commitmentMaxWindow
+			}
+			if cmd.Flags().Changed("allowed-name-scripts") {
+				config.AllowedNameScripts, err = cmd.Flags().GetStringSlice("allowed-name-scripts")
+				if err != nil {
+					return err
+				}
 			}
 
 			if err := config.Validate(); err != nil {
@@ -285,9 +361,13 @@ func getCmdUpdateConfig() *cobra.Command {
 	cmd.Flags().Uint32("certificate-count-max", uint32(defaultNumber), "maximum number of certificates that could be saved under an account")
 	cmd.Flags().Uint64("metadata-size-max", uint64(defaultNumber), "maximum size of metadata that could be saved under an account")
 
-	cmd.Flags().Duration("escrow-max-period", defaultDuration, "maximum allowed duration for an escrow")
-	cmd.Flags().String("escrow-commission", defaultString, "commission that will be received by the broker. The number represent the fraction of the price that will be sent to the broker account, it must be between 0 and 1.")
-	cmd.Flags().String("escrow-broker", defaultString, "bech32 encoded address of the broker account")
+	cmd.Flags().String("escrow-royalty-max", defaultString, "maximum royalty rate domain admins can set on the escrow sales of the accounts of their domain, it must be between 0 and 1.")
+
+	cmd.Flags().Bool("commit-reveal-enabled", false, "require domain and open domain account registrations to be committed before being revealed")
+	cmd.Flags().Duration("commitment-min-delay", defaultDuration, "minimum duration between a registration commitment and its reveal")
+	cmd.Flags().Duration("commitment-max-window", defaultDuration, "duration after which a registration commitment expires")
+
+	cmd.Flags().StringSlice("allowed-name-scripts", nil, "comma separated unicode scripts, such as Latin,Greek, names can be written in, any script if empty")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/iov-one/starnamed/x/configuration/client/cli"
+	"github.com/iov-one/starnamed/x/configuration/client/rest"
+)
+
+// ReservedNamesProposalHandler is the reserved names proposal handler
+var ReservedNamesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReservedNamesProposal, rest.ReservedNamesProposalHandler)
@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/iov-one/starnamed/x/configuration/types"
+)
+
+type reservedNamesProposal struct {
+	BaseReq     rest.BaseReq         `json:"base_req"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	Upsert      []types.ReservedName `json:"upsert"`
+	Remove      []types.ReservedName `json:"remove"`
+	Proposer    string               `json:"proposer"`
+	Deposit     sdk.Coins            `json:"deposit"`
+}
+
+// ReservedNamesProposalHandler returns the REST handler used to submit a reserved names governance proposal
+func ReservedNamesProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "reserved_names",
+		Handler: func(writer http.ResponseWriter, request *http.Request) {
+			var req reservedNamesProposal
+			if !rest.ReadRESTReq(writer, request, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(writer) {
+				return
+			}
+			proposer, err := sdk.AccAddressFromBech32(req.Proposer)
+			if rest.CheckBadRequestError(writer, err) {
+				return
+			}
+			content := &types.ReservedNamesProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Upsert:      req.Upsert,
+				Remove:      req.Remove,
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, proposer)
+			if rest.CheckBadRequestError(writer, err) {
+				return
+			}
+			if rest.CheckBadRequestError(writer, msg.ValidateBasic()) {
+				return
+			}
+			tx.WriteGeneratedTxResponse(cliCtx, writer, req.BaseReq, msg)
+		},
+	}
+}
@@ -16,8 +16,9 @@ import (
 // txRouteList clubs together all the transaction routes, which are the transactions
 // // that return the bytes to sign to send a request that modifies state to the domain module
 var txRoutesList = map[string]func(cliContext client.Context) http.HandlerFunc{
-	"updateConfig": updateConfigHandler,
-	"updateFees":   updateFeesHandler,
+	"updateConfig":        updateConfigHandler,
+	"updateFees":          updateFeesHandler,
+	"updateReservedNames": updateReservedNamesHandler,
 }
 
 // registerTxRoutes registers all the transaction routes to the router
@@ -55,3 +55,18 @@ func updateFeesHandler(cliCtx client.Context) http.HandlerFunc {
 		handleTxRequest(cliCtx, req.BaseReq, req.Message, writer)
 	}
 }
+
+type updateReservedNames struct {
+	BaseReq rest.BaseReq                  `json:"base_req"`
+	Message *types.MsgUpdateReservedNames `json:"message"`
+}
+
+func updateReservedNamesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var req updateReservedNames
+		if !rest.ReadRESTReq(writer, request, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		handleTxRequest(cliCtx, req.BaseReq, req.Message, writer)
+	}
+}
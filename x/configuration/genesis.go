@@ -22,6 +22,9 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err := data.Fees.Validate(); err != nil {
 		return err
 	}
+	if err := types.ValidateReservedNames(data.ReservedNames); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -48,6 +51,10 @@ func DefaultGenesisState() types.GenesisState {
 		EscrowCommission:       sdk.NewDecFromInt(sdk.NewInt(1)).QuoInt(sdk.NewInt(100)), // 1%
 		EscrowBroker:           "star1nrnx8mft8mks3l2akduxdjlf8rwqs8r9l36a78",            // IOV's multisig
 		EscrowMaxPeriod:        7890000 * 1e9,                                            // 3 months
+		EscrowRoyaltyMax:       sdk.ZeroDec(),
+		CommitRevealEnabled:    false,
+		CommitmentMinDelay:     60 * 1e9,    // 1 minute
+		CommitmentMaxWindow:    86400 * 1e9, // 1 day
 	}
 	// set fees
 	// add domain module fees
@@ -67,12 +74,16 @@ func DefaultGenesisState() types.GenesisState {
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetConfig(ctx, data.Config)
 	k.SetFees(ctx, &data.Fees)
+	for _, reserved := range data.ReservedNames {
+		k.SetReservedName(ctx, reserved)
+	}
 }
 
 // ExportGenesis saves the state of the configuration module
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Config: k.GetConfiguration(ctx),
-		Fees:   *k.GetFees(ctx),
+		Config:        k.GetConfiguration(ctx),
+		Fees:          *k.GetFees(ctx),
+		ReservedNames: k.GetReservedNames(ctx),
 	}
 }
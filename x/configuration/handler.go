@@ -3,6 +3,7 @@ package configuration
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/iov-one/starnamed/x/configuration/types"
 )
 
@@ -14,6 +15,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleUpdateConfig(ctx, *msg, k)
 		case *types.MsgUpdateFees:
 			return handleUpdateFees(ctx, *msg, k)
+		case *types.MsgUpdateReservedNames:
+			return handleUpdateReservedNames(ctx, *msg, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown request")
 		}
@@ -40,3 +43,25 @@ func handleUpdateConfig(ctx sdk.Context, msg types.MsgUpdateConfig, k Keeper) (*
 	// TODO emit event
 	return &sdk.Result{}, nil
 }
+
+func handleUpdateReservedNames(ctx sdk.Context, msg types.MsgUpdateReservedNames, k Keeper) (*sdk.Result, error) {
+	configurer := k.GetConfigurer(ctx)
+	if configurer != msg.Signer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to update reserved names", msg.Signer)
+	}
+	k.UpdateReservedNames(ctx, msg.Upsert, msg.Remove)
+	return &sdk.Result{}, nil
+}
+
+// NewReservedNamesProposalHandler returns the governance handler of the reserved names proposals
+func NewReservedNamesProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ReservedNamesProposal:
+			k.UpdateReservedNames(ctx, c.Upsert, c.Remove)
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized configuration proposal content type: %T", c)
+		}
+	}
+}
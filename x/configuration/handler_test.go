@@ -41,3 +41,73 @@ func Test_HandleUpdateConfig(t *testing.T) {
 	}
 	RunTests(t, cases)
 }
+
+func Test_HandleUpdateReservedNames(t *testing.T) {
+	cases := map[string]SubTest{
+		"only configurer can update reserved names": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context) {
+				k.SetConfig(ctx, Config{Configurer: AliceKey.String()})
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context) {
+				_, err := handleUpdateReservedNames(ctx, types.MsgUpdateReservedNames{
+					Signer: BobKey.String(),
+					Upsert: []types.ReservedName{{Name: "iov"}},
+				}, k)
+				if !errors.Is(err, sdkerrors.ErrUnauthorized) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			},
+		},
+		"success upsert and remove": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context) {
+				k.SetConfig(ctx, Config{Configurer: AliceKey.String()})
+				k.SetReservedName(ctx, types.ReservedName{Name: "test"})
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context) {
+				_, err := handleUpdateReservedNames(ctx, types.MsgUpdateReservedNames{
+					Signer: AliceKey.String(),
+					Upsert: []types.ReservedName{{Name: "iov", Claimant: BobKey.String()}, {Name: "^bank.*$", Pattern: true}},
+					Remove: []types.ReservedName{{Name: "test"}},
+				}, k)
+				if err != nil {
+					t.Fatalf("handleUpdateReservedNames() got error: %s", err)
+				}
+			},
+			AfterTest: func(t *testing.T, k Keeper, ctx sdk.Context) {
+				if _, ok := k.GetReservation(ctx, "test"); ok {
+					t.Fatal("removed name is still reserved")
+				}
+				reservation, ok := k.GetReservation(ctx, "iov")
+				if !ok || !reservation.IsClaimableBy(BobKey) {
+					t.Fatalf("unexpected reservation: %v", reservation)
+				}
+				reservation, ok = k.GetReservation(ctx, "bankofiov")
+				if !ok || !reservation.Pattern || reservation.IsClaimableBy(BobKey) {
+					t.Fatalf("unexpected reservation: %v", reservation)
+				}
+				if _, ok := k.GetReservation(ctx, "mybank"); ok {
+					t.Fatal("unexpected reservation for mybank")
+				}
+				if len(k.GetReservedNames(ctx)) != 2 {
+					t.Fatalf("unexpected reserved names: %v", k.GetReservedNames(ctx))
+				}
+			},
+		},
+		"proposal updates reserved names": {
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context) {
+				err := NewReservedNamesProposalHandler(k)(ctx, &types.ReservedNamesProposal{
+					Title:       "reserve",
+					Description: "reserve iov",
+					Upsert:      []types.ReservedName{{Name: "iov"}},
+				})
+				if err != nil {
+					t.Fatalf("NewReservedNamesProposalHandler() got error: %s", err)
+				}
+				if _, ok := k.GetReservation(ctx, "iov"); !ok {
+					t.Fatal("iov is not reserved")
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
@@ -0,0 +1,96 @@
+package configuration
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/iov-one/starnamed/x/configuration/types"
+)
+
+// SetReservedName saves or updates a reserved name
+func (k Keeper) SetReservedName(ctx sdk.Context, reserved types.ReservedName) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(reserved.Key(), k.cdc.MustMarshal(&reserved))
+}
+
+// DeleteReservedName removes a reserved name
+func (k Keeper) DeleteReservedName(ctx sdk.Context, reserved types.ReservedName) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(reserved.Key())
+}
+
+// UpdateReservedNames removes and then saves the provided reserved names
+func (k Keeper) UpdateReservedNames(ctx sdk.Context, upsert []types.ReservedName, remove []types.ReservedName) {
+	for _, reserved := range remove {
+		k.DeleteReservedName(ctx, reserved)
+	}
+	for _, reserved := range upsert {
+		k.SetReservedName(ctx, reserved)
+	}
+}
+
+// IterateReservedNames iterates over the reserved exact names and then over the reserved patterns
+// until the provided function returns true
+func (k Keeper) IterateReservedNames(ctx sdk.Context, f func(reserved types.ReservedName) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(types.ReservedKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reserved types.ReservedName
+		k.cdc.MustUnmarshal(iterator.Value(), &reserved)
+		if f(reserved) {
+			return
+		}
+	}
+}
+
+// GetReservedNames returns all the reserved names and patterns
+func (k Keeper) GetReservedNames(ctx sdk.Context) []types.ReservedName {
+	var reserved []types.ReservedName
+	k.IterateReservedNames(ctx, func(r types.ReservedName) bool {
+		reserved = append(reserved, r)
+		return false
+	})
+	return reserved
+}
+
+// GetReservation returns the exact name or the first pattern that reserves the provided name, if any
+func (k Keeper) GetReservation(ctx sdk.Context, name string) (types.ReservedName, bool) {
+	var reservation types.ReservedName
+	bz := ctx.KVStore(k.storeKey).Get(types.ReservedName{Name: name}.Key())
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &reservation)
+		return reservation, true
+	}
+	found := false
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(types.ReservedPatternKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		k.cdc.MustUnmarshal(iterator.Value(), &reservation)
+		if reservation.Matches(name) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return types.ReservedName{}, false
+	}
+	return reservation, true
+}
+
+// paginateReservedNames returns a page of the reserved exact names followed by the reserved patterns
+func (k Keeper) paginateReservedNames(ctx sdk.Context, pagination *query.PageRequest) ([]types.ReservedName, *query.PageResponse, error) {
+	var reserved []types.ReservedName
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReservedKeyPrefix))
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var r types.ReservedName
+		if err := k.cdc.Unmarshal(value, &r); err != nil {
+			return err
+		}
+		reserved = append(reserved, r)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reserved, pageRes, nil
+}
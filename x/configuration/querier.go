@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/iov-one/starnamed/x/configuration/types"
 )
 
@@ -31,6 +32,34 @@ func (q grpcQuerier) Fees(c context.Context, req *types.QueryFeesRequest) (*type
 	}, nil
 }
 
+func (q grpcQuerier) ReservedNames(c context.Context, req *types.QueryReservedNamesRequest) (*types.QueryReservedNamesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	reserved, page, err := q.keeper.paginateReservedNames(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed pagination")
+	}
+	return &types.QueryReservedNamesResponse{
+		ReservedNames: reserved,
+		Pagination:    page,
+	}, nil
+}
+
+func (q grpcQuerier) ReservedName(c context.Context, req *types.QueryReservedNameRequest) (*types.QueryReservedNameResponse, error) {
+	if req == nil || req.Name == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty name")
+	}
+	reservation, ok := q.keeper.GetReservation(sdk.UnwrapSDKContext(c), req.Name)
+	if !ok {
+		return &types.QueryReservedNameResponse{}, nil
+	}
+	return &types.QueryReservedNameResponse{
+		Reserved:     true,
+		ReservedName: &reservation,
+	}, nil
+}
+
 func queryConfig(ctx sdk.Context, keeper Keeper) *types.Config {
 	config := keeper.GetConfiguration(ctx) // panics on failure
 	return &config
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers concrete types that will appear in
@@ -14,6 +15,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgUpdateFees{}, fmt.Sprintf("%s/MsgUpdateFees", ModuleName), nil)
 	cdc.RegisterConcrete(MsgUpdateConfig{}, fmt.Sprintf("%s/MsgUpdateConfig", ModuleName), nil)
+	cdc.RegisterConcrete(MsgUpdateReservedNames{}, fmt.Sprintf("%s/MsgUpdateReservedNames", ModuleName), nil)
+	cdc.RegisterConcrete(&ReservedNamesProposal{}, fmt.Sprintf("%s/ReservedNamesProposal", ModuleName), nil)
 }
 
 // RegisterInterfaces registers implementations on registry.
@@ -22,6 +25,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateConfig{},
 		&MsgUpdateFees{},
+		&MsgUpdateReservedNames{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ReservedNamesProposal{},
 	)
 }
 
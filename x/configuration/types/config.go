@@ -5,6 +5,8 @@ import (
 	"regexp"
 
 	"github.com/cosmos/cosmos-sdk/types"
+
+	starnametypes "github.com/iov-one/starnamed/x/starname/types"
 )
 
 // Validate validates the Config object.
@@ -36,18 +38,29 @@ func (c Config) Validate() error {
 	if _, err := regexp.Compile(c.ValidDomainName); err != nil {
 		return err
 	}
-	if c.EscrowMaxPeriod < 0 {
-		return fmt.Errorf("empty escrow maximum duration")
+	// the deprecated escrow settings are only read, and validated, by the escrow migration to its own parameters
+	if royaltyMax := c.GetEscrowRoyaltyMax(); royaltyMax.IsNegative() || royaltyMax.GT(types.OneDec()) {
+		return fmt.Errorf("invalid escrow royalty maximum: not in interval [0;1]")
 	}
-	if c.EscrowBroker == "" {
-		return fmt.Errorf("empty escrow broker")
+	if c.CommitmentMinDelay < 0 {
+		return fmt.Errorf("negative commitment minimum delay")
 	}
-	if _, err := types.AccAddressFromBech32(c.EscrowBroker); err != nil {
-		return fmt.Errorf("invalid escrow broker address : %s", err)
+	if c.CommitRevealEnabled && c.CommitmentMaxWindow <= c.CommitmentMinDelay {
+		return fmt.Errorf("commitment maximum window must be greater than the commitment minimum delay")
 	}
-	if c.EscrowCommission.LT(types.ZeroDec()) || c.EscrowCommission.GT(types.OneDec()) {
-		return fmt.Errorf("invalid escrow commission: not in interval [0;1]")
+	for _, script := range c.AllowedNameScripts {
+		if !starnametypes.IsNameScript(script) {
+			return fmt.Errorf("unknown name script %s", script)
+		}
 	}
 
 	return nil
 }
+
+// GetEscrowRoyaltyMax returns the maximum escrow royalty rate, zero if it is not set
+func (c Config) GetEscrowRoyaltyMax() types.Dec {
+	if c.EscrowRoyaltyMax.IsNil() {
+		return types.ZeroDec()
+	}
+	return c.EscrowRoyaltyMax
+}
@@ -21,6 +21,9 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Fees.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateReservedNames(data.ReservedNames); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -47,6 +50,10 @@ func DefaultGenesisState() GenesisState {
 		EscrowCommission:       sdk.NewDecFromInt(sdk.NewInt(1)).QuoInt(sdk.NewInt(100)), // 1%
 		EscrowBroker:           "star1nrnx8mft8mks3l2akduxdjlf8rwqs8r9l36a78", 					 // to IOV msig account
 		EscrowMaxPeriod:        7890000 * 1e9,                                 					 // 3 months
+		EscrowRoyaltyMax:       sdk.ZeroDec(),
+		CommitRevealEnabled:    false,
+		CommitmentMinDelay:     60 * 1e9,    // 1 minute
+		CommitmentMaxWindow:    86400 * 1e9, // 1 day
 	}
 	// set fees
 	// add domain module fees
@@ -69,3 +69,38 @@ func (m MsgUpdateFees) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{}
 }
+
+var _ sdk.Msg = (*MsgUpdateReservedNames)(nil)
+
+// Route implements sdk.Msg
+func (m MsgUpdateReservedNames) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m MsgUpdateReservedNames) Type() string { return "update_reserved_names" }
+
+// ValidateBasic implements sdk.Msg
+func (m MsgUpdateReservedNames) ValidateBasic() error {
+	if m.Signer == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no signer specified")
+	}
+	if len(m.Upsert) == 0 && len(m.Remove) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no reserved names to update")
+	}
+	if err := ValidateReservedNames(m.Upsert); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m MsgUpdateReservedNames) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgUpdateReservedNames) GetSigners() []sdk.AccAddress {
+	if signer, err := sdk.AccAddressFromBech32(m.Signer); err == nil {
+		return []sdk.AccAddress{signer}
+	}
+	return []sdk.AccAddress{}
+}
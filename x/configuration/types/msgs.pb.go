@@ -132,9 +132,74 @@ func (m *MsgUpdateFees) GetConfigurer() string {
 	return ""
 }
 
+// MsgUpdateReservedNames is used to add, update or remove reserved names
+type MsgUpdateReservedNames struct {
+	// Signer is the address of the entity who is doing the transaction
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// Upsert contains the reserved names to add or update
+	Upsert []ReservedName `protobuf:"bytes,2,rep,name=upsert,proto3" json:"upsert" yaml:"upsert"`
+	// Remove contains the reserved names to remove
+	Remove []ReservedName `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove" yaml:"remove"`
+}
+
+func (m *MsgUpdateReservedNames) Reset()         { *m = MsgUpdateReservedNames{} }
+func (m *MsgUpdateReservedNames) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateReservedNames) ProtoMessage()    {}
+func (*MsgUpdateReservedNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae93b9835c563ab6, []int{2}
+}
+func (m *MsgUpdateReservedNames) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateReservedNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateReservedNames.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateReservedNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateReservedNames.Merge(m, src)
+}
+func (m *MsgUpdateReservedNames) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateReservedNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateReservedNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateReservedNames proto.InternalMessageInfo
+
+func (m *MsgUpdateReservedNames) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUpdateReservedNames) GetUpsert() []ReservedName {
+	if m != nil {
+		return m.Upsert
+	}
+	return nil
+}
+
+func (m *MsgUpdateReservedNames) GetRemove() []ReservedName {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateConfig)(nil), "starnamed.x.configuration.v1beta1.MsgUpdateConfig")
 	proto.RegisterType((*MsgUpdateFees)(nil), "starnamed.x.configuration.v1beta1.MsgUpdateFees")
+	proto.RegisterType((*MsgUpdateReservedNames)(nil), "starnamed.x.configuration.v1beta1.MsgUpdateReservedNames")
 }
 
 func init() {
@@ -142,28 +207,32 @@ func init() {
 }
 
 var fileDescriptor_ae93b9835c563ab6 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0x2f, 0xd3,
-	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x2f, 0x2d, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0x2d, 0x4e, 0x2f, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x52, 0x2c, 0x2e, 0x49, 0x2c, 0xca, 0x4b, 0xcc, 0x4d, 0x4d, 0xd1, 0xab, 0xd0, 0x43, 0x51,
-	0xad, 0x07, 0x55, 0x2d, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xad, 0x0f, 0x62, 0x41, 0x34,
-	0x4a, 0xa9, 0xe2, 0x36, 0xbe, 0xa4, 0xb2, 0x20, 0x15, 0x6a, 0xbe, 0xd2, 0x36, 0x46, 0x2e, 0x7e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0x54, 0x67, 0xb0, 0x72, 0x21, 0x4d, 0x2e, 0xb6,
-	0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0xc1, 0x4f, 0xf7,
-	0xe4, 0x79, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x20, 0xe2, 0x4a, 0x41, 0x50, 0x05, 0x42, 0x15,
-	0x5c, 0x82, 0x79, 0xa9, 0xe5, 0xf1, 0x28, 0xf6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x69,
-	0xea, 0x11, 0x74, 0xba, 0x1e, 0xc4, 0x42, 0x27, 0x85, 0x13, 0xf7, 0xe4, 0x19, 0x3f, 0xdd, 0x93,
-	0x97, 0x80, 0x58, 0x82, 0x61, 0xa2, 0x52, 0x90, 0x40, 0x5e, 0x6a, 0xb9, 0x33, 0x8a, 0x50, 0x33,
-	0x23, 0x17, 0x2f, 0xdc, 0xe1, 0x6e, 0xa9, 0xa9, 0xc5, 0x42, 0xd6, 0x5c, 0x2c, 0x69, 0xa9, 0xa9,
-	0xc5, 0x60, 0x47, 0x73, 0x1b, 0xa9, 0x13, 0x61, 0x3d, 0x48, 0x5b, 0x10, 0x58, 0x93, 0x90, 0x29,
-	0x17, 0x17, 0x4c, 0x4d, 0x6a, 0x11, 0xd8, 0x07, 0x9c, 0x4e, 0xa2, 0x9f, 0xee, 0xc9, 0x0b, 0x42,
-	0x9c, 0x84, 0x90, 0x53, 0x0a, 0x42, 0x52, 0xe8, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0x99, 0xf9, 0x65, 0xba, 0xf9, 0x79, 0xa9, 0xfa, 0x70, 0x17, 0xe9, 0x57, 0xa0, 0x45, 0x0d, 0x38,
-	0x4a, 0x92, 0xd8, 0xc0, 0x71, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd0, 0xf0, 0x5e, 0x48,
-	0x1b, 0x02, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcf, 0x8a, 0xda, 0x50,
+	0x14, 0xc6, 0x73, 0xb5, 0x08, 0x5e, 0x91, 0xd6, 0x50, 0x4b, 0x70, 0x91, 0xa4, 0x97, 0x96, 0xea,
+	0xa2, 0xb9, 0x98, 0xd2, 0x4d, 0xbb, 0x8b, 0xd0, 0x55, 0xdb, 0x45, 0xa0, 0x9b, 0x2e, 0x66, 0x88,
+	0x7a, 0xcc, 0x04, 0x26, 0xb9, 0xe1, 0xde, 0x6b, 0xd4, 0xb5, 0x2f, 0x30, 0x4f, 0x33, 0xcf, 0xe0,
+	0xd2, 0xe5, 0xac, 0xc2, 0xa0, 0x6f, 0xe0, 0x13, 0x0c, 0x26, 0x19, 0xff, 0xcc, 0x30, 0x8c, 0x03,
+	0xb3, 0x0b, 0x27, 0xbf, 0xf3, 0xfb, 0xbe, 0x84, 0x83, 0x3f, 0x05, 0x2c, 0xa1, 0x03, 0x16, 0x8d,
+	0x02, 0x7f, 0xcc, 0x3d, 0x19, 0xb0, 0x88, 0x26, 0xdd, 0x3e, 0x48, 0xaf, 0x4b, 0x43, 0xe1, 0x0b,
+	0x2b, 0xe6, 0x4c, 0x32, 0xf5, 0xa3, 0x90, 0x1e, 0x8f, 0xbc, 0x10, 0x86, 0xd6, 0xd4, 0x3a, 0xa2,
+	0xad, 0x82, 0x6e, 0xbd, 0xf7, 0x99, 0xcf, 0x32, 0x9a, 0x6e, 0x9f, 0xf2, 0xc5, 0xd6, 0xe7, 0xa7,
+	0xf5, 0x72, 0x16, 0x43, 0xe1, 0x27, 0xd7, 0x08, 0xbf, 0xfd, 0x23, 0xfc, 0x7f, 0xf1, 0xd0, 0x93,
+	0xd0, 0xcb, 0x70, 0xb5, 0x83, 0x2b, 0x22, 0xf0, 0x23, 0xe0, 0x1a, 0x32, 0x51, 0xbb, 0xea, 0x34,
+	0x36, 0xa9, 0x51, 0x9f, 0x79, 0xe1, 0xe5, 0x0f, 0x92, 0xcf, 0x89, 0x5b, 0x00, 0xea, 0x14, 0x37,
+	0x22, 0x98, 0x9c, 0x1f, 0xe5, 0x68, 0x25, 0x13, 0xb5, 0x6b, 0x76, 0xc7, 0x7a, 0xb6, 0xba, 0x95,
+	0x07, 0x3a, 0xe6, 0x22, 0x35, 0xd0, 0x26, 0x35, 0xb4, 0x3c, 0xe4, 0x91, 0x91, 0xb8, 0xef, 0x22,
+	0x98, 0xf4, 0x8e, 0x46, 0x73, 0x84, 0xeb, 0xbb, 0xe2, 0xbf, 0x00, 0x84, 0xfa, 0x13, 0xbf, 0x19,
+	0x01, 0x88, 0xac, 0x74, 0xcd, 0xfe, 0x72, 0x42, 0xfc, 0x76, 0xcd, 0xcd, 0x96, 0xd4, 0xef, 0x18,
+	0xdf, 0x33, 0xc0, 0xb3, 0x2f, 0xa8, 0x3a, 0xcd, 0x4d, 0x6a, 0x34, 0xf2, 0x4a, 0xfb, 0x77, 0xc4,
+	0x3d, 0x00, 0xc9, 0xbc, 0x84, 0x3f, 0xec, 0x5a, 0xb8, 0x20, 0x80, 0x27, 0x30, 0xfc, 0xeb, 0x85,
+	0x20, 0x5e, 0xf2, 0x17, 0xcf, 0x70, 0x65, 0x1c, 0x0b, 0xe0, 0x52, 0x2b, 0x99, 0xe5, 0x76, 0xcd,
+	0xa6, 0x27, 0x74, 0x3f, 0x0c, 0x73, 0x9a, 0x8b, 0xd4, 0x50, 0xf6, 0xfe, 0x5c, 0x46, 0xdc, 0xc2,
+	0xba, 0xf5, 0x73, 0x08, 0x59, 0x02, 0x5a, 0xf9, 0x55, 0xfc, 0xb9, 0x8c, 0xb8, 0x85, 0xd5, 0xf9,
+	0xbd, 0x58, 0xe9, 0x68, 0xb9, 0xd2, 0xd1, 0xed, 0x4a, 0x47, 0x57, 0x6b, 0x5d, 0x59, 0xae, 0x75,
+	0xe5, 0x66, 0xad, 0x2b, 0xff, 0x6d, 0x3f, 0x90, 0x17, 0xe3, 0xbe, 0x35, 0x60, 0x21, 0x0d, 0x58,
+	0xf2, 0x95, 0x45, 0x40, 0x77, 0xd9, 0x74, 0xfa, 0xe0, 0x40, 0xb3, 0xc3, 0xec, 0x57, 0xb2, 0xcb,
+	0xfc, 0x76, 0x37, 0x00, 0x03, 0x88, 0xb0, 0x44, 0x21, 0x03, 0x00, 0x00,
 }
 
 func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
@@ -250,6 +319,64 @@ func (m *MsgUpdateFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateReservedNames) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateReservedNames) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateReservedNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remove[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Upsert) > 0 {
+		for iNdEx := len(m.Upsert) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upsert[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -295,6 +422,31 @@ func (m *MsgUpdateFees) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateReservedNames) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Upsert) > 0 {
+		for _, e := range m.Upsert {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, e := range m.Remove {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -537,6 +689,156 @@ func (m *MsgUpdateFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateReservedNames) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateReservedNames: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateReservedNames: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upsert = append(m.Upsert, ReservedName{})
+			if err := m.Upsert[len(m.Upsert)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, ReservedName{})
+			if err := m.Remove[len(m.Remove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,3 +30,12 @@ const (
 	// this is the only key we will need
 	FeeKey = "fee"
 )
+
+const (
+	// ReservedKeyPrefix defines the prefix of the reserved names and patterns
+	ReservedKeyPrefix = "reserved/"
+	// ReservedNameKeyPrefix defines the prefix of the reserved exact names
+	ReservedNameKeyPrefix = ReservedKeyPrefix + "name/"
+	// ReservedPatternKeyPrefix defines the prefix of the reserved name patterns
+	ReservedPatternKeyPrefix = ReservedKeyPrefix + "pattern/"
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeReservedNames defines the type of the reserved names proposal
+const ProposalTypeReservedNames = "ReservedNames"
+
+var _ govtypes.Content = (*ReservedNamesProposal)(nil)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReservedNames)
+}
+
+// GetTitle implements govtypes.Content
+func (p ReservedNamesProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p ReservedNamesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p ReservedNamesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p ReservedNamesProposal) ProposalType() string { return ProposalTypeReservedNames }
+
+// ValidateBasic implements govtypes.Content
+func (p ReservedNamesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	if len(p.Upsert) == 0 && len(p.Remove) == 0 {
+		return govtypes.ErrInvalidProposalContent.Wrap("no reserved names to update")
+	}
+	if err := ValidateReservedNames(p.Upsert); err != nil {
+		return govtypes.ErrInvalidProposalContent.Wrap(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: iov/configuration/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReservedNamesProposal is the governance proposal used to add, update or
+// remove reserved names
+type ReservedNamesProposal struct {
+	// Title is a short summary of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Upsert contains the reserved names to add or update
+	Upsert []ReservedName `protobuf:"bytes,3,rep,name=upsert,proto3" json:"upsert" yaml:"upsert"`
+	// Remove contains the reserved names to remove
+	Remove []ReservedName `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove" yaml:"remove"`
+}
+
+func (m *ReservedNamesProposal) Reset()         { *m = ReservedNamesProposal{} }
+func (m *ReservedNamesProposal) String() string { return proto.CompactTextString(m) }
+func (*ReservedNamesProposal) ProtoMessage()    {}
+func (*ReservedNamesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e621ec72fa8849, []int{0}
+}
+func (m *ReservedNamesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedNamesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedNamesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedNamesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedNamesProposal.Merge(m, src)
+}
+func (m *ReservedNamesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedNamesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedNamesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedNamesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ReservedNamesProposal)(nil), "starnamed.x.configuration.v1beta1.ReservedNamesProposal")
+}
+
+func init() {
+	proto.RegisterFile("iov/configuration/v1beta1/proposal.proto", fileDescriptor_88e621ec72fa8849)
+}
+
+var fileDescriptor_88e621ec72fa8849 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x41, 0x4a, 0xfb, 0x40,
+	0x14, 0xc6, 0x93, 0xf6, 0xff, 0x2f, 0x98, 0x2a, 0x48, 0xb0, 0x12, 0xba, 0x98, 0xd4, 0x80, 0xd2,
+	0x8d, 0x33, 0xb4, 0x6e, 0xc4, 0x65, 0x0f, 0x20, 0x12, 0x5c, 0xb9, 0x10, 0xa6, 0xed, 0x33, 0x0e,
+	0x34, 0x79, 0x61, 0x66, 0x1a, 0xda, 0x5b, 0x78, 0x0c, 0xaf, 0xe0, 0x0d, 0xba, 0xec, 0xd2, 0x55,
+	0xd0, 0xf4, 0x06, 0x3d, 0x81, 0x34, 0x13, 0x24, 0x0a, 0xe2, 0xc6, 0xdd, 0x30, 0xef, 0xfb, 0x7e,
+	0xdf, 0x7b, 0x7c, 0x4e, 0x5f, 0x60, 0xc6, 0x26, 0x98, 0x3c, 0x88, 0x68, 0x2e, 0xb9, 0x16, 0x98,
+	0xb0, 0x6c, 0x30, 0x06, 0xcd, 0x07, 0x2c, 0x95, 0x98, 0xa2, 0xe2, 0x33, 0x9a, 0x4a, 0xd4, 0xe8,
+	0x9e, 0x28, 0xcd, 0x65, 0xc2, 0x63, 0x98, 0xd2, 0x05, 0xfd, 0xe2, 0xa0, 0x95, 0xa3, 0x7b, 0x14,
+	0x61, 0x84, 0xa5, 0x9a, 0xed, 0x5e, 0xc6, 0xd8, 0x3d, 0xfd, 0x39, 0x42, 0x2f, 0x53, 0x50, 0x46,
+	0x16, 0xbc, 0x34, 0x9c, 0x4e, 0x08, 0x0a, 0x64, 0x06, 0xd3, 0x6b, 0x1e, 0x83, 0xba, 0xa9, 0xf2,
+	0xdd, 0x33, 0xe7, 0xbf, 0x16, 0x7a, 0x06, 0x9e, 0xdd, 0xb3, 0xfb, 0x7b, 0xa3, 0xc3, 0x6d, 0xee,
+	0xef, 0x2f, 0x79, 0x3c, 0xbb, 0x0a, 0xca, 0xef, 0x20, 0x34, 0x63, 0xf7, 0xd2, 0x69, 0x4f, 0x41,
+	0x4d, 0xa4, 0x48, 0x77, 0x21, 0x5e, 0xa3, 0x54, 0x1f, 0x6f, 0x73, 0xdf, 0x35, 0xea, 0xda, 0x30,
+	0x08, 0xeb, 0x52, 0xf7, 0xde, 0x69, 0xcd, 0x53, 0x05, 0x52, 0x7b, 0xcd, 0x5e, 0xb3, 0xdf, 0x1e,
+	0x32, 0xfa, 0xeb, 0xb1, 0xb4, 0xbe, 0xeb, 0xa8, 0xb3, 0xca, 0x7d, 0x6b, 0x9b, 0xfb, 0x07, 0x26,
+	0xc9, 0xc0, 0x82, 0xb0, 0xa2, 0xee, 0xf8, 0x12, 0x62, 0xcc, 0xc0, 0xfb, 0xf7, 0x27, 0x7c, 0x03,
+	0x0b, 0xc2, 0x8a, 0x3a, 0xba, 0x5d, 0xbd, 0x13, 0xeb, 0xb9, 0x20, 0xf6, 0xaa, 0x20, 0xf6, 0xba,
+	0x20, 0xf6, 0x5b, 0x41, 0xec, 0xa7, 0x0d, 0xb1, 0xd6, 0x1b, 0x62, 0xbd, 0x6e, 0x88, 0x75, 0x37,
+	0x8c, 0x84, 0x7e, 0x9c, 0x8f, 0xe9, 0x04, 0x63, 0x26, 0x30, 0x3b, 0xc7, 0x04, 0xd8, 0xe7, 0x0e,
+	0x6c, 0xf1, 0xad, 0x9f, 0xb2, 0x97, 0x71, 0xab, 0x2c, 0xe6, 0xe2, 0x63, 0x00, 0x53, 0xcd, 0x1c,
+	0x0d, 0x24, 0x02, 0x00, 0x00,
+}
+
+func (this *ReservedNamesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReservedNamesProposal)
+	if !ok {
+		that2, ok := that.(ReservedNamesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Upsert) != len(that1.Upsert) {
+		return false
+	}
+	for i := range this.Upsert {
+		if !this.Upsert[i].Equal(&that1.Upsert[i]) {
+			return false
+		}
+	}
+	if len(this.Remove) != len(that1.Remove) {
+		return false
+	}
+	for i := range this.Remove {
+		if !this.Remove[i].Equal(&that1.Remove[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *ReservedNamesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedNamesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedNamesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remove[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Upsert) > 0 {
+		for iNdEx := len(m.Upsert) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upsert[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReservedNamesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Upsert) > 0 {
+		for _, e := range m.Upsert {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, e := range m.Remove {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReservedNamesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedNamesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedNamesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upsert = append(m.Upsert, ReservedName{})
+			if err := m.Upsert[len(m.Upsert)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, ReservedName{})
+			if err := m.Remove[len(m.Remove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
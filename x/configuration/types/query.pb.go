@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -183,11 +184,177 @@ func (m *QueryFeesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFeesResponse proto.InternalMessageInfo
 
+// QueryReservedNamesRequest is the request type for the Query/ReservedNames
+// RPC method.
+type QueryReservedNamesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReservedNamesRequest) Reset()         { *m = QueryReservedNamesRequest{} }
+func (m *QueryReservedNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNamesRequest) ProtoMessage()    {}
+func (*QueryReservedNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c58aef036fc829a, []int{4}
+}
+func (m *QueryReservedNamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNamesRequest.Merge(m, src)
+}
+func (m *QueryReservedNamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservedNamesRequest proto.InternalMessageInfo
+
+// QueryReservedNamesResponse is the response type for the Query/ReservedNames
+// RPC method.
+type QueryReservedNamesResponse struct {
+	// ReservedNames contains the reserved names and patterns
+	ReservedNames []ReservedName      `protobuf:"bytes,1,rep,name=reserved_names,json=reservedNames,proto3" json:"reserved_names" yaml:"reserved_names"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReservedNamesResponse) Reset()         { *m = QueryReservedNamesResponse{} }
+func (m *QueryReservedNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNamesResponse) ProtoMessage()    {}
+func (*QueryReservedNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c58aef036fc829a, []int{5}
+}
+func (m *QueryReservedNamesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNamesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNamesResponse.Merge(m, src)
+}
+func (m *QueryReservedNamesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservedNamesResponse proto.InternalMessageInfo
+
+// QueryReservedNameRequest is the request type for the Query/ReservedName RPC
+// method.
+type QueryReservedNameRequest struct {
+	// Name is the domain or account name to check
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+}
+
+func (m *QueryReservedNameRequest) Reset()         { *m = QueryReservedNameRequest{} }
+func (m *QueryReservedNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNameRequest) ProtoMessage()    {}
+func (*QueryReservedNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c58aef036fc829a, []int{6}
+}
+func (m *QueryReservedNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNameRequest.Merge(m, src)
+}
+func (m *QueryReservedNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservedNameRequest proto.InternalMessageInfo
+
+// QueryReservedNameResponse is the response type for the Query/ReservedName
+// RPC method.
+type QueryReservedNameResponse struct {
+	// Reserved defines if the name is reserved
+	Reserved bool `protobuf:"varint,1,opt,name=reserved,proto3" json:"reserved,omitempty" yaml:"reserved"`
+	// ReservedName is the exact name or pattern that reserves the name
+	ReservedName *ReservedName `protobuf:"bytes,2,opt,name=reserved_name,json=reservedName,proto3" json:"reserved_name,omitempty" yaml:"reserved_name"`
+}
+
+func (m *QueryReservedNameResponse) Reset()         { *m = QueryReservedNameResponse{} }
+func (m *QueryReservedNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNameResponse) ProtoMessage()    {}
+func (*QueryReservedNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c58aef036fc829a, []int{7}
+}
+func (m *QueryReservedNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNameResponse.Merge(m, src)
+}
+func (m *QueryReservedNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservedNameResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryConfigRequest)(nil), "starnamed.x.configuration.v1beta1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "starnamed.x.configuration.v1beta1.QueryConfigResponse")
 	proto.RegisterType((*QueryFeesRequest)(nil), "starnamed.x.configuration.v1beta1.QueryFeesRequest")
 	proto.RegisterType((*QueryFeesResponse)(nil), "starnamed.x.configuration.v1beta1.QueryFeesResponse")
+	proto.RegisterType((*QueryReservedNamesRequest)(nil), "starnamed.x.configuration.v1beta1.QueryReservedNamesRequest")
+	proto.RegisterType((*QueryReservedNamesResponse)(nil), "starnamed.x.configuration.v1beta1.QueryReservedNamesResponse")
+	proto.RegisterType((*QueryReservedNameRequest)(nil), "starnamed.x.configuration.v1beta1.QueryReservedNameRequest")
+	proto.RegisterType((*QueryReservedNameResponse)(nil), "starnamed.x.configuration.v1beta1.QueryReservedNameResponse")
 }
 
 func init() {
@@ -195,32 +362,49 @@ func init() {
 }
 
 var fileDescriptor_7c58aef036fc829a = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xcc, 0x2f, 0xd3,
-	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x2f, 0x2d, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x52, 0x2c, 0x2e, 0x49, 0x2c, 0xca, 0x4b, 0xcc, 0x4d, 0x4d, 0xd1, 0xab, 0xd0, 0x43,
-	0x51, 0xae, 0x07, 0x55, 0x2e, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xad, 0x0f, 0x62, 0x41,
-	0x34, 0x4a, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6,
-	0xe5, 0xe5, 0x97, 0x80, 0x35, 0x15, 0x43, 0x65, 0xf1, 0xd8, 0x5e, 0x52, 0x59, 0x90, 0x0a, 0x55,
-	0xa6, 0x24, 0xc2, 0x25, 0x14, 0x08, 0x72, 0x8c, 0x33, 0x58, 0x65, 0x50, 0x6a, 0x61, 0x69, 0x6a,
-	0x71, 0x89, 0x52, 0x36, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x50, 0x08,
-	0x17, 0x1b, 0xc4, 0x44, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0x82, 0x6e, 0xd7,
-	0x83, 0x18, 0xe1, 0x24, 0xf8, 0xe9, 0x9e, 0x3c, 0x6f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x44,
-	0x9d, 0x52, 0x10, 0xd4, 0x2c, 0x25, 0x21, 0x2e, 0x01, 0xb0, 0x65, 0x6e, 0xa9, 0xa9, 0xc5, 0x30,
-	0x07, 0x24, 0x72, 0x09, 0x22, 0x89, 0x41, 0xad, 0xf7, 0xe1, 0x62, 0x49, 0x4b, 0x4d, 0x2d, 0x86,
-	0x5a, 0xae, 0x4e, 0x84, 0xe5, 0x20, 0xed, 0x4e, 0xfc, 0x9f, 0xee, 0xc9, 0x73, 0x43, 0xac, 0x06,
-	0x69, 0x57, 0x0a, 0x02, 0x9b, 0x62, 0x74, 0x99, 0x89, 0x8b, 0x15, 0x6c, 0x87, 0xd0, 0x72, 0x46,
-	0x2e, 0x36, 0x88, 0x33, 0x85, 0x4c, 0x89, 0x30, 0x14, 0x33, 0xbc, 0xa4, 0xcc, 0x48, 0xd5, 0x06,
-	0xf1, 0x91, 0x92, 0x5e, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x34, 0x84, 0xd4, 0xf4, 0x61, 0xfa, 0xe1,
-	0x91, 0x84, 0x1a, 0x75, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0xf3, 0x19, 0xb9, 0x58, 0x40,
-	0x7e, 0x12, 0x32, 0x26, 0xd6, 0x42, 0xa4, 0x40, 0x95, 0x32, 0x21, 0x4d, 0x13, 0xd4, 0x8d, 0x3a,
-	0x60, 0x37, 0xaa, 0x09, 0xa9, 0x10, 0x72, 0x23, 0x28, 0x54, 0x9d, 0x42, 0x4e, 0x3c, 0x94, 0x63,
-	0x58, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c,
-	0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x33, 0xf3, 0xcb, 0x74, 0xf3,
-	0xf3, 0x52, 0xe1, 0x26, 0xa7, 0xe8, 0x57, 0xa0, 0x19, 0x0a, 0x4e, 0xab, 0x49, 0x6c, 0xe0, 0xc4,
-	0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xd5, 0xe0, 0xdb, 0x53, 0x03, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4e, 0x13, 0x41,
+	0x1c, 0xef, 0x62, 0x21, 0x38, 0x50, 0x91, 0x01, 0x13, 0x6c, 0x74, 0x57, 0x47, 0x45, 0x34, 0x32,
+	0x23, 0x45, 0x30, 0x41, 0x88, 0x49, 0x4d, 0xf0, 0x62, 0x8c, 0x6e, 0x38, 0x79, 0x31, 0x53, 0x18,
+	0xd6, 0x8d, 0x74, 0xa7, 0xec, 0x6c, 0x1b, 0x88, 0xf1, 0xe2, 0x13, 0x98, 0xf8, 0x00, 0x7a, 0xd3,
+	0x87, 0xf0, 0xe8, 0xa1, 0x47, 0x12, 0x2f, 0x9e, 0x1a, 0x2d, 0x9a, 0x78, 0xee, 0x13, 0x98, 0xf9,
+	0xd8, 0x75, 0x57, 0x1a, 0xfb, 0x71, 0x82, 0xec, 0xfc, 0x7f, 0x9f, 0xb3, 0xff, 0x2e, 0xb8, 0xe6,
+	0xf3, 0x06, 0xd9, 0xe6, 0xc1, 0xae, 0xef, 0xd5, 0x43, 0x1a, 0xf9, 0x3c, 0x20, 0x8d, 0xa5, 0x0a,
+	0x8b, 0xe8, 0x12, 0xd9, 0xaf, 0xb3, 0xf0, 0x10, 0xd7, 0x42, 0x1e, 0x71, 0x78, 0x59, 0x44, 0x34,
+	0x0c, 0x68, 0x95, 0xed, 0xe0, 0x03, 0x9c, 0x19, 0xc7, 0x66, 0xbc, 0x38, 0xeb, 0x71, 0x8f, 0xab,
+	0x69, 0x22, 0xff, 0xd3, 0xc0, 0xe2, 0x05, 0x8f, 0x73, 0x6f, 0x8f, 0x11, 0x5a, 0xf3, 0x09, 0x0d,
+	0x02, 0x1e, 0x29, 0x90, 0x30, 0xa7, 0x37, 0xb7, 0xb9, 0xa8, 0x72, 0x41, 0x2a, 0x54, 0x30, 0xad,
+	0x97, 0xa8, 0xd7, 0xa8, 0xe7, 0x07, 0x5a, 0x41, 0xcf, 0xfe, 0xc7, 0x69, 0x74, 0x58, 0x63, 0x86,
+	0x12, 0xcd, 0x02, 0xf8, 0x54, 0x12, 0x3d, 0x50, 0x93, 0x2e, 0xdb, 0xaf, 0x33, 0x11, 0xa1, 0x97,
+	0x60, 0x26, 0xf3, 0x54, 0xd4, 0x78, 0x20, 0x18, 0xdc, 0x02, 0x63, 0x9a, 0x71, 0xce, 0xba, 0x64,
+	0x2d, 0x4c, 0x94, 0x6e, 0xe0, 0x9e, 0x39, 0xb1, 0xa6, 0x28, 0x4f, 0x77, 0x5a, 0x4e, 0xe1, 0x90,
+	0x56, 0xf7, 0xd6, 0x90, 0x9e, 0x43, 0xae, 0xe1, 0x42, 0x10, 0x9c, 0x55, 0x62, 0x9b, 0x8c, 0x89,
+	0xd8, 0x00, 0x05, 0xd3, 0xa9, 0x67, 0x46, 0xfe, 0x11, 0xc8, 0xef, 0x32, 0x26, 0x8c, 0xf8, 0xf5,
+	0x3e, 0xc4, 0x25, 0xbc, 0x3c, 0xd5, 0x69, 0x39, 0x13, 0x5a, 0x5a, 0xc2, 0x91, 0xab, 0x58, 0x90,
+	0x0f, 0xce, 0x2b, 0x09, 0x97, 0x09, 0x16, 0x36, 0xd8, 0xce, 0x63, 0x5a, 0x4d, 0xf4, 0xe1, 0x26,
+	0x00, 0x7f, 0x1b, 0x35, 0x82, 0xf3, 0x58, 0xd7, 0x8f, 0x65, 0xfd, 0x58, 0x5f, 0x77, 0x2c, 0xf4,
+	0x84, 0x7a, 0xcc, 0x60, 0xdd, 0x14, 0x72, 0x2d, 0xff, 0xfb, 0x83, 0x93, 0x43, 0x6d, 0x0b, 0x14,
+	0xbb, 0x69, 0x99, 0x5c, 0x75, 0x70, 0x26, 0x34, 0x07, 0xcf, 0x65, 0x1e, 0x99, 0xf0, 0xd4, 0xc2,
+	0x44, 0x89, 0xf4, 0x91, 0x30, 0xcd, 0x58, 0xbe, 0xd8, 0x6c, 0x39, 0xb9, 0x4e, 0xcb, 0x39, 0xa7,
+	0xd3, 0x66, 0x49, 0x91, 0x5b, 0x08, 0xd3, 0xf2, 0xf0, 0x61, 0x26, 0xe3, 0x88, 0x29, 0xb5, 0x57,
+	0x46, 0xed, 0xb9, 0x4b, 0xc8, 0xfb, 0x60, 0xee, 0x44, 0xc6, 0xb8, 0xce, 0x2b, 0x20, 0x2f, 0x3d,
+	0xa8, 0x22, 0x4f, 0xa7, 0x2f, 0x44, 0x3e, 0x45, 0xae, 0x3a, 0x44, 0x9f, 0xad, 0x2e, 0x37, 0x92,
+	0x94, 0x44, 0xc0, 0x78, 0x6c, 0x5f, 0xd1, 0x8c, 0x97, 0x67, 0x3a, 0x2d, 0x67, 0x2a, 0x9b, 0x14,
+	0xb9, 0xc9, 0x10, 0x0c, 0x40, 0x21, 0x53, 0x80, 0x49, 0x38, 0x70, 0xa9, 0x73, 0x9d, 0x96, 0x33,
+	0xdb, 0xa5, 0x50, 0xe4, 0x4e, 0xa6, 0xfb, 0x2c, 0xfd, 0x1a, 0x05, 0xa3, 0xca, 0x3e, 0xfc, 0x68,
+	0x81, 0x31, 0xfd, 0xda, 0xc3, 0x95, 0x3e, 0xd4, 0x4e, 0xee, 0x5f, 0x71, 0x75, 0x50, 0x98, 0x2e,
+	0x09, 0xe1, 0x37, 0x5f, 0x7f, 0xbe, 0x1b, 0x59, 0x80, 0xf3, 0x24, 0xc6, 0x27, 0x4b, 0x9f, 0xfd,
+	0x29, 0xa8, 0xd1, 0x90, 0x56, 0x05, 0x7c, 0x6f, 0x81, 0xbc, 0xdc, 0x11, 0xb8, 0xdc, 0xaf, 0x60,
+	0x6a, 0x49, 0x8b, 0x77, 0x06, 0x03, 0x19, 0x8f, 0xb7, 0x94, 0xc7, 0x79, 0x78, 0xb5, 0x97, 0x47,
+	0xb9, 0xa5, 0xf0, 0x8b, 0x05, 0x0a, 0x99, 0xad, 0x81, 0xeb, 0xfd, 0xaa, 0x76, 0x5b, 0xec, 0xe2,
+	0xc6, 0x90, 0x68, 0x63, 0x7e, 0x55, 0x99, 0xbf, 0x0d, 0x71, 0x2f, 0xf3, 0xf1, 0xab, 0xb1, 0x18,
+	0x28, 0xd3, 0x4d, 0x0b, 0x4c, 0xa6, 0x19, 0xe1, 0xbd, 0x61, 0x7c, 0xc4, 0x21, 0xd6, 0x87, 0x03,
+	0x9b, 0x0c, 0x1b, 0x2a, 0xc3, 0x5d, 0xb8, 0x32, 0x58, 0x06, 0xf2, 0x4a, 0xfe, 0x79, 0x5d, 0xde,
+	0x6a, 0xfe, 0xb0, 0x73, 0x9f, 0xda, 0xb6, 0xd5, 0x6c, 0xdb, 0xd6, 0x51, 0xdb, 0xb6, 0xbe, 0xb7,
+	0x6d, 0xeb, 0xed, 0xb1, 0x9d, 0x3b, 0x3a, 0xb6, 0x73, 0xdf, 0x8e, 0xed, 0xdc, 0xb3, 0x92, 0xe7,
+	0x47, 0x2f, 0xea, 0x15, 0xbc, 0xcd, 0xab, 0xc4, 0xe7, 0x8d, 0x45, 0x1e, 0xb0, 0x44, 0x6a, 0x87,
+	0x1c, 0xfc, 0xa3, 0xa2, 0xbe, 0x46, 0x95, 0x31, 0xf5, 0x39, 0x5a, 0xfe, 0x33, 0x00, 0x67, 0xa5,
+	0xc7, 0xbd, 0x61, 0x07, 0x00, 0x00,
 }
 
 func (this *QueryConfigRequest) Equal(that interface{}) bool {
@@ -313,6 +497,57 @@ func (this *QueryFeesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueryReservedNameRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryReservedNameRequest)
+	if !ok {
+		that2, ok := that.(QueryReservedNameRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *QueryReservedNameResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryReservedNameResponse)
+	if !ok {
+		that2, ok := that.(QueryReservedNameResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reserved != that1.Reserved {
+		return false
+	}
+	if !this.ReservedName.Equal(that1.ReservedName) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -330,6 +565,10 @@ type QueryClient interface {
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
 	// Fees gets starname product fees.
 	Fees(ctx context.Context, in *QueryFeesRequest, opts ...grpc.CallOption) (*QueryFeesResponse, error)
+	// ReservedNames gets the reserved names and patterns.
+	ReservedNames(ctx context.Context, in *QueryReservedNamesRequest, opts ...grpc.CallOption) (*QueryReservedNamesResponse, error)
+	// ReservedName checks if a name is reserved.
+	ReservedName(ctx context.Context, in *QueryReservedNameRequest, opts ...grpc.CallOption) (*QueryReservedNameResponse, error)
 }
 
 type queryClient struct {
@@ -358,12 +597,34 @@ func (c *queryClient) Fees(ctx context.Context, in *QueryFeesRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) ReservedNames(ctx context.Context, in *QueryReservedNamesRequest, opts ...grpc.CallOption) (*QueryReservedNamesResponse, error) {
+	out := new(QueryReservedNamesResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.configuration.v1beta1.Query/ReservedNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReservedName(ctx context.Context, in *QueryReservedNameRequest, opts ...grpc.CallOption) (*QueryReservedNameResponse, error) {
+	out := new(QueryReservedNameResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.configuration.v1beta1.Query/ReservedName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Config gets starname configuration.
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
 	// Fees gets starname product fees.
 	Fees(context.Context, *QueryFeesRequest) (*QueryFeesResponse, error)
+	// ReservedNames gets the reserved names and patterns.
+	ReservedNames(context.Context, *QueryReservedNamesRequest) (*QueryReservedNamesResponse, error)
+	// ReservedName checks if a name is reserved.
+	ReservedName(context.Context, *QueryReservedNameRequest) (*QueryReservedNameResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -376,6 +637,12 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 func (*UnimplementedQueryServer) Fees(ctx context.Context, req *QueryFeesRequest) (*QueryFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Fees not implemented")
 }
+func (*UnimplementedQueryServer) ReservedNames(ctx context.Context, req *QueryReservedNamesRequest) (*QueryReservedNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservedNames not implemented")
+}
+func (*UnimplementedQueryServer) ReservedName(ctx context.Context, req *QueryReservedNameRequest) (*QueryReservedNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservedName not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -417,6 +684,42 @@ func _Query_Fees_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReservedNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservedNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReservedNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.configuration.v1beta1.Query/ReservedNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReservedNames(ctx, req.(*QueryReservedNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReservedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservedNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReservedName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.configuration.v1beta1.Query/ReservedName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReservedName(ctx, req.(*QueryReservedNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "starnamed.x.configuration.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -429,6 +732,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Fees",
 			Handler:    _Query_Fees_Handler,
 		},
+		{
+			MethodName: "ReservedNames",
+			Handler:    _Query_ReservedNames_Handler,
+		},
+		{
+			MethodName: "ReservedName",
+			Handler:    _Query_ReservedName_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iov/configuration/v1beta1/query.proto",
@@ -550,62 +861,282 @@ func (m *QueryFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryReservedNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryReservedNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReservedNamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReservedNamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReservedNamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Fees != nil {
-		l = m.Fees.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ReservedNames) > 0 {
+		for iNdEx := len(m.ReservedNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryReservedNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservedNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservedNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservedNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReservedName != nil {
+		{
+			size, err := m.ReservedName.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reserved {
+		i--
+		if m.Reserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservedNamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservedNamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReservedNames) > 0 {
+		for _, e := range m.ReservedNames {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservedNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservedNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reserved {
+		n += 2
+	}
+	if m.ReservedName != nil {
+		l = m.ReservedName.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -883,6 +1414,400 @@ func (m *QueryFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReservedNamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservedNamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedNames = append(m.ReservedNames, ReservedName{})
+			if err := m.ReservedNames[len(m.ReservedNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservedNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservedNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reserved = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReservedName == nil {
+				m.ReservedName = &ReservedName{}
+			}
+			if err := m.ReservedName.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
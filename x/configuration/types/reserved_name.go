@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the ReservedName object.
+func (r ReservedName) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("empty reserved name")
+	}
+	if r.Pattern {
+		if _, err := regexp.Compile(r.Name); err != nil {
+			return fmt.Errorf("invalid reserved name pattern %s: %s", r.Name, err)
+		}
+	}
+	if r.Claimant != "" {
+		if _, err := sdk.AccAddressFromBech32(r.Claimant); err != nil {
+			return fmt.Errorf("invalid reserved name claimant %s: %s", r.Claimant, err)
+		}
+	}
+	return nil
+}
+
+// Key returns the store key of the reserved name
+func (r ReservedName) Key() []byte {
+	if r.Pattern {
+		return []byte(ReservedPatternKeyPrefix + r.Name)
+	}
+	return []byte(ReservedNameKeyPrefix + r.Name)
+}
+
+// Matches checks if the provided name is reserved by r
+func (r ReservedName) Matches(name string) bool {
+	if !r.Pattern {
+		return r.Name == name
+	}
+	// patterns are validated before being saved
+	return regexp.MustCompile(r.Name).MatchString(name)
+}
+
+// IsClaimableBy checks if the provided address is allowed to register the reserved name
+func (r ReservedName) IsClaimableBy(addr sdk.AccAddress) bool {
+	return r.Claimant != "" && r.Claimant == addr.String()
+}
+
+// ValidateReservedNames validates a list of reserved names and makes sure it contains no duplicates
+func ValidateReservedNames(reserved []ReservedName) error {
+	keys := make(map[string]struct{}, len(reserved))
+	for _, r := range reserved {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		key := string(r.Key())
+		if _, ok := keys[key]; ok {
+			return fmt.Errorf("duplicate reserved name %s", r.Name)
+		}
+		keys[key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import "testing"
+
+func TestValidateReservedNames(t *testing.T) {
+	cases := map[string]struct {
+		reserved []ReservedName
+		wantErr  bool
+	}{
+		"valid": {
+			reserved: []ReservedName{{Name: "iov"}, {Name: "iov", Pattern: true}, {Name: "^bank.*$", Pattern: true}},
+		},
+		"empty name": {
+			reserved: []ReservedName{{Name: ""}},
+			wantErr:  true,
+		},
+		"invalid pattern": {
+			reserved: []ReservedName{{Name: "^(bank$", Pattern: true}},
+			wantErr:  true,
+		},
+		"invalid claimant": {
+			reserved: []ReservedName{{Name: "iov", Claimant: "invalid"}},
+			wantErr:  true,
+		},
+		"duplicate": {
+			reserved: []ReservedName{{Name: "iov"}, {Name: "iov"}},
+			wantErr:  true,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := ValidateReservedNames(c.reserved); (err != nil) != c.wantErr {
+				t.Fatalf("ValidateReservedNames() error = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
@@ -73,12 +73,32 @@ type Config struct {
 	MetadataSizeMax uint64 `protobuf:"varint,15,opt,name=metadata_size_max,json=metadataSizeMax,proto3" json:"metadata_size_max,omitempty" yaml:"metadata_size_max"`
 	// EscrowBroker defines an address that will receive a commission for
 	// completed escrows
+	// Deprecated: moved to the escrow module parameters, only read by the escrow
+	// store migration to version 3
 	EscrowBroker string `protobuf:"bytes,16,opt,name=escrow_broker,json=escrowBroker,proto3" json:"escrow_broker,omitempty" yaml:"escrow_broker"`
 	// EscrowCommission defines the commission taken by the broker for a completed
 	// escrow, between 0 (no commission) and 1 (100% commission)
+	// Deprecated: moved to the escrow module parameters
 	EscrowCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=escrow_commission,json=escrowCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"escrow_commission" yaml:"escrow_commission"`
 	// EscrowPeriod defines the maximum duration of an escrow in seconds
+	// Deprecated: moved to the escrow module parameters
 	EscrowMaxPeriod time.Duration `protobuf:"bytes,18,opt,name=escrow_max_period,json=escrowMaxPeriod,proto3,stdduration" json:"escrow_max_period" yaml:"escrow_max_period"`
+	// CommitRevealEnabled defines if domain and open domain account
+	// registrations must be preceded by a registration commitment
+	CommitRevealEnabled bool `protobuf:"varint,19,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty" yaml:"commit_reveal_enabled"`
+	// CommitmentMinDelay defines the minimum duration between a registration
+	// commitment and its reveal
+	CommitmentMinDelay time.Duration `protobuf:"bytes,20,opt,name=commitment_min_delay,json=commitmentMinDelay,proto3,stdduration" json:"commitment_min_delay" yaml:"commitment_min_delay"`
+	// CommitmentMaxWindow defines the duration after which a registration
+	// commitment that has not been revealed expires
+	CommitmentMaxWindow time.Duration `protobuf:"bytes,21,opt,name=commitment_max_window,json=commitmentMaxWindow,proto3,stdduration" json:"commitment_max_window" yaml:"commitment_max_window"`
+	// AllowedNameScripts defines the unicode scripts, such as Latin or Greek,
+	// the letters of domain and account names can be written in, any script is
+	// allowed if empty
+	AllowedNameScripts []string `protobuf:"bytes,22,rep,name=allowed_name_scripts,json=allowedNameScripts,proto3" json:"allowed_name_scripts,omitempty" yaml:"allowed_name_scripts"`
+	// EscrowRoyaltyMax defines the maximum royalty rate domain admins can take
+	// on the escrow sales of the accounts of their domain
+	EscrowRoyaltyMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=escrow_royalty_max,json=escrowRoyaltyMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"escrow_royalty_max" yaml:"escrow_royalty_max"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -233,6 +253,34 @@ func (m *Config) GetEscrowMaxPeriod() time.Duration {
 	return 0
 }
 
+func (m *Config) GetCommitRevealEnabled() bool {
+	if m != nil {
+		return m.CommitRevealEnabled
+	}
+	return false
+}
+
+func (m *Config) GetCommitmentMinDelay() time.Duration {
+	if m != nil {
+		return m.CommitmentMinDelay
+	}
+	return 0
+}
+
+func (m *Config) GetCommitmentMaxWindow() time.Duration {
+	if m != nil {
+		return m.CommitmentMaxWindow
+	}
+	return 0
+}
+
+func (m *Config) GetAllowedNameScripts() []string {
+	if m != nil {
+		return m.AllowedNameScripts
+	}
+	return nil
+}
+
 // Fees contains different type of fees to calculate coins to detract when
 // processing different messages
 type Fees struct {
@@ -293,13 +341,17 @@ type Fees struct {
 	// renew_domain_open is the fee to be paid to renew an open domain
 	RenewDomainOpen github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=renew_domain_open,json=renewDomainOpen,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"renew_domain_open" yaml:"renew_domain_open"`
 	// create_escrow is the fee to be paid to create an escrow
+	// Deprecated: the escrow fees are moved to the escrow module parameters
 	CreateEscrow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=create_escrow,json=createEscrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"create_escrow" yaml:"create_escrow"`
 	// update_escrow is the fee to be paid to update an escrow
+	// Deprecated: the escrow fees are moved to the escrow module parameters
 	UpdateEscrow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=update_escrow,json=updateEscrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"update_escrow" yaml:"update_escrow"`
 	// transfer_to_escrow is the fee to be paid to transfer coins to an escrow
+	// Deprecated: the escrow fees are moved to the escrow module parameters
 	TransferToEscrow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=transfer_to_escrow,json=transferToEscrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transfer_to_escrow" yaml:"transfer_to_escrow"`
 	// refund_escrow is the fee to be paid to refund the account or domain placed
 	// in an escrow
+	// Deprecated: the escrow fees are moved to the escrow module parameters
 	RefundEscrow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=refund_escrow,json=refundEscrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"refund_escrow" yaml:"refund_escrow"`
 }
 
@@ -347,6 +399,8 @@ func (m *Fees) GetFeeCoinDenom() string {
 type GenesisState struct {
 	Config Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config" yaml:"config"`
 	Fees   Fees   `protobuf:"bytes,2,opt,name=fees,proto3" json:"fees" yaml:"fees"`
+	// ReservedNames contains the names that are protected from registration
+	ReservedNames []ReservedName `protobuf:"bytes,3,rep,name=reserved_names,json=reservedNames,proto3" json:"reserved_names" yaml:"reserved_names"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -396,10 +450,84 @@ func (m *GenesisState) GetFees() Fees {
 	return Fees{}
 }
 
+func (m *GenesisState) GetReservedNames() []ReservedName {
+	if m != nil {
+		return m.ReservedNames
+	}
+	return nil
+}
+
+// ReservedName is a name, or a pattern of names, that can not be registered
+// as a domain or as an account in an open domain unless the registrant is the
+// allowed claimant
+type ReservedName struct {
+	// Name is the reserved name, or a regexp if Pattern is true
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Pattern defines if Name is a regexp matching the reserved names
+	Pattern bool `protobuf:"varint,2,opt,name=pattern,proto3" json:"pattern,omitempty" yaml:"pattern"`
+	// Claimant is the optional address allowed to register the reserved name
+	Claimant string `protobuf:"bytes,3,opt,name=claimant,proto3" json:"claimant,omitempty" yaml:"claimant"`
+}
+
+func (m *ReservedName) Reset()         { *m = ReservedName{} }
+func (m *ReservedName) String() string { return proto.CompactTextString(m) }
+func (*ReservedName) ProtoMessage()    {}
+func (*ReservedName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67b15c914656dc9a, []int{3}
+}
+func (m *ReservedName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedName.Merge(m, src)
+}
+func (m *ReservedName) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedName) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedName proto.InternalMessageInfo
+
+func (m *ReservedName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReservedName) GetPattern() bool {
+	if m != nil {
+		return m.Pattern
+	}
+	return false
+}
+
+func (m *ReservedName) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "starnamed.x.configuration.v1beta1.Config")
 	proto.RegisterType((*Fees)(nil), "starnamed.x.configuration.v1beta1.Fees")
 	proto.RegisterType((*GenesisState)(nil), "starnamed.x.configuration.v1beta1.GenesisState")
+	proto.RegisterType((*ReservedName)(nil), "starnamed.x.configuration.v1beta1.ReservedName")
 }
 
 func init() {
@@ -407,101 +535,117 @@ func init() {
 }
 
 var fileDescriptor_67b15c914656dc9a = []byte{
-	// 1495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x42, 0x48, 0x9b, 0x89, 0xd3, 0xc4, 0x1b, 0x3b, 0xb5, 0x4b, 0xea, 0x35, 0x43, 0x69,
-	0xd3, 0x43, 0x6d, 0xc5, 0x49, 0x2e, 0x48, 0x15, 0xd4, 0x09, 0x6d, 0xa1, 0x4a, 0x1b, 0xa6, 0x2d,
-	0xaa, 0x10, 0xc8, 0xda, 0xec, 0x8e, 0xcd, 0x2a, 0xde, 0x1d, 0xb3, 0xbb, 0x4e, 0xd2, 0x5e, 0x2a,
-	0x21, 0x21, 0xc1, 0x05, 0xc1, 0x8d, 0x1b, 0x57, 0x7e, 0x03, 0xbf, 0xa0, 0x12, 0x97, 0x1e, 0x11,
-	0x07, 0x83, 0xd2, 0x7f, 0xe0, 0x5f, 0x80, 0xf6, 0x9d, 0x99, 0xfd, 0xf0, 0xae, 0x15, 0x2c, 0xe7,
-	0x94, 0xcc, 0xfb, 0xf1, 0x3c, 0xcf, 0xbc, 0x33, 0x9e, 0x77, 0x66, 0xd1, 0x07, 0x16, 0x3b, 0xaa,
-	0x1b, 0xcc, 0x69, 0x5b, 0x9d, 0xbe, 0xab, 0xfb, 0x16, 0x73, 0xea, 0x47, 0x1b, 0x07, 0xd4, 0xd7,
-	0x37, 0xea, 0xfe, 0xf3, 0x1e, 0xf5, 0x6a, 0x3d, 0x97, 0xf9, 0x4c, 0x7d, 0xcf, 0xf3, 0x75, 0xd7,
-	0xd1, 0x6d, 0x6a, 0xd6, 0x4e, 0x6a, 0x89, 0xf0, 0x9a, 0x08, 0xbf, 0x52, 0xe8, 0xb0, 0x0e, 0x83,
-	0xe8, 0x7a, 0xf0, 0x1f, 0x4f, 0xbc, 0x52, 0xe9, 0x30, 0xd6, 0xe9, 0xd2, 0x3a, 0x8c, 0x0e, 0xfa,
-	0xed, 0xba, 0x29, 0xf3, 0xc0, 0x82, 0xff, 0xcc, 0xa1, 0xb9, 0x1d, 0xc0, 0x53, 0xb7, 0x11, 0x92,
-	0xc8, 0xd4, 0x2d, 0x29, 0x55, 0x65, 0x7d, 0xbe, 0x59, 0x1c, 0x0e, 0xb4, 0xfc, 0x73, 0xdd, 0xee,
-	0x7e, 0x88, 0x23, 0x1f, 0x26, 0xb1, 0x40, 0xf5, 0x3e, 0xca, 0x1f, 0xe9, 0x5d, 0xcb, 0x6c, 0x99,
-	0xcc, 0xd6, 0x2d, 0xa7, 0x15, 0xa8, 0x2c, 0xbd, 0x05, 0xd9, 0x6b, 0xc3, 0x81, 0x56, 0xe2, 0xd9,
-	0xa9, 0x10, 0x4c, 0x96, 0xc0, 0xb6, 0x0b, 0xa6, 0x87, 0xba, 0x4d, 0xd5, 0x07, 0x48, 0xe5, 0x61,
-	0xba, 0x61, 0xb0, 0xbe, 0xe3, 0x73, 0xa8, 0xb7, 0x01, 0xea, 0xea, 0x70, 0xa0, 0x95, 0xe3, 0x50,
-	0xf1, 0x18, 0x4c, 0x96, 0xc1, 0x78, 0x87, 0xdb, 0x00, 0xec, 0x36, 0x9a, 0xe7, 0x81, 0x7d, 0xd7,
-	0x2a, 0xcd, 0x02, 0x46, 0xf5, 0x74, 0xa0, 0x5d, 0xfc, 0x22, 0x30, 0x3e, 0x25, 0x9f, 0x0e, 0x07,
-	0xda, 0x72, 0x1c, 0xaf, 0xef, 0x5a, 0x98, 0x5c, 0x84, 0xff, 0x9f, 0xba, 0x96, 0xfa, 0x31, 0xba,
-	0xc4, 0xed, 0x2e, 0xf5, 0x58, 0xdf, 0x35, 0x68, 0xe9, 0x1d, 0xc0, 0x28, 0x0f, 0x07, 0x5a, 0x31,
-	0x9e, 0x27, 0xfd, 0x98, 0x2c, 0x82, 0x81, 0x88, 0xb1, 0x7a, 0x8c, 0x8a, 0x62, 0xba, 0x2e, 0x75,
-	0xe8, 0xb1, 0xde, 0x6d, 0xf5, 0xa8, 0x6b, 0x31, 0xb3, 0x34, 0x57, 0x55, 0xd6, 0x17, 0x1a, 0xe5,
-	0x1a, 0x5f, 0x99, 0x9a, 0x5c, 0x99, 0xda, 0xae, 0x58, 0x99, 0xe6, 0xfa, 0xab, 0x81, 0x36, 0x33,
-	0x1c, 0x68, 0x6b, 0x9c, 0x27, 0x13, 0x05, 0xff, 0xfa, 0x8f, 0xa6, 0x90, 0x15, 0xee, 0x23, 0xdc,
-	0xb5, 0x0f, 0x1e, 0xf5, 0x2b, 0x54, 0x1a, 0x49, 0xe1, 0x95, 0xb2, 0xf5, 0x93, 0xd2, 0x85, 0xaa,
-	0xb2, 0xbe, 0xd8, 0x7c, 0x7f, 0x38, 0xd0, 0xb4, 0x4c, 0xf0, 0x30, 0x12, 0x93, 0x62, 0x02, 0x7b,
-	0x27, 0x70, 0xec, 0xe9, 0x27, 0xea, 0xb7, 0x48, 0x90, 0xb6, 0x3a, 0xae, 0x6e, 0x50, 0x39, 0xa9,
-	0x8b, 0x67, 0x4d, 0xea, 0xba, 0x98, 0xd4, 0x95, 0x04, 0x6f, 0x1c, 0x83, 0x4f, 0x29, 0xcf, 0x3d,
-	0xf7, 0x02, 0x87, 0x98, 0xd0, 0x0b, 0xb4, 0x2a, 0x57, 0x7b, 0xa4, 0x94, 0xf3, 0x67, 0xb1, 0xde,
-	0x14, 0xac, 0x57, 0x39, 0x6b, 0x36, 0x0c, 0x27, 0x2e, 0x08, 0x67, 0xb2, 0x98, 0x2d, 0x54, 0x1e,
-	0x4d, 0x8a, 0xaa, 0x89, 0xa0, 0x9a, 0xd7, 0x86, 0x03, 0xad, 0x9a, 0x8d, 0x1f, 0x2b, 0xe7, 0x6a,
-	0x12, 0x3e, 0xac, 0xa7, 0x8f, 0x24, 0x71, 0xb2, 0xa0, 0x0b, 0x67, 0x4d, 0xed, 0x86, 0x98, 0xda,
-	0xbb, 0x49, 0xea, 0x74, 0x45, 0x55, 0xe1, 0x8a, 0x97, 0xf4, 0x36, 0x5a, 0x94, 0x1b, 0xd7, 0x83,
-	0xa9, 0xe4, 0x60, 0x2a, 0xa5, 0xe1, 0x40, 0x2b, 0x70, 0xbc, 0x84, 0x1b, 0x93, 0x5c, 0x38, 0x0e,
-	0x44, 0x7f, 0x8e, 0x0a, 0x06, 0x75, 0x7d, 0xab, 0x6d, 0x19, 0xba, 0x4f, 0x5b, 0x9e, 0xf5, 0x82,
-	0x02, 0xca, 0x62, 0x55, 0x59, 0x9f, 0x6d, 0x6a, 0x91, 0xaa, 0xac, 0x28, 0x4c, 0xd4, 0x98, 0xf9,
-	0xb1, 0xf5, 0x82, 0x06, 0x90, 0x4f, 0x50, 0x31, 0x1e, 0x1c, 0x15, 0xf9, 0x12, 0x28, 0xab, 0x46,
-	0xbf, 0x87, 0xcc, 0x30, 0x4c, 0x56, 0x62, 0xf6, 0xb0, 0xba, 0xf7, 0x51, 0xde, 0xa6, 0xbe, 0x6e,
-	0xea, 0xbe, 0x1e, 0xa9, 0x5c, 0x02, 0x95, 0xb1, 0xc3, 0x29, 0x15, 0x82, 0xc9, 0x92, 0xb4, 0x49,
-	0x7d, 0xb7, 0xd1, 0x22, 0xf5, 0x0c, 0x97, 0x1d, 0xb7, 0x0e, 0x5c, 0x76, 0x48, 0xdd, 0xd2, 0x32,
-	0x9c, 0x07, 0xb1, 0x8a, 0x25, 0xdc, 0x98, 0xe4, 0xf8, 0xb8, 0x09, 0x43, 0xf5, 0x18, 0xe5, 0x85,
-	0xdf, 0x60, 0xb6, 0x6d, 0x79, 0x9e, 0xc5, 0x9c, 0x52, 0x1e, 0x20, 0x3e, 0x0b, 0x16, 0xf2, 0xef,
-	0x81, 0x76, 0xbd, 0x63, 0xf9, 0xdf, 0xf4, 0x0f, 0x6a, 0x06, 0xb3, 0xeb, 0x06, 0xf3, 0x6c, 0xe6,
-	0x89, 0x3f, 0xb7, 0x3c, 0xf3, 0x50, 0x74, 0x83, 0x5d, 0x6a, 0x44, 0xb2, 0x53, 0x80, 0x98, 0x2c,
-	0x73, 0xdb, 0x4e, 0x68, 0x52, 0x0f, 0x43, 0x62, 0x5b, 0x3f, 0x91, 0x9b, 0x4b, 0x3d, 0x6b, 0x73,
-	0x5d, 0x13, 0x9b, 0x2b, 0xc9, 0x14, 0x21, 0xf0, 0x9d, 0xb5, 0xc4, 0xed, 0x7b, 0xfa, 0x09, 0xdf,
-	0x56, 0xf8, 0x97, 0x32, 0x9a, 0xbd, 0x4b, 0xa9, 0xa7, 0x7e, 0x84, 0x2e, 0xb5, 0x69, 0xb0, 0x3c,
-	0x96, 0xd3, 0x32, 0xa9, 0xc3, 0x6c, 0xd1, 0x4f, 0x62, 0xc7, 0x67, 0xd2, 0x8f, 0x49, 0xae, 0x4d,
-	0xe9, 0x0e, 0xb3, 0x9c, 0xdd, 0x60, 0xa8, 0xda, 0x31, 0x80, 0x9e, 0x6b, 0x19, 0xb2, 0xa5, 0xdc,
-	0x9b, 0xb8, 0x58, 0xa3, 0x74, 0x80, 0x16, 0xd1, 0xed, 0x07, 0x43, 0x95, 0xa2, 0x85, 0x20, 0xc0,
-	0xa4, 0x6d, 0xbd, 0xdf, 0xf5, 0x45, 0xcf, 0xd9, 0x9d, 0x98, 0x4b, 0x8d, 0xb8, 0x04, 0x14, 0x26,
-	0xa8, 0x4d, 0xe9, 0x2e, 0x1f, 0xa8, 0x3f, 0x28, 0xe8, 0xb2, 0x4b, 0x3b, 0x96, 0xe7, 0x53, 0x37,
-	0xec, 0x60, 0x46, 0x97, 0x79, 0xd4, 0x14, 0x3d, 0x6a, 0x7f, 0x62, 0xce, 0x8a, 0xfc, 0xbd, 0x66,
-	0xc2, 0x62, 0x52, 0x94, 0x1e, 0xd1, 0x1d, 0x77, 0xc0, 0xae, 0x7e, 0xa7, 0xa0, 0x62, 0x2a, 0x87,
-	0xf5, 0xa8, 0x23, 0x1a, 0xdd, 0xc3, 0x89, 0x85, 0xac, 0x8d, 0x11, 0x12, 0x80, 0x62, 0xb2, 0x32,
-	0x22, 0xe3, 0x51, 0x8f, 0x3a, 0x50, 0x0f, 0xdf, 0xd5, 0x1d, 0xaf, 0x9d, 0xae, 0xc7, 0xdc, 0x74,
-	0xf5, 0x18, 0x03, 0x8b, 0x49, 0x51, 0x7a, 0xd2, 0xf5, 0x48, 0xe5, 0x40, 0x3d, 0x2e, 0x4c, 0x57,
-	0x8f, 0x4c, 0x50, 0x4c, 0x56, 0x46, 0x64, 0x40, 0x3d, 0x7e, 0x52, 0x50, 0xd9, 0xa5, 0xbd, 0x6e,
-	0x70, 0x84, 0x47, 0xbd, 0x44, 0x1c, 0xbc, 0xd0, 0x63, 0xe7, 0x9b, 0x64, 0x62, 0x21, 0x55, 0xb9,
-	0x30, 0x63, 0x80, 0x31, 0xb9, 0x2c, 0x7c, 0x77, 0x64, 0x8f, 0x12, 0x1e, 0x58, 0x20, 0xdd, 0x8c,
-	0x6e, 0x5b, 0xb1, 0x33, 0x16, 0x9a, 0xef, 0x14, 0x0b, 0x34, 0x06, 0x16, 0x93, 0xa2, 0x6e, 0xca,
-	0x9b, 0xdc, 0x4e, 0x64, 0x07, 0x29, 0x26, 0xed, 0x66, 0x4a, 0x41, 0xd3, 0x49, 0x19, 0x03, 0x1b,
-	0xdc, 0x81, 0x68, 0x37, 0x43, 0xca, 0x4b, 0x54, 0xf0, 0xa8, 0x1f, 0xa6, 0xc8, 0x56, 0x01, 0x3d,
-	0x7b, 0xbe, 0xb9, 0x37, 0xb1, 0x0c, 0xd1, 0x2c, 0xb3, 0x30, 0x31, 0x51, 0x3d, 0xea, 0x0b, 0x0d,
-	0x7b, 0xc2, 0xa8, 0xfe, 0xa8, 0xa0, 0x7c, 0xf8, 0x3b, 0x13, 0x57, 0xa9, 0x0d, 0xe8, 0xe1, 0xf3,
-	0xcd, 0xaf, 0x27, 0xa3, 0x3f, 0x1d, 0x68, 0x4b, 0x44, 0x40, 0xf1, 0xbb, 0xf8, 0x46, 0x74, 0xee,
-	0xa7, 0x38, 0x30, 0x59, 0x72, 0x93, 0xc1, 0x99, 0x5a, 0x1a, 0x70, 0x13, 0x38, 0x07, 0x2d, 0x8d,
-	0xf1, 0x5a, 0x1a, 0x29, 0x2d, 0x8d, 0x4c, 0x2d, 0x9b, 0x70, 0x83, 0x38, 0x07, 0x2d, 0x9b, 0xe3,
-	0xb5, 0x6c, 0xa6, 0xb4, 0x6c, 0x66, 0x6a, 0xd9, 0x82, 0xbb, 0xc7, 0x39, 0x68, 0xd9, 0x1a, 0xaf,
-	0x65, 0x2b, 0xa5, 0x65, 0x2b, 0x53, 0xcb, 0xb6, 0xb8, 0xc1, 0x4c, 0xad, 0x65, 0x7b, 0xbc, 0x96,
-	0xed, 0x94, 0x96, 0xed, 0x64, 0x0f, 0x14, 0x71, 0xb2, 0xef, 0xe6, 0xcf, 0xa9, 0x07, 0x26, 0x61,
-	0x63, 0x3d, 0x90, 0x8b, 0x90, 0xed, 0xf8, 0x37, 0x05, 0x69, 0x61, 0x4e, 0x70, 0x2c, 0xcb, 0x44,
-	0xbb, 0xdf, 0xf5, 0xad, 0x5e, 0xd7, 0xa2, 0x2e, 0x5c, 0x95, 0xe6, 0x9b, 0xcf, 0x26, 0x96, 0x74,
-	0x7d, 0x44, 0x52, 0x36, 0x3c, 0x26, 0x6b, 0x32, 0x22, 0x68, 0x00, 0x5c, 0xde, 0x5e, 0xe8, 0x56,
-	0xbf, 0x57, 0xd0, 0x6a, 0xd8, 0x40, 0x44, 0xb6, 0xe8, 0x8f, 0x2b, 0x20, 0xec, 0xd1, 0xc4, 0xc2,
-	0xae, 0x8e, 0xb4, 0xa5, 0x04, 0x2a, 0x26, 0x05, 0xe9, 0xe0, 0x5a, 0x44, 0x77, 0x7c, 0x89, 0x0a,
-	0xa3, 0x09, 0xd0, 0x1b, 0x0b, 0xd3, 0x9d, 0x78, 0x59, 0x98, 0x98, 0xa8, 0x49, 0x09, 0xd0, 0x19,
-	0x8f, 0x82, 0x0d, 0xec, 0xd0, 0xe3, 0x04, 0x7b, 0x71, 0xba, 0xfb, 0x73, 0x0a, 0x10, 0x76, 0xab,
-	0x43, 0x8f, 0x63, 0xbc, 0x87, 0x68, 0xd1, 0x70, 0x69, 0xf0, 0xd4, 0xe0, 0x77, 0xdd, 0xd2, 0x2a,
-	0x70, 0xde, 0x9d, 0x98, 0x53, 0x3c, 0x12, 0x12, 0x60, 0x98, 0xe4, 0xf8, 0xf8, 0x13, 0x18, 0x06,
-	0x64, 0xfd, 0x9e, 0x19, 0x23, 0xbb, 0x3c, 0x1d, 0x59, 0x02, 0x0c, 0x93, 0x1c, 0x1f, 0x0b, 0xb2,
-	0xe7, 0x28, 0xac, 0x73, 0xcb, 0x67, 0x92, 0xb1, 0x04, 0x8c, 0x0f, 0x26, 0x66, 0x2c, 0x8f, 0x2c,
-	0x68, 0x88, 0x88, 0xc9, 0xb2, 0x34, 0x3e, 0x61, 0xd1, 0x3c, 0x5d, 0xda, 0xee, 0x3b, 0xa6, 0x64,
-	0x2d, 0x4f, 0x37, 0xcf, 0x04, 0x18, 0xbc, 0x55, 0x83, 0x31, 0x27, 0xc3, 0x7f, 0x28, 0x28, 0x77,
-	0x8f, 0x3a, 0xd4, 0xb3, 0xbc, 0xc7, 0x7e, 0xd0, 0xbd, 0x9f, 0xa1, 0x39, 0xfe, 0xf9, 0x0a, 0xde,
-	0x24, 0x0b, 0x8d, 0x9b, 0xb5, 0x33, 0x3f, 0xae, 0xd5, 0xf8, 0x27, 0xb2, 0x66, 0x51, 0x3c, 0x8b,
-	0x16, 0xe3, 0x9f, 0xc4, 0x30, 0x11, 0x78, 0xea, 0x3e, 0x9a, 0x6d, 0x53, 0xea, 0xc1, 0x53, 0x65,
-	0xa1, 0x71, 0xe3, 0x7f, 0xe0, 0x06, 0x8f, 0xa5, 0xe6, 0x8a, 0x40, 0x5d, 0x08, 0x5f, 0x0f, 0x1e,
-	0x26, 0x80, 0xd4, 0xdc, 0xff, 0xfd, 0xb4, 0xa2, 0xbc, 0x3a, 0xad, 0x28, 0xaf, 0x4f, 0x2b, 0xca,
-	0xbf, 0xa7, 0x15, 0xe5, 0xe7, 0x37, 0x95, 0x99, 0xd7, 0x6f, 0x2a, 0x33, 0x7f, 0xbd, 0xa9, 0xcc,
-	0x7c, 0xd9, 0x88, 0x15, 0xca, 0x62, 0x47, 0xb7, 0x98, 0x43, 0xeb, 0x21, 0x67, 0xfd, 0x64, 0xe4,
-	0xbb, 0x22, 0x14, 0xee, 0x60, 0x0e, 0x1e, 0x7b, 0x9b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xde,
-	0x6d, 0x39, 0x44, 0x79, 0x14, 0x00, 0x00,
+	// 1752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x5f, 0x66, 0x5d, 0xaf, 0x35, 0xb6, 0x2c, 0x7b, 0x2c, 0x79, 0xa9, 0xed, 0xae, 0xa8, 0x4e,
+	0xd2, 0x8d, 0x02, 0x34, 0x12, 0x56, 0x5e, 0x5f, 0x0a, 0x2c, 0xda, 0xc8, 0x4e, 0x36, 0x6d, 0xe0,
+	0xc4, 0x9d, 0x4d, 0xda, 0xa0, 0x68, 0x21, 0x8c, 0xc9, 0x91, 0x4a, 0x98, 0xe4, 0xa8, 0x24, 0x65,
+	0xc9, 0x7b, 0x09, 0x50, 0xa0, 0x40, 0x7b, 0x29, 0xda, 0x9e, 0x7a, 0xeb, 0xb5, 0xd7, 0xfe, 0x17,
+	0x39, 0xe6, 0x58, 0xf4, 0xc0, 0x16, 0xde, 0xff, 0x40, 0x7f, 0x41, 0x30, 0x1f, 0xfc, 0x92, 0x28,
+	0x38, 0x82, 0x7c, 0x92, 0xe6, 0x7d, 0xfc, 0xde, 0x6f, 0xde, 0x7c, 0xbc, 0x37, 0x04, 0x3f, 0xb4,
+	0xd9, 0x55, 0xc7, 0x64, 0xde, 0xc0, 0x1e, 0x8e, 0x7d, 0x12, 0xda, 0xcc, 0xeb, 0x5c, 0x3d, 0xbb,
+	0xa0, 0x21, 0x79, 0xd6, 0x09, 0xaf, 0x47, 0x34, 0x68, 0x8f, 0x7c, 0x16, 0x32, 0xf8, 0x83, 0x20,
+	0x24, 0xbe, 0x47, 0x5c, 0x6a, 0xb5, 0xa7, 0xed, 0x9c, 0x79, 0x5b, 0x99, 0x3f, 0xaa, 0x0e, 0xd9,
+	0x90, 0x09, 0xeb, 0x0e, 0xff, 0x27, 0x1d, 0x1f, 0x35, 0x86, 0x8c, 0x0d, 0x1d, 0xda, 0x11, 0xa3,
+	0x8b, 0xf1, 0xa0, 0x63, 0xc5, 0x7e, 0x42, 0x82, 0xa2, 0x3d, 0xb0, 0x79, 0x22, 0xf0, 0xe0, 0x31,
+	0x00, 0x31, 0x32, 0xf5, 0x75, 0xad, 0xa9, 0xb5, 0x4a, 0xbd, 0xda, 0x2c, 0x32, 0xf6, 0xaf, 0x89,
+	0xeb, 0xfc, 0x18, 0xa5, 0x3a, 0x84, 0x33, 0x86, 0xf0, 0x63, 0xb0, 0x7f, 0x45, 0x1c, 0xdb, 0xea,
+	0x5b, 0xcc, 0x25, 0xb6, 0xd7, 0xe7, 0x2c, 0xf5, 0xb7, 0x84, 0xf7, 0xe3, 0x59, 0x64, 0xe8, 0xd2,
+	0x7b, 0xc1, 0x04, 0xe1, 0x8a, 0x90, 0x9d, 0x0a, 0xd1, 0xa7, 0xc4, 0xa5, 0xf0, 0x13, 0x00, 0xa5,
+	0x19, 0x31, 0x4d, 0x36, 0xf6, 0x42, 0x09, 0x75, 0x5f, 0x40, 0x3d, 0x99, 0x45, 0x46, 0x3d, 0x0b,
+	0x95, 0xb5, 0x41, 0x78, 0x4f, 0x08, 0x3f, 0x90, 0x32, 0x01, 0xf6, 0x02, 0x94, 0xa4, 0xe1, 0xd8,
+	0xb7, 0xf5, 0x0d, 0x81, 0xd1, 0xbc, 0x89, 0x8c, 0xad, 0x5f, 0x72, 0xe1, 0x17, 0xf8, 0x67, 0xb3,
+	0xc8, 0xd8, 0xcb, 0xe2, 0x8d, 0x7d, 0x1b, 0xe1, 0x2d, 0xf1, 0xff, 0x0b, 0xdf, 0x86, 0x3f, 0x05,
+	0xbb, 0x52, 0xee, 0xd3, 0x80, 0x8d, 0x7d, 0x93, 0xea, 0xdf, 0x13, 0x18, 0xf5, 0x59, 0x64, 0xd4,
+	0xb2, 0x7e, 0xb1, 0x1e, 0xe1, 0xb2, 0x10, 0x60, 0x35, 0x86, 0x13, 0x50, 0x53, 0xd3, 0xf5, 0xa9,
+	0x47, 0x27, 0xc4, 0xe9, 0x8f, 0xa8, 0x6f, 0x33, 0x4b, 0xdf, 0x6c, 0x6a, 0xad, 0xed, 0x6e, 0xbd,
+	0x2d, 0x57, 0xa6, 0x1d, 0xaf, 0x4c, 0xfb, 0x54, 0xad, 0x4c, 0xaf, 0xf5, 0x75, 0x64, 0xdc, 0x9b,
+	0x45, 0xc6, 0x63, 0x19, 0xa7, 0x10, 0x05, 0xfd, 0xe3, 0x7f, 0x86, 0x86, 0x0f, 0xa4, 0x0e, 0x4b,
+	0xd5, 0xb9, 0xd0, 0xc0, 0xdf, 0x00, 0x7d, 0xce, 0x45, 0x66, 0xca, 0x25, 0x53, 0xfd, 0x41, 0x53,
+	0x6b, 0x95, 0x7b, 0x6f, 0xcf, 0x22, 0xc3, 0x28, 0x04, 0x4f, 0x2c, 0x11, 0xae, 0xe5, 0xb0, 0x4f,
+	0xb8, 0xe2, 0x8c, 0x4c, 0xe1, 0xef, 0x81, 0x0a, 0xda, 0x1f, 0xfa, 0xc4, 0xa4, 0xf1, 0xa4, 0xb6,
+	0x6e, 0x9b, 0xd4, 0x53, 0x35, 0xa9, 0x47, 0xb9, 0xb8, 0x59, 0x0c, 0x39, 0xa5, 0x7d, 0xa9, 0x79,
+	0xc9, 0x15, 0x6a, 0x42, 0xaf, 0xc1, 0x61, 0xbc, 0xda, 0x73, 0xa9, 0x2c, 0xdd, 0x16, 0xf5, 0x3d,
+	0x15, 0xf5, 0x89, 0x8c, 0x5a, 0x0c, 0x23, 0x03, 0x57, 0x95, 0x32, 0x9f, 0xcc, 0x3e, 0xa8, 0xcf,
+	0x3b, 0xa5, 0xd9, 0x04, 0x22, 0x9b, 0xef, 0xcc, 0x22, 0xa3, 0x59, 0x8c, 0x9f, 0x49, 0xe7, 0x61,
+	0x1e, 0x3e, 0xc9, 0x67, 0x08, 0xe2, 0xc0, 0xf9, 0x84, 0x6e, 0xdf, 0x36, 0xb5, 0x77, 0xd5, 0xd4,
+	0xbe, 0x9f, 0x0f, 0xbd, 0x98, 0x51, 0xa8, 0x54, 0xd9, 0x94, 0xbe, 0x00, 0xe5, 0x78, 0xe3, 0x06,
+	0x62, 0x2a, 0x3b, 0x62, 0x2a, 0xfa, 0x2c, 0x32, 0xaa, 0x12, 0x2f, 0xa7, 0x46, 0x78, 0x27, 0x19,
+	0x73, 0xd2, 0xbf, 0x00, 0x55, 0x93, 0xfa, 0xa1, 0x3d, 0xb0, 0x4d, 0x12, 0xd2, 0x7e, 0x60, 0xbf,
+	0xa6, 0x02, 0xa5, 0xdc, 0xd4, 0x5a, 0x1b, 0x3d, 0x23, 0x65, 0x55, 0x64, 0x85, 0x30, 0xcc, 0x88,
+	0x5f, 0xd9, 0xaf, 0x29, 0x87, 0xfc, 0x1c, 0xd4, 0xb2, 0xc6, 0x69, 0x92, 0x77, 0x05, 0xb3, 0x66,
+	0x7a, 0x1e, 0x0a, 0xcd, 0x10, 0x3e, 0xc8, 0xc8, 0x93, 0xec, 0x7e, 0x0c, 0xf6, 0x5d, 0x1a, 0x12,
+	0x8b, 0x84, 0x24, 0x65, 0x59, 0x11, 0x2c, 0x33, 0x97, 0xd3, 0x82, 0x09, 0xc2, 0x95, 0x58, 0x16,
+	0xf3, 0x7b, 0x01, 0xca, 0x34, 0x30, 0x7d, 0x36, 0xe9, 0x5f, 0xf8, 0xec, 0x92, 0xfa, 0xfa, 0x9e,
+	0xb8, 0x0f, 0x32, 0x19, 0xcb, 0xa9, 0x11, 0xde, 0x91, 0xe3, 0x9e, 0x18, 0xc2, 0x09, 0xd8, 0x57,
+	0x7a, 0x93, 0xb9, 0xae, 0x1d, 0x04, 0x36, 0xf3, 0xf4, 0x7d, 0x01, 0xf1, 0x73, 0xbe, 0x90, 0xff,
+	0x8d, 0x8c, 0xa7, 0x43, 0x3b, 0xfc, 0xdd, 0xf8, 0xa2, 0x6d, 0x32, 0xb7, 0x63, 0xb2, 0xc0, 0x65,
+	0x81, 0xfa, 0x79, 0x3f, 0xb0, 0x2e, 0x55, 0x35, 0x38, 0xa5, 0x66, 0x4a, 0x7b, 0x01, 0x10, 0xe1,
+	0x3d, 0x29, 0x3b, 0x49, 0x44, 0xf0, 0x32, 0x09, 0xec, 0x92, 0x69, 0xbc, 0xb9, 0xe0, 0x6d, 0x9b,
+	0xeb, 0x1d, 0xb5, 0xb9, 0xf2, 0x91, 0x52, 0x04, 0xb9, 0xb3, 0x2a, 0x52, 0x7e, 0x46, 0xa6, 0x6a,
+	0x5b, 0xf1, 0x45, 0xe4, 0xa1, 0xf9, 0x09, 0xb8, 0xa2, 0xc4, 0xe9, 0x53, 0x8f, 0x5c, 0x38, 0xd4,
+	0xd2, 0x0f, 0x9a, 0x5a, 0x6b, 0x2b, 0xb7, 0x88, 0x45, 0x66, 0x7c, 0x11, 0x85, 0x1c, 0x0b, 0xf1,
+	0x87, 0x52, 0xca, 0x8f, 0x88, 0x14, 0xbb, 0x94, 0x2f, 0xb6, 0xed, 0xf5, 0x2d, 0xea, 0x90, 0x6b,
+	0xbd, 0xba, 0xe2, 0x11, 0x29, 0x02, 0x51, 0x47, 0x24, 0x55, 0x9d, 0xd9, 0xde, 0x29, 0x57, 0xf0,
+	0xfb, 0x3b, 0xeb, 0x40, 0xa6, 0xfd, 0x89, 0xed, 0x59, 0x6c, 0xa2, 0xd7, 0x56, 0xbc, 0xbf, 0x0b,
+	0x51, 0xd4, 0xfd, 0x9d, 0x89, 0x4b, 0xa6, 0xbf, 0x12, 0x1a, 0x7e, 0xb8, 0x88, 0xe3, 0xb0, 0x09,
+	0xb5, 0x44, 0x71, 0xeb, 0x07, 0xa6, 0x6f, 0x8f, 0xc2, 0x40, 0x3f, 0x6c, 0xde, 0x6f, 0x95, 0xb2,
+	0x87, 0xab, 0xc8, 0x0a, 0x61, 0xa8, 0xc4, 0xbc, 0x0a, 0xbe, 0x92, 0x42, 0x78, 0x0d, 0xa0, 0x5a,
+	0x42, 0x9f, 0x5d, 0x13, 0x27, 0xbc, 0x16, 0xe7, 0xe0, 0xa1, 0xd8, 0x7e, 0x9f, 0xac, 0xbc, 0xfd,
+	0xea, 0xb9, 0x4d, 0x91, 0x41, 0x4c, 0xf6, 0x1f, 0x96, 0xb2, 0x33, 0x32, 0x45, 0x7f, 0xab, 0x83,
+	0x8d, 0x8f, 0x28, 0x0d, 0xe0, 0x4f, 0xc0, 0xee, 0x80, 0xf2, 0x13, 0x2b, 0x52, 0xef, 0x31, 0x57,
+	0xd7, 0xe6, 0x2b, 0x6a, 0x5e, 0x8f, 0xf0, 0xce, 0x80, 0xd2, 0x13, 0xc6, 0x17, 0xc4, 0x63, 0x2e,
+	0x74, 0x33, 0x00, 0x23, 0xdf, 0x36, 0xe3, 0x2e, 0xe3, 0xe5, 0xca, 0x13, 0x98, 0x0f, 0x27, 0xd0,
+	0xd2, 0x70, 0xe7, 0x7c, 0x08, 0x29, 0xd8, 0xe6, 0x06, 0x16, 0x1d, 0x90, 0xb1, 0x13, 0xaa, 0x36,
+	0xe4, 0x74, 0xe5, 0x58, 0x30, 0x8d, 0xa5, 0xa0, 0x10, 0x06, 0x03, 0x4a, 0x4f, 0xe5, 0x00, 0xfe,
+	0x49, 0x03, 0x0f, 0x7d, 0x3a, 0xb4, 0x83, 0x90, 0xfa, 0x49, 0x53, 0x63, 0x3a, 0x2c, 0xa0, 0x96,
+	0x6a, 0x5b, 0xce, 0x57, 0x8e, 0xd9, 0x88, 0xaf, 0xf0, 0x42, 0x58, 0x84, 0x6b, 0xb1, 0x46, 0x35,
+	0x4c, 0x27, 0x42, 0x0e, 0xff, 0xa0, 0x81, 0xda, 0x82, 0x0f, 0x1b, 0x51, 0x4f, 0xf5, 0x3e, 0x9f,
+	0xae, 0x4c, 0xe4, 0xf1, 0x12, 0x22, 0x1c, 0x14, 0xe1, 0x83, 0x39, 0x1a, 0x9f, 0x8d, 0xa8, 0x27,
+	0xf2, 0x11, 0xfa, 0xc4, 0x0b, 0x06, 0x8b, 0xf9, 0xd8, 0x5c, 0x2f, 0x1f, 0x4b, 0x60, 0x11, 0xae,
+	0xc5, 0x9a, 0xc5, 0x7c, 0x2c, 0xf8, 0x88, 0x7c, 0x3c, 0x58, 0x2f, 0x1f, 0x85, 0xa0, 0x08, 0x1f,
+	0xcc, 0xd1, 0x10, 0xf9, 0xf8, 0x8b, 0x06, 0xea, 0x3e, 0x1d, 0x39, 0xbc, 0xaa, 0xa7, 0xed, 0x85,
+	0xaa, 0xc5, 0xa2, 0xed, 0x2a, 0xf5, 0xf0, 0xca, 0x44, 0x9a, 0xf1, 0xc2, 0x2c, 0x01, 0x46, 0xf8,
+	0xa1, 0xd2, 0x7d, 0x10, 0xb7, 0x2d, 0x4a, 0x23, 0x16, 0x88, 0x58, 0x69, 0x03, 0x9e, 0x29, 0xbb,
+	0x7a, 0x69, 0xbd, 0x05, 0x5a, 0x02, 0x8b, 0x70, 0x8d, 0x58, 0x71, 0x73, 0x7f, 0x92, 0xca, 0x05,
+	0x15, 0x8b, 0x3a, 0x85, 0x54, 0xc0, 0x7a, 0x54, 0x96, 0xc0, 0xf2, 0xb6, 0x98, 0x3a, 0x05, 0x54,
+	0xbe, 0x02, 0xd5, 0x80, 0x86, 0x89, 0x4b, 0xdc, 0x3d, 0x88, 0x36, 0xae, 0xd4, 0x3b, 0x5b, 0x99,
+	0x86, 0xba, 0xe2, 0x8b, 0x30, 0x11, 0x86, 0x01, 0x0d, 0x15, 0x87, 0x33, 0x25, 0x84, 0x7f, 0xd6,
+	0xc0, 0x7e, 0x72, 0xce, 0x54, 0x77, 0xfd, 0x4c, 0xb4, 0x75, 0xa5, 0xde, 0x6f, 0x57, 0x0b, 0x7f,
+	0x13, 0x19, 0x15, 0xac, 0xa0, 0xe4, 0xf3, 0xec, 0x59, 0xda, 0x0a, 0x2c, 0xc4, 0x40, 0xb8, 0xe2,
+	0xe7, 0x8d, 0x0b, 0xb9, 0x74, 0xf5, 0xf2, 0xdd, 0x70, 0xe9, 0x2e, 0xe7, 0xd2, 0x5d, 0xe0, 0xd2,
+	0x2d, 0xe4, 0x72, 0xa4, 0xef, 0xde, 0x0d, 0x97, 0xa3, 0xe5, 0x5c, 0x8e, 0x16, 0xb8, 0x1c, 0x15,
+	0x72, 0x79, 0xae, 0x57, 0xee, 0x86, 0xcb, 0xf3, 0xe5, 0x5c, 0x9e, 0x2f, 0x70, 0x79, 0x5e, 0xc8,
+	0xe5, 0x58, 0xdf, 0xbb, 0x1b, 0x2e, 0xc7, 0xcb, 0xb9, 0x1c, 0x2f, 0x70, 0x39, 0xce, 0xd7, 0x40,
+	0x65, 0x17, 0xd7, 0xdd, 0xfd, 0x3b, 0xaa, 0x81, 0x79, 0xd8, 0x4c, 0x0d, 0x94, 0x24, 0xe2, 0x72,
+	0xfc, 0x4f, 0x0d, 0x18, 0x89, 0x0f, 0xbf, 0x96, 0x63, 0x47, 0x77, 0xec, 0x84, 0xf6, 0xc8, 0xb1,
+	0xa9, 0x2f, 0xba, 0xe7, 0x52, 0xef, 0xcb, 0x95, 0x29, 0x3d, 0x9d, 0xa3, 0x54, 0x0c, 0x8f, 0xf0,
+	0xe3, 0xd8, 0x82, 0x17, 0x00, 0x49, 0xef, 0x2c, 0x51, 0xc3, 0x3f, 0x6a, 0xe0, 0x30, 0x29, 0x20,
+	0xca, 0x5b, 0xd5, 0xc7, 0x03, 0x41, 0xec, 0xb3, 0x95, 0x89, 0x3d, 0x99, 0x2b, 0x4b, 0x39, 0x54,
+	0x84, 0xab, 0xb1, 0x42, 0x72, 0x51, 0xd5, 0xf1, 0x2b, 0x50, 0x9d, 0x77, 0x10, 0xb5, 0xb1, 0xba,
+	0xde, 0x8d, 0x57, 0x84, 0x89, 0x30, 0xcc, 0x53, 0x10, 0x95, 0xf1, 0x8a, 0x6f, 0x60, 0x8f, 0x4e,
+	0x72, 0xd1, 0x6b, 0xeb, 0x3d, 0xa9, 0x16, 0x00, 0xc5, 0x6e, 0xf5, 0xe8, 0x24, 0x13, 0xf7, 0x12,
+	0x94, 0x4d, 0x9f, 0x92, 0x90, 0xf6, 0x65, 0xb3, 0xab, 0x1f, 0x8a, 0x98, 0x1f, 0xad, 0x1c, 0x53,
+	0xbd, 0x1b, 0x73, 0x60, 0x08, 0xef, 0xc8, 0xf1, 0x87, 0x62, 0xc8, 0x83, 0x8d, 0x47, 0x56, 0x26,
+	0xd8, 0xc3, 0xf5, 0x82, 0xe5, 0xc0, 0x10, 0xde, 0x91, 0x63, 0x15, 0xec, 0x1a, 0x24, 0x79, 0xee,
+	0x87, 0x2c, 0x8e, 0xa8, 0xaf, 0xf7, 0x4c, 0x58, 0x44, 0x44, 0x78, 0x2f, 0x16, 0x7e, 0xce, 0xd2,
+	0x79, 0xfa, 0x74, 0x30, 0xf6, 0xac, 0x38, 0x6a, 0x7d, 0xbd, 0x79, 0xe6, 0xc0, 0xc4, 0xe7, 0x0b,
+	0x3e, 0x96, 0xc1, 0xd0, 0xbf, 0xdf, 0x02, 0x3b, 0x2f, 0xa9, 0x47, 0x03, 0x3b, 0x78, 0x15, 0xf2,
+	0xea, 0xfd, 0x25, 0xd8, 0x94, 0x5f, 0x34, 0xc5, 0x9b, 0x64, 0xbb, 0xfb, 0x5e, 0xfb, 0xd6, 0xef,
+	0xad, 0x6d, 0xf9, 0xd5, 0xb4, 0x57, 0x53, 0x8f, 0xbd, 0x72, 0xf6, 0x2b, 0x29, 0xc2, 0x0a, 0x0f,
+	0x9e, 0x83, 0x8d, 0x01, 0xa5, 0x81, 0x78, 0xaa, 0x6c, 0x77, 0xdf, 0xfd, 0x0e, 0xb8, 0xfc, 0xb1,
+	0xd4, 0x3b, 0x50, 0xa8, 0xdb, 0xc9, 0xeb, 0x21, 0x40, 0x58, 0x20, 0xc1, 0x31, 0xd8, 0xf5, 0x69,
+	0x40, 0xfd, 0x2b, 0xf5, 0xf2, 0x0b, 0xf4, 0xfb, 0xcd, 0xfb, 0xad, 0xed, 0x6e, 0xe7, 0x3b, 0x60,
+	0x63, 0xe5, 0xc8, 0xdf, 0x86, 0xbd, 0x27, 0x2a, 0x46, 0x2d, 0xce, 0x58, 0x16, 0x14, 0xe1, 0xb2,
+	0x9f, 0x31, 0x0e, 0xd0, 0xdf, 0x35, 0xb0, 0x93, 0x75, 0x87, 0x6f, 0x83, 0x0d, 0x6e, 0xa9, 0x5e,
+	0x71, 0x95, 0x94, 0xac, 0xfc, 0x22, 0x2b, 0x94, 0xf0, 0x47, 0xe0, 0xc1, 0x88, 0x84, 0x21, 0xf5,
+	0x3d, 0x91, 0x81, 0xad, 0x1e, 0x9c, 0x45, 0xc6, 0xae, 0xb4, 0x53, 0x0a, 0x84, 0x63, 0x13, 0xd8,
+	0x01, 0x5b, 0xa6, 0x43, 0x6c, 0x97, 0x78, 0xf1, 0x7b, 0xeb, 0x60, 0x16, 0x19, 0x15, 0x95, 0x59,
+	0xa5, 0x41, 0x38, 0x31, 0xea, 0x9d, 0xff, 0xeb, 0xa6, 0xa1, 0x7d, 0x7d, 0xd3, 0xd0, 0xbe, 0xb9,
+	0x69, 0x68, 0xff, 0xbf, 0x69, 0x68, 0x7f, 0x7d, 0xd3, 0xb8, 0xf7, 0xcd, 0x9b, 0xc6, 0xbd, 0xff,
+	0xbc, 0x69, 0xdc, 0xfb, 0x75, 0x37, 0xb3, 0x69, 0x6c, 0x76, 0xf5, 0x3e, 0xf3, 0x68, 0x27, 0xc9,
+	0x51, 0x67, 0x3a, 0xf7, 0xd9, 0x5d, 0x6c, 0xa2, 0x8b, 0x4d, 0xf1, 0x9c, 0x3f, 0xfa, 0x76, 0x00,
+	0x03, 0xa5, 0x32, 0xc1, 0x98, 0x17, 0x00, 0x00,
 }
 
 func (this *Config) Equal(that interface{}) bool {
@@ -577,6 +721,26 @@ func (this *Config) Equal(that interface{}) bool {
 	if this.EscrowMaxPeriod != that1.EscrowMaxPeriod {
 		return false
 	}
+	if this.CommitRevealEnabled != that1.CommitRevealEnabled {
+		return false
+	}
+	if this.CommitmentMinDelay != that1.CommitmentMinDelay {
+		return false
+	}
+	if this.CommitmentMaxWindow != that1.CommitmentMaxWindow {
+		return false
+	}
+	if len(this.AllowedNameScripts) != len(that1.AllowedNameScripts) {
+		return false
+	}
+	for i := range this.AllowedNameScripts {
+		if this.AllowedNameScripts[i] != that1.AllowedNameScripts[i] {
+			return false
+		}
+	}
+	if !this.EscrowRoyaltyMax.Equal(that1.EscrowRoyaltyMax) {
+		return false
+	}
 	return true
 }
 func (this *Fees) Equal(that interface{}) bool {
@@ -700,6 +864,44 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.Fees.Equal(&that1.Fees) {
 		return false
 	}
+	if len(this.ReservedNames) != len(that1.ReservedNames) {
+		return false
+	}
+	for i := range this.ReservedNames {
+		if !this.ReservedNames[i].Equal(&that1.ReservedNames[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReservedName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReservedName)
+	if !ok {
+		that2, ok := that.(ReservedName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Pattern != that1.Pattern {
+		return false
+	}
+	if this.Claimant != that1.Claimant {
+		return false
+	}
 	return true
 }
 func (m *Config) Marshal() (dAtA []byte, err error) {
@@ -722,7 +924,30 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EscrowMaxPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EscrowMaxPeriod):])
+	{
+		size := m.EscrowRoyaltyMax.Size()
+		i -= size
+		if _, err := m.EscrowRoyaltyMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.AllowedNameScripts) > 0 {
+		for iNdEx := len(m.AllowedNameScripts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedNameScripts[iNdEx])
+			copy(dAtA[i:], m.AllowedNameScripts[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedNameScripts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CommitmentMaxWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommitmentMaxWindow):])
 	if err1 != nil {
 		return 0, err1
 	}
@@ -731,6 +956,38 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
+	dAtA[i] = 0xaa
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CommitmentMinDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommitmentMinDelay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.CommitRevealEnabled {
+		i--
+		if m.CommitRevealEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EscrowMaxPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EscrowMaxPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
 	dAtA[i] = 0x92
 	{
 		size := m.EscrowCommission.Size()
@@ -773,12 +1030,12 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x60
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AccountGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AccountGracePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AccountGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AccountGracePeriod):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x5a
 	if m.AccountRenewalCountMax != 0 {
@@ -786,20 +1043,20 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x50
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AccountRenewalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AccountRenewalPeriod):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AccountRenewalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AccountRenewalPeriod):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x4a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DomainGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DomainGracePeriod):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DomainGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DomainGracePeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x42
 	if m.DomainRenewalCountMax != 0 {
@@ -807,12 +1064,12 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x38
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DomainRenewalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DomainRenewalPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DomainRenewalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DomainRenewalPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.ValidResource) > 0 {
@@ -1163,6 +1420,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReservedNames) > 0 {
+		for iNdEx := len(m.ReservedNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1186,6 +1457,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReservedName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pattern {
+		i--
+		if m.Pattern {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1257,6 +1575,21 @@ func (m *Config) Size() (n int) {
 	n += 2 + l + sovTypes(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EscrowMaxPeriod)
 	n += 2 + l + sovTypes(uint64(l))
+	if m.CommitRevealEnabled {
+		n += 3
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommitmentMinDelay)
+	n += 2 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommitmentMaxWindow)
+	n += 2 + l + sovTypes(uint64(l))
+	if len(m.AllowedNameScripts) > 0 {
+		for _, s := range m.AllowedNameScripts {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.EscrowRoyaltyMax.Size()
+	n += 2 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1331,6 +1664,32 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.Fees.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ReservedNames) > 0 {
+		for _, e := range m.ReservedNames {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReservedName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pattern {
+		n += 2
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1874,6 +2233,158 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealEnabled = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentMinDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CommitmentMinDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentMaxWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CommitmentMaxWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedNameScripts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedNameScripts = append(m.AllowedNameScripts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRoyaltyMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowRoyaltyMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2888,6 +3399,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedNames = append(m.ReservedNames, ReservedName{})
+			if err := m.ReservedNames[len(m.ReservedNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReservedName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pattern = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
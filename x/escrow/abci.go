@@ -10,9 +10,13 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	ctx = ctx.WithLogger(ctx.Logger().With("handler", "beginBlock").With("module", "starname/x/escrow"))
 
-	// Automatically refund all expired escrows
+	// Mark the escrows with a passed deadline as expired, at most max_expirations_per_block of them per block
 	currentDate := uint64(ctx.BlockTime().Unix())
 	k.MarkExpiredEscrows(ctx, currentDate)
+	// Refund the expired escrows, at most max_expirations_per_block attempts per block
+	k.RefundExpiredEscrows(ctx)
+	// Give back the coins of the expired offers to their buyers
+	k.RefundExpiredOffers(ctx, currentDate)
 
 	k.SetLastBlockTime(ctx, currentDate)
 }
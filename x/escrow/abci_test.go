@@ -10,28 +10,38 @@ import (
 )
 
 func TestBeginBlocker(t *testing.T) {
-	keeper, ctx, _, _, _, _ := test.NewTestKeeper(nil, true)
+	keeper, ctx, store, _, _ := test.NewTestKeeper(nil, true)
 	gen := test.NewEscrowGenerator(uint64(ctx.BlockTime().Unix()))
+	// saveEscrowedObject saves the object of an escrow in the store, as if the escrow held it
+	saveEscrowedObject := func(escrow types.Escrow, obj *types.TestObject) {
+		obj.Owner = keeper.GetEscrowAddress(escrow.Id)
+		if err := store.Create(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
 
 	normalEscrow, _ := gen.NewRandomTestEscrow()
 	keeper.SaveEscrow(ctx, normalEscrow)
 
-	expiredEscrow, _ := gen.NewRandomTestEscrow()
+	expiredEscrow, expiredObj := gen.NewRandomTestEscrow()
 	expiredEscrow.Deadline = gen.NowAfter(0) - 10
 	expiredEscrow.State = types.EscrowState_Expired
 	keeper.SaveEscrow(ctx, expiredEscrow)
+	saveEscrowedObject(expiredEscrow, expiredObj)
 
-	expiringEscrow, _ := gen.NewRandomTestEscrow()
+	expiringEscrow, expiringObj := gen.NewRandomTestEscrow()
 	expiringEscrow.Deadline = expiredEscrow.Deadline
 	keeper.SaveEscrow(ctx, expiringEscrow)
+	saveEscrowedObject(expiringEscrow, expiringObj)
 
 	// Just to test if everything works as expected independently of position
 	anotherNormalEscrow, _ := gen.NewRandomTestEscrow()
 	keeper.SaveEscrow(ctx, anotherNormalEscrow)
 
-	anotherExpiringEscrow, _ := gen.NewRandomTestEscrow()
+	anotherExpiringEscrow, anotherExpiringObj := gen.NewRandomTestEscrow()
 	anotherExpiringEscrow.Deadline = expiringEscrow.Deadline
 	keeper.SaveEscrow(ctx, anotherExpiringEscrow)
+	saveEscrowedObject(anotherExpiringEscrow, anotherExpiringObj)
 
 	escrow.BeginBlocker(ctx, keeper)
 
@@ -39,7 +49,7 @@ func TestBeginBlocker(t *testing.T) {
 		t.Fatalf("Invalid last block time : expected %v, got %v", gen.NowAfter(0), keeper.GetLastBlockTime(ctx))
 	}
 
-	normalEscrows := []types.Escrow{normalEscrow, expiredEscrow, anotherNormalEscrow}
+	normalEscrows := []types.Escrow{normalEscrow, anotherNormalEscrow}
 	for _, expected := range normalEscrows {
 		actual, found := keeper.GetEscrow(ctx, expected.Id)
 		if !found {
@@ -50,14 +60,22 @@ func TestBeginBlocker(t *testing.T) {
 		}
 	}
 
-	expiringEscrows := []types.Escrow{expiringEscrow, anotherExpiringEscrow}
-	for _, escrow := range expiringEscrows {
-		actual, found := keeper.GetEscrow(ctx, escrow.Id)
-		if !found {
-			t.Fatalf("Escrow %v not found after BeginBlocker call", escrow.Id)
+	// The escrows with a passed deadline are marked as expired and refunded
+	expiredEscrows := []types.Escrow{expiredEscrow, expiringEscrow, anotherExpiringEscrow}
+	for _, escrow := range expiredEscrows {
+		if keeper.HasEscrow(ctx, escrow.Id) {
+			t.Fatalf("Escrow %v has not been refunded when it should have", escrow.Id)
+		}
+		record, found := keeper.GetEscrowRecord(ctx, escrow.Id)
+		if !found || record.State != types.EscrowState_Refunded {
+			t.Fatalf("Escrow %v has no refund record: %v", escrow.Id, record)
+		}
+		var obj types.TestObject
+		if err := store.Read(escrow.GetObject().GetUniqueKey(), &obj); err != nil {
+			t.Fatal(err)
 		}
-		if actual.State != types.EscrowState_Expired {
-			t.Fatalf("Escrow %v has not been marked expired when it should have, its state is %v", escrow.Id, actual.State)
+		if obj.Owner.String() != escrow.Seller {
+			t.Fatalf("The object of the escrow %v has not been given back to its seller", escrow.Id)
 		}
 	}
 }
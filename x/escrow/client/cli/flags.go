@@ -7,32 +7,85 @@ import (
 
 const (
 	FlagSeller           = "seller"
+	FlagBuyer            = "buyer"
+	FlagClearBuyer       = "clear-buyer"
 	FlagPrice            = "price"
+	FlagFloorPrice       = "floor-price"
+	FlagPriceDecay       = "price-decay"
 	FlagDeadline         = "expiration"
 	FlagFeePayer         = "fee-payer"
 	FlagObjectKey        = "object"
+	FlagWantedObjectKey  = "wanted-object"
 	FlagState            = "state"
+	FlagObjectType       = "object-type"
+	FlagDomain           = "domain"
+	FlagPriceMin         = "price-min"
+	FlagPriceMax         = "price-max"
+	FlagDeadlineMin      = "expiration-min"
+	FlagDeadlineMax      = "expiration-max"
+	FlagTimeMin          = "time-min"
+	FlagTimeMax          = "time-max"
+	FlagOrderBy          = "order-by"
+	FlagDescending       = "descending"
 	FlagPaginationStart  = "pagination-start"
 	FlagPaginationLength = "pagination-length"
+
+	FlagBroker             = "broker"
+	FlagBrokerCommission   = "broker-commission"
+	FlagSellingBrokerShare = "selling-broker-share"
 )
 
 var (
 	FsEscrow       = flag.NewFlagSet("escrow", flag.PanicOnError)
 	FsQueryEscrows = flag.NewFlagSet("query_escrows", flag.PanicOnError)
+	FsQueryOffers  = flag.NewFlagSet("query_offers", flag.PanicOnError)
+	FsQueryRecords = flag.NewFlagSet("query_records", flag.PanicOnError)
 )
 
 func init() {
 	FsEscrow.String(FlagSeller, "", "Bech32 encoded address of the new seller for the escrow")
 	FsEscrow.String(FlagPrice, "", "Price of the object")
 	FsEscrow.String(FlagDeadline, "", "Expiration date of the escrow, in the RFC3339 time format")
+	FsEscrow.String(FlagBuyer, "", "Bech32 encoded address of the designated buyer of a private sale")
+	FsEscrow.Bool(FlagClearBuyer, false, "Remove the designated buyer so that anybody can buy the object")
 	addCommonFlags(FsEscrow)
 
 	FsQueryEscrows.String(FlagSeller, "", "Bech32 encoded address of the seller of the escrow")
 	FsQueryEscrows.String(FlagState, "", "State of the escrow, can be open or expired")
 	FsQueryEscrows.String(FlagObjectKey, "", "Primary key of the escrow's object, encoded in hexadecimal")
+	FsQueryEscrows.String(FlagWantedObjectKey, "", "Primary key of the object wanted in exchange by a swap escrow, encoded in hexadecimal")
+	FsQueryEscrows.String(FlagBuyer, "", "Bech32 encoded address of the designated buyer of a private sale")
+	FsQueryEscrows.Uint64(FlagObjectType, 0, "Type ID of the escrow's object, 0 for any type")
+	FsQueryEscrows.String(FlagDomain, "", "Domain of the escrow's object")
+	FsQueryEscrows.String(FlagPriceMin, "", "Minimum current price of the escrow, e.g. 10tiov")
+	FsQueryEscrows.String(FlagPriceMax, "", "Maximum current price of the escrow, e.g. 100tiov")
+	FsQueryEscrows.String(FlagDeadlineMin, "", "Minimum expiration date of the escrow, in the RFC3339 time format")
+	FsQueryEscrows.String(FlagDeadlineMax, "", "Maximum expiration date of the escrow, in the RFC3339 time format")
+	FsQueryEscrows.String(FlagOrderBy, "id", "Sort order of the escrows, can be id, price or deadline")
+	FsQueryEscrows.Bool(FlagDescending, false, "Sort the escrows in descending order")
 	FsQueryEscrows.Uint64(FlagPaginationStart, 0, "Pagination starting index")
 	FsQueryEscrows.Uint64(FlagPaginationLength, 0, "Maximal number of escrows to fetch, 0 to fetch them all")
 
+	FsQueryOffers.String(FlagBuyer, "", "Bech32 encoded address of the buyer of the offer")
+	FsQueryOffers.String(FlagObjectKey, "", "Primary key of the offer's object, encoded in hexadecimal")
+	FsQueryOffers.Uint64(FlagPaginationStart, 0, "Pagination starting index")
+	FsQueryOffers.Uint64(FlagPaginationLength, 0, "Maximal number of offers to fetch, 0 to fetch them all")
+
+	FsQueryRecords.String(FlagSeller, "", "Bech32 encoded address of the seller of the escrow")
+	FsQueryRecords.String(FlagBuyer, "", "Bech32 encoded address of the buyer of the escrow")
+	FsQueryRecords.String(FlagState, "", "State of the escrow, can be completed or refunded")
+	FsQueryRecords.String(FlagObjectKey, "", "Primary key of the escrow's object, encoded in hexadecimal")
+	FsQueryRecords.Uint64(FlagObjectType, 0, "Type ID of the escrow's object, 0 for any type")
+	FsQueryRecords.String(FlagDomain, "", "Domain of the escrow's object")
+	FsQueryRecords.String(FlagPriceMin, "", "Minimum price paid, e.g. 10tiov")
+	FsQueryRecords.String(FlagPriceMax, "", "Maximum price paid, e.g. 100tiov")
+	FsQueryRecords.String(FlagTimeMin, "", "Minimum completion or refund date, in the RFC3339 time format")
+	FsQueryRecords.String(FlagTimeMax, "", "Maximum completion or refund date, in the RFC3339 time format")
+	FsQueryRecords.String(FlagOrderBy, "id", "Sort order of the records, can be id, price or time")
+	FsQueryRecords.Bool(FlagDescending, false, "Sort the records in descending order")
+	FsQueryRecords.Uint64(FlagPaginationStart, 0, "Pagination starting index")
+	FsQueryRecords.Uint64(FlagPaginationLength, 0, "Maximal number of records to fetch, 0 to fetch them all")
+
 }
 
 func addCommonFlags(flagSet *flag.FlagSet) {
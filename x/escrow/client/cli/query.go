@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 	escrowQueryCmd.AddCommand(
 		getCmdQueryEscrow(),
 		getCmdQueryEscrows(),
+		getCmdQueryOffer(),
+		getCmdQueryOffers(),
+		getCmdQueryRecords(),
 	)
 
 	return escrowQueryCmd
@@ -67,7 +70,7 @@ func getCmdQueryEscrows() *cobra.Command {
 	escrowQueryCmd := &cobra.Command{
 		Use:                        "escrows",
 		Short:                      "Do a query over all the escrows",
-		Long:                       "Query details of a list of escrows, with the possibility to filter by seller, object and/or state.",
+		Long:                       "Query details of a list of escrows, with the possibility to filter by seller, designated buyer, state, object, object type, domain, price and/or expiration date, and to sort them by id, price or expiration date.",
 		Example:                    fmt.Sprintf("%s query escrow escrows --seller <seller>", version.AppName),
 		Args:                       cobra.ExactArgs(0),
 		SuggestionsMinimumDistance: 2,
@@ -92,6 +95,53 @@ func getCmdQueryEscrows() *cobra.Command {
 				return sdkerrors.Wrap(err, "Invalid object key")
 			}
 
+			wantedObjectKey, err := cmd.Flags().GetString(FlagWantedObjectKey)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid wanted object key")
+			}
+
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid buyer address")
+			}
+
+			objectType, err := cmd.Flags().GetUint64(FlagObjectType)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid object type")
+			}
+
+			domain, err := cmd.Flags().GetString(FlagDomain)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid domain")
+			}
+
+			priceMin, err := cmd.Flags().GetString(FlagPriceMin)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid minimum price")
+			}
+			priceMax, err := cmd.Flags().GetString(FlagPriceMax)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid maximum price")
+			}
+
+			deadlineMin, err := getDateFlag(cmd, FlagDeadlineMin)
+			if err != nil {
+				return err
+			}
+			deadlineMax, err := getDateFlag(cmd, FlagDeadlineMax)
+			if err != nil {
+				return err
+			}
+
+			orderBy, err := cmd.Flags().GetString(FlagOrderBy)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid sort order")
+			}
+			descending, err := cmd.Flags().GetBool(FlagDescending)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid sort direction")
+			}
+
 			paginationStart, err := cmd.Flags().GetUint64(FlagPaginationStart)
 			if err != nil {
 				return sdkerrors.Wrap(err, "Invalid pagination starting index")
@@ -106,6 +156,16 @@ func getCmdQueryEscrows() *cobra.Command {
 				Seller:           seller,
 				State:            state,
 				ObjectKey:        objectKey,
+				WantedObjectKey:  wantedObjectKey,
+				Buyer:            buyer,
+				ObjectType:       objectType,
+				Domain:           domain,
+				PriceMin:         priceMin,
+				PriceMax:         priceMax,
+				DeadlineMin:      deadlineMin,
+				DeadlineMax:      deadlineMax,
+				OrderBy:          orderBy,
+				Descending:       descending,
 				PaginationStart:  paginationStart,
 				PaginationLength: paginationLength,
 			}
@@ -123,3 +183,200 @@ func getCmdQueryEscrows() *cobra.Command {
 
 	return escrowQueryCmd
 }
+
+func getCmdQueryOffer() *cobra.Command {
+	offerQueryCmd := &cobra.Command{
+		Use:                        "offer [id]",
+		Short:                      "Query an offer",
+		Long:                       "Query details of an offer with the specified id.",
+		Example:                    fmt.Sprintf("%s query escrow offer <id>", version.AppName),
+		Args:                       cobra.ExactArgs(1),
+		SuggestionsMinimumDistance: 2,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			param := types.QueryOfferRequest{Id: args[0]}
+			response, err := queryClient.Offer(context.Background(), &param)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(offerQueryCmd)
+
+	return offerQueryCmd
+}
+
+func getCmdQueryOffers() *cobra.Command {
+	offerQueryCmd := &cobra.Command{
+		Use:                        "offers",
+		Short:                      "Do a query over all the offers",
+		Long:                       "Query details of a list of offers, with the possibility to filter by buyer and/or object.",
+		Example:                    fmt.Sprintf("%s query escrow offers --buyer <buyer>", version.AppName),
+		Args:                       cobra.ExactArgs(0),
+		SuggestionsMinimumDistance: 2,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid buyer address")
+			}
+
+			objectKey, err := cmd.Flags().GetString(FlagObjectKey)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid object key")
+			}
+
+			paginationStart, err := cmd.Flags().GetUint64(FlagPaginationStart)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid pagination starting index")
+			}
+			paginationLength, err := cmd.Flags().GetUint64(FlagPaginationLength)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid pagination length")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			param := types.QueryOffersRequest{
+				Buyer:            buyer,
+				ObjectKey:        objectKey,
+				PaginationStart:  paginationStart,
+				PaginationLength: paginationLength,
+			}
+			response, err := queryClient.Offers(context.Background(), &param)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	offerQueryCmd.Flags().AddFlagSet(FsQueryOffers)
+	flags.AddQueryFlagsToCmd(offerQueryCmd)
+
+	return offerQueryCmd
+}
+
+func getCmdQueryRecords() *cobra.Command {
+	recordQueryCmd := &cobra.Command{
+		Use:                        "records",
+		Short:                      "Do a query over the records of the completed and refunded escrows",
+		Long:                       "Query the sales history of the escrows, with the possibility to filter by seller, buyer, state, object, object type, domain, price and/or completion date, and to sort them by id, price or completion date.",
+		Example:                    fmt.Sprintf("%s query escrow records --buyer <buyer> --order-by time --descending", version.AppName),
+		Args:                       cobra.ExactArgs(0),
+		SuggestionsMinimumDistance: 2,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seller, err := cmd.Flags().GetString(FlagSeller)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid seller address")
+			}
+
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid buyer address")
+			}
+
+			state, err := cmd.Flags().GetString(FlagState)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid escrow state")
+			}
+
+			objectKey, err := cmd.Flags().GetString(FlagObjectKey)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid object key")
+			}
+
+			objectType, err := cmd.Flags().GetUint64(FlagObjectType)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid object type")
+			}
+
+			domain, err := cmd.Flags().GetString(FlagDomain)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid domain")
+			}
+
+			priceMin, err := cmd.Flags().GetString(FlagPriceMin)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid minimum price")
+			}
+			priceMax, err := cmd.Flags().GetString(FlagPriceMax)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid maximum price")
+			}
+
+			timeMin, err := getDateFlag(cmd, FlagTimeMin)
+			if err != nil {
+				return err
+			}
+			timeMax, err := getDateFlag(cmd, FlagTimeMax)
+			if err != nil {
+				return err
+			}
+
+			orderBy, err := cmd.Flags().GetString(FlagOrderBy)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid sort order")
+			}
+			descending, err := cmd.Flags().GetBool(FlagDescending)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid sort direction")
+			}
+
+			paginationStart, err := cmd.Flags().GetUint64(FlagPaginationStart)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid pagination starting index")
+			}
+			paginationLength, err := cmd.Flags().GetUint64(FlagPaginationLength)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Invalid pagination length")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			param := types.QueryEscrowRecordsRequest{
+				Seller:           seller,
+				Buyer:            buyer,
+				State:            state,
+				ObjectKey:        objectKey,
+				ObjectType:       objectType,
+				Domain:           domain,
+				PriceMin:         priceMin,
+				PriceMax:         priceMax,
+				TimeMin:          timeMin,
+				TimeMax:          timeMax,
+				OrderBy:          orderBy,
+				Descending:       descending,
+				PaginationStart:  paginationStart,
+				PaginationLength: paginationLength,
+			}
+			response, err := queryClient.EscrowRecords(context.Background(), &param)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	recordQueryCmd.Flags().AddFlagSet(FsQueryRecords)
+	flags.AddQueryFlagsToCmd(recordQueryCmd)
+
+	return recordQueryCmd
+}
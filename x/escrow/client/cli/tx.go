@@ -28,6 +28,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdateEscrow(),
 		GetCmdTransferToEscrow(),
 		GetCmdRefundEscrow(),
+		GetCmdAcceptOffer(),
+		GetCmdWithdrawOffer(),
+		GetCmdCompleteSwap(),
 	)
 
 	return escrowTxCmd
@@ -87,13 +90,24 @@ func GetCmdUpdateEscrow() *cobra.Command {
 				}
 			}
 
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return err
+			}
+			clearBuyer, err := cmd.Flags().GetBool(FlagClearBuyer)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgUpdateEscrow{
-				Id:       args[0],
-				Updater:  updater,
-				FeePayer: feePayer,
-				Seller:   seller,
-				Price:    priceCoins,
-				Deadline: deadline,
+				Id:         args[0],
+				Updater:    updater,
+				FeePayer:   feePayer,
+				Seller:     seller,
+				Price:      priceCoins,
+				Deadline:   deadline,
+				Buyer:      buyer,
+				ClearBuyer: clearBuyer,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -115,7 +129,7 @@ func GetCmdTransferToEscrow() *cobra.Command {
 		Short: "Transfers coins to an escrow",
 		Long: "Transfer coins to an escrow, if the minimum price is not reached, the transaction will fail." +
 			"Otherwise, an amount equal to the escrow price will be sent to the escrow and the exchange will" +
-			"be done",
+			"be done. The marketplace completing the sale can set its broker to receive its share of the commission",
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -138,11 +152,17 @@ func GetCmdTransferToEscrow() *cobra.Command {
 				return sdkerrors.Wrap(err, "Invalid amount format")
 			}
 
+			broker, err := cmd.Flags().GetString(FlagBroker)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgTransferToEscrow{
 				Id:       args[0],
 				Sender:   sender,
 				FeePayer: feePayer,
 				Amount:   amount,
+				Broker:   broker,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -153,6 +173,7 @@ func GetCmdTransferToEscrow() *cobra.Command {
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	addCommonFlags(cmd.Flags())
+	cmd.Flags().String(FlagBroker, "", "Bech32 encoded address of the broker of the marketplace completing the sale, optional")
 
 	return cmd
 }
@@ -197,3 +218,137 @@ func GetCmdRefundEscrow() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdAcceptOffer implements accepting an offer command
+func GetCmdAcceptOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-offer [id]",
+		Short: "Accept an offer on an object you own",
+		Long: "Accept an offer on an object you own, the object is transferred to the buyer and the offered coins " +
+			"are sent to you.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seller := clientCtx.GetFromAddress().String()
+			if len(seller) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			feePayer, err := cmd.Flags().GetString(FlagFeePayer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptOffer{
+				Id:       args[0],
+				Seller:   seller,
+				FeePayer: feePayer,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addCommonFlags(cmd.Flags())
+
+	return cmd
+}
+
+// GetCmdWithdrawOffer implements withdrawing an offer command
+func GetCmdWithdrawOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-offer [id]",
+		Short: "Withdraw an offer and get back the offered coins",
+		Long:  "Withdraw an offer and get back the offered coins.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			buyer := clientCtx.GetFromAddress().String()
+			if len(buyer) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			feePayer, err := cmd.Flags().GetString(FlagFeePayer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgWithdrawOffer{
+				Id:       args[0],
+				Buyer:    buyer,
+				FeePayer: feePayer,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addCommonFlags(cmd.Flags())
+
+	return cmd
+}
+
+// GetCmdCompleteSwap implements completing a swap escrow command
+func GetCmdCompleteSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complete-swap [id] [amount]",
+		Short: "Completes a swap escrow",
+		Long: "Complete a swap escrow by giving the wanted object to the seller of the escrow in exchange for its object." +
+			" If the swap has a price, the amount is the maximum the sender accepts to pay on top of the wanted object",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			if len(sender) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			feePayer, err := cmd.Flags().GetString(FlagFeePayer)
+			if err != nil {
+				return err
+			}
+
+			var amount sdk.Coins
+			if len(args) == 2 {
+				amount, err = sdk.ParseCoinsNormalized(args[1])
+				if err != nil {
+					return sdkerrors.Wrap(err, "Invalid amount format")
+				}
+			}
+
+			msg := types.MsgCompleteSwap{
+				Id:       args[0],
+				Sender:   sender,
+				FeePayer: feePayer,
+				Amount:   amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addCommonFlags(cmd.Flags())
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -43,6 +44,109 @@ func NewMsgCreateEscrow(ctx client.Context, cmd *cobra.Command, obj types.Transf
 
 	msg := types.NewMsgCreateEscrow(seller, feePayer, obj, price, deadline)
 
+	// The price decay flags are only available on the commands calling AddPriceDecayFlags
+	if cmd.Flags().Lookup(FlagPriceDecay) != nil {
+		if msg.PriceDecay, msg.FloorPrice, err = parsePriceDecay(cmd); err != nil {
+			return nil, err
+		}
+	}
+
+	// The broker flags are only available on the commands calling AddBrokerFlags
+	if cmd.Flags().Lookup(FlagBroker) != nil {
+		if msg.Broker, msg.BrokerCommission, msg.SellingBrokerShare, err = parseBroker(cmd); err != nil {
+			return nil, err
+		}
+	}
+
+	// The buyer flag is only available on the commands calling AddDesignatedBuyerFlag
+	if cmd.Flags().Lookup(FlagBuyer) != nil {
+		if msg.Buyer, err = cmd.Flags().GetString(FlagBuyer); err != nil {
+			return nil, err
+		}
+	}
+
+	// check if valid
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
+// NewMsgCreateOffer creates a types.MsgCreateOffer including the buyer, the fee payer, the price, the deadline
+// and the given object. This method calls ValidateBasic on the created message.
+// The AddCreateEscrowFlags function has to be called on the same cmd object before.
+func NewMsgCreateOffer(ctx client.Context, cmd *cobra.Command, obj types.TransferableObject) (*types.MsgCreateOffer, error) {
+	buyer := ctx.GetFromAddress().String()
+	feePayer, err := cmd.Flags().GetString(FlagFeePayer)
+	if err != nil {
+		return nil, err
+	}
+
+	priceStr, err := verifyErrAndNonEmpty(cmd, FlagPrice)
+	if err != nil {
+		return nil, err
+	}
+	price, err := sdk.ParseCoinsNormalized(priceStr)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid price : %v", priceStr)
+	}
+
+	deadlineStr, err := verifyErrAndNonEmpty(cmd, FlagDeadline)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, err := parseDeadline(deadlineStr)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgCreateOffer(buyer, feePayer, obj, price, deadline)
+
+	// check if valid
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
+// NewMsgCreateSwap creates a types.MsgCreateSwap including the seller, the fee payer, the optional price, the deadline,
+// the given object and the wanted object. This method calls ValidateBasic on the created message.
+// The AddCreateEscrowFlags function has to be called on the same cmd object before.
+func NewMsgCreateSwap(ctx client.Context, cmd *cobra.Command, obj, wanted types.TransferableObject) (*types.MsgCreateSwap, error) {
+	seller := ctx.GetFromAddress().String()
+	feePayer, err := cmd.Flags().GetString(FlagFeePayer)
+	if err != nil {
+		return nil, err
+	}
+
+	// The price is optional for a swap
+	priceStr, err := cmd.Flags().GetString(FlagPrice)
+	if err != nil {
+		return nil, err
+	}
+	var price sdk.Coins
+	if len(priceStr) != 0 {
+		price, err = sdk.ParseCoinsNormalized(priceStr)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "Invalid price : %v", priceStr)
+		}
+	}
+
+	deadlineStr, err := verifyErrAndNonEmpty(cmd, FlagDeadline)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, err := parseDeadline(deadlineStr)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgCreateSwap(seller, feePayer, obj, wanted, price, deadline)
+
 	// check if valid
 	if err = msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -58,6 +162,86 @@ func AddCreateEscrowFlags(cmd *cobra.Command) {
 	cmd.Flags().String(FlagDeadline, "", "Expiration date of the escrow, in the RFC3339 time format")
 }
 
+// AddPriceDecayFlags adds the flags used by NewMsgCreateEscrow to create an escrow with a declining price
+func AddPriceDecayFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPriceDecay, "", "Decay of the price down to the floor price by the expiration date, can be linear or exponential")
+	cmd.Flags().String(FlagFloorPrice, "", "Floor price of the object, required with a price decay")
+}
+
+// AddBrokerFlags adds the flags used by NewMsgCreateEscrow to create an escrow listed by a marketplace with its own broker
+func AddBrokerFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBroker, "", "Bech32 encoded address of the broker of the marketplace listing the escrow, the configured broker if empty")
+	cmd.Flags().String(FlagBrokerCommission, "", "Commission of the broker, a fraction of the price lower than the configured maximum, e.g. 0.02")
+	cmd.Flags().String(FlagSellingBrokerShare, "", "Share of the commission paid to the broker of the marketplace completing the sale if it is not the listing one, e.g. 0.5")
+}
+
+// AddDesignatedBuyerFlag adds the flag used by NewMsgCreateEscrow to create an escrow restricted to a designated buyer
+func AddDesignatedBuyerFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBuyer, "", "Bech32 encoded address of the designated buyer of a private sale, anybody can buy the object if empty")
+}
+
+// parseBroker parses the broker, the broker commission and the selling broker share flags
+func parseBroker(cmd *cobra.Command) (string, sdk.Dec, sdk.Dec, error) {
+	broker, err := cmd.Flags().GetString(FlagBroker)
+	if err != nil {
+		return "", sdk.Dec{}, sdk.Dec{}, err
+	}
+	commission, err := parseDec(cmd, FlagBrokerCommission)
+	if err != nil {
+		return "", sdk.Dec{}, sdk.Dec{}, err
+	}
+	share, err := parseDec(cmd, FlagSellingBrokerShare)
+	if err != nil {
+		return "", sdk.Dec{}, sdk.Dec{}, err
+	}
+	return broker, commission, share, nil
+}
+
+// parseDec parses an optional decimal flag, zero if it is empty
+func parseDec(cmd *cobra.Command, flag string) (sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if len(str) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(err, "Invalid %v : %v", flag, str)
+	}
+	return dec, nil
+}
+
+// parsePriceDecay parses the price decay and the floor price flags
+func parsePriceDecay(cmd *cobra.Command) (types.PriceDecay, sdk.Coins, error) {
+	decayStr, err := cmd.Flags().GetString(FlagPriceDecay)
+	if err != nil {
+		return types.PriceDecay_None, nil, err
+	}
+	var decay types.PriceDecay
+	switch strings.ToLower(decayStr) {
+	case "":
+		return types.PriceDecay_None, nil, nil
+	case "linear":
+		decay = types.PriceDecay_Linear
+	case "exponential":
+		decay = types.PriceDecay_Exponential
+	default:
+		return types.PriceDecay_None, nil, fmt.Errorf("the price decay must be one of linear or exponential : %v", decayStr)
+	}
+
+	floorPriceStr, err := verifyErrAndNonEmpty(cmd, FlagFloorPrice)
+	if err != nil {
+		return types.PriceDecay_None, nil, err
+	}
+	floorPrice, err := sdk.ParseCoinsNormalized(floorPriceStr)
+	if err != nil {
+		return types.PriceDecay_None, nil, sdkerrors.Wrapf(err, "Invalid floor price : %v", floorPriceStr)
+	}
+	return decay, floorPrice, nil
+}
+
 func verifyErrAndNonEmpty(cmd *cobra.Command, flag string) (string, error) {
 	val, err := cmd.Flags().GetString(flag)
 	if err != nil {
@@ -77,3 +261,16 @@ func parseDeadline(date string) (uint64, error) {
 
 	return uint64(t.Unix()), nil
 }
+
+// getDateFlag returns the date of a flag in the RFC3339 format as a unix timestamp, or 0 if the flag is not set
+func getDateFlag(cmd *cobra.Command, flag string) (uint64, error) {
+	date, err := cmd.Flags().GetString(flag)
+	if err != nil || len(date) == 0 {
+		return 0, err
+	}
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(err, "The %s date is not in RFC3339 format : %v", flag, date)
+	}
+	return uint64(t.Unix()), nil
+}
@@ -21,6 +21,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, escrow := range data.GetEscrows() {
 		k.SaveEscrow(ctx, escrow)
 	}
+	for _, offer := range data.GetOffers() {
+		k.SaveOffer(ctx, offer)
+	}
+	for _, record := range data.GetRecords() {
+		k.SaveEscrowRecord(ctx, record)
+	}
 }
 
 // ExportGenesis outputs the genesis state
@@ -34,8 +40,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
+	var offers []types.Offer
+	k.IterateOffers(
+		ctx,
+		func(o types.Offer) (stop bool) {
+			offers = append(offers, o)
+			return false
+		},
+	)
+
+	var records []types.EscrowRecord
+	k.IterateEscrowRecords(
+		ctx,
+		func(r types.EscrowRecord) (stop bool) {
+			records = append(records, r)
+			return false
+		},
+	)
+
 	lastBlockTime := k.GetLastBlockTime(ctx)
 	nextID := k.GetNextIDForExport(ctx)
 
-	return types.NewGenesisState(escrows, lastBlockTime, nextID, k.GetParams(ctx))
+	return types.NewGenesisState(escrows, offers, records, lastBlockTime, nextID, k.GetParams(ctx))
 }
@@ -32,7 +32,7 @@ type GenesisTestSuite struct {
 func (suite *GenesisTestSuite) SetupTest() {
 	test.SetConfig()
 
-	suite.keeper, suite.ctx, suite.crudStore, _, suite.storeKey, _ = test.NewTestKeeper(nil, true)
+	suite.keeper, suite.ctx, suite.crudStore, _, suite.storeKey = test.NewTestKeeper(nil, true)
 	suite.keeper.ImportNextID(suite.ctx, 1)
 	app := app.Setup(false)
 	//suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: tmtime.Now()})
@@ -128,3 +128,67 @@ func (suite *GenesisTestSuite) TestImportExport() {
 		suite.Fail(fmt.Sprintf("Invriant broken after export with message : %v", msg))
 	}
 }
+
+func (suite *GenesisTestSuite) TestImportExportOffers() {
+	var offers []types.Offer
+	for i := 0; i < 10; i++ {
+		obj := suite.gen.NewTestObject(suite.gen.NewAccAddress())
+		id := suite.keeper.FetchNextId(suite.ctx)
+		offer := types.NewOffer(id, suite.gen.NewAccAddress(), sdk.NewCoins(sdk.NewInt64Coin(test.Denom, 10)), obj, suite.gen.NowAfter(10))
+		suite.keeper.SaveOffer(suite.ctx, offer)
+		suite.keeper.NextId(suite.ctx)
+		offers = append(offers, offer)
+	}
+
+	genesis := escrow.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+
+	// Import the exported state in a fresh keeper
+	k, ctx, _, _, _ := test.NewTestKeeper(nil, true)
+	escrow.InitGenesis(ctx, k, *genesis)
+
+	suite.Equal(suite.keeper.GetNextIDForExport(suite.ctx), k.GetNextIDForExport(ctx))
+	for _, expected := range offers {
+		actual, found := k.GetOffer(ctx, expected.Id)
+		suite.Require().True(found, "Expected offer %v not found", expected.Id)
+		suite.Equal(expected.Buyer, actual.Buyer)
+		suite.Equal(expected.Price, actual.Price)
+		suite.Equal(expected.Deadline, actual.Deadline)
+	}
+	var expired int
+	k.IterateOffersWithPassedDeadline(ctx, suite.gen.NowAfter(10), func(types.Offer) bool {
+		expired++
+		return false
+	})
+	suite.Equal(len(offers), expired, "The offers must be indexed by deadline")
+}
+
+func (suite *GenesisTestSuite) TestImportExportRecords() {
+	var records []types.EscrowRecord
+	for i := 0; i < 10; i++ {
+		escrow, obj := suite.gen.NewRandomTestEscrow()
+		seller := obj.Owner
+		suite.Require().NoError(suite.crudStore.Create(obj))
+		id, err := suite.keeper.CreateEscrow(suite.ctx, seller, escrow.Price, obj, escrow.Deadline)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.keeper.RefundEscrow(suite.ctx, seller, id))
+
+		record, found := suite.keeper.GetEscrowRecord(suite.ctx, id)
+		suite.Require().True(found)
+		records = append(records, record)
+	}
+
+	genesis := escrow.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Len(genesis.Records, len(records))
+
+	// Import the exported state in a fresh keeper
+	k, ctx, _, _, _ := test.NewTestKeeper(nil, true)
+	escrow.InitGenesis(ctx, k, *genesis)
+
+	for _, expected := range records {
+		actual, found := k.GetEscrowRecord(ctx, expected.Id)
+		suite.Require().True(found, "Expected record %v not found", expected.Id)
+		suite.Equal(expected, actual)
+	}
+}
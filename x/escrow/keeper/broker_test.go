@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type BrokerTestSuite struct {
+	BaseKeeperSuite
+	buyer, seller         sdk.AccAddress
+	listing, selling      sdk.AccAddress
+	defaultBroker         sdk.AccAddress
+	price                 sdk.Coins
+	commission, halfShare sdk.Dec
+}
+
+func (s *BrokerTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.listing = s.generator.NewAccAddress()
+	s.selling = s.generator.NewAccAddress()
+	s.defaultBroker = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer, s.seller}, true)
+	s.price = coins(1000)
+	s.commission = sdk.NewDecWithPrec(4, 2)
+	s.halfShare = sdk.NewDecWithPrec(5, 1)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.Broker = s.defaultBroker.String()
+	params.Commission = sdk.NewDecWithPrec(1, 2)
+	params.CommissionMax = sdk.NewDecWithPrec(5, 2)
+	s.keeper.SetParams(s.ctx, params)
+}
+
+func (s *BrokerTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins(s.balances[addr.String()]...)
+}
+
+// received returns the coins received by the seller, which started with the coins of a coin holder
+func (s *BrokerTestSuite) received() sdk.Coins {
+	return s.balance(s.seller).Sub(s.balance(s.buyer).Add(s.price...))
+}
+
+func (s *BrokerTestSuite) createListedEscrow() string {
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.SetEscrowBroker(s.ctx, id, s.seller, s.listing, s.commission, s.halfShare))
+	return id
+}
+
+func (s *BrokerTestSuite) TestSetEscrowBroker() {
+	id := s.createListedEscrow()
+	escrow, found := s.keeper.GetEscrow(s.ctx, id)
+	s.Require().True(found)
+	s.Assert().Equal(s.listing.String(), escrow.BrokerAddress)
+	s.Assert().Equal(s.commission, escrow.BrokerCommission)
+	s.Assert().Equal(s.halfShare, escrow.GetSellingBrokerShare())
+}
+
+func (s *BrokerTestSuite) TestSetEscrowBrokerInvalid() {
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	err = s.keeper.SetEscrowBroker(s.ctx, id, s.seller, s.listing, sdk.NewDecWithPrec(6, 2), s.halfShare)
+	s.Assert().Error(err, "the commission exceeds the configured maximum")
+	err = s.keeper.SetEscrowBroker(s.ctx, id, s.buyer, s.listing, s.commission, s.halfShare)
+	s.Assert().Error(err, "only the seller can set the broker")
+	err = s.keeper.SetEscrowBroker(s.ctx, id, s.seller, s.listing, s.commission, sdk.NewDec(2))
+	s.Assert().Error(err, "the selling broker share must be in [0;1]")
+	err = s.keeper.SetEscrowBroker(s.ctx, "0000000000000042", s.seller, s.listing, s.commission, s.halfShare)
+	s.Assert().Error(err, "the escrow does not exist")
+}
+
+func (s *BrokerTestSuite) TestSaleThroughListingBroker() {
+	id := s.createListedEscrow()
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(40), s.balance(s.listing))
+	s.Assert().Equal(coins(960), s.received())
+	s.Assert().True(s.balance(s.defaultBroker).IsZero())
+}
+
+func (s *BrokerTestSuite) TestSaleThroughSellingBroker() {
+	id := s.createListedEscrow()
+	s.Require().NoError(s.keeper.TransferToEscrowThroughBroker(s.ctx, s.buyer, id, s.price, s.selling))
+	s.Assert().Equal(coins(20), s.balance(s.listing))
+	s.Assert().Equal(coins(20), s.balance(s.selling))
+	s.Assert().Equal(coins(960), s.received())
+	s.Assert().True(s.balance(s.keeper.GetEscrowAddress(id)).IsZero())
+}
+
+func (s *BrokerTestSuite) TestSaleThroughSameBroker() {
+	// The listing broker completing the sale gets the whole commission
+	id := s.createListedEscrow()
+	s.Require().NoError(s.keeper.TransferToEscrowThroughBroker(s.ctx, s.buyer, id, s.price, s.listing))
+	s.Assert().Equal(coins(40), s.balance(s.listing))
+	s.Assert().Equal(coins(960), s.received())
+}
+
+func (s *BrokerTestSuite) TestMsgCreateEscrow() {
+	msg := types.NewMsgCreateEscrow(s.seller.String(), "", newSavedObject(s.generator, s.seller, s.store), s.price, s.generator.NowAfter(10))
+	msg.Broker = s.listing.String()
+	msg.BrokerCommission = s.commission
+	res, err := s.msgServer.CreateEscrow(sdk.WrapSDKContext(s.ctx), &msg)
+	s.Require().NoError(err)
+
+	escrow, found := s.keeper.GetEscrow(s.ctx, res.Id)
+	s.Require().True(found)
+	s.Assert().Equal(s.listing.String(), escrow.BrokerAddress)
+	s.Assert().Equal(s.commission, escrow.BrokerCommission)
+	s.Assert().True(escrow.GetSellingBrokerShare().IsZero())
+
+	// Without broker, the broker and commission of the parameters are used
+	msg = types.NewMsgCreateEscrow(s.seller.String(), "", newSavedObject(s.generator, s.seller, s.store), s.price, s.generator.NowAfter(10))
+	res, err = s.msgServer.CreateEscrow(sdk.WrapSDKContext(s.ctx), &msg)
+	s.Require().NoError(err)
+	escrow, found = s.keeper.GetEscrow(s.ctx, res.Id)
+	s.Require().True(found)
+	s.Assert().Equal(s.defaultBroker.String(), escrow.BrokerAddress)
+	s.Assert().Equal(sdk.NewDecWithPrec(1, 2), escrow.BrokerCommission)
+}
+
+func TestBroker(t *testing.T) {
+	suite.Run(t, new(BrokerTestSuite))
+}
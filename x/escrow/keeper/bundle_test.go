@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type BundleTestSuite struct {
+	BaseKeeperSuite
+	buyer  sdk.AccAddress
+	seller sdk.AccAddress
+	price  sdk.Coins
+}
+
+func (s *BundleTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+	s.price = sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(100)))
+}
+
+func (s *BundleTestSuite) owner(obj *types.TestObject) sdk.AccAddress {
+	var stored types.TestObject
+	s.Require().NoError(s.store.Read(obj.PrimaryKey(), &stored))
+	return stored.Owner
+}
+
+func (s *BundleTestSuite) newBundle(objects ...types.TransferableObject) *types.ObjectBundle {
+	bundle, err := types.NewObjectBundle(objects...)
+	s.Require().NoError(err)
+	return bundle
+}
+
+func (s *BundleTestSuite) TestNewObjectBundle() {
+	obj := s.generator.NewTestObject(s.seller)
+	_, err := types.NewObjectBundle()
+	s.Assert().Error(err, "empty bundle")
+	_, err = types.NewObjectBundle(obj, obj)
+	s.Assert().Error(err, "duplicated object")
+	_, err = types.NewObjectBundle(s.newBundle(obj))
+	s.Assert().Error(err, "nested bundle")
+	objects := make([]types.TransferableObject, types.MaxBundleSize+1)
+	for i := range objects {
+		objects[i] = s.generator.NewTestObject(s.seller)
+	}
+	_, err = types.NewObjectBundle(objects...)
+	s.Assert().Error(err, "too many objects")
+}
+
+func (s *BundleTestSuite) TestSale() {
+	first := newSavedObject(s.generator, s.seller, s.store)
+	second := newSavedObject(s.generator, s.seller, s.store)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(first, second), s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	s.Assert().Equal(s.keeper.GetEscrowAddress(id), s.owner(first))
+	s.Assert().Equal(s.keeper.GetEscrowAddress(id), s.owner(second))
+
+	// The escrow can be found from any of its objects
+	for _, obj := range []*types.TestObject{first, second} {
+		escrows, err := s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowsRequest{ObjectKey: hex.EncodeToString(obj.GetUniqueKey())})
+		s.Require().NoError(err)
+		s.Require().Len(escrows.Escrows, 1)
+		s.Assert().Equal(id, escrows.Escrows[0].Id)
+	}
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(s.buyer, s.owner(first))
+	s.Assert().Equal(s.buyer, s.owner(second))
+}
+
+func (s *BundleTestSuite) TestRefund() {
+	first := newSavedObject(s.generator, s.seller, s.store)
+	second := newSavedObject(s.generator, s.seller, s.store)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(first, second), s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.RefundEscrow(s.ctx, s.seller, id))
+	s.Assert().Equal(s.seller, s.owner(first))
+	s.Assert().Equal(s.seller, s.owner(second))
+}
+
+func (s *BundleTestSuite) TestInvalidObject() {
+	owned := newSavedObject(s.generator, s.seller, s.store)
+	notOwned := newSavedObject(s.generator, s.generator.NewAccAddress(), s.store)
+	_, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(owned, notOwned), s.generator.NowAfter(10))
+	s.Assert().Error(err, "every object of the bundle must be owned by the seller")
+
+	errored := s.generator.NewErroredTestObject(0)
+	s.Require().NoError(s.store.Create(errored))
+	_, err = s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(errored, newSavedObject(s.generator, s.seller, s.store)), s.generator.NowAfter(10))
+	s.Assert().Error(err, "every object of the bundle must be transferable")
+}
+
+func (s *BundleTestSuite) TestDeadline() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	constrained := s.generator.NewTimeConstrainedObject(s.seller, s.generator.NowAfter(5))
+	s.Require().NoError(s.store.Create(constrained))
+	_, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(obj, constrained), s.generator.NowAfter(10))
+	s.Assert().Error(err, "the deadline must be validated by every object of the bundle")
+	_, err = s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(obj, constrained), s.generator.NowAfter(4))
+	s.Assert().NoError(err)
+}
+
+func (s *BundleTestSuite) TestFees() {
+	single := types.NewMsgCreateEscrow(s.seller.String(), "", s.generator.NewTestObject(s.seller), s.price, s.generator.NowAfter(10))
+	bundled := types.NewMsgCreateEscrow(s.seller.String(), "", s.newBundle(
+		s.generator.NewTestObject(s.seller),
+		s.generator.NewTestObject(s.seller),
+		s.generator.NewTestObject(s.seller),
+	), s.price, s.generator.NowAfter(10))
+
+	singleFees := s.keeper.ComputeFees(s.ctx, &single)
+	s.Assert().Equal(singleFees.AmountOf(test.Denom).MulRaw(3), s.keeper.ComputeFees(s.ctx, &bundled).AmountOf(test.Denom))
+}
+
+func (s *BundleTestSuite) TestUpdateEscrowedObjects() {
+	obj1 := newSavedObject(s.generator, s.seller, s.store)
+	obj2 := newSavedObject(s.generator, s.seller, s.store)
+	obj3 := newSavedObject(s.generator, s.seller, s.store)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, s.newBundle(obj1, obj2), s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	offerID, err := s.keeper.CreateOffer(s.ctx, s.buyer, s.price, obj3, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	updated := map[uint64]bool{obj2.Id: true, obj3.Id: true}
+	s.Require().NoError(s.keeper.UpdateEscrowedObjects(s.ctx, func(obj types.TransferableObject) bool {
+		testObj := obj.(*types.TestObject)
+		if updated[testObj.Id] {
+			testObj.NumAllowedTransfers = 42
+		}
+		return updated[testObj.Id]
+	}))
+
+	escrow, found := s.keeper.GetEscrow(s.ctx, id)
+	s.Require().True(found)
+	objects := escrow.GetObject().(*types.ObjectBundle).GetObjects()
+	s.Assert().Equal(obj1.NumAllowedTransfers, objects[0].(*types.TestObject).NumAllowedTransfers)
+	s.Assert().Equal(int64(42), objects[1].(*types.TestObject).NumAllowedTransfers)
+	offer, found := s.keeper.GetOffer(s.ctx, offerID)
+	s.Require().True(found)
+	s.Assert().Equal(int64(42), offer.GetObject().(*types.TestObject).NumAllowedTransfers)
+}
+
+func TestBundle(t *testing.T) {
+	suite.Run(t, new(BundleTestSuite))
+}
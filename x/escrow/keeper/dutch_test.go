@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type DutchTestSuite struct {
+	BaseKeeperSuite
+	buyer  sdk.AccAddress
+	seller sdk.AccAddress
+	price  sdk.Coins
+	floor  sdk.Coins
+}
+
+func (s *DutchTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+	s.price = coins(100)
+	s.floor = coins(20)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(amount)))
+}
+
+func (s *DutchTestSuite) owner(obj *types.TestObject) sdk.AccAddress {
+	var stored types.TestObject
+	s.Require().NoError(s.store.Read(obj.PrimaryKey(), &stored))
+	return stored.Owner
+}
+
+func (s *DutchTestSuite) createDutchEscrow(obj *types.TestObject, decay types.PriceDecay) string {
+	id, err := s.keeper.CreateDutchEscrow(s.ctx, s.seller, s.price, s.floor, decay, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *DutchTestSuite) currentPrice(id string, after time.Duration) sdk.Coins {
+	escrow, found := s.keeper.GetEscrow(s.ctx, id)
+	s.Require().True(found)
+	return s.keeper.GetCurrentPrice(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(after)), escrow)
+}
+
+func (s *DutchTestSuite) TestLinearDecay() {
+	id := s.createDutchEscrow(newSavedObject(s.generator, s.seller, s.store), types.PriceDecay_Linear)
+	s.Assert().Equal(s.price, s.currentPrice(id, 0))
+	s.Assert().Equal(coins(60), s.currentPrice(id, 5*time.Second))
+	s.Assert().Equal(coins(28), s.currentPrice(id, 9*time.Second))
+	s.Assert().Equal(s.floor, s.currentPrice(id, 10*time.Second))
+}
+
+func (s *DutchTestSuite) TestExponentialDecay() {
+	id := s.createDutchEscrow(newSavedObject(s.generator, s.seller, s.store), types.PriceDecay_Exponential)
+	s.Assert().Equal(s.price, s.currentPrice(id, 0))
+	s.Assert().Equal(coins(60), s.currentPrice(id, time.Second))
+	s.Assert().Equal(coins(23), s.currentPrice(id, 5*time.Second))
+	s.Assert().Equal(s.floor, s.currentPrice(id, 10*time.Second))
+
+	// The price never increases and never goes below the floor price
+	previous := s.price
+	for i := time.Duration(0); i <= 10; i++ {
+		price := s.currentPrice(id, i*time.Second)
+		s.Assert().True(previous.IsAllGTE(price))
+		s.Assert().True(price.IsAllGTE(s.floor))
+		previous = price
+	}
+}
+
+func (s *DutchTestSuite) TestCreateInvalid() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	_, err := s.keeper.CreateDutchEscrow(s.ctx, s.seller, s.price, s.price, types.PriceDecay_Linear, obj, s.generator.NowAfter(10))
+	s.Assert().Error(err, "the floor price must be below the price")
+	_, err = s.keeper.CreateDutchEscrow(s.ctx, s.seller, s.price, nil, types.PriceDecay_Linear, obj, s.generator.NowAfter(10))
+	s.Assert().Error(err, "a floor price is required")
+	_, err = s.keeper.CreateDutchEscrow(s.ctx, s.seller, s.price, s.floor, types.PriceDecay_None, obj, s.generator.NowAfter(10))
+	s.Assert().Error(err, "a floor price requires a price decay")
+	_, err = s.keeper.CreateDutchEscrow(s.ctx, s.seller, s.price, s.floor, types.PriceDecay(42), obj, s.generator.NowAfter(10))
+	s.Assert().Error(err, "unknown price decay")
+}
+
+func (s *DutchTestSuite) TestTransferToEscrow() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	id := s.createDutchEscrow(obj, types.PriceDecay_Linear)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+
+	s.Assert().Error(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, coins(59)), "the amount is below the current price")
+
+	before := sdk.NewCoins(s.balances[s.buyer.String()]...)
+	// The amount is the maximum the buyer accepts to pay, only the current price is paid
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(s.buyer, s.owner(obj))
+	s.Assert().Equal(before.Sub(coins(60)), sdk.NewCoins(s.balances[s.buyer.String()]...))
+}
+
+func (s *DutchTestSuite) TestUpdatePrice() {
+	id := s.createDutchEscrow(newSavedObject(s.generator, s.seller, s.store), types.PriceDecay_Linear)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+
+	s.Assert().Error(s.keeper.UpdateEscrow(s.ctx, id, s.seller, nil, s.floor, 0), "the new price must be above the floor price")
+
+	// The price declines from the new price from now on
+	s.Require().NoError(s.keeper.UpdateEscrow(s.ctx, id, s.seller, nil, coins(80), 0))
+	s.Assert().Equal(coins(80), s.currentPrice(id, 0))
+	s.Assert().Equal(coins(44), s.currentPrice(id, 3*time.Second))
+}
+
+func (s *DutchTestSuite) TestUpdateDeadline() {
+	id := s.createDutchEscrow(newSavedObject(s.generator, s.seller, s.store), types.PriceDecay_Linear)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+
+	// The price declines from the current price to the floor price at the new deadline, it does not jump
+	s.Require().NoError(s.keeper.UpdateEscrow(s.ctx, id, s.seller, nil, nil, s.generator.NowAfter(15)))
+	s.Assert().Equal(coins(60), s.currentPrice(id, 0))
+	s.Assert().Equal(coins(40), s.currentPrice(id, 5*time.Second))
+	s.Assert().Equal(s.floor, s.currentPrice(id, 10*time.Second))
+
+	// A new price provided with the new deadline is the start of the decline
+	s.Require().NoError(s.keeper.UpdateEscrow(s.ctx, id, s.seller, nil, coins(80), s.generator.NowAfter(25)))
+	s.Assert().Equal(coins(80), s.currentPrice(id, 0))
+	s.Assert().Equal(coins(65), s.currentPrice(id, 5*time.Second))
+}
+
+func (s *DutchTestSuite) TestQueryCurrentPrice() {
+	id := s.createDutchEscrow(newSavedObject(s.generator, s.seller, s.store), types.PriceDecay_Linear)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+
+	res, err := s.keeper.Escrow(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowRequest{Id: id})
+	s.Require().NoError(err)
+	s.Assert().Equal(coins(60), res.CurrentPrice)
+	s.Assert().Equal(s.price, res.Escrow.Price)
+}
+
+func TestDutch(t *testing.T) {
+	suite.Run(t, new(DutchTestSuite))
+}
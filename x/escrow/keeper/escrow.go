@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/hex"
+	"sort"
 	"strings"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	crud "github.com/iov-one/cosmos-sdk-crud"
@@ -13,10 +16,10 @@ import (
 )
 
 // CreateEscrow creates an escrow and transfer the object to the escrow account.
-// The deadline must be included in the interval ]now, now + escrow_max_period].
-// The price must be in fee_coin_denom denomination.
-// The escrow is created with the predefined escrow_broker broker address and
-// escrow_commission commission.
+// The deadline must be included in the interval ]now, now + max_period].
+// The price must be in the price_denom denomination.
+// The escrow is created with the broker parameter and
+// commission parameter.
 // The returned string is the 16 character escrow ID
 func (k Keeper) CreateEscrow(
 	ctx sdk.Context,
@@ -27,6 +30,45 @@ func (k Keeper) CreateEscrow(
 ) (
 	string,
 	error,
+) {
+	return k.createEscrow(ctx, seller, price, object, deadline, nil)
+}
+
+// CreateDutchEscrow creates an escrow whose price declines from price to floorPrice by the deadline, according to
+// the given price decay, and transfer the object to the escrow account.
+// The price decay starts at the block time, the constraints of CreateEscrow apply as well.
+func (k Keeper) CreateDutchEscrow(
+	ctx sdk.Context,
+	seller sdk.AccAddress,
+	price sdk.Coins,
+	floorPrice sdk.Coins,
+	decay types.PriceDecay,
+	object types.TransferableObject,
+	deadline uint64,
+) (
+	string,
+	error,
+) {
+	return k.createEscrow(ctx, seller, price, object, deadline, func(escrow *types.Escrow) error {
+		escrow.PriceDecay = decay
+		escrow.FloorPrice = floorPrice
+		escrow.StartTime = uint64(ctx.BlockTime().Unix())
+		return nil
+	})
+}
+
+// createEscrow creates and saves an escrow, setup is called, if not nil, on the escrow before its validation to set
+// the fields specific to the kind of escrow
+func (k Keeper) createEscrow(
+	ctx sdk.Context,
+	seller sdk.AccAddress,
+	price sdk.Coins,
+	object types.TransferableObject,
+	deadline uint64,
+	setup func(*types.Escrow) error,
+) (
+	string,
+	error,
 ) {
 	k.checkThatModuleIsEnabled(ctx)
 
@@ -50,6 +92,11 @@ func (k Keeper) CreateEscrow(
 	escrow := types.NewEscrow(
 		id, seller, price, object, deadline, k.GetBrokerAddress(ctx), k.GetBrokerCommission(ctx),
 	)
+	if setup != nil {
+		if err := setup(&escrow); err != nil {
+			return "", err
+		}
+	}
 	err := escrow.ValidateWithContext(ctx, k.GetEscrowPriceDenom(ctx), k.GetLastBlockTime(ctx), k.getCustomDataForType(object.GetObjectTypeID()))
 	if err != nil {
 		return "", err
@@ -90,7 +137,7 @@ func (k Keeper) UpdateEscrow(
 	}
 
 	// check that the escrow is open
-	if escrow.State != types.EscrowState_Open {
+	if escrow.State != types.EscrowState_Open || hasExpired(ctx, escrow) {
 		return sdkerrors.Wrap(types.ErrEscrowExpired, id)
 	}
 
@@ -113,12 +160,22 @@ func (k Keeper) UpdateEscrow(
 	// Update seller, price and deadline if provided
 	if newSeller != nil {
 		escrow.Seller = newSeller.String()
+		if err := types.ValidateDesignatedBuyer(escrow.Buyer, escrow.Seller); err != nil {
+			return err
+		}
 	}
 	if newPrice != nil {
 		if err := types.ValidatePrice(newPrice, k.GetEscrowPriceDenom(ctx)); err != nil {
 			return err
 		}
+		if err := types.ValidatePriceDecay(escrow.PriceDecay, newPrice, escrow.FloorPrice, k.GetEscrowPriceDenom(ctx)); err != nil {
+			return err
+		}
 		escrow.Price = newPrice
+		// The price of a dutch escrow declines from its new price from now on
+		if escrow.IsDutch() {
+			escrow.StartTime = uint64(ctx.BlockTime().Unix())
+		}
 	}
 	if newDeadline != 0 {
 		if err := types.ValidateDeadline(newDeadline, k.GetLastBlockTime(ctx)); err != nil {
@@ -135,6 +192,12 @@ func (k Keeper) UpdateEscrow(
 		} else if newDeadline > uint64(ctx.BlockTime().Unix())+uint64(k.GetMaximumEscrowDuration(ctx).Seconds()) {
 			return sdkerrors.Wrap(types.ErrInvalidDeadline, "The new deadline exceeds the maximum escrow duration")
 		}
+		// The price of a dutch escrow declines from its current price, unless a new price is provided, to its floor
+		// price at the new deadline from now on
+		if escrow.IsDutch() && newPrice == nil {
+			escrow.Price = k.GetCurrentPrice(ctx, escrow)
+			escrow.StartTime = uint64(ctx.BlockTime().Unix())
+		}
 		// We are modifying the deadline, get rid of old deadline indexing
 		k.deleteEscrowFromDeadlineStore(ctx, escrow)
 		// The new deadline indexing will be added when we save the escrow
@@ -145,18 +208,107 @@ func (k Keeper) UpdateEscrow(
 	return nil
 }
 
+// SetEscrowBroker replaces the broker of an open escrow by the marketplace listing it, only the seller can set it.
+// The commission cannot exceed the configured maximum commission, the selling broker share is the share of the
+// commission paid to the broker completing the sale if it is not the listing broker.
+func (k Keeper) SetEscrowBroker(
+	ctx sdk.Context,
+	id string,
+	seller sdk.AccAddress,
+	broker sdk.AccAddress,
+	commission sdk.Dec,
+	sellingShare sdk.Dec,
+) error {
+	k.checkThatModuleIsEnabled(ctx)
+
+	// check that the escrow exists
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrEscrowNotFound, id)
+	}
+
+	// check that the escrow is open
+	if escrow.State != types.EscrowState_Open || hasExpired(ctx, escrow) {
+		return sdkerrors.Wrap(types.ErrEscrowNotOpen, escrow.Id)
+	}
+
+	if escrow.Seller != seller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Only the seller can set the broker of the escrow")
+	}
+
+	if commission.GT(k.GetBrokerCommissionMax(ctx)) {
+		return sdkerrors.Wrapf(types.ErrInvalidBroker, "The broker commission cannot exceed %s", k.GetBrokerCommissionMax(ctx))
+	}
+
+	escrow.BrokerAddress = broker.String()
+	escrow.BrokerCommission = commission
+	escrow.SellingBrokerShare = sellingShare
+	if err := escrow.ValidateWithoutDeadlineAndObject(k.GetEscrowPriceDenom(ctx)); err != nil {
+		return err
+	}
+
+	k.SaveEscrow(ctx, escrow)
+	return nil
+}
+
+// SetEscrowBuyer restricts an open escrow to a designated buyer, for a private sale, only the seller can set it.
+// A nil buyer removes the restriction so that anybody can complete the escrow.
+func (k Keeper) SetEscrowBuyer(ctx sdk.Context, id string, seller sdk.AccAddress, buyer sdk.AccAddress) error {
+	k.checkThatModuleIsEnabled(ctx)
+
+	// check that the escrow exists
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrEscrowNotFound, id)
+	}
+
+	// check that the escrow is open
+	if escrow.State != types.EscrowState_Open || hasExpired(ctx, escrow) {
+		return sdkerrors.Wrap(types.ErrEscrowNotOpen, escrow.Id)
+	}
+
+	if escrow.Seller != seller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Only the seller can set the buyer of the escrow")
+	}
+
+	escrow.Buyer = ""
+	if buyer != nil {
+		escrow.Buyer = buyer.String()
+	}
+	if err := types.ValidateDesignatedBuyer(escrow.Buyer, escrow.Seller); err != nil {
+		return err
+	}
+
+	k.SaveEscrow(ctx, escrow)
+	return nil
+}
+
 // TransferToEscrow transfers coins from the buyer to the escrow account.
 // The specified amount must be greater than or equal to the escrow price.
 // The actual transferred coins match the price of the escrow, the amount provided there is just a security to limit
 // the coins the buyer accepts to spend.
 // The coins will be transferred to the escrow account and then the object is transferred to the buyer and the coins
 // are sent to the seller. The escrow is then marked as completed and removed.
+// If the escrow has a designated buyer, only this buyer can complete it.
 // If the object or the coin transfer from the escrow account fail, this function panics.
 func (k Keeper) TransferToEscrow(
 	ctx sdk.Context,
 	buyer sdk.AccAddress,
 	id string,
 	amount sdk.Coins,
+) error {
+	return k.TransferToEscrowThroughBroker(ctx, buyer, id, amount, nil)
+}
+
+// TransferToEscrowThroughBroker is like TransferToEscrow for a sale completed through the given selling broker, which
+// receives the selling broker share of the escrow commission if it is not the listing broker of the escrow.
+// The selling broker is optional, the listing broker receives the whole commission if it is nil.
+func (k Keeper) TransferToEscrowThroughBroker(
+	ctx sdk.Context,
+	buyer sdk.AccAddress,
+	id string,
+	amount sdk.Coins,
+	sellingBroker sdk.AccAddress,
 ) error {
 	k.checkThatModuleIsEnabled(ctx)
 
@@ -167,10 +319,15 @@ func (k Keeper) TransferToEscrow(
 	}
 
 	// check that the escrow is open
-	if escrow.State != types.EscrowState_Open {
+	if escrow.State != types.EscrowState_Open || hasExpired(ctx, escrow) {
 		return sdkerrors.Wrap(types.ErrEscrowNotOpen, escrow.Id)
 	}
 
+	// A swap escrow also requires the wanted object
+	if escrow.IsSwap() {
+		return sdkerrors.Wrapf(types.ErrInvalidSwap, "The escrow %s is a swap escrow and must be completed with the wanted object", escrow.Id)
+	}
+
 	seller, err := sdk.AccAddressFromBech32(escrow.Seller)
 	if err != nil {
 		//this should be always valid because the escrow is guaranteed to be in a valid state when created/updated
@@ -188,12 +345,18 @@ func (k Keeper) TransferToEscrow(
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "The owner of the escrow cannot transfer coins to the escrow")
 	}
 
+	// A private sale can only be completed by its designated buyer
+	if !escrow.IsDesignatedBuyer(buyer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "The escrow is reserved to its designated buyer")
+	}
+
 	// Check if the provided amount is valid
 	if !amount.IsValid() {
 		return types.ErrInvalidAmount
 	}
 
-	// Check if the amount is greater or equal than the price
+	// Check if the amount is greater or equal than the price, the buyer pays the price of the escrow at this time
+	escrow.Price = k.GetCurrentPrice(ctx, escrow)
 	if !amount.IsAllGTE(escrow.Price) {
 		return types.ErrTransferAmountTooLow
 	}
@@ -206,7 +369,7 @@ func (k Keeper) TransferToEscrow(
 	}
 
 	// Do the exchange
-	err = k.doSwap(ctx, escrow, buyer, seller, broker)
+	err = k.doSwap(ctx, escrow, buyer, seller, broker, sellingBroker)
 	// If an error occurs here, the buyer have sent the coins and :
 	// - The buyer can have received the object or not
 	// - The seller has not received the coins
@@ -216,12 +379,17 @@ func (k Keeper) TransferToEscrow(
 	}
 
 	escrow.State = types.EscrowState_Completed
-	k.deleteEscrow(ctx, escrow)
+	k.deleteEscrow(ctx, escrow, buyer)
 	return nil
 }
 
+// GetCurrentPrice returns the price to pay to complete the given escrow at the current block time
+func (k Keeper) GetCurrentPrice(ctx sdk.Context, escrow types.Escrow) sdk.Coins {
+	return escrow.CurrentPrice(uint64(ctx.BlockTime().Unix()))
+}
+
 // doSwap perform the actual swap between the object and the coins, which need to belong to the escrow account
-func (k Keeper) doSwap(ctx sdk.Context, escrow types.Escrow, buyer, seller sdk.AccAddress, broker sdk.AccAddress) error {
+func (k Keeper) doSwap(ctx sdk.Context, escrow types.Escrow, buyer, seller sdk.AccAddress, broker, sellingBroker sdk.AccAddress) error {
 
 	// Transfer the object from the module to the buyer
 	err := k.doObjectTransfer(ctx, k.GetEscrowAddress(escrow.Id), buyer, escrow.GetObject())
@@ -229,21 +397,117 @@ func (k Keeper) doSwap(ctx sdk.Context, escrow types.Escrow, buyer, seller sdk.A
 		return sdkerrors.Wrap(err, "Cannot send the object to the buyer")
 	}
 
-	// Transfer the coins, making sure that brokerCoins + sellerCoins = escrow.Price
-	brokerCoins, _ := sdk.NewDecCoinsFromCoins(escrow.Price...).MulDec(escrow.BrokerCommission).TruncateDecimal()
-	sellerCoins := escrow.Price.Sub(brokerCoins)
+	// Transfer the coins
+	brokers := brokerage{
+		listing:      broker,
+		selling:      sellingBroker,
+		commission:   escrow.BrokerCommission,
+		sellingShare: escrow.GetSellingBrokerShare(),
+	}
+	if err := k.payOut(ctx, escrow.Id, escrow.GetObject(), escrow.Price, seller, brokers); err != nil {
+		return err
+	}
+
+	k.recordSale(ctx, escrow.GetObject(), seller, buyer, escrow.Price)
+	return nil
+}
+
+// brokerage defines the brokers of a sale and how the commission is split between them
+type brokerage struct {
+	// listing is the broker which listed the sale, it receives the commission minus the selling broker share
+	listing sdk.AccAddress
+	// selling is the optional broker which completed the sale, it receives the selling broker share of the commission
+	// if it is not the listing broker
+	selling      sdk.AccAddress
+	commission   sdk.Dec
+	sellingShare sdk.Dec
+}
+
+// payOut sends the price locked in the given escrow account to the royalty recipients of the object, the broker
+// commission to the brokers and the remainder to the seller, making sure that
+// royalties + listingCoins + sellingCoins + sellerCoins = price
+func (k Keeper) payOut(
+	ctx sdk.Context,
+	id string,
+	object types.TransferableObject,
+	price sdk.Coins,
+	seller sdk.AccAddress,
+	brokers brokerage,
+) error {
+	// Nothing to pay for a swap without price
+	if price.Empty() {
+		return nil
+	}
+	sellerCoins := price
+	for _, royalty := range k.GetRoyalties(ctx, object, price, brokers.commission) {
+		recipient, err := sdk.AccAddressFromBech32(royalty.Recipient)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "Invalid royalty recipient : %v", royalty.Recipient)
+		}
+		var negative bool
+		if sellerCoins, negative = sellerCoins.SafeSub(royalty.Amount); negative {
+			return sdkerrors.Wrap(types.ErrInvalidAmount, "The royalties exceed the price")
+		}
+		if err := k.transferCoinsFromEscrow(ctx, id, recipient, royalty.Amount); err != nil {
+			return sdkerrors.Wrap(err, "Cannot send the coins to the royalty recipient")
+		}
+	}
+	brokerCoins, _ := sdk.NewDecCoinsFromCoins(price...).MulDec(brokers.commission).TruncateDecimal()
+	sellerCoins, negative := sellerCoins.SafeSub(brokerCoins)
+	if negative {
+		return sdkerrors.Wrap(types.ErrInvalidAmount, "The royalties and the broker commission exceed the price")
+	}
+
+	// The selling broker only gets its share when it did not list the sale itself
+	if brokers.selling != nil && !brokers.selling.Equals(brokers.listing) {
+		sellingCoins, _ := sdk.NewDecCoinsFromCoins(brokerCoins...).MulDec(brokers.sellingShare).TruncateDecimal()
+		brokerCoins = brokerCoins.Sub(sellingCoins)
+		if err := k.transferCoinsFromEscrow(ctx, id, brokers.selling, sellingCoins); err != nil {
+			return sdkerrors.Wrap(err, "Cannot send the coins to the selling broker")
+		}
+	}
 
-	err = k.transferCoinsFromEscrow(ctx, escrow.Id, broker, brokerCoins)
+	err := k.transferCoinsFromEscrow(ctx, id, brokers.listing, brokerCoins)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Cannot send the coins to the broker")
 	}
-	err = k.transferCoinsFromEscrow(ctx, escrow.Id, seller, sellerCoins)
+	err = k.transferCoinsFromEscrow(ctx, id, seller, sellerCoins)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Cannot send the coins to the seller")
 	}
 	return nil
 }
 
+// GetRoyalties returns the royalties paid on a sale of the given object for the given price, with their amounts.
+// The royalty rates are scaled down proportionally when they exceed the part of the price left by the broker commission.
+func (k Keeper) GetRoyalties(ctx sdk.Context, object types.TransferableObject, price sdk.Coins, commission sdk.Dec) []types.Royalty {
+	obj, hasRoyalties := object.(types.ObjectWithRoyalties)
+	if !hasRoyalties {
+		return nil
+	}
+	royalties := obj.GetRoyalties(ctx, k.getCustomDataForType(object.GetObjectTypeID()))
+	total := sdk.ZeroDec()
+	for _, royalty := range royalties {
+		total = total.Add(royalty.Rate)
+	}
+	if available := sdk.OneDec().Sub(commission); total.GT(available) {
+		for i := range royalties {
+			royalties[i].Rate = royalties[i].Rate.MulTruncate(available).QuoTruncate(total)
+		}
+	}
+	for i := range royalties {
+		royalties[i].Amount, _ = sdk.NewDecCoinsFromCoins(price...).MulDec(royalties[i].Rate).TruncateDecimal()
+	}
+	return royalties
+}
+
+// recordSale lets the object record its sale, if it keeps such a record
+func (k Keeper) recordSale(ctx sdk.Context, object types.TransferableObject, seller, buyer sdk.AccAddress, price sdk.Coins) {
+	if obj, hasSaleRecord := object.(types.ObjectWithSaleRecord); hasSaleRecord {
+		obj.RecordSale(ctx, seller, buyer, price, k.getCustomDataForType(object.GetObjectTypeID()))
+	}
+}
+
 // RefundEscrow refunds the specified escrow, returning the object to the seller and removing the escrow.
 // An escrow can only be refunded by its owner (the seller) or by anybody when it is expired
 func (k Keeper) RefundEscrow(ctx sdk.Context, sender sdk.AccAddress, id string) error {
@@ -265,7 +529,7 @@ func (k Keeper) RefundEscrow(ctx sdk.Context, sender sdk.AccAddress, id string)
 	}
 
 	// Ensure the seller is the one asking for a refund or that escrow is expired
-	if !sender.Equals(seller) && escrow.State != types.EscrowState_Expired {
+	if !sender.Equals(seller) && !hasExpired(ctx, escrow) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Only the escrow owner can trigger a refund if the escrow is not expired")
 	}
 
@@ -290,7 +554,7 @@ func (k Keeper) refundEscrow(ctx sdk.Context, escrow types.Escrow, seller sdk.Ac
 	// update the state of the escrow
 	escrow.State = types.EscrowState_Refunded
 	// delete escrow
-	k.deleteEscrow(ctx, escrow)
+	k.deleteEscrow(ctx, escrow, nil)
 	return nil
 }
 
@@ -353,8 +617,9 @@ func (k Keeper) SaveEscrow(ctx sdk.Context, escrow types.Escrow) {
 	k.addEscrowToDeadlineStore(ctx, escrow)
 }
 
-// deleteEscrow deletes an escrow and its associated deadline store entry, if the escrow is refunded or completed
-func (k Keeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow) {
+// deleteEscrow deletes an escrow and its associated deadline store entry, if the escrow is refunded or completed, and
+// keeps a record of it. The buyer is the one who completed the escrow, it must be nil for a refunded escrow.
+func (k Keeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow, buyer sdk.AccAddress) {
 	if escrow.State == types.EscrowState_Open {
 		panic("Attempted to delete an open escrow")
 	}
@@ -366,6 +631,12 @@ func (k Keeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow) {
 		panic(err)
 	}
 	k.deleteEscrowFromDeadlineStore(ctx, escrow)
+
+	var price sdk.Coins
+	if escrow.State == types.EscrowState_Completed {
+		price = escrow.Price
+	}
+	k.SaveEscrowRecord(ctx, types.NewEscrowRecord(escrow, buyer, price, uint64(ctx.BlockTime().Unix())))
 }
 
 // GetEscrow retrieves the specified escrow
@@ -429,30 +700,26 @@ func (k Keeper) GetEscrowsByObject(ctx sdk.Context, object types.TransferableObj
 	})
 }
 
-// queryEscrowsByAttributes query escrows by specified attributes (only filters if attribute is non-zero), starting
-// at escrow with index `start` and returns a maximum of `length` escrows
-func (k Keeper) queryEscrowsByAttributes(
-	ctx sdk.Context,
-	sellerStr string,
-	stateStr string,
-	objectKeyStr string,
-	start uint64,
-	length uint64,
-) ([]types.Escrow, error) {
-	var seller sdk.AccAddress
-	if len(sellerStr) != 0 {
-		var err error
-		seller, err = sdk.AccAddressFromBech32(sellerStr)
-		if err != nil {
-			return nil, sdkerrors.Wrap(err, "Invalid seller address")
-		}
+// queryEscrowsByAttributes query escrows matching the attributes of the request (only filters if attribute is
+// non-zero), sorted by the order of the request, starting at escrow with index `start` and returns a maximum of
+// `length` escrows.
+// The seller, designated buyer, state, object and wanted object attributes are looked up in the indexes. The other attributes and orders
+// are applied while reading the escrows matching the indexed attributes, keeping only those which can be on the page.
+func (k Keeper) queryEscrowsByAttributes(ctx sdk.Context, request *types.QueryEscrowsRequest) ([]types.Escrow, error) {
+	seller, err := parseAddressFilter(request.Seller, "seller")
+	if err != nil {
+		return nil, err
+	}
+	buyer, err := parseAddressFilter(request.Buyer, "buyer")
+	if err != nil {
+		return nil, err
 	}
 
 	var state types.EscrowState
 	var hasState bool
-	if len(stateStr) != 0 {
+	if len(request.State) != 0 {
 		hasState = true
-		switch strings.ToLower(stateStr) {
+		switch strings.ToLower(request.State) {
 		case "open":
 			state = types.EscrowState_Open
 		case "expired":
@@ -463,19 +730,27 @@ func (k Keeper) queryEscrowsByAttributes(
 	}
 
 	var objectKey []byte
-	if len(objectKeyStr) != 0 {
-		var err error
-		objectKey, err = hex.DecodeString(objectKeyStr)
+	if len(request.ObjectKey) != 0 {
+		objectKey, err = hex.DecodeString(request.ObjectKey)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Object key must be an hex-encoded byte array : "+err.Error())
 		}
 	}
 
-	var end uint64
-	if length == 0 {
-		end = 0
-	} else {
-		end = start + length
+	var wantedObjectKey []byte
+	if len(request.WantedObjectKey) != 0 {
+		wantedObjectKey, err = hex.DecodeString(request.WantedObjectKey)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Wanted object key must be an hex-encoded byte array : "+err.Error())
+		}
+	}
+
+	prices, err := parsePriceRange(request.PriceMin, request.PriceMax)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateOrder(request.OrderBy, "deadline"); err != nil {
+		return nil, err
 	}
 
 	filter := func(query crud.QueryStatement) crud.ValidQuery {
@@ -500,6 +775,14 @@ func (k Keeper) queryEscrowsByAttributes(
 			previousStatement = getStatement(query, previousStatement).
 				Index(types.ObjectIndex).Equals(objectKey)
 		}
+		if wantedObjectKey != nil {
+			previousStatement = getStatement(query, previousStatement).
+				Index(types.WantedObjectIndex).Equals(wantedObjectKey)
+		}
+		if buyer != nil {
+			previousStatement = getStatement(query, previousStatement).
+				Index(types.BuyerIndex).Equals(buyer)
+		}
 
 		if previousStatement == nil {
 			return query
@@ -508,7 +791,80 @@ func (k Keeper) queryEscrowsByAttributes(
 		}
 	}
 
-	return k.QueryEscrowsWithRange(ctx, filter, start, end)
+	start, length := request.PaginationStart, request.PaginationLength
+	isIndexedQuery := request.ObjectType == 0 && len(request.Domain) == 0 && prices == (priceRange{}) &&
+		request.DeadlineMin == 0 && request.DeadlineMax == 0 &&
+		(len(request.OrderBy) == 0 || strings.EqualFold(request.OrderBy, "id")) && !request.Descending
+	if isIndexedQuery {
+		var end uint64
+		if length != 0 {
+			end = start + length
+		}
+		return k.QueryEscrowsWithRange(ctx, filter, start, end)
+	}
+
+	k.checkThatModuleIsEnabled(ctx)
+	cursor, err := filter(k.getEscrowStore(ctx).Query()).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	type pricedEscrow struct {
+		escrow types.Escrow
+		price  sdk.Coins
+	}
+	var matches []pricedEscrow
+	priceDenom := k.GetEscrowPriceDenom(ctx)
+	sortMatches := func() {
+		sort.Slice(matches, getOrderLess(request.OrderBy, request.Descending,
+			func(i int) string { return matches[i].escrow.Id },
+			func(i int) sdk.Int { return matches[i].price.AmountOf(priceDenom) },
+			func(i int) uint64 { return matches[i].escrow.Deadline },
+		))
+	}
+	// Only the first start + length matches in the requested order can be on the page, the others are dropped as the
+	// escrows are read. The cursor follows the order of the ids, so the reading stops once the page is full if it is
+	// the requested order.
+	var bound uint64
+	if length != 0 {
+		bound = start + length
+	}
+	byID := (len(request.OrderBy) == 0 || strings.EqualFold(request.OrderBy, "id")) && !request.Descending
+	for ; cursor.Valid(); cursor.Next() {
+		var escrow types.Escrow
+		if err := cursor.Read(&escrow); err != nil {
+			return nil, err
+		}
+		object := escrow.GetObject()
+		if (request.ObjectType != 0 && object.GetObjectTypeID() != types.TypeID(request.ObjectType)) ||
+			(len(request.Domain) != 0 && types.GetObjectDomain(object) != request.Domain) ||
+			!isInTimeRange(escrow.Deadline, request.DeadlineMin, request.DeadlineMax) {
+			continue
+		}
+		price := k.GetCurrentPrice(ctx, escrow)
+		if !prices.contains(price) {
+			continue
+		}
+		matches = append(matches, pricedEscrow{escrow: escrow, price: price})
+		if bound == 0 {
+			continue
+		}
+		if byID && uint64(len(matches)) == bound {
+			break
+		}
+		if uint64(len(matches)) == 2*bound {
+			sortMatches()
+			matches = matches[:bound]
+		}
+	}
+	sortMatches()
+
+	first, last := getPageBounds(len(matches), start, length)
+	result := make([]types.Escrow, 0, last-first)
+	for _, match := range matches[first:last] {
+		result = append(result, match.escrow)
+	}
+	return result, nil
 }
 
 // getEscrowByKey retrieves the specified escrow with its key
@@ -524,9 +880,16 @@ func (k Keeper) getEscrowByKey(ctx sdk.Context, key []byte) (escrow types.Escrow
 
 // IterateEscrowsWithPassedDeadline iterates over all escrows that have an expired deadline at the specified date.
 func (k Keeper) IterateEscrowsWithPassedDeadline(ctx sdk.Context, date uint64, op func(types.Escrow) bool) {
+	k.iterateDeadlineStore(ctx, nil, sdk.Uint64ToBigEndian(date+1), func(_ []byte, escrow types.Escrow) bool {
+		return op(escrow)
+	})
+}
+
+// iterateDeadlineStore iterates over the escrows of the deadline store whose key is in [start, end[, in the order of
+// their deadlines. A nil bound is not checked.
+func (k Keeper) iterateDeadlineStore(ctx sdk.Context, start, end []byte, op func(key []byte, escrow types.Escrow) bool) {
 	store := k.getDeadlineStore(ctx)
-	end := sdk.Uint64ToBigEndian(date + 1)
-	iterator := store.Iterator(nil, end)
+	iterator := store.Iterator(start, end)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -534,48 +897,109 @@ func (k Keeper) IterateEscrowsWithPassedDeadline(ctx sdk.Context, date uint64, o
 		if !found {
 			panic("Inconstancy in expired escrows store : escrow not found")
 		}
-		if stop := op(escrow); stop {
+		if stop := op(iterator.Key(), escrow); stop {
 			break
 		}
 	}
-
 }
 
 // MarkExpiredEscrows syncs the state of the escrows that are effectively expired.
-// It iterates over all escrows that have a passed deadline at the specified date and mark them as expired if they are not already
-func (k Keeper) MarkExpiredEscrows(ctx sdk.Context, date uint64) {
-	k.IterateEscrowsWithPassedDeadline(ctx, date,
-		func(e types.Escrow) (stop bool) {
-			if e.State == types.EscrowState_Open {
-				e.State = types.EscrowState_Expired
-				k.SaveEscrow(ctx, e)
-			}
-			return false
+// It iterates over the escrows that have a passed deadline at the specified date in the order of their deadlines,
+// starting from the expiration cursor, and marks at most max_expirations_per_block of them as expired. The cursor is
+// then moved after the last processed escrow, so that the remaining escrows are processed in the following blocks and
+// the expired escrows are not iterated over again. It returns the number of processed escrows.
+func (k Keeper) MarkExpiredEscrows(ctx sdk.Context, date uint64) uint64 {
+	limit := k.GetMaxExpirationsPerBlock(ctx)
+
+	// Collect the escrows first as the stores can not be updated while they are iterated over
+	var expired []types.Escrow
+	var last []byte
+	k.iterateDeadlineStore(ctx, k.getExpirationCursor(ctx), sdk.Uint64ToBigEndian(date+1),
+		func(key []byte, escrow types.Escrow) bool {
+			expired = append(expired, escrow)
+			last = append(last[:0], key...)
+			return uint64(len(expired)) >= limit
 		})
+	if len(expired) == 0 {
+		return 0
+	}
+
+	for _, escrow := range expired {
+		if escrow.State == types.EscrowState_Open {
+			escrow.State = types.EscrowState_Expired
+			k.SaveEscrow(ctx, escrow)
+		}
+	}
+	// The cursor is the smallest key greater than the last processed one
+	k.setExpirationCursor(ctx, append(last, 0x00))
+	return uint64(len(expired))
 }
 
-// RefundExpiredEscrows refunds all escrows that have an expired state.
-func (k Keeper) RefundExpiredEscrows(ctx sdk.Context) {
-	k.IterateEscrows(ctx,
-		func(e types.Escrow) (stop bool) {
-			//TODO: check if allowed because we modify expired store (refund -> delete escrow -> delete escrow from expired store)
-			// while iterating over it
+// RefundExpiredEscrows attempts to refund at most max_expirations_per_block escrows that have an expired state.
+// The expired escrows are the ones that are between the refund cursor and the expiration cursor in the deadline store,
+// so that the escrows that are not expired yet are not iterated over. Every attempt counts against the limit and the
+// refund cursor is then moved after the last attempted escrow: an escrow that cannot be refunded is left in the
+// expired state and is not attempted again, it can still be refunded by anybody. It returns the number of refunded
+// escrows.
+func (k Keeper) RefundExpiredEscrows(ctx sdk.Context) uint64 {
+	cursor := k.getExpirationCursor(ctx)
+	if cursor == nil {
+		// No escrow was marked as expired yet
+		return 0
+	}
+	limit := k.GetMaxExpirationsPerBlock(ctx)
+
+	// Collect the escrows first as the stores can not be updated while they are iterated over
+	var expired []types.Escrow
+	var last []byte
+	k.iterateDeadlineStore(ctx, k.getRefundCursor(ctx), cursor, func(key []byte, escrow types.Escrow) bool {
+		expired = append(expired, escrow)
+		last = append(last[:0], key...)
+		return uint64(len(expired)) >= limit
+	})
+	if len(expired) == 0 {
+		return 0
+	}
 
-			if e.State != types.EscrowState_Expired {
-				return false
-			}
+	var refunded uint64
+	for _, escrow := range expired {
+		if escrow.State == types.EscrowState_Expired && k.tryRefundExpiredEscrow(ctx, escrow) {
+			refunded++
+		}
+	}
+	// The cursor is the smallest key greater than the last attempted one
+	k.setRefundCursor(ctx, append(last, 0x00))
+	return refunded
+}
 
-			// refund escrow
-			seller, err := sdk.AccAddressFromBech32(e.Seller)
-			if err != nil {
-				panic(err)
-			}
-			err = k.refundEscrow(ctx, e, seller)
-			if err != nil {
-				panic(err)
-			}
-			return false
-		})
+// tryRefundExpiredEscrow refunds the given expired escrow, it returns false and logs the error if the refund failed
+func (k Keeper) tryRefundExpiredEscrow(ctx sdk.Context, escrow types.Escrow) bool {
+	seller, err := sdk.AccAddressFromBech32(escrow.Seller)
+	if err != nil {
+		//this should be always valid because the escrow is guaranteed to be in a valid state when created/updated
+		panic(sdkerrors.Wrapf(err, "Invalid seller address : %v", escrow.Seller))
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.refundEscrow(cacheCtx, escrow, seller); err != nil {
+		k.Logger(ctx).Error("cannot refund the expired escrow", "id", escrow.Id, "error", err)
+		return false
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return true
+}
+
+// isPendingExpiration returns true if the given escrow is after the expiration cursor, in which case it is marked as
+// expired in a following block if its deadline is passed
+func (k Keeper) isPendingExpiration(ctx sdk.Context, escrow types.Escrow) bool {
+	cursor := k.getExpirationCursor(ctx)
+	return cursor != nil && bytes.Compare(types.GetDeadlineKey(escrow.Deadline, escrow.Id), cursor) >= 0
+}
+
+// hasExpired returns true if the escrow is expired or if its deadline is passed, as the escrows with a passed deadline
+// exceeding max_expirations_per_block are marked as expired in the following blocks
+func hasExpired(ctx sdk.Context, escrow types.Escrow) bool {
+	return escrow.State == types.EscrowState_Expired || escrow.Deadline <= uint64(ctx.BlockTime().Unix())
 }
 
 // IterateEscrows iterates through all the escrows.
@@ -601,3 +1025,61 @@ func (k Keeper) IterateEscrows(
 		}
 	}
 }
+
+// UpdateEscrowedObjects lets the module of the objects held by the escrows and the offers update them in place, when
+// its store migrations rename them for instance. The update returns true if it modified the object, the escrows and
+// the offers holding a modified object, as their object or in a bundle, are saved again with their object indexes.
+func (k Keeper) UpdateEscrowedObjects(ctx sdk.Context, update func(obj types.TransferableObject) bool) error {
+	// Collect the updated escrows and offers first as the stores can not be updated while they are iterated over
+	var escrows []types.Escrow
+	k.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+		updated := updateObject(&escrow.Object, update)
+		if escrow.IsSwap() && updateObject(&escrow.WantedObject, update) {
+			updated = true
+		}
+		if updated {
+			escrows = append(escrows, escrow)
+		}
+		return false
+	})
+	var offers []types.Offer
+	k.IterateOffers(ctx, func(offer types.Offer) bool {
+		if updateObject(&offer.Object, update) {
+			offers = append(offers, offer)
+		}
+		return false
+	})
+
+	for i := range escrows {
+		if err := k.getEscrowStore(ctx).Update(&escrows[i]); err != nil {
+			return sdkerrors.Wrapf(err, "unable to update escrow %s", escrows[i].Id)
+		}
+	}
+	for i := range offers {
+		if err := k.getOfferStore(ctx).Update(&offers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "unable to update offer %s", offers[i].Id)
+		}
+	}
+	return nil
+}
+
+// updateObject applies an in place update to a packed object, or to the objects of a packed bundle, and packs the
+// object again if it was modified
+func updateObject(objectAny **codectypes.Any, update func(obj types.TransferableObject) bool) bool {
+	obj := (*objectAny).GetCachedValue().(types.TransferableObject)
+	updated := false
+	if bundle, ok := obj.(*types.ObjectBundle); ok {
+		updated = bundle.UpdateObjects(update)
+	} else {
+		updated = update(obj)
+	}
+	if !updated {
+		return false
+	}
+	packed, err := codectypes.NewAnyWithValue(obj)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "cannot sync the object with its cached value"))
+	}
+	*objectAny = packed
+	return true
+}
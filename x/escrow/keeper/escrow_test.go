@@ -128,7 +128,7 @@ func (s *EscrowTestSuite) SetupTest() {
 	s.seller = s.generator.NewAccAddress()
 	s.buyer = s.generator.NewAccAddress()
 	var storeKey sdk.StoreKey
-	s.keeper, s.ctx, s.store, s.balances, storeKey, s.configKeeper = test.NewTestKeeper([]sdk.AccAddress{s.buyer}, true)
+	s.keeper, s.ctx, s.store, s.balances, storeKey = test.NewTestKeeper([]sdk.AccAddress{s.buyer}, true)
 
 	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
 
@@ -545,7 +545,7 @@ func (s *EscrowTestSuite) TestUpdate() {
 }
 
 func (s *EscrowTestSuite) TestTransferTo() {
-	defaultConfig := s.configKeeper.GetConfiguration(s.ctx)
+	defaultParams := s.keeper.GetParams(s.ctx)
 
 	var testEscrows = make(map[string]string)
 	defaultPriceAmt := int64(50)
@@ -571,12 +571,12 @@ func (s *EscrowTestSuite) TestTransferTo() {
 	}
 
 	createAndSaveEscrowWithBroker := func(seller sdk.AccAddress, price sdk.Coins, commission sdk.Dec) string {
-		config := defaultConfig
-		config.EscrowBroker = brokerAddr.String()
-		config.EscrowCommission = commission
-		s.configKeeper.SetConfig(s.ctx, config)
+		params := defaultParams
+		params.Broker = brokerAddr.String()
+		params.Commission = commission
+		s.keeper.SetParams(s.ctx, params)
 		id := createAndSaveEscrow(seller, price)
-		s.configKeeper.SetConfig(s.ctx, defaultConfig)
+		s.keeper.SetParams(s.ctx, defaultParams)
 		return id
 	}
 
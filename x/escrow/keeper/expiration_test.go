@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/keeper"
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type ExpirationTestSuite struct {
+	BaseKeeperSuite
+	buyer, seller sdk.AccAddress
+}
+
+func (s *ExpirationTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxExpirationsPerBlock = 3
+	s.keeper.SetParams(s.ctx, params)
+}
+
+// createEscrows creates n escrows with the given deadline, in the order of their IDs
+func (s *ExpirationTestSuite) createEscrows(n int, deadline uint64) []string {
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		obj := newSavedObject(s.generator, s.seller, s.store)
+		id, err := s.keeper.CreateEscrow(s.ctx, s.seller, coins(100), obj, s.generator.NowAfter(deadline))
+		s.Require().NoError(err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (s *ExpirationTestSuite) states(ids []string) []types.EscrowState {
+	states := make([]types.EscrowState, 0, len(ids))
+	for _, id := range ids {
+		escrow, found := s.keeper.GetEscrow(s.ctx, id)
+		s.Require().True(found)
+		states = append(states, escrow.State)
+	}
+	return states
+}
+
+func (s *ExpirationTestSuite) advanceTo(date uint64) {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(date), 0))
+}
+
+func (s *ExpirationTestSuite) TestMarkExpiredEscrowsIsBounded() {
+	ids := s.createEscrows(5, 10)
+	later := s.createEscrows(1, 20)[0]
+	open, expired := types.EscrowState_Open, types.EscrowState_Expired
+
+	s.advanceTo(s.generator.NowAfter(10))
+	s.Assert().Equal(uint64(3), s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10)))
+	s.Assert().Equal([]types.EscrowState{expired, expired, expired, open, open}, s.states(ids))
+	msg, broken := keeper.StateInvariant(s.keeper)(s.ctx)
+	s.Assert().False(broken, msg)
+
+	// An escrow waiting to be marked as expired can not be bought
+	err := s.keeper.TransferToEscrow(s.ctx, s.buyer, ids[3], coins(100))
+	s.Assert().ErrorIs(err, types.ErrEscrowNotOpen)
+
+	s.Assert().Equal(uint64(2), s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10)))
+	s.Assert().Equal([]types.EscrowState{expired, expired, expired, expired, expired}, s.states(ids))
+	// The expired escrows are not processed again
+	s.Assert().Equal(uint64(0), s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10)))
+	s.Assert().Equal([]types.EscrowState{open}, s.states([]string{later}))
+
+	s.advanceTo(s.generator.NowAfter(20))
+	s.Assert().Equal(uint64(1), s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(20)))
+	s.Assert().Equal([]types.EscrowState{expired}, s.states([]string{later}))
+}
+
+func (s *ExpirationTestSuite) TestRefundPendingExpiration() {
+	ids := s.createEscrows(4, 10)
+	s.advanceTo(s.generator.NowAfter(10))
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+
+	// Anybody can refund an escrow with a passed deadline, even if it is not marked as expired yet
+	s.Require().NoError(s.keeper.RefundEscrow(s.ctx, s.buyer, ids[3]))
+	s.Assert().False(s.keeper.HasEscrow(s.ctx, ids[3]))
+	s.Assert().Equal(uint64(0), s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10)))
+}
+
+func (s *ExpirationTestSuite) TestRefundExpiredEscrowsIsBounded() {
+	// Nothing is refunded before the first escrows are marked as expired
+	s.Assert().Equal(uint64(0), s.keeper.RefundExpiredEscrows(s.ctx))
+
+	ids := s.createEscrows(5, 10)
+	open := s.createEscrows(1, 20)[0]
+	s.advanceTo(s.generator.NowAfter(10))
+
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+	s.Assert().Equal(uint64(3), s.keeper.RefundExpiredEscrows(s.ctx))
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+	s.Assert().Equal(uint64(2), s.keeper.RefundExpiredEscrows(s.ctx))
+
+	for _, id := range ids {
+		record, found := s.keeper.GetEscrowRecord(s.ctx, id)
+		s.Require().True(found)
+		s.Assert().Equal(types.EscrowState_Refunded, record.State)
+	}
+	s.Assert().True(s.keeper.HasEscrow(s.ctx, open))
+	s.Assert().Equal(uint64(0), s.keeper.RefundExpiredEscrows(s.ctx))
+}
+
+func (s *ExpirationTestSuite) TestRefundExpiredEscrowsCountsAttempts() {
+	// The object of the first escrow can not be transferred back to its seller
+	obj := s.generator.NewErroredTestObject(1)
+	s.Require().NoError(s.store.Create(obj))
+	failing, err := s.keeper.CreateEscrow(s.ctx, s.seller, coins(100), obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	ids := s.createEscrows(3, 10)
+	s.advanceTo(s.generator.NowAfter(10))
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+
+	// The failing refund counts against the limit and is not attempted again
+	s.Assert().Equal(uint64(2), s.keeper.RefundExpiredEscrows(s.ctx))
+	s.Assert().Equal(uint64(1), s.keeper.RefundExpiredEscrows(s.ctx))
+	s.Assert().Equal(uint64(0), s.keeper.RefundExpiredEscrows(s.ctx))
+	for _, id := range ids {
+		s.Assert().False(s.keeper.HasEscrow(s.ctx, id))
+	}
+	s.Assert().Equal([]types.EscrowState{types.EscrowState_Expired}, s.states([]string{failing}))
+}
+
+func TestExpiration(t *testing.T) {
+	suite.Run(t, new(ExpirationTestSuite))
+}
+
+// setupExpirationBenchmark returns a keeper holding `expired` escrows already marked as expired and `pending` open
+// escrows whose deadline is passed at the returned date
+func setupExpirationBenchmark(b *testing.B, expired, pending int) (keeper.Keeper, sdk.Context, uint64) {
+	test.SetConfig()
+	k, ctx, store, _, _ := test.NewTestKeeper(nil, true)
+	gen := test.NewEscrowGenerator(uint64(ctx.BlockTime().Unix()))
+	seller := gen.NewAccAddress()
+
+	for i := 0; i < expired; i++ {
+		escrow, obj := gen.NewTestEscrow(seller, coins(100), gen.NowAfter(1))
+		k.SaveEscrow(ctx, escrow)
+		// The escrow holds its object, so that it can be refunded
+		obj.Owner = k.GetEscrowAddress(escrow.Id)
+		if err := store.Create(obj); err != nil {
+			b.Fatal(err)
+		}
+	}
+	// Move the expiration cursor after the expired escrows
+	for k.MarkExpiredEscrows(ctx, gen.NowAfter(1)) != 0 {
+	}
+	for i := 0; i < pending; i++ {
+		escrow, _ := gen.NewTestEscrow(seller, coins(100), gen.NowAfter(2))
+		k.SaveEscrow(ctx, escrow)
+	}
+	b.ResetTimer()
+	return k, ctx, gen.NowAfter(2)
+}
+
+// BenchmarkMarkExpiredEscrows processes a block expiring a few escrows, the escrows expired in the previous blocks are
+// not iterated over again
+func BenchmarkMarkExpiredEscrows(b *testing.B) {
+	for _, expired := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("expired=%d", expired), func(b *testing.B) {
+			k, ctx, date := setupExpirationBenchmark(b, expired, 10)
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				k.MarkExpiredEscrows(cacheCtx, date)
+			}
+		})
+	}
+}
+
+// BenchmarkMarkExpiredEscrowsBacklog processes a block with a backlog of escrows with a passed deadline, at most
+// max_expirations_per_block of them are processed
+func BenchmarkMarkExpiredEscrowsBacklog(b *testing.B) {
+	for _, pending := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("pending=%d", pending), func(b *testing.B) {
+			k, ctx, date := setupExpirationBenchmark(b, 0, pending)
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				k.MarkExpiredEscrows(cacheCtx, date)
+			}
+		})
+	}
+}
+
+// BenchmarkRefundExpiredEscrows processes a block with a backlog of expired escrows, at most max_expirations_per_block
+// of them are refunded
+func BenchmarkRefundExpiredEscrows(b *testing.B) {
+	for _, expired := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("expired=%d", expired), func(b *testing.B) {
+			k, ctx, _ := setupExpirationBenchmark(b, expired, 10)
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				k.RefundExpiredEscrows(cacheCtx)
+			}
+		})
+	}
+}
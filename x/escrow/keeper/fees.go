@@ -4,15 +4,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
-	"github.com/iov-one/starnamed/x/configuration"
 	"github.com/iov-one/starnamed/x/escrow/types"
 )
 
-// FIXME: this module should not have a dependency on the configuration module
-
-// CollectFees collect the fees for the given message
+// CollectFees collect the fees for the given message, through the fee collector hook if one is set
 func (k *Keeper) CollectFees(ctx sdk.Context, msg types.MsgWithFeePayer) error {
 	fees := k.ComputeFees(ctx, msg)
+	if k.feeCollector != nil {
+		return k.feeCollector.CollectFees(ctx, msg, msg.GetFeePayer(), fees)
+	}
 	return k.bankKeeper.SendCoinsFromAccountToModule(
 		ctx,
 		msg.GetFeePayer(),
@@ -21,37 +21,54 @@ func (k *Keeper) CollectFees(ctx sdk.Context, msg types.MsgWithFeePayer) error {
 	)
 }
 
+// ComputeFees returns the fees of the given message, as defined by the escrow parameters
 func (k *Keeper) ComputeFees(ctx sdk.Context, msg sdk.Msg) sdk.Coins {
-	feesConfiguration := k.configurationKeeper.GetFees(ctx)
-
-	defaultFee := feesConfiguration.FeeDefault
-
-	specificFee := getFee(feesConfiguration, msg)
-
-	if specificFee.LT(defaultFee) {
-		specificFee = defaultFee
-	}
-
-	finalFeeAmount := specificFee.Quo(feesConfiguration.FeeCoinPrice).TruncateInt()
-	return sdk.NewCoins(sdk.NewCoin(feesConfiguration.FeeCoinDenom, finalFeeAmount))
+	return getFee(k.GetParams(ctx).Fees, msg)
 }
 
-func getFee(feesConfig *configuration.Fees, msg sdk.Msg) sdk.Dec {
+func getFee(fees types.Fees, msg sdk.Msg) sdk.Coins {
 	switch m := msg.(type) {
 	case *types.MsgCreateEscrow:
 		if m.Object != nil {
-			if obj, hasCustomFees := m.Object.GetCachedValue().(types.ObjectWithCustomFees); hasCustomFees {
-				return obj.GetCreationFees()
+			if obj, isObject := m.Object.GetCachedValue().(types.TransferableObject); isObject {
+				return getCreationFee(fees, obj)
 			}
 		}
-		return feesConfig.CreateEscrow
+		return fees.CreateEscrow
+	case *types.MsgCreateSwap:
+		if m.Object != nil {
+			if obj, isObject := m.Object.GetCachedValue().(types.TransferableObject); isObject {
+				return getCreationFee(fees, obj)
+			}
+		}
+		return fees.CreateEscrow
+	case *types.MsgCreateOffer:
+		return fees.CreateEscrow
 	case *types.MsgUpdateEscrow:
-		return feesConfig.UpdateEscrow
+		return fees.UpdateEscrow
 	case *types.MsgTransferToEscrow:
-		return feesConfig.TransferToEscrow
-	case *types.MsgRefundEscrow:
-		return feesConfig.RefundEscrow
+		return fees.TransferToEscrow
+	case *types.MsgAcceptOffer, *types.MsgCompleteSwap:
+		return fees.TransferToEscrow
+	case *types.MsgRefundEscrow, *types.MsgWithdrawOffer:
+		return fees.RefundEscrow
 	default:
-		return feesConfig.FeeDefault
+		return sdk.NewCoins()
+	}
+}
+
+// getCreationFee returns the fee of the creation of an escrow for the given object, the fee of a bundle is the sum of
+// the fees of its objects
+func getCreationFee(fees types.Fees, object types.TransferableObject) sdk.Coins {
+	if bundle, isBundle := object.(*types.ObjectBundle); isBundle {
+		fee := sdk.NewCoins()
+		for _, obj := range bundle.GetObjects() {
+			fee = fee.Add(getCreationFee(fees, obj)...)
+		}
+		return fee
+	}
+	if obj, hasCustomFees := object.(types.ObjectWithCustomFees); hasCustomFees {
+		return obj.GetCreationFees()
 	}
+	return fees.CreateEscrow
 }
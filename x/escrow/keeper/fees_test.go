@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type recordingFeeCollector struct {
+	payer sdk.AccAddress
+	fees  sdk.Coins
+}
+
+func (c *recordingFeeCollector) CollectFees(_ sdk.Context, _ sdk.Msg, payer sdk.AccAddress, fees sdk.Coins) error {
+	c.payer = payer
+	c.fees = fees
+	return nil
+}
+
+type FeesTestSuite struct {
+	BaseKeeperSuite
+	seller sdk.AccAddress
+}
+
+func (s *FeesTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.seller = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.seller}, true)
+}
+
+func (s *FeesTestSuite) TestComputeFees() {
+	params := s.keeper.GetParams(s.ctx)
+	params.Fees.UpdateEscrow = sdk.NewCoins(sdk.NewInt64Coin(test.Denom, 7))
+	s.keeper.SetParams(s.ctx, params)
+
+	msg := types.MsgUpdateEscrow{Id: "0000000000000001", Updater: s.seller.String()}
+	s.Assert().Equal(params.Fees.UpdateEscrow, s.keeper.ComputeFees(s.ctx, &msg))
+}
+
+func (s *FeesTestSuite) TestCollectFeesThroughHook() {
+	collector := &recordingFeeCollector{}
+	s.keeper.SetFeeCollector(collector)
+	s.Assert().Panics(func() { s.keeper.SetFeeCollector(collector) }, "the fee collector can only be set once")
+
+	balance := s.balances[s.seller.String()]
+	msg := types.MsgRefundEscrow{Id: "0000000000000001", Sender: s.seller.String()}
+	s.Require().NoError(s.keeper.CollectFees(s.ctx, &msg))
+	s.Assert().Equal(s.seller, collector.payer)
+	s.Assert().Equal(s.keeper.GetParams(s.ctx).Fees.RefundEscrow, collector.fees)
+	s.Assert().Equal(balance, s.balances[s.seller.String()], "the hook collects the fees instead of the keeper")
+}
+
+func TestFees(t *testing.T) {
+	suite.Run(t, new(FeesTestSuite))
+}
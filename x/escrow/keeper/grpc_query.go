@@ -25,16 +25,49 @@ func (k Keeper) Escrow(c context.Context, request *types.QueryEscrowRequest) (*t
 		return nil, sdkerrors.Wrap(types.ErrEscrowNotFound, request.Id)
 	}
 
-	return &types.QueryEscrowResponse{Escrow: &escrow}, nil
+	price := k.GetCurrentPrice(ctx, escrow)
+
+	return &types.QueryEscrowResponse{
+		Escrow:       &escrow,
+		CurrentPrice: price,
+		Royalties:    k.GetRoyalties(ctx, escrow.GetObject(), price, escrow.BrokerCommission),
+	}, nil
 }
 
 func (k Keeper) Escrows(c context.Context, request *types.QueryEscrowsRequest) (*types.QueryEscrowsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	escrows, err := k.queryEscrowsByAttributes(
+	escrows, err := k.queryEscrowsByAttributes(ctx, request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsResponse{Escrows: escrows}, nil
+}
+
+func (k Keeper) Offer(c context.Context, request *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := types.ValidateID(request.Id); err != nil {
+		return nil, err
+	}
+
+	offer, found := k.GetOffer(ctx, request.Id)
+
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrOfferNotFound, request.Id)
+	}
+
+	return &types.QueryOfferResponse{Offer: &offer}, nil
+}
+
+func (k Keeper) Offers(c context.Context, request *types.QueryOffersRequest) (*types.QueryOffersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offers, err := k.queryOffersByAttributes(
 		ctx,
-		request.Seller,
-		request.State,
+		request.Buyer,
 		request.ObjectKey,
 		request.PaginationStart,
 		request.PaginationLength,
@@ -44,5 +77,17 @@ func (k Keeper) Escrows(c context.Context, request *types.QueryEscrowsRequest) (
 		return nil, err
 	}
 
-	return &types.QueryEscrowsResponse{Escrows: escrows}, nil
+	return &types.QueryOffersResponse{Offers: offers}, nil
+}
+
+func (k Keeper) EscrowRecords(c context.Context, request *types.QueryEscrowRecordsRequest) (*types.QueryEscrowRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, err := k.queryEscrowRecords(ctx, request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowRecordsResponse{Records: records}, nil
 }
@@ -58,7 +58,7 @@ func AttributeInvariant(k Keeper) sdk.Invariant {
 				return false
 			}
 
-			if types.ValidatePrice(escrow.Price, k.GetEscrowPriceDenom(ctx)) != nil {
+			if escrow.ValidatePrice(k.GetEscrowPriceDenom(ctx)) != nil {
 				invalidPriceEscrows++
 				return false
 			}
@@ -143,8 +143,11 @@ func StateInvariant(k Keeper) sdk.Invariant {
 				return false
 			}
 
-			// Check that escrow is expired iff deadline is passed
-			if (escrow.State == types.EscrowState_Expired) != (date >= escrow.Deadline) {
+			// Check that escrow is expired iff deadline is passed, an open escrow with a passed deadline being allowed
+			// when it is waiting to be processed after the expiration cursor
+			isPassed := date >= escrow.Deadline
+			if (escrow.State == types.EscrowState_Expired) != isPassed &&
+				!(escrow.State == types.EscrowState_Open && k.isPendingExpiration(ctx, escrow)) {
 				invalidExpirationEscrows++
 				return false
 			}
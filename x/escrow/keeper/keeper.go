@@ -27,22 +27,28 @@ var (
 	EscrowStoreKey   = []byte{0x01} // prefix for escrow
 	DeadlineStoreKey = []byte{0x02} // prefix for escrow stored by expiration date
 	ParamsStoreKey   = []byte{0x03} // prefix for the keeper parameters
+	// 0x04 is reserved for the test objects store
+	OfferStoreKey         = []byte{0x05} // prefix for offer
+	OfferDeadlineStoreKey = []byte{0x06} // prefix for offer stored by expiration date
+	RecordStoreKey        = []byte{0x07} // prefix for the records of completed and refunded escrows
 
 	// Keys for the parameters store
-	paramsStoreLastBlockTime = []byte{0x01}
-	paramsStoreNextId        = []byte{0x02}
+	paramsStoreLastBlockTime    = []byte{0x01}
+	paramsStoreNextId           = []byte{0x02}
+	paramsStoreExpirationCursor = []byte{0x03} // deadline store key from which the next expirations are processed
+	paramsStoreRefundCursor     = []byte{0x04} // deadline store key from which the next expired escrows are refunded
 )
 
 // Keeper defines the escrow keeper
 type Keeper struct {
-	storeKey            sdk.StoreKey
-	cdc                 codec.Codec
-	paramSpace          paramstypes.Subspace
-	accountKeeper       types.AccountKeeper
-	bankKeeper          types.BankKeeper
-	configurationKeeper types.ConfigurationKeeper
-	customData          map[types.TypeID]types.CustomData
-	blockedAddrs        map[string]bool
+	storeKey      sdk.StoreKey
+	cdc           codec.Codec
+	paramSpace    paramstypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	feeCollector  types.FeeCollector
+	customData    map[types.TypeID]types.CustomData
+	blockedAddrs  map[string]bool
 }
 
 // NewKeeper creates a new escrow Keeper instance
@@ -52,7 +58,6 @@ func NewKeeper(
 	paramSpace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
-	configurationKeeper types.ConfigurationKeeper,
 	blockedAddrs map[string]bool,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
@@ -64,16 +69,28 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
-	return Keeper{
-		storeKey:            key,
-		cdc:                 cdc,
-		paramSpace:          paramSpace,
-		accountKeeper:       accountKeeper,
-		bankKeeper:          bankKeeper,
-		configurationKeeper: configurationKeeper,
-		customData:          make(map[types.TypeID]types.CustomData),
-		blockedAddrs:        blockedAddrs,
+	k := Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		customData:    make(map[types.TypeID]types.CustomData),
+		blockedAddrs:  blockedAddrs,
 	}
+	// The objects of a bundle receive the custom data registered for their own type
+	k.customData[types.BundleTypeID] = types.BundleCustomData(k.getCustomDataForType)
+	return k
+}
+
+// SetFeeCollector sets the hook collecting the fees of the escrow operations, it must be set before the keeper is
+// given to the other modules as they get a copy of it
+func (k *Keeper) SetFeeCollector(feeCollector types.FeeCollector) *Keeper {
+	if k.feeCollector != nil {
+		panic("cannot set the escrow fee collector twice")
+	}
+	k.feeCollector = feeCollector
+	return k
 }
 
 // RegisterCustomData registers custom data to be given to the Transfer function of a certain type of TransferableObject
@@ -120,6 +137,18 @@ func (k Keeper) getDeadlineStore(ctx sdk.Context) sdk.KVStore {
 	return prefix.NewStore(ctx.KVStore(k.storeKey), DeadlineStoreKey)
 }
 
+func (k Keeper) getOfferStore(ctx sdk.Context) crud.Store {
+	return crudtypes.NewStore(k.cdc, ctx.KVStore(k.storeKey), OfferStoreKey)
+}
+
+func (k Keeper) getOfferDeadlineStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), OfferDeadlineStoreKey)
+}
+
+func (k Keeper) getRecordStore(ctx sdk.Context) crud.Store {
+	return crudtypes.NewStore(k.cdc, ctx.KVStore(k.storeKey), RecordStoreKey)
+}
+
 func (k Keeper) getParamStore(ctx sdk.Context) store.KVStore {
 	return prefix.NewStore(ctx.KVStore(k.storeKey), ParamsStoreKey)
 }
@@ -132,31 +161,59 @@ func (k Keeper) GetLastBlockTime(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(k.getParamStore(ctx).Get(paramsStoreLastBlockTime))
 }
 
+// getExpirationCursor returns the deadline store key from which the next expirations are processed, it is nil until
+// a first escrow is processed
+func (k Keeper) getExpirationCursor(ctx sdk.Context) []byte {
+	return k.getParamStore(ctx).Get(paramsStoreExpirationCursor)
+}
+
+func (k Keeper) setExpirationCursor(ctx sdk.Context, cursor []byte) {
+	k.getParamStore(ctx).Set(paramsStoreExpirationCursor, cursor)
+}
+
+// getRefundCursor returns the deadline store key from which the next expired escrows are refunded, it is nil until a
+// first expired escrow is refunded
+func (k Keeper) getRefundCursor(ctx sdk.Context) []byte {
+	return k.getParamStore(ctx).Get(paramsStoreRefundCursor)
+}
+
+func (k Keeper) setRefundCursor(ctx sdk.Context, cursor []byte) {
+	k.getParamStore(ctx).Set(paramsStoreRefundCursor, cursor)
+}
+
+// GetMaxExpirationsPerBlock returns the maximum number of escrows with a passed deadline processed in a block
+func (k Keeper) GetMaxExpirationsPerBlock(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxExpirationsPerBlock
+}
+
 // GetMaximumEscrowDuration returns the maximum allowed duration of an escrow
 func (k Keeper) GetMaximumEscrowDuration(ctx sdk.Context) time.Duration {
-	return k.configurationKeeper.GetConfiguration(ctx).EscrowMaxPeriod
+	return k.GetParams(ctx).MaxPeriod
 }
 
 // GetEscrowPriceDenom returns the denomination of the allowed token for the price fo an escrow
 func (k Keeper) GetEscrowPriceDenom(ctx sdk.Context) string {
-	return k.configurationKeeper.GetFees(ctx).FeeCoinDenom
+	return k.GetParams(ctx).PriceDenom
 }
 
 // GetBrokerAddress returns the escrow broker address
 func (k Keeper) GetBrokerAddress(ctx sdk.Context) string {
-	return k.configurationKeeper.GetConfiguration(ctx).EscrowBroker
+	return k.GetParams(ctx).Broker
 }
 
 // GetBrokerCommission returns the escrow broker commission
 func (k Keeper) GetBrokerCommission(ctx sdk.Context) sdk.Dec {
-	return k.configurationKeeper.GetConfiguration(ctx).EscrowCommission
+	return k.GetParams(ctx).Commission
+}
+
+// GetBrokerCommissionMax returns the maximum commission of the brokers listing escrows
+func (k Keeper) GetBrokerCommissionMax(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).CommissionMax
 }
 
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	params = types.DefaultParams()
-	if k.paramSpace.Has(ctx, types.KeyModuleEnabled) {
-		k.paramSpace.Get(ctx, types.KeyModuleEnabled, &params.ModuleEnabled)
-	}
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return
 }
 
@@ -49,7 +49,7 @@ func (s *KeeperSuite) TestNextID() {
 
 func TestModuleDisabled(t *testing.T) {
 
-	k, ctx, _, _, _, _ := test.NewTestKeeper(nil, false)
+	k, ctx, _, _, _ := test.NewTestKeeper(nil, false)
 	gen := test.NewEscrowGenerator(10)
 
 	testCases := []struct {
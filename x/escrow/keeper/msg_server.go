@@ -36,12 +36,43 @@ func (m msgServer) CreateEscrow(ctx context.Context, msg *types.MsgCreateEscrow)
 	}
 
 	obj := msg.Object.GetCachedValue().(types.TransferableObject)
-	// Create the escrow
-	id, err := m.Keeper.CreateEscrow(sdkCtx, seller, msg.Price, obj, msg.Deadline)
+	// Create the escrow, with a declining price if a price decay is provided
+	var id string
+	if msg.PriceDecay != types.PriceDecay_None {
+		id, err = m.Keeper.CreateDutchEscrow(sdkCtx, seller, msg.Price, msg.FloorPrice, msg.PriceDecay, obj, msg.Deadline)
+	} else {
+		id, err = m.Keeper.CreateEscrow(sdkCtx, seller, msg.Price, obj, msg.Deadline)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	// Replace the configured broker by the marketplace listing the escrow, if any
+	if len(msg.Broker) != 0 {
+		broker, err := sdk.AccAddressFromBech32(msg.Broker)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "Invalid broker address : %v", msg.Broker)
+		}
+		// The broker receives coins, it cannot be a blocked account
+		if m.isBlockedAddr(msg.Broker) {
+			return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Broker)
+		}
+		if err := m.Keeper.SetEscrowBroker(sdkCtx, id, seller, broker, msg.GetBrokerCommission(), msg.GetSellingBrokerShare()); err != nil {
+			return nil, err
+		}
+	}
+	// Restrict the escrow to its designated buyer for a private sale, if any
+	if len(msg.Buyer) != 0 {
+		buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "Invalid buyer address : %v", msg.Buyer)
+		}
+		if err := m.Keeper.SetEscrowBuyer(sdkCtx, id, seller, buyer); err != nil {
+			return nil, err
+		}
+	}
+	escrow, _ := m.Keeper.GetEscrow(sdkCtx, id)
+
 	// Collect fees
 	if err := m.Keeper.CollectFees(sdkCtx, msg); err != nil {
 		return nil, err
@@ -49,15 +80,19 @@ func (m msgServer) CreateEscrow(ctx context.Context, msg *types.MsgCreateEscrow)
 
 	// Emit event
 	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCreatedEscrow{
-		Id:               id,
-		Seller:           msg.Seller,
-		FeePayer:         msg.FeePayer,
-		BrokerAddress:    m.Keeper.GetBrokerAddress(sdkCtx),
-		BrokerCommission: m.Keeper.GetBrokerCommission(sdkCtx),
-		Price:            msg.Price,
-		Object:           msg.Object,
-		Deadline:         msg.Deadline,
-		Fees:             m.Keeper.ComputeFees(sdkCtx, msg),
+		Id:                 id,
+		Seller:             msg.Seller,
+		FeePayer:           msg.FeePayer,
+		BrokerAddress:      escrow.BrokerAddress,
+		BrokerCommission:   escrow.BrokerCommission,
+		Price:              msg.Price,
+		Object:             msg.Object,
+		Deadline:           msg.Deadline,
+		Fees:               m.Keeper.ComputeFees(sdkCtx, msg),
+		PriceDecay:         msg.PriceDecay,
+		FloorPrice:         msg.FloorPrice,
+		SellingBrokerShare: escrow.GetSellingBrokerShare(),
+		Buyer:              msg.Buyer,
 	}); err != nil {
 		return nil, err
 	}
@@ -90,10 +125,29 @@ func (m msgServer) UpdateEscrow(ctx context.Context, msg *types.MsgUpdateEscrow)
 		}
 	}
 
+	// The designated buyer is optional
+	var buyer sdk.AccAddress
+	if len(msg.Buyer) != 0 {
+		buyer, err = sdk.AccAddressFromBech32(msg.Buyer)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "Invalid buyer address : %v", msg.Buyer)
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	err = m.Keeper.UpdateEscrow(sdkCtx, msg.Id, updater, seller, msg.Price, msg.Deadline)
-	if err != nil {
-		return nil, err
+	// The designated buyer is set by the current seller, before a seller update
+	updatesBuyer := buyer != nil || msg.ClearBuyer
+	if updatesBuyer {
+		if err := m.Keeper.SetEscrowBuyer(sdkCtx, msg.Id, updater, buyer); err != nil {
+			return nil, err
+		}
+	}
+	// An update of the designated buyer only does not update the other fields
+	if !updatesBuyer || seller != nil || msg.Price != nil || msg.Deadline != 0 {
+		err = m.Keeper.UpdateEscrow(sdkCtx, msg.Id, updater, seller, msg.Price, msg.Deadline)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Collect fees
@@ -103,13 +157,15 @@ func (m msgServer) UpdateEscrow(ctx context.Context, msg *types.MsgUpdateEscrow)
 
 	// Emit event
 	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdatedEscrow{
-		Id:          msg.Id,
-		Updater:     msg.Updater,
-		FeePayer:    msg.FeePayer,
-		NewPrice:    msg.Price,
-		NewSeller:   msg.Seller,
-		NewDeadline: msg.Deadline,
-		Fees:        m.Keeper.ComputeFees(sdkCtx, msg),
+		Id:           msg.Id,
+		Updater:      msg.Updater,
+		FeePayer:     msg.FeePayer,
+		NewPrice:     msg.Price,
+		NewSeller:    msg.Seller,
+		NewDeadline:  msg.Deadline,
+		Fees:         m.Keeper.ComputeFees(sdkCtx, msg),
+		NewBuyer:     msg.Buyer,
+		BuyerCleared: msg.ClearBuyer,
 	}); err != nil {
 		return nil, err
 	}
@@ -129,8 +185,28 @@ func (m msgServer) TransferToEscrow(ctx context.Context, msg *types.MsgTransferT
 		return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Sender)
 	}
 
+	// The selling broker is optional
+	var sellingBroker sdk.AccAddress
+	if len(msg.Broker) != 0 {
+		sellingBroker, err = sdk.AccAddressFromBech32(msg.Broker)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "Invalid broker address : %v", msg.Broker)
+		}
+		// The broker receives coins, it cannot be a blocked account
+		if m.isBlockedAddr(msg.Broker) {
+			return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Broker)
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	err = m.Keeper.TransferToEscrow(sdkCtx, sender, msg.Id, msg.Amount)
+	// Get the price paid by the sender and the royalties paid out of it before the escrow is completed and removed
+	var price sdk.Coins
+	var royalties []types.Royalty
+	if escrow, found := m.Keeper.GetEscrow(sdkCtx, msg.Id); found {
+		price = m.Keeper.GetCurrentPrice(sdkCtx, escrow)
+		royalties = m.Keeper.GetRoyalties(sdkCtx, escrow.GetObject(), price, escrow.BrokerCommission)
+	}
+	err = m.Keeper.TransferToEscrowThroughBroker(sdkCtx, sender, msg.Id, msg.Amount, sellingBroker)
 	if err != nil {
 		return nil, err
 	}
@@ -142,10 +218,13 @@ func (m msgServer) TransferToEscrow(ctx context.Context, msg *types.MsgTransferT
 
 	// Emit event
 	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCompletedEscrow{
-		Id:       msg.Id,
-		FeePayer: msg.FeePayer,
-		Buyer:    msg.Sender,
-		Fees:     m.Keeper.ComputeFees(sdkCtx, msg),
+		Id:            msg.Id,
+		FeePayer:      msg.FeePayer,
+		Buyer:         msg.Sender,
+		Fees:          m.Keeper.ComputeFees(sdkCtx, msg),
+		Price:         price,
+		Royalties:     royalties,
+		SellingBroker: msg.Broker,
 	}); err != nil {
 		return nil, err
 	}
@@ -188,3 +267,197 @@ func (m msgServer) RefundEscrow(ctx context.Context, msg *types.MsgRefundEscrow)
 
 	return &types.MsgRefundEscrowResponse{}, nil
 }
+
+func (m msgServer) CreateOffer(ctx context.Context, msg *types.MsgCreateOffer) (*types.MsgCreateOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Extract and check buyer address
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid buyer address : %v", msg.Buyer)
+	}
+
+	// Check that we are not using blocked (e.g module) accounts
+	if m.isBlockedAddr(msg.Buyer) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Buyer)
+	}
+
+	obj := msg.Object.GetCachedValue().(types.TransferableObject)
+	// Create the offer
+	id, err := m.Keeper.CreateOffer(sdkCtx, buyer, msg.Price, obj, msg.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect fees
+	if err := m.Keeper.CollectFees(sdkCtx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit event
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCreatedOffer{
+		Id:       id,
+		Buyer:    msg.Buyer,
+		FeePayer: msg.FeePayer,
+		Price:    msg.Price,
+		Object:   msg.Object,
+		Deadline: msg.Deadline,
+		Fees:     m.Keeper.ComputeFees(sdkCtx, msg),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateOfferResponse{Id: id}, nil
+}
+
+func (m msgServer) AcceptOffer(ctx context.Context, msg *types.MsgAcceptOffer) (*types.MsgAcceptOfferResponse, error) {
+
+	// Check and extract seller address
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid seller address : %v", msg.Seller)
+	}
+	// Check that we are not using blocked (e.g module) accounts
+	if m.isBlockedAddr(msg.Seller) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Seller)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.AcceptOffer(sdkCtx, seller, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect fees
+	if err := m.Keeper.CollectFees(sdkCtx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit event
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAcceptedOffer{
+		Id:       msg.Id,
+		FeePayer: msg.FeePayer,
+		Seller:   msg.Seller,
+		Fees:     m.Keeper.ComputeFees(sdkCtx, msg),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptOfferResponse{}, nil
+}
+
+func (m msgServer) WithdrawOffer(ctx context.Context, msg *types.MsgWithdrawOffer) (*types.MsgWithdrawOfferResponse, error) {
+
+	// Check and extract the buyer (who sent this message) address
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid buyer address : %v", msg.Buyer)
+	}
+	// Check that we are not using blocked (e.g module) accounts
+	if m.isBlockedAddr(msg.Buyer) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Buyer)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.WithdrawOffer(sdkCtx, buyer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect fees
+	if err := m.Keeper.CollectFees(sdkCtx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit event
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventWithdrawnOffer{
+		Id:       msg.Id,
+		FeePayer: msg.FeePayer,
+		Buyer:    msg.Buyer,
+		Fees:     m.Keeper.ComputeFees(sdkCtx, msg),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawOfferResponse{}, nil
+}
+
+func (m msgServer) CreateSwap(ctx context.Context, msg *types.MsgCreateSwap) (*types.MsgCreateSwapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Extract and check seller address
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid seller address : %v", msg.Seller)
+	}
+
+	// Check that we are not using blocked (e.g module) accounts
+	if m.isBlockedAddr(msg.Seller) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Seller)
+	}
+
+	obj := msg.Object.GetCachedValue().(types.TransferableObject)
+	wanted := msg.WantedObject.GetCachedValue().(types.TransferableObject)
+	// Create the swap escrow
+	id, err := m.Keeper.CreateSwap(sdkCtx, seller, obj, wanted, msg.Price, msg.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect fees
+	if err := m.Keeper.CollectFees(sdkCtx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit event
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCreatedSwap{
+		Id:           id,
+		Seller:       msg.Seller,
+		FeePayer:     msg.FeePayer,
+		Object:       msg.Object,
+		WantedObject: msg.WantedObject,
+		Price:        msg.Price,
+		Deadline:     msg.Deadline,
+		Fees:         m.Keeper.ComputeFees(sdkCtx, msg),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSwapResponse{Id: id}, nil
+}
+
+func (m msgServer) CompleteSwap(ctx context.Context, msg *types.MsgCompleteSwap) (*types.MsgCompleteSwapResponse, error) {
+
+	// Check and extract sender address
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid sender address : %v", msg.Sender)
+	}
+	// Check that we are not using blocked (e.g module) accounts
+	if m.isBlockedAddr(msg.Sender) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAccount, msg.Sender)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.CompleteSwap(sdkCtx, sender, msg.Id, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect fees
+	if err := m.Keeper.CollectFees(sdkCtx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit event
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCompletedSwap{
+		Id:       msg.Id,
+		FeePayer: msg.FeePayer,
+		Buyer:    msg.Sender,
+		Fees:     m.Keeper.ComputeFees(sdkCtx, msg),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCompleteSwapResponse{}, nil
+}
@@ -0,0 +1,324 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	crud "github.com/iov-one/cosmos-sdk-crud"
+	"github.com/pkg/errors"
+
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// CreateOffer creates an offer of the buyer on an object and locks the offered price in the offer account.
+// The object does not need to be listed in an escrow, the offer can be accepted by whoever owns the object.
+// The deadline must be included in the interval ]now, now + max_period].
+// The price must be in the price_denom denomination.
+// Offers share their 16 character IDs with the escrows, the returned string is the offer ID
+func (k Keeper) CreateOffer(
+	ctx sdk.Context,
+	buyer sdk.AccAddress,
+	price sdk.Coins,
+	object types.TransferableObject,
+	deadline uint64,
+) (
+	string,
+	error,
+) {
+	k.checkThatModuleIsEnabled(ctx)
+
+	id := k.FetchNextId(ctx)
+
+	// check if the offer already exists
+	if k.HasOffer(ctx, id) || k.HasEscrow(ctx, id) {
+		return "", sdkerrors.Wrap(types.ErrIdNotUnique, id)
+	}
+
+	// Check the deadline validity
+	if deadline > uint64(ctx.BlockTime().Unix())+uint64(k.GetMaximumEscrowDuration(ctx).Seconds()) {
+		return "", sdkerrors.Wrap(types.ErrInvalidDeadline, "The deadline exceeds the maximum escrow duration")
+	}
+
+	if object == nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The transferable object is nil")
+	}
+
+	// Create and validate the offer
+	offer := types.NewOffer(id, buyer, price, object, deadline)
+	if err := offer.ValidateWithoutObject(k.GetEscrowPriceDenom(ctx), k.GetLastBlockTime(ctx)); err != nil {
+		return "", err
+	}
+	if err := types.ValidateObjectDeadline(ctx, object, deadline, k.getCustomDataForType(object.GetObjectTypeID())); err != nil {
+		return "", err
+	}
+
+	// The buyer cannot make an offer on its own object
+	ownedByBuyer, err := object.IsOwnedBy(buyer)
+	if err != nil {
+		return "", err
+	}
+	if ownedByBuyer {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "The owner of the object cannot make an offer on it")
+	}
+
+	// lock the price in the offer account
+	if err := k.transferCoinsToEscrow(ctx, buyer, id, price); err != nil {
+		return "", sdkerrors.Wrap(err, "Cannot send the coins to the offer account")
+	}
+
+	// save the offer
+	k.SaveOffer(ctx, offer)
+	k.NextId(ctx)
+
+	return id, nil
+}
+
+// AcceptOffer accepts the specified offer, transferring the object from the seller to the buyer and the offered price
+// from the offer account to the seller, minus the commission which is sent to the broker.
+// The seller must be the current owner of the object and the offer must not be expired. The offer is then removed.
+func (k Keeper) AcceptOffer(ctx sdk.Context, seller sdk.AccAddress, id string) error {
+	k.checkThatModuleIsEnabled(ctx)
+
+	// check that the offer exists
+	offer, found := k.GetOffer(ctx, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrOfferNotFound, id)
+	}
+
+	// check that the offer is not expired, it is refunded in the next block
+	if offer.Deadline <= uint64(ctx.BlockTime().Unix()) {
+		return sdkerrors.Wrap(types.ErrOfferExpired, id)
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		//this should be always valid because the offer is guaranteed to be in a valid state when created
+		panic(sdkerrors.Wrapf(err, "Invalid buyer address : %v", offer.Buyer))
+	}
+
+	// Ensure that the seller is not the buyer of this offer
+	if seller.Equals(buyer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "The buyer cannot accept its own offer")
+	}
+
+	broker, err := sdk.AccAddressFromBech32(k.GetBrokerAddress(ctx))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "Invalid broker address : %v", k.GetBrokerAddress(ctx))
+	}
+
+	// Transfer the object, this fails if the seller does not own the object
+	object := offer.GetObject()
+	if err := k.doObjectTransfer(ctx, seller, buyer, object); err != nil {
+		return sdkerrors.Wrap(err, "Cannot send the object to the buyer")
+	}
+
+	// Transfer the coins locked in the offer account
+	// This should not fail because the offer account possess the coins
+	brokers := brokerage{listing: broker, commission: k.GetBrokerCommission(ctx), sellingShare: sdk.ZeroDec()}
+	if err := k.payOut(ctx, offer.Id, object, offer.Price, seller, brokers); err != nil {
+		panic(err)
+	}
+
+	k.recordSale(ctx, object, seller, buyer, offer.Price)
+	k.deleteOffer(ctx, offer)
+	return nil
+}
+
+// WithdrawOffer refunds the price locked in the specified offer to the buyer and removes the offer.
+// An offer can only be withdrawn by its buyer, expired offers are automatically refunded.
+func (k Keeper) WithdrawOffer(ctx sdk.Context, sender sdk.AccAddress, id string) error {
+	k.checkThatModuleIsEnabled(ctx)
+
+	// check that the offer exists
+	offer, found := k.GetOffer(ctx, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrOfferNotFound, id)
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the buyer is the one withdrawing the offer
+	if !sender.Equals(buyer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Only the buyer can withdraw an offer")
+	}
+
+	return k.refundOffer(ctx, offer, buyer)
+}
+
+// refundOffer sends the price locked in the offer back to the buyer and removes the offer
+func (k Keeper) refundOffer(ctx sdk.Context, offer types.Offer, buyer sdk.AccAddress) error {
+	if err := k.transferCoinsFromEscrow(ctx, offer.Id, buyer, offer.Price); err != nil {
+		return sdkerrors.Wrap(err, "Error while transferring the coins back to the buyer")
+	}
+	k.deleteOffer(ctx, offer)
+	return nil
+}
+
+// RefundExpiredOffers refunds at most max_expirations_per_block offers that have a passed deadline at the specified
+// date, in the order of their deadlines, and returns the number of refunded offers.
+// A refunded offer is removed from the offer deadline store, so the first entry of the store is the deadline cursor:
+// the offers left behind are refunded in the following blocks and the offers already refunded are never iterated over.
+func (k Keeper) RefundExpiredOffers(ctx sdk.Context, date uint64) uint64 {
+	limit := k.GetMaxExpirationsPerBlock(ctx)
+
+	// Collect the offers first as the stores can not be updated while they are iterated over
+	var expired []types.Offer
+	k.IterateOffersWithPassedDeadline(ctx, date, func(offer types.Offer) bool {
+		expired = append(expired, offer)
+		return uint64(len(expired)) >= limit
+	})
+
+	for _, offer := range expired {
+		buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.refundOffer(ctx, offer, buyer); err != nil {
+			panic(err)
+		}
+	}
+	return uint64(len(expired))
+}
+
+// IterateOffersWithPassedDeadline iterates over all offers that have an expired deadline at the specified date.
+func (k Keeper) IterateOffersWithPassedDeadline(ctx sdk.Context, date uint64, op func(types.Offer) bool) {
+	store := k.getOfferDeadlineStore(ctx)
+	end := sdk.Uint64ToBigEndian(date + 1)
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		offer, found := k.getOfferByKey(ctx, iterator.Value())
+		if !found {
+			panic("Inconstancy in expired offers store : offer not found")
+		}
+		if stop := op(offer); stop {
+			break
+		}
+	}
+}
+
+// IterateOffers iterates through all the offers.
+func (k Keeper) IterateOffers(ctx sdk.Context, op func(types.Offer) bool) {
+	cursor, err := k.getOfferStore(ctx).Query().Do()
+	if err != nil {
+		panic(err)
+	}
+
+	for ; cursor.Valid(); cursor.Next() {
+		var offer types.Offer
+		if err := cursor.Read(&offer); err != nil {
+			panic(err)
+		}
+
+		if stop := op(offer); stop {
+			break
+		}
+	}
+}
+
+// HasOffer checks if the given offer exists
+func (k Keeper) HasOffer(ctx sdk.Context, id string) bool {
+	_, found := k.getOfferByKey(ctx, types.GetEscrowKey(id))
+	return found
+}
+
+// SaveOffer sets the given offer in the offer store and adds it to the offer deadline store
+func (k Keeper) SaveOffer(ctx sdk.Context, offer types.Offer) {
+	k.checkThatModuleIsEnabled(ctx)
+
+	if err := k.getOfferStore(ctx).Create(&offer); err != nil {
+		panic(err)
+	}
+	k.getOfferDeadlineStore(ctx).Set(types.GetDeadlineKey(offer.Deadline, offer.Id), offer.PrimaryKey())
+}
+
+// deleteOffer deletes an offer and its associated offer deadline store entry
+func (k Keeper) deleteOffer(ctx sdk.Context, offer types.Offer) {
+	if err := k.getOfferStore(ctx).Delete(offer.PrimaryKey()); err != nil {
+		panic(err)
+	}
+	k.getOfferDeadlineStore(ctx).Delete(types.GetDeadlineKey(offer.Deadline, offer.Id))
+}
+
+// GetOffer retrieves the specified offer
+func (k Keeper) GetOffer(ctx sdk.Context, id string) (offer types.Offer, found bool) {
+	k.checkThatModuleIsEnabled(ctx)
+
+	return k.getOfferByKey(ctx, types.GetEscrowKey(id))
+}
+
+// getOfferByKey retrieves the specified offer with its key
+func (k Keeper) getOfferByKey(ctx sdk.Context, key []byte) (offer types.Offer, found bool) {
+	err := k.getOfferStore(ctx).Read(key, &offer)
+	if errors.Is(err, crud.ErrNotFound) {
+		return offer, false
+	} else if err != nil {
+		panic(err)
+	}
+	return offer, true
+}
+
+// queryOffersByAttributes query offers by buyer and object key (only filters if attribute is non-zero), starting
+// at offer with index `start` and returns a maximum of `length` offers
+func (k Keeper) queryOffersByAttributes(
+	ctx sdk.Context,
+	buyerStr string,
+	objectKeyStr string,
+	start uint64,
+	length uint64,
+) ([]types.Offer, error) {
+	k.checkThatModuleIsEnabled(ctx)
+
+	var buyer sdk.AccAddress
+	if len(buyerStr) != 0 {
+		var err error
+		buyer, err = sdk.AccAddressFromBech32(buyerStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "Invalid buyer address")
+		}
+	}
+
+	var objectKey []byte
+	if len(objectKeyStr) != 0 {
+		var err error
+		objectKey, err = hex.DecodeString(objectKeyStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Object key must be an hex-encoded byte array : "+err.Error())
+		}
+	}
+
+	var end uint64
+	if length != 0 {
+		end = start + length
+	}
+
+	query := k.getOfferStore(ctx).Query()
+	var filter crud.ValidQuery = query
+	switch {
+	case buyer != nil && objectKey != nil:
+		filter = query.Where().Index(types.OfferBuyerIndex).Equals(buyer).And().Index(types.OfferObjectIndex).Equals(objectKey)
+	case buyer != nil:
+		filter = query.Where().Index(types.OfferBuyerIndex).Equals(buyer)
+	case objectKey != nil:
+		filter = query.Where().Index(types.OfferObjectIndex).Equals(objectKey)
+	}
+
+	cursor, err := filter.WithRange().Start(start).End(end).Do()
+	if err != nil {
+		return nil, err
+	}
+	var offers []types.Offer
+	for ; cursor.Valid(); cursor.Next() {
+		var offer types.Offer
+		if err := cursor.Read(&offer); err != nil {
+			return nil, err
+		}
+		offers = append(offers, offer)
+	}
+	return offers, nil
+}
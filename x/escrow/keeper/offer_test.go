@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type OfferTestSuite struct {
+	BaseKeeperSuite
+	buyer  sdk.AccAddress
+	seller sdk.AccAddress
+	broker sdk.AccAddress
+	price  sdk.Coins
+}
+
+func (s *OfferTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.broker = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+	s.price = sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(100)))
+
+	params := s.keeper.GetParams(s.ctx)
+	params.Broker = s.broker.String()
+	params.Commission = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+}
+
+func (s *OfferTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins(s.balances[addr.String()]...)
+}
+
+func (s *OfferTestSuite) owner(obj *types.TestObject) sdk.AccAddress {
+	var stored types.TestObject
+	s.Require().NoError(s.store.Read(obj.PrimaryKey(), &stored))
+	return stored.Owner
+}
+
+func (s *OfferTestSuite) createOffer(obj *types.TestObject, deadline uint64) string {
+	id, err := s.keeper.CreateOffer(s.ctx, s.buyer, s.price, obj, deadline)
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *OfferTestSuite) TestCreateOffer() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	before := s.balance(s.buyer)
+
+	id := s.createOffer(obj, s.generator.NowAfter(10))
+
+	offer, found := s.keeper.GetOffer(s.ctx, id)
+	s.Require().True(found)
+	s.Assert().Equal(s.buyer.String(), offer.Buyer)
+	s.Assert().Equal(s.price, offer.Price)
+	s.Assert().Equal(before.Sub(s.price), s.balance(s.buyer))
+	s.Assert().Equal(s.price, s.balance(s.keeper.GetEscrowAddress(id)))
+	s.Assert().Equal(s.seller, s.owner(obj), "the object must not be locked by an offer")
+
+	testCases := []struct {
+		name     string
+		buyer    sdk.AccAddress
+		price    sdk.Coins
+		object   types.TransferableObject
+		deadline uint64
+	}{
+		{
+			name:     "past deadline",
+			buyer:    s.buyer,
+			price:    s.price,
+			object:   obj,
+			deadline: s.generator.NowAfter(0) - 1,
+		},
+		{
+			name:     "deadline after the maximum duration",
+			buyer:    s.buyer,
+			price:    s.price,
+			object:   obj,
+			deadline: s.generator.NowAfter(uint64(s.keeper.GetMaximumEscrowDuration(s.ctx).Seconds()) + 1),
+		},
+		{
+			name:     "invalid denomination",
+			buyer:    s.buyer,
+			price:    sdk.NewCoins(sdk.NewCoin(test.DenomAux, sdk.NewInt(100))),
+			object:   obj,
+			deadline: s.generator.NowAfter(10),
+		},
+		{
+			name:     "object owned by the buyer",
+			buyer:    s.buyer,
+			price:    s.price,
+			object:   newSavedObject(s.generator, s.buyer, s.store),
+			deadline: s.generator.NowAfter(10),
+		},
+		{
+			name:     "insufficient funds",
+			buyer:    s.generator.NewAccAddress(),
+			price:    s.price,
+			object:   obj,
+			deadline: s.generator.NowAfter(10),
+		},
+		{
+			name:     "nil object",
+			buyer:    s.buyer,
+			price:    s.price,
+			deadline: s.generator.NowAfter(10),
+		},
+	}
+	for _, tc := range testCases {
+		_, err := s.keeper.CreateOffer(s.ctx, tc.buyer, tc.price, tc.object, tc.deadline)
+		s.Assert().Error(err, tc.name)
+	}
+}
+
+func (s *OfferTestSuite) TestAcceptOffer() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	id := s.createOffer(obj, s.generator.NowAfter(10))
+
+	s.Assert().Error(s.keeper.AcceptOffer(s.ctx, s.buyer, id), "the buyer cannot accept its own offer")
+	s.Assert().Error(s.keeper.AcceptOffer(s.ctx, s.generator.NewAccAddress(), id), "only the owner can accept the offer")
+	s.Assert().Error(s.keeper.AcceptOffer(s.ctx, s.seller, "00000000000000ff"), "unknown offer")
+
+	s.Require().NoError(s.keeper.AcceptOffer(s.ctx, s.seller, id))
+	s.Assert().Equal(s.buyer, s.owner(obj))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(90))), s.balance(s.seller))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(10))), s.balance(s.broker))
+	s.Assert().True(s.balance(s.keeper.GetEscrowAddress(id)).IsZero())
+	s.Assert().False(s.keeper.HasOffer(s.ctx, id))
+
+	// An expired offer cannot be accepted anymore
+	other := newSavedObject(s.generator, s.seller, s.store)
+	id = s.createOffer(other, s.generator.NowAfter(10))
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(10 * time.Second))
+	s.Assert().Error(s.keeper.AcceptOffer(s.ctx, s.seller, id))
+	s.Assert().Equal(s.seller, s.owner(other))
+}
+
+func (s *OfferTestSuite) TestWithdrawOffer() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	before := s.balance(s.buyer)
+	id := s.createOffer(obj, s.generator.NowAfter(10))
+
+	s.Assert().Error(s.keeper.WithdrawOffer(s.ctx, s.seller, id), "only the buyer can withdraw the offer")
+
+	s.Require().NoError(s.keeper.WithdrawOffer(s.ctx, s.buyer, id))
+	s.Assert().Equal(before, s.balance(s.buyer))
+	s.Assert().False(s.keeper.HasOffer(s.ctx, id))
+	s.Assert().Error(s.keeper.WithdrawOffer(s.ctx, s.buyer, id), "the offer is already withdrawn")
+}
+
+func (s *OfferTestSuite) TestRefundExpiredOffers() {
+	before := s.balance(s.buyer)
+	expiring := s.createOffer(newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(10))
+	open := s.createOffer(newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(20))
+
+	s.keeper.RefundExpiredOffers(s.ctx, s.generator.NowAfter(10))
+
+	s.Assert().False(s.keeper.HasOffer(s.ctx, expiring))
+	s.Assert().True(s.keeper.HasOffer(s.ctx, open))
+	s.Assert().Equal(before.Sub(s.price), s.balance(s.buyer))
+}
+
+func (s *OfferTestSuite) TestRefundExpiredOffersIsBounded() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxExpirationsPerBlock = 2
+	s.keeper.SetParams(s.ctx, params)
+	var expiring []string
+	for i := 0; i < 3; i++ {
+		expiring = append(expiring, s.createOffer(newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(uint64(10+i))))
+	}
+	open := s.createOffer(newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(20))
+
+	// The offers are refunded in the order of their deadlines
+	s.Assert().Equal(uint64(2), s.keeper.RefundExpiredOffers(s.ctx, s.generator.NowAfter(15)))
+	s.Assert().False(s.keeper.HasOffer(s.ctx, expiring[0]))
+	s.Assert().False(s.keeper.HasOffer(s.ctx, expiring[1]))
+	s.Assert().True(s.keeper.HasOffer(s.ctx, expiring[2]))
+	// The remaining one is refunded in the next block
+	s.Assert().Equal(uint64(1), s.keeper.RefundExpiredOffers(s.ctx, s.generator.NowAfter(15)))
+	s.Assert().False(s.keeper.HasOffer(s.ctx, expiring[2]))
+	s.Assert().Equal(uint64(0), s.keeper.RefundExpiredOffers(s.ctx, s.generator.NowAfter(15)))
+	s.Assert().True(s.keeper.HasOffer(s.ctx, open))
+}
+
+func (s *OfferTestSuite) TestQueryOffers() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	first := s.createOffer(obj, s.generator.NowAfter(10))
+	s.createOffer(newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(10))
+
+	res, err := s.keeper.Offers(sdk.WrapSDKContext(s.ctx), &types.QueryOffersRequest{Buyer: s.buyer.String()})
+	s.Require().NoError(err)
+	s.Assert().Len(res.Offers, 2)
+
+	res, err = s.keeper.Offers(sdk.WrapSDKContext(s.ctx), &types.QueryOffersRequest{ObjectKey: hex.EncodeToString(obj.GetUniqueKey())})
+	s.Require().NoError(err)
+	s.Require().Len(res.Offers, 1)
+	s.Assert().Equal(first, res.Offers[0].Id)
+
+	res, err = s.keeper.Offers(sdk.WrapSDKContext(s.ctx), &types.QueryOffersRequest{Buyer: s.seller.String()})
+	s.Require().NoError(err)
+	s.Assert().Empty(res.Offers)
+}
+
+func TestOffer(t *testing.T) {
+	suite.Run(t, new(OfferTestSuite))
+}
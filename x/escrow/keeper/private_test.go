@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type PrivateSaleTestSuite struct {
+	BaseKeeperSuite
+	buyer, other, seller sdk.AccAddress
+	price                sdk.Coins
+}
+
+func (s *PrivateSaleTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.other = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer, s.other, s.seller}, true)
+	s.price = coins(100)
+}
+
+func (s *PrivateSaleTestSuite) createPrivateEscrow() (string, *types.TestObject) {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.SetEscrowBuyer(s.ctx, id, s.seller, s.buyer))
+	return id, obj
+}
+
+func (s *PrivateSaleTestSuite) designatedBuyer(id string) string {
+	escrow, found := s.keeper.GetEscrow(s.ctx, id)
+	s.Require().True(found)
+	return escrow.Buyer
+}
+
+func (s *PrivateSaleTestSuite) TestSetEscrowBuyer() {
+	id, _ := s.createPrivateEscrow()
+	s.Assert().Equal(s.buyer.String(), s.designatedBuyer(id))
+
+	s.Assert().Error(s.keeper.SetEscrowBuyer(s.ctx, id, s.other, s.other), "only the seller can set the buyer")
+	s.Assert().Error(s.keeper.SetEscrowBuyer(s.ctx, id, s.seller, s.seller), "the seller cannot be the buyer")
+	s.Assert().Error(s.keeper.SetEscrowBuyer(s.ctx, "0000000000000042", s.seller, s.buyer), "the escrow does not exist")
+	s.Assert().Error(s.keeper.UpdateEscrow(s.ctx, id, s.seller, s.buyer, nil, 0), "the buyer cannot become the seller")
+
+	s.Require().NoError(s.keeper.SetEscrowBuyer(s.ctx, id, s.seller, nil))
+	s.Assert().Empty(s.designatedBuyer(id))
+}
+
+func (s *PrivateSaleTestSuite) TestTransferToPrivateEscrow() {
+	id, obj := s.createPrivateEscrow()
+
+	err := s.keeper.TransferToEscrow(s.ctx, s.other, id, s.price)
+	s.Assert().ErrorIs(err, sdkerrors.ErrUnauthorized, "only the designated buyer can buy the object")
+	s.Assert().True(s.keeper.HasEscrow(s.ctx, id))
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	var stored types.TestObject
+	s.Require().NoError(s.store.Read(obj.PrimaryKey(), &stored))
+	s.Assert().Equal(s.buyer, stored.Owner)
+}
+
+func (s *PrivateSaleTestSuite) TestTransferToClearedEscrow() {
+	id, _ := s.createPrivateEscrow()
+	s.Require().NoError(s.keeper.SetEscrowBuyer(s.ctx, id, s.seller, nil))
+	s.Assert().NoError(s.keeper.TransferToEscrow(s.ctx, s.other, id, s.price))
+}
+
+func (s *PrivateSaleTestSuite) TestPrivateSwap() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	wanted := newSavedObject(s.generator, s.other, s.store)
+	id, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, nil, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.SetEscrowBuyer(s.ctx, id, s.seller, s.buyer))
+
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.other, id, nil), "only the designated buyer can complete the swap")
+}
+
+func (s *PrivateSaleTestSuite) TestUpdateBuyer() {
+	id, _ := s.createPrivateEscrow()
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.UpdateEscrow(ctx, &types.MsgUpdateEscrow{Id: id, Updater: s.seller.String(), Buyer: s.other.String()})
+	s.Require().NoError(err)
+	s.Assert().Equal(s.other.String(), s.designatedBuyer(id))
+
+	_, err = s.msgServer.UpdateEscrow(ctx, &types.MsgUpdateEscrow{Id: id, Updater: s.seller.String(), ClearBuyer: true, Price: coins(50)})
+	s.Require().NoError(err)
+	s.Assert().Empty(s.designatedBuyer(id))
+	escrow, _ := s.keeper.GetEscrow(s.ctx, id)
+	s.Assert().Equal(coins(50), escrow.Price)
+
+	_, err = s.msgServer.UpdateEscrow(ctx, &types.MsgUpdateEscrow{Id: id, Updater: s.other.String(), Buyer: s.other.String()})
+	s.Assert().Error(err, "only the seller can set the buyer")
+}
+
+func (s *PrivateSaleTestSuite) TestQueryByBuyer() {
+	private, _ := s.createPrivateEscrow()
+	_, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, newSavedObject(s.generator, s.seller, s.store), s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	resp, err := s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowsRequest{Buyer: s.buyer.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Escrows, 1)
+	s.Assert().Equal(private, resp.Escrows[0].Id)
+
+	resp, err = s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowsRequest{Buyer: s.other.String()})
+	s.Require().NoError(err)
+	s.Assert().Empty(resp.Escrows)
+}
+
+func TestPrivateSale(t *testing.T) {
+	suite.Run(t, new(PrivateSaleTestSuite))
+}
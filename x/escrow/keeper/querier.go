@@ -18,6 +18,12 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 			return queryEscrow(ctx, req, k, legacyQuerierCdc)
 		case types.QueryEscrows:
 			return queryEscrows(ctx, req, k, legacyQuerierCdc)
+		case types.QueryOffer:
+			return queryOffer(ctx, req, k, legacyQuerierCdc)
+		case types.QueryOffers:
+			return queryOffers(ctx, req, k, legacyQuerierCdc)
+		case types.QueryRecords:
+			return queryRecords(ctx, req, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query path: %s", types.ModuleName, path[0])
 		}
@@ -39,7 +45,13 @@ func queryEscrow(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerier
 		return nil, sdkerrors.Wrap(types.ErrEscrowNotFound, params.Id)
 	}
 
-	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryEscrowResponse{Escrow: &escrow})
+	price := k.GetCurrentPrice(ctx, escrow)
+
+	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryEscrowResponse{
+		Escrow:       &escrow,
+		CurrentPrice: price,
+		Royalties:    k.GetRoyalties(ctx, escrow.GetObject(), price, escrow.BrokerCommission),
+	})
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Cannot marshall the queried escrow")
 	}
@@ -52,10 +64,65 @@ func queryEscrows(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerie
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	escrows, err := k.queryEscrowsByAttributes(
+	escrows, err := k.queryEscrowsByAttributes(ctx, &types.QueryEscrowsRequest{
+		Seller:           params.Seller,
+		State:            params.State,
+		ObjectKey:        params.ObjectKey,
+		WantedObjectKey:  params.WantedObjectKey,
+		Buyer:            params.Buyer,
+		ObjectType:       params.ObjectType,
+		Domain:           params.Domain,
+		PriceMin:         params.PriceMin,
+		PriceMax:         params.PriceMax,
+		DeadlineMin:      params.DeadlineMin,
+		DeadlineMax:      params.DeadlineMax,
+		OrderBy:          params.OrderBy,
+		Descending:       params.Descending,
+		PaginationStart:  params.PaginationStart,
+		PaginationLength: params.PaginationLength,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryEscrowsResponse{Escrows: escrows})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Cannot marshall the queried escrows")
+	}
+	return bz, nil
+}
+
+func queryOffer(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryOfferParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := types.ValidateID(params.Id); err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid provided ID")
+	}
+
+	offer, found := k.GetOffer(ctx, params.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrOfferNotFound, params.Id)
+	}
+
+	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryOfferResponse{Offer: &offer})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Cannot marshall the queried offer")
+	}
+	return bz, nil
+}
+
+func queryOffers(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryOffersParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	offers, err := k.queryOffersByAttributes(
 		ctx,
-		params.Seller,
-		params.State,
+		params.Buyer,
 		params.ObjectKey,
 		params.PaginationStart,
 		params.PaginationLength,
@@ -64,9 +131,42 @@ func queryEscrows(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerie
 		return nil, err
 	}
 
-	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryEscrowsResponse{Escrows: escrows})
+	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryOffersResponse{Offers: offers})
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "Cannot marshall the queried escrows")
+		return nil, sdkerrors.Wrap(err, "Cannot marshall the queried offers")
+	}
+	return bz, nil
+}
+
+func queryRecords(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryRecordsParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	records, err := k.queryEscrowRecords(ctx, &types.QueryEscrowRecordsRequest{
+		Seller:           params.Seller,
+		Buyer:            params.Buyer,
+		State:            params.State,
+		ObjectKey:        params.ObjectKey,
+		ObjectType:       params.ObjectType,
+		Domain:           params.Domain,
+		PriceMin:         params.PriceMin,
+		PriceMax:         params.PriceMax,
+		TimeMin:          params.TimeMin,
+		TimeMax:          params.TimeMax,
+		OrderBy:          params.OrderBy,
+		Descending:       params.Descending,
+		PaginationStart:  params.PaginationStart,
+		PaginationLength: params.PaginationLength,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := legacyQuerierCdc.MarshalJSON(&types.QueryEscrowRecordsResponse{Records: records})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Cannot marshall the queried escrow records")
 	}
 	return bz, nil
 }
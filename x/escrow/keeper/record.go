@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	crud "github.com/iov-one/cosmos-sdk-crud"
+	"github.com/pkg/errors"
+
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// SaveEscrowRecord sets the given record in the record store
+func (k Keeper) SaveEscrowRecord(ctx sdk.Context, record types.EscrowRecord) {
+	if err := k.getRecordStore(ctx).Create(&record); err != nil {
+		panic(err)
+	}
+}
+
+// GetEscrowRecord retrieves the record of the specified escrow, which only exists once the escrow is completed or
+// refunded
+func (k Keeper) GetEscrowRecord(ctx sdk.Context, id string) (record types.EscrowRecord, found bool) {
+	err := k.getRecordStore(ctx).Read(types.GetEscrowKey(id), &record)
+	if errors.Is(err, crud.ErrNotFound) {
+		return record, false
+	} else if err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+// IterateEscrowRecords iterates through all the escrow records.
+func (k Keeper) IterateEscrowRecords(ctx sdk.Context, op func(types.EscrowRecord) bool) {
+	cursor, err := k.getRecordStore(ctx).Query().Do()
+	if err != nil {
+		panic(err)
+	}
+
+	for ; cursor.Valid(); cursor.Next() {
+		var record types.EscrowRecord
+		if err := cursor.Read(&record); err != nil {
+			panic(err)
+		}
+
+		if stop := op(record); stop {
+			break
+		}
+	}
+}
+
+// queryEscrowRecords query the escrow records matching the attributes of the request (only filters if attribute is
+// non-zero), sorted by the order of the request, starting at record with index `start` and returns a maximum of
+// `length` records.
+// The seller, buyer and object attributes are looked up in the indexes, the other attributes are filtered out of the
+// records matching the indexed attributes while they are read, keeping only the records that can be on the page.
+func (k Keeper) queryEscrowRecords(ctx sdk.Context, request *types.QueryEscrowRecordsRequest) ([]types.EscrowRecord, error) {
+	k.checkThatModuleIsEnabled(ctx)
+
+	seller, err := parseAddressFilter(request.Seller, "seller")
+	if err != nil {
+		return nil, err
+	}
+	buyer, err := parseAddressFilter(request.Buyer, "buyer")
+	if err != nil {
+		return nil, err
+	}
+
+	var state types.EscrowState
+	var hasState bool
+	if len(request.State) != 0 {
+		hasState = true
+		switch strings.ToLower(request.State) {
+		case "completed":
+			state = types.EscrowState_Completed
+		case "refunded":
+			state = types.EscrowState_Refunded
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The state is invalid, it must be one of completed or refunded")
+		}
+	}
+
+	var objectKey []byte
+	if len(request.ObjectKey) != 0 {
+		objectKey, err = hex.DecodeString(request.ObjectKey)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Object key must be an hex-encoded byte array : "+err.Error())
+		}
+	}
+
+	prices, err := parsePriceRange(request.PriceMin, request.PriceMax)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateOrder(request.OrderBy, "time"); err != nil {
+		return nil, err
+	}
+
+	query := k.getRecordStore(ctx).Query()
+	var statement crud.FinalizedIndexStatement
+	where := func() crud.WhereStatement {
+		if statement == nil {
+			return query.Where()
+		}
+		return statement.And()
+	}
+	if seller != nil {
+		statement = where().Index(types.RecordSellerIndex).Equals(seller)
+	}
+	if buyer != nil {
+		statement = where().Index(types.RecordBuyerIndex).Equals(buyer)
+	}
+	if objectKey != nil {
+		statement = where().Index(types.RecordObjectIndex).Equals(objectKey)
+	}
+	var filter crud.ValidQuery = query
+	if statement != nil {
+		filter = statement
+	}
+
+	cursor, err := filter.Do()
+	if err != nil {
+		return nil, err
+	}
+	var records []types.EscrowRecord
+	priceDenom := k.GetEscrowPriceDenom(ctx)
+	sortRecords := func() {
+		sort.Slice(records, getOrderLess(request.OrderBy, request.Descending,
+			func(i int) string { return records[i].Id },
+			func(i int) sdk.Int { return records[i].Price.AmountOf(priceDenom) },
+			func(i int) uint64 { return records[i].Time },
+		))
+	}
+	// Only the first start + length records in the requested order can be on the page, the others are dropped as the
+	// records are read. The cursor follows the order of the ids, so the reading stops once the page is full if it is
+	// the requested order.
+	start, length := request.PaginationStart, request.PaginationLength
+	var bound uint64
+	if length != 0 {
+		bound = start + length
+	}
+	byID := (len(request.OrderBy) == 0 || strings.EqualFold(request.OrderBy, "id")) && !request.Descending
+	for ; cursor.Valid(); cursor.Next() {
+		var record types.EscrowRecord
+		if err := cursor.Read(&record); err != nil {
+			return nil, err
+		}
+		if (hasState && record.State != state) ||
+			(request.ObjectType != 0 && record.ObjectType != types.TypeID(request.ObjectType)) ||
+			(len(request.Domain) != 0 && record.Domain != request.Domain) ||
+			!isInTimeRange(record.Time, request.TimeMin, request.TimeMax) ||
+			!prices.contains(record.Price) {
+			continue
+		}
+		records = append(records, record)
+		if bound == 0 {
+			continue
+		}
+		if byID && uint64(len(records)) == bound {
+			break
+		}
+		if uint64(len(records)) == 2*bound {
+			sortRecords()
+			records = records[:bound]
+		}
+	}
+	sortRecords()
+
+	first, last := getPageBounds(len(records), start, length)
+	return records[first:last], nil
+}
+
+// parseAddressFilter parses the bech32 address used as a filter of a query, it returns nil if the address is empty
+func parseAddressFilter(address string, name string) (sdk.AccAddress, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Invalid %s address", name)
+	}
+	return addr, nil
+}
+
+// priceRange is an optional price range, each bound is a coin that must be held in the same denomination by the price
+type priceRange struct {
+	min, max *sdk.Coin
+}
+
+// parsePriceRange parses the bounds of a price range, an empty bound is not checked
+func parsePriceRange(min, max string) (priceRange, error) {
+	var prices priceRange
+	for _, bound := range []struct {
+		value string
+		coin  **sdk.Coin
+	}{{min, &prices.min}, {max, &prices.max}} {
+		if len(bound.value) == 0 {
+			continue
+		}
+		coin, err := sdk.ParseCoinNormalized(bound.value)
+		if err != nil {
+			return prices, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "The price bound %s is invalid : %s", bound.value, err.Error())
+		}
+		*bound.coin = &coin
+	}
+	return prices, nil
+}
+
+// contains returns true if the amount of the price in the denomination of each bound is included in the range
+func (r priceRange) contains(price sdk.Coins) bool {
+	if r.min != nil && price.AmountOf(r.min.Denom).LT(r.min.Amount) {
+		return false
+	}
+	if r.max != nil && price.AmountOf(r.max.Denom).GT(r.max.Amount) {
+		return false
+	}
+	return true
+}
+
+// isInTimeRange returns true if the given time is included in [min, max], a zero bound is not checked
+func isInTimeRange(time, min, max uint64) bool {
+	return (min == 0 || time >= min) && (max == 0 || time <= max)
+}
+
+// validateOrder checks that the sort order of a query is the id, the price or the given time attribute
+func validateOrder(orderBy string, timeAttribute string) error {
+	switch strings.ToLower(orderBy) {
+	case "", "id", "price", timeAttribute:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "The order is invalid, it must be one of id, price or %s", timeAttribute)
+	}
+}
+
+// getOrderLess returns the less function of a sort by the validated order, which is the id, the price or the time of
+// the elements. The elements with the same price or time are sorted by id.
+func getOrderLess(
+	orderBy string,
+	descending bool,
+	id func(int) string,
+	price func(int) sdk.Int,
+	time func(int) uint64,
+) func(i, j int) bool {
+	var compare func(i, j int) int
+	switch strings.ToLower(orderBy) {
+	case "", "id":
+		compare = func(i, j int) int { return 0 }
+	case "price":
+		compare = func(i, j int) int { return price(i).BigInt().Cmp(price(j).BigInt()) }
+	default:
+		compare = func(i, j int) int {
+			switch {
+			case time(i) < time(j):
+				return -1
+			case time(i) > time(j):
+				return 1
+			}
+			return 0
+		}
+	}
+	return func(i, j int) bool {
+		c := compare(i, j)
+		if c == 0 {
+			c = strings.Compare(id(i), id(j))
+		}
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	}
+}
+
+// getPageBounds returns the bounds of the page of `length` elements starting at `start` among n elements, a zero
+// length returns all the elements after `start`
+func getPageBounds(n int, start, length uint64) (int, int) {
+	if start >= uint64(n) {
+		return n, n
+	}
+	if length == 0 || length > uint64(n)-start {
+		return int(start), n
+	}
+	return int(start), int(start + length)
+}
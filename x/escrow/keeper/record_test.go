@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type RecordTestSuite struct {
+	BaseKeeperSuite
+	buyer  sdk.AccAddress
+	seller sdk.AccAddress
+}
+
+func (s *RecordTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+}
+
+func (s *RecordTestSuite) createEscrow(price int64, deadline uint64) (string, *types.TestObject) {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, coins(price), obj, s.generator.NowAfter(deadline))
+	s.Require().NoError(err)
+	return id, obj
+}
+
+func (s *RecordTestSuite) queryRecords(request types.QueryEscrowRecordsRequest) []string {
+	resp, err := s.keeper.EscrowRecords(sdk.WrapSDKContext(s.ctx), &request)
+	s.Require().NoError(err)
+	ids := make([]string, 0, len(resp.Records))
+	for _, record := range resp.Records {
+		ids = append(ids, record.Id)
+	}
+	return ids
+}
+
+func (s *RecordTestSuite) queryEscrows(request types.QueryEscrowsRequest) []string {
+	resp, err := s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &request)
+	s.Require().NoError(err)
+	ids := make([]string, 0, len(resp.Escrows))
+	for _, escrow := range resp.Escrows {
+		ids = append(ids, escrow.Id)
+	}
+	return ids
+}
+
+func (s *RecordTestSuite) TestRecordSale() {
+	id, obj := s.createEscrow(100, 10)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, coins(100)))
+
+	record, found := s.keeper.GetEscrowRecord(s.ctx, id)
+	s.Require().True(found)
+	s.Assert().Equal(types.EscrowRecord{
+		Id:         id,
+		State:      types.EscrowState_Completed,
+		Seller:     s.seller.String(),
+		Buyer:      s.buyer.String(),
+		Price:      coins(100),
+		ObjectType: obj.GetObjectTypeID(),
+		ObjectKeys: [][]byte{obj.GetUniqueKey()},
+		Time:       uint64(s.ctx.BlockTime().Unix()),
+	}, record)
+	s.Assert().NoError(record.Validate())
+}
+
+func (s *RecordTestSuite) TestRecordRefund() {
+	id, _ := s.createEscrow(100, 10)
+	s.Require().NoError(s.keeper.RefundEscrow(s.ctx, s.seller, id))
+
+	record, found := s.keeper.GetEscrowRecord(s.ctx, id)
+	s.Require().True(found)
+	s.Assert().Equal(types.EscrowState_Refunded, record.State)
+	s.Assert().Empty(record.Buyer)
+	s.Assert().True(record.Price.Empty())
+	s.Assert().NoError(record.Validate())
+
+	// Expired escrows are recorded as well
+	expired, _ := s.createEscrow(100, 10)
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+	s.keeper.RefundExpiredEscrows(s.ctx)
+	record, found = s.keeper.GetEscrowRecord(s.ctx, expired)
+	s.Require().True(found)
+	s.Assert().Equal(types.EscrowState_Refunded, record.State)
+}
+
+func (s *RecordTestSuite) TestQueryRecords() {
+	cheap, _ := s.createEscrow(10, 10)
+	expensive, _ := s.createEscrow(50, 10)
+	refunded, obj := s.createEscrow(30, 10)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, expensive, coins(50)))
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, cheap, coins(10)))
+	s.Require().NoError(s.keeper.RefundEscrow(s.ctx, s.seller, refunded))
+	// An open escrow has no record
+	s.createEscrow(20, 10)
+
+	s.Assert().Equal([]string{cheap, expensive, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{}))
+	s.Assert().Equal([]string{cheap, expensive, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{Seller: s.seller.String()}))
+	s.Assert().Equal([]string{cheap, expensive}, s.queryRecords(types.QueryEscrowRecordsRequest{Buyer: s.buyer.String()}))
+	s.Assert().Equal([]string{refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{State: "refunded"}))
+	s.Assert().Equal([]string{refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{ObjectKey: hex.EncodeToString(obj.GetUniqueKey())}))
+	s.Assert().Equal([]string{cheap, expensive, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{ObjectType: uint64(obj.GetObjectTypeID())}))
+	s.Assert().Empty(s.queryRecords(types.QueryEscrowRecordsRequest{ObjectType: uint64(obj.GetObjectTypeID()) + 1}))
+	s.Assert().Empty(s.queryRecords(types.QueryEscrowRecordsRequest{Domain: "domain"}))
+	s.Assert().Equal([]string{expensive}, s.queryRecords(types.QueryEscrowRecordsRequest{PriceMin: "20" + test.Denom}))
+	s.Assert().Equal([]string{cheap, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{PriceMax: "20" + test.Denom}))
+	s.Assert().Equal([]string{expensive}, s.queryRecords(types.QueryEscrowRecordsRequest{TimeMax: s.generator.NowAfter(0)}))
+	s.Assert().Equal([]string{cheap, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{TimeMin: s.generator.NowAfter(1)}))
+	s.Assert().Equal([]string{expensive, cheap, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{OrderBy: "price", Descending: true}))
+	s.Assert().Equal([]string{expensive, cheap, refunded}, s.queryRecords(types.QueryEscrowRecordsRequest{OrderBy: "time"}))
+	s.Assert().Equal([]string{expensive}, s.queryRecords(types.QueryEscrowRecordsRequest{PaginationStart: 1, PaginationLength: 1}))
+	s.Assert().Empty(s.queryRecords(types.QueryEscrowRecordsRequest{PaginationStart: 3}))
+
+	for _, invalid := range []types.QueryEscrowRecordsRequest{
+		{Buyer: "invalid"},
+		{State: "open"},
+		{ObjectKey: "invalid"},
+		{PriceMin: "invalid"},
+		{OrderBy: "deadline"},
+	} {
+		invalid := invalid
+		_, err := s.keeper.EscrowRecords(sdk.WrapSDKContext(s.ctx), &invalid)
+		s.Assert().Error(err, "%v", invalid)
+	}
+}
+
+func (s *RecordTestSuite) TestQueryEscrowsWithFilters() {
+	first, obj := s.createEscrow(30, 10)
+	second, _ := s.createEscrow(10, 30)
+	third, _ := s.createEscrow(20, 20)
+	all := []string{first, second, third}
+
+	s.Assert().Equal(all, s.queryEscrows(types.QueryEscrowsRequest{ObjectType: uint64(obj.GetObjectTypeID())}))
+	s.Assert().Empty(s.queryEscrows(types.QueryEscrowsRequest{ObjectType: uint64(obj.GetObjectTypeID()) + 1}))
+	s.Assert().Empty(s.queryEscrows(types.QueryEscrowsRequest{Domain: "domain"}))
+	s.Assert().Equal([]string{first, third}, s.queryEscrows(types.QueryEscrowsRequest{PriceMin: "20" + test.Denom}))
+	s.Assert().Equal([]string{second, third}, s.queryEscrows(types.QueryEscrowsRequest{PriceMax: "20" + test.Denom}))
+	s.Assert().Equal([]string{first, third}, s.queryEscrows(types.QueryEscrowsRequest{DeadlineMax: s.generator.NowAfter(20)}))
+	s.Assert().Equal([]string{second, third}, s.queryEscrows(types.QueryEscrowsRequest{DeadlineMin: s.generator.NowAfter(20)}))
+	s.Assert().Equal([]string{second, third, first}, s.queryEscrows(types.QueryEscrowsRequest{OrderBy: "price"}))
+	s.Assert().Equal([]string{second, third, first}, s.queryEscrows(types.QueryEscrowsRequest{OrderBy: "deadline", Descending: true}))
+	s.Assert().Equal([]string{third, first}, s.queryEscrows(types.QueryEscrowsRequest{OrderBy: "price", PaginationStart: 1}))
+	s.Assert().Equal([]string{third}, s.queryEscrows(types.QueryEscrowsRequest{
+		Seller:           s.seller.String(),
+		OrderBy:          "price",
+		Descending:       true,
+		PaginationStart:  1,
+		PaginationLength: 1,
+	}))
+
+	_, err := s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowsRequest{PriceMax: "invalid"})
+	s.Assert().Error(err)
+	_, err = s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowsRequest{OrderBy: "time"})
+	s.Assert().Error(err)
+}
+
+func (s *RecordTestSuite) TestQueryEscrowsPageWithFilters() {
+	// The prices are in the reverse order of the ids, the pages of the filtered queries are kept while reading
+	ids := make([]string, 8)
+	for i := range ids {
+		ids[i], _ = s.createEscrow(int64(100-i), uint64(10+i))
+	}
+	for start := uint64(0); start < 9; start++ {
+		first, last := getTestPageBounds(len(ids), start, 3)
+		byPrice := []string{ids[7], ids[6], ids[5], ids[4], ids[3], ids[2], ids[1], ids[0]}[first:last]
+		s.Assert().Equal(byPrice, s.queryEscrows(types.QueryEscrowsRequest{OrderBy: "price", PaginationStart: start, PaginationLength: 3}))
+		s.Assert().Equal(ids[first:last], s.queryEscrows(types.QueryEscrowsRequest{PriceMin: "1" + test.Denom, PaginationStart: start, PaginationLength: 3}))
+	}
+}
+
+func (s *RecordTestSuite) TestQueryRecordsPageWithFilters() {
+	// The prices are in the reverse order of the ids, the pages of the filtered queries are kept while reading
+	ids := make([]string, 8)
+	for i := range ids {
+		ids[i], _ = s.createEscrow(int64(100-i), 10)
+		s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, ids[i], coins(int64(100-i))))
+	}
+	for start := uint64(0); start < 9; start++ {
+		first, last := getTestPageBounds(len(ids), start, 3)
+		byPrice := []string{ids[7], ids[6], ids[5], ids[4], ids[3], ids[2], ids[1], ids[0]}[first:last]
+		s.Assert().Equal(byPrice, s.queryRecords(types.QueryEscrowRecordsRequest{OrderBy: "price", PaginationStart: start, PaginationLength: 3}))
+		s.Assert().Equal(ids[first:last], s.queryRecords(types.QueryEscrowRecordsRequest{PriceMin: "1" + test.Denom, PaginationStart: start, PaginationLength: 3}))
+	}
+}
+
+// getTestPageBounds returns the bounds of the page of the given length starting at start among n elements
+func getTestPageBounds(n int, start uint64, length int) (int, int) {
+	first := int(start)
+	if first > n {
+		first = n
+	}
+	last := first + length
+	if last > n {
+		last = n
+	}
+	return first, last
+}
+
+func TestRecord(t *testing.T) {
+	suite.Run(t, new(RecordTestSuite))
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type RoyaltyTestSuite struct {
+	BaseKeeperSuite
+	buyer     sdk.AccAddress
+	seller    sdk.AccAddress
+	broker    sdk.AccAddress
+	recipient sdk.AccAddress
+	price     sdk.Coins
+}
+
+func (s *RoyaltyTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.broker = s.generator.NewAccAddress()
+	s.recipient = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+	s.price = coins(100)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.Broker = s.broker.String()
+	params.Commission = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+}
+
+func (s *RoyaltyTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins(s.balances[addr.String()]...)
+}
+
+func (s *RoyaltyTestSuite) newObjectWithRoyalty(rate sdk.Dec) *types.TestObject {
+	obj := s.generator.NewTestObject(s.seller)
+	obj.Royalties = []types.Royalty{{Recipient: s.recipient.String(), Rate: rate}}
+	s.Require().NoError(s.store.Create(obj))
+	return obj
+}
+
+func (s *RoyaltyTestSuite) TestSale() {
+	obj := s.newObjectWithRoyalty(sdk.NewDecWithPrec(5, 2))
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(5), s.balance(s.recipient))
+	s.Assert().Equal(coins(10), s.balance(s.broker))
+	s.Assert().Equal(coins(85), s.balance(s.seller))
+	s.Assert().True(s.balance(s.keeper.GetEscrowAddress(id)).IsZero())
+}
+
+func (s *RoyaltyTestSuite) TestRoundedDown() {
+	obj := s.newObjectWithRoyalty(sdk.NewDecWithPrec(33, 3))
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(3), s.balance(s.recipient))
+	s.Assert().Equal(coins(87), s.balance(s.seller))
+}
+
+func (s *RoyaltyTestSuite) TestBundle() {
+	// The rate of the royalties is not diluted by the objects of the bundle without royalties
+	bundle, err := types.NewObjectBundle(s.newObjectWithRoyalty(sdk.NewDecWithPrec(1, 1)), newSavedObject(s.generator, s.seller, s.store))
+	s.Require().NoError(err)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, bundle, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(10), s.balance(s.recipient))
+	s.Assert().Equal(coins(80), s.balance(s.seller))
+}
+
+func (s *RoyaltyTestSuite) TestBundleSameRecipient() {
+	// A recipient is paid its highest rate once, whatever the number of its objects in the bundle
+	bundle, err := types.NewObjectBundle(
+		s.newObjectWithRoyalty(sdk.NewDecWithPrec(1, 1)),
+		s.newObjectWithRoyalty(sdk.NewDecWithPrec(2, 1)),
+		newSavedObject(s.generator, s.seller, s.store),
+	)
+	s.Require().NoError(err)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, bundle, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(20), s.balance(s.recipient))
+	s.Assert().Equal(coins(70), s.balance(s.seller))
+}
+
+func (s *RoyaltyTestSuite) TestExceedingPrice() {
+	// The royalties are scaled down to the 90% of the price left by the 10% commission
+	obj := s.newObjectWithRoyalty(sdk.NewDecWithPrec(95, 2))
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	res, err := s.keeper.Escrow(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowRequest{Id: id})
+	s.Require().NoError(err)
+	s.Require().Len(res.Royalties, 1)
+	s.Assert().Equal(sdk.NewDecWithPrec(9, 1), res.Royalties[0].Rate)
+	s.Assert().Equal(coins(90), res.Royalties[0].Amount)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(90), s.balance(s.recipient))
+	s.Assert().Equal(coins(10), s.balance(s.broker))
+	s.Assert().True(s.balance(s.seller).IsZero())
+}
+
+func (s *RoyaltyTestSuite) TestExceedingPriceScaledProportionally() {
+	// Two royalties of 60% exceed the 90% left by the commission, they are both scaled down to 45%
+	other := s.generator.NewAccAddress()
+	obj := s.generator.NewTestObject(s.seller)
+	obj.Royalties = []types.Royalty{
+		{Recipient: s.recipient.String(), Rate: sdk.NewDecWithPrec(6, 1)},
+		{Recipient: other.String(), Rate: sdk.NewDecWithPrec(6, 1)},
+	}
+	s.Require().NoError(s.store.Create(obj))
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(coins(45), s.balance(s.recipient))
+	s.Assert().Equal(coins(45), s.balance(other))
+	s.Assert().Equal(coins(10), s.balance(s.broker))
+	s.Assert().True(s.balance(s.seller).IsZero())
+}
+
+func (s *RoyaltyTestSuite) TestQuery() {
+	obj := s.newObjectWithRoyalty(sdk.NewDecWithPrec(5, 2))
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	res, err := s.keeper.Escrow(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowRequest{Id: id})
+	s.Require().NoError(err)
+	s.Require().Len(res.Royalties, 1)
+	s.Assert().Equal(s.recipient.String(), res.Royalties[0].Recipient)
+	s.Assert().Equal(coins(5), res.Royalties[0].Amount)
+}
+
+func TestRoyalty(t *testing.T) {
+	suite.Run(t, new(RoyaltyTestSuite))
+}
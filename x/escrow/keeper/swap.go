@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// CreateSwap creates a swap escrow exchanging the object for the wanted object and transfers the object to the escrow
+// account. The price is optional and, if not empty, must be paid by the counterparty on top of the wanted object.
+// The deadline must be included in the interval ]now, now + max_period].
+// The returned string is the 16 character escrow ID
+func (k Keeper) CreateSwap(
+	ctx sdk.Context,
+	seller sdk.AccAddress,
+	object types.TransferableObject,
+	wanted types.TransferableObject,
+	price sdk.Coins,
+	deadline uint64,
+) (
+	string,
+	error,
+) {
+	if wanted == nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The wanted object is nil")
+	}
+	return k.createEscrow(ctx, seller, price, object, deadline, func(escrow *types.Escrow) error {
+		wantedAny, err := codectypes.NewAnyWithValue(wanted)
+		if err != nil {
+			return sdkerrors.Wrap(err, "Cannot pack the wanted object")
+		}
+		escrow.WantedObject = wantedAny
+		return nil
+	})
+}
+
+// CompleteSwap completes a swap escrow: the wanted object is transferred from the buyer to the seller, as well as the
+// price if it is not empty, and the object of the escrow is transferred to the buyer.
+// The specified amount must be greater than or equal to the escrow price, like in TransferToEscrow.
+// Either all the transfers are done or none of them. The escrow is then marked as completed and removed.
+func (k Keeper) CompleteSwap(
+	ctx sdk.Context,
+	buyer sdk.AccAddress,
+	id string,
+	amount sdk.Coins,
+) error {
+	k.checkThatModuleIsEnabled(ctx)
+
+	// check that the escrow exists
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrEscrowNotFound, id)
+	}
+
+	// check that the escrow is open
+	if escrow.State != types.EscrowState_Open || hasExpired(ctx, escrow) {
+		return sdkerrors.Wrap(types.ErrEscrowNotOpen, escrow.Id)
+	}
+
+	if !escrow.IsSwap() {
+		return sdkerrors.Wrapf(types.ErrInvalidSwap, "The escrow %s is not a swap escrow", escrow.Id)
+	}
+
+	seller, err := sdk.AccAddressFromBech32(escrow.Seller)
+	if err != nil {
+		//this should be always valid because the escrow is guaranteed to be in a valid state when created/updated
+		panic(sdkerrors.Wrapf(err, "Invalid seller address : %v", escrow.Seller))
+	}
+
+	broker, err := sdk.AccAddressFromBech32(escrow.BrokerAddress)
+	if err != nil {
+		//this should be always valid because the escrow is guaranteed to be in a valid state when created
+		panic(sdkerrors.Wrapf(err, "Invalid broker address : %v", escrow.BrokerAddress))
+	}
+
+	// Ensure that the buyer is not the seller of this escrow
+	if buyer.Equals(seller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "The owner of the escrow cannot complete the swap")
+	}
+
+	// A private swap can only be completed by its designated buyer
+	if !escrow.IsDesignatedBuyer(buyer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "The escrow is reserved to its designated buyer")
+	}
+
+	// Check if the provided amount is valid
+	if !amount.IsValid() {
+		return types.ErrInvalidAmount
+	}
+
+	// Check if the amount is greater or equal than the price
+	if !amount.IsAllGTE(escrow.Price) {
+		return types.ErrTransferAmountTooLow
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	// Send the wanted object to the seller
+	wanted := escrow.GetWantedObject()
+	if err := k.doObjectTransfer(cacheCtx, buyer, seller, wanted); err != nil {
+		return sdkerrors.Wrap(err, "Cannot transfer the wanted object to the seller")
+	}
+
+	// Send the price to the module
+	if !escrow.Price.Empty() {
+		if err := k.transferCoinsToEscrow(cacheCtx, buyer, escrow.Id, escrow.Price); err != nil {
+			return sdkerrors.Wrap(err, "Cannot send the coins to the escrow")
+		}
+	}
+
+	// Do the exchange, only the escrowed object records the sale as the wanted object is not sold for a price
+	if err := k.doSwap(cacheCtx, escrow, buyer, seller, broker, nil); err != nil {
+		return sdkerrors.Wrap(err, "Cannot complete the swap")
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	escrow.State = types.EscrowState_Completed
+	k.deleteEscrow(ctx, escrow, buyer)
+	return nil
+}
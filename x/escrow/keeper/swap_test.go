@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type SwapTestSuite struct {
+	BaseKeeperSuite
+	buyer  sdk.AccAddress
+	seller sdk.AccAddress
+	broker sdk.AccAddress
+	price  sdk.Coins
+}
+
+func (s *SwapTestSuite) SetupTest() {
+	s.generator = test.NewEscrowGenerator(uint64(test.TimeNow.Unix()))
+	s.buyer = s.generator.NewAccAddress()
+	s.seller = s.generator.NewAccAddress()
+	s.broker = s.generator.NewAccAddress()
+	s.Setup([]sdk.AccAddress{s.buyer}, true)
+	s.price = sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(100)))
+
+	params := s.keeper.GetParams(s.ctx)
+	params.Broker = s.broker.String()
+	params.Commission = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+}
+
+func (s *SwapTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins(s.balances[addr.String()]...)
+}
+
+func (s *SwapTestSuite) owner(obj *types.TestObject) sdk.AccAddress {
+	var stored types.TestObject
+	s.Require().NoError(s.store.Read(obj.PrimaryKey(), &stored))
+	return stored.Owner
+}
+
+func (s *SwapTestSuite) TestCreateSwap() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	wanted := newSavedObject(s.generator, s.buyer, s.store)
+
+	id, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, nil, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	s.Assert().Equal(s.keeper.GetEscrowAddress(id), s.owner(obj))
+	s.Assert().Equal(s.buyer, s.owner(wanted))
+
+	escrow, found := s.keeper.GetEscrow(s.ctx, id)
+	s.Require().True(found)
+	s.Assert().True(escrow.IsSwap())
+	s.Assert().Equal(wanted.GetUniqueKey(), escrow.GetWantedObject().GetUniqueKey())
+
+	// The swap can be found from the wanted object
+	escrows, err := s.keeper.Escrows(sdk.WrapSDKContext(s.ctx), &types.QueryEscrowsRequest{WantedObjectKey: hex.EncodeToString(wanted.GetUniqueKey())})
+	s.Require().NoError(err)
+	s.Require().Len(escrows.Escrows, 1)
+	s.Assert().Equal(id, escrows.Escrows[0].Id)
+}
+
+func (s *SwapTestSuite) TestCreateSwapInvalid() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	owned := newSavedObject(s.generator, s.seller, s.store)
+	wanted := newSavedObject(s.generator, s.buyer, s.store)
+
+	_, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, nil, nil, s.generator.NowAfter(10))
+	s.Assert().Error(err, "nil wanted object")
+	_, err = s.keeper.CreateSwap(s.ctx, s.seller, obj, obj, nil, s.generator.NowAfter(10))
+	s.Assert().Error(err, "an object cannot be swapped for itself")
+	_, err = s.keeper.CreateSwap(s.ctx, s.seller, obj, owned, nil, s.generator.NowAfter(10))
+	s.Assert().Error(err, "the wanted object already belongs to the seller")
+	_, err = s.keeper.CreateSwap(s.ctx, s.seller, wanted, obj, nil, s.generator.NowAfter(10))
+	s.Assert().Error(err, "the object does not belong to the seller")
+	_, err = s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, sdk.NewCoins(sdk.NewCoin("invalid", sdk.NewInt(1))), s.generator.NowAfter(10))
+	s.Assert().Error(err, "the price must be in the escrow denomination")
+}
+
+func (s *SwapTestSuite) TestCompleteSwap() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	wanted := newSavedObject(s.generator, s.buyer, s.store)
+	id, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, nil, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.seller, id, nil), "the seller cannot complete its own swap")
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.generator.NewAccAddress(), id, nil), "only the owner of the wanted object can complete the swap")
+	s.Assert().Error(s.keeper.TransferToEscrow(s.ctx, s.buyer, id, s.price), "a swap cannot be bought with coins only")
+
+	before := s.balance(s.buyer)
+	s.Require().NoError(s.keeper.CompleteSwap(s.ctx, s.buyer, id, nil))
+	s.Assert().Equal(s.buyer, s.owner(obj))
+	s.Assert().Equal(s.seller, s.owner(wanted))
+	s.Assert().Equal(before, s.balance(s.buyer))
+	s.Assert().False(s.keeper.HasEscrow(s.ctx, id))
+}
+
+func (s *SwapTestSuite) TestCompleteSwapWithPrice() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	wanted := newSavedObject(s.generator, s.buyer, s.store)
+	id, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, s.price, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.buyer, id, nil), "the amount must cover the price")
+
+	s.Require().NoError(s.keeper.CompleteSwap(s.ctx, s.buyer, id, s.price))
+	s.Assert().Equal(s.buyer, s.owner(obj))
+	s.Assert().Equal(s.seller, s.owner(wanted))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(90))), s.balance(s.seller))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewCoin(test.Denom, sdk.NewInt(10))), s.balance(s.broker))
+	s.Assert().True(s.balance(s.keeper.GetEscrowAddress(id)).IsZero())
+}
+
+func (s *SwapTestSuite) TestCompleteSwapFailure() {
+	// The escrowed object cannot be transferred out of the escrow
+	obj := s.generator.NewErroredTestObject(1)
+	obj.Owner = s.seller
+	s.Require().NoError(s.store.Create(obj))
+	wanted := newSavedObject(s.generator, s.buyer, s.store)
+	id, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, s.price, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.buyer, id, s.price), "a failing swap returns an error")
+	s.Assert().True(s.keeper.HasEscrow(s.ctx, id))
+}
+
+func (s *SwapTestSuite) TestCompleteNotSwap() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	id, err := s.keeper.CreateEscrow(s.ctx, s.seller, s.price, obj, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.buyer, id, s.price), "a regular escrow is not a swap")
+}
+
+func (s *SwapTestSuite) TestRefundExpiredSwap() {
+	obj := newSavedObject(s.generator, s.seller, s.store)
+	wanted := newSavedObject(s.generator, s.buyer, s.store)
+	id, err := s.keeper.CreateSwap(s.ctx, s.seller, obj, wanted, nil, s.generator.NowAfter(10))
+	s.Require().NoError(err)
+
+	s.keeper.MarkExpiredEscrows(s.ctx, s.generator.NowAfter(10))
+	s.Assert().Error(s.keeper.CompleteSwap(s.ctx, s.buyer, id, nil), "an expired swap cannot be completed")
+	s.keeper.RefundExpiredEscrows(s.ctx)
+	s.Assert().Equal(s.seller, s.owner(obj))
+	s.Assert().Equal(s.buyer, s.owner(wanted))
+	s.Assert().False(s.keeper.HasEscrow(s.ctx, id))
+}
+
+func TestSwap(t *testing.T) {
+	suite.Run(t, new(SwapTestSuite))
+}
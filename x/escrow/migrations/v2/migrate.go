@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	crudtypes "github.com/iov-one/cosmos-sdk-crud/types"
+
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// escrowStoreKey is the prefix of the escrow store of version 1, which the migration reads from
+var escrowStoreKey = []byte{0x01}
+
+// MigrateStore performs in-place store migrations from version 1 to version 2
+// The escrows of version 1 have no selling broker share, which is a non nullable field of version 2: the escrows are
+// saved again with a zero share, the share of an escrow listed without a broker
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.Codec) error {
+	escrowStore := crudtypes.NewStore(cdc, ctx.KVStore(storeKey), escrowStoreKey)
+
+	// Collect the escrows first as the store can not be updated while it is iterated over
+	var escrows []types.Escrow
+	cursor, err := escrowStore.Query().Do()
+	if err != nil {
+		return err
+	}
+	for ; cursor.Valid(); cursor.Next() {
+		var escrow types.Escrow
+		if err := cursor.Read(&escrow); err != nil {
+			return sdkerrors.Wrap(err, "unable to read escrow")
+		}
+		if escrow.SellingBrokerShare.IsNil() {
+			escrows = append(escrows, escrow)
+		}
+	}
+
+	for i := range escrows {
+		escrows[i].SellingBrokerShare = sdk.ZeroDec()
+		if err := escrowStore.Update(&escrows[i]); err != nil {
+			return sdkerrors.Wrapf(err, "unable to update escrow %s", escrows[i].Id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crudtypes "github.com/iov-one/cosmos-sdk-crud/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/iov-one/starnamed/x/escrow/keeper"
+	v2 "github.com/iov-one/starnamed/x/escrow/migrations/v2"
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// lastV1Field is the last field number of the escrows of version 1
+const lastV1Field = 8
+
+// toV1 encodes an escrow the way version 1 stored it, without the fields added by version 2
+func toV1(t *testing.T, escrow types.Escrow) []byte {
+	bz, err := escrow.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v1 []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			t.Fatal(protowire.ParseError(n))
+		}
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if m < 0 {
+			t.Fatal(protowire.ParseError(m))
+		}
+		if num <= lastV1Field {
+			v1 = append(v1, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	length := make([]byte, binary.MaxVarintLen64)
+	return append(length[:binary.PutUvarint(length, uint64(len(v1)))], v1...)
+}
+
+func TestMigrateStore(t *testing.T) {
+	test.SetConfig()
+	k, ctx, _, _, storeKey := test.NewTestKeeper(nil, true)
+	cdc, _ := test.NewTestCodec()
+	now := uint64(ctx.BlockTime().Unix())
+	generator := test.NewEscrowGenerator(now)
+
+	// Store the escrows with their indexes, then replace them with their version 1 encoding
+	escrowStore := crudtypes.NewStore(cdc, ctx.KVStore(storeKey), keeper.EscrowStoreKey)
+	objects := prefix.NewStore(ctx.KVStore(storeKey), append(keeper.EscrowStoreKey, crudtypes.ObjectsPrefix))
+	var escrows []types.Escrow
+	for i := 0; i < 2; i++ {
+		escrow, _ := generator.NewTestEscrow(generator.NewAccAddress(), sdk.NewCoins(sdk.NewInt64Coin(test.Denom, 10)), now+100)
+		if err := escrowStore.Create(&escrow); err != nil {
+			t.Fatal(err)
+		}
+		objects.Set(escrow.PrimaryKey(), toV1(t, escrow))
+		escrows = append(escrows, escrow)
+	}
+	stored, _ := k.GetEscrow(ctx, escrows[0].Id)
+	assert.True(t, stored.SellingBrokerShare.IsNil(), "the fixture should have no selling broker share")
+
+	if err := v2.MigrateStore(ctx, storeKey, cdc); err != nil {
+		t.Fatalf("MigrateStore() got error: %s", err)
+	}
+
+	for _, escrow := range escrows {
+		stored, found := k.GetEscrow(ctx, escrow.Id)
+		assert.True(t, found)
+		assert.False(t, stored.SellingBrokerShare.IsNil())
+		assert.True(t, stored.SellingBrokerShare.IsZero())
+		assert.Equal(t, escrow.Seller, stored.Seller)
+		assert.Equal(t, escrow.Deadline, stored.Deadline)
+		assert.Equal(t, escrow.Price, stored.Price)
+		assert.Equal(t, escrow.BrokerCommission, stored.BrokerCommission)
+	}
+	// the escrows are still indexed
+	resp, err := k.Escrows(sdk.WrapSDKContext(ctx), &types.QueryEscrowsRequest{Seller: escrows[1].Seller})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Escrows, 1)
+}
+
+func TestMigrateStoreEmpty(t *testing.T) {
+	test.SetConfig()
+	_, ctx, _, _, storeKey := test.NewTestKeeper(nil, true)
+	cdc, _ := test.NewTestCodec()
+
+	if err := v2.MigrateStore(ctx, storeKey, cdc); err != nil {
+		t.Fatalf("MigrateStore() got error: %s", err)
+	}
+}
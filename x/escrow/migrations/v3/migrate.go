@@ -0,0 +1,53 @@
+package v3
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+// ParamsSource provides the escrow settings of version 2, which were held outside of the escrow module. The app
+// adapts the module that held them, so that the escrow module does not depend on it.
+type ParamsSource interface {
+	// GetEscrowBroker returns the address of the broker receiving the commission
+	GetEscrowBroker(ctx sdk.Context) string
+	// GetEscrowCommission returns the commission of the broker
+	GetEscrowCommission(ctx sdk.Context) sdk.Dec
+	// GetEscrowMaxPeriod returns the maximum duration of an escrow
+	GetEscrowMaxPeriod(ctx sdk.Context) time.Duration
+	// GetEscrowPriceDenom returns the denomination of the escrow prices
+	GetEscrowPriceDenom(ctx sdk.Context) string
+	// GetEscrowFees returns the fees of the escrow messages, as they were charged in version 2
+	GetEscrowFees(ctx sdk.Context) types.Fees
+}
+
+// MigrateParams performs in-place migrations from version 2 to version 3
+// This moves the escrow broker, commission, maximum period, price denomination and fees provided by the source into
+// the escrow module parameters
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace, source ParamsSource) error {
+	var moduleEnabled bool
+	paramSpace.GetIfExists(ctx, types.KeyModuleEnabled, &moduleEnabled)
+
+	commission := source.GetEscrowCommission(ctx)
+	params := types.Params{
+		ModuleEnabled: moduleEnabled,
+		Broker:        source.GetEscrowBroker(ctx),
+		Commission:    commission,
+		// The brokers listing escrows could not set their own commission in version 2
+		CommissionMax:          commission,
+		MaxPeriod:              source.GetEscrowMaxPeriod(ctx),
+		PriceDenom:             source.GetEscrowPriceDenom(ctx),
+		Fees:                   source.GetEscrowFees(ctx),
+		MaxExpirationsPerBlock: types.DefaultMaxExpirationsPerBlock,
+	}
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid escrow parameters in the source")
+	}
+	paramSpace.SetParamSet(ctx, &params)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iov-one/starnamed/x/escrow/keeper"
+	"github.com/iov-one/starnamed/x/escrow/test"
+	"github.com/iov-one/starnamed/x/escrow/types"
+)
+
+type paramsSource struct {
+	broker     string
+	commission sdk.Dec
+	maxPeriod  time.Duration
+	fees       types.Fees
+}
+
+func (s paramsSource) GetEscrowBroker(sdk.Context) string           { return s.broker }
+func (s paramsSource) GetEscrowCommission(sdk.Context) sdk.Dec      { return s.commission }
+func (s paramsSource) GetEscrowMaxPeriod(sdk.Context) time.Duration { return s.maxPeriod }
+func (s paramsSource) GetEscrowPriceDenom(sdk.Context) string       { return test.Denom }
+func (s paramsSource) GetEscrowFees(sdk.Context) types.Fees         { return s.fees }
+
+func newParamsSource() paramsSource {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(test.Denom, 20))
+	return paramsSource{
+		broker:     test.NewEscrowGenerator(0).NewAccAddress().String(),
+		commission: sdk.NewDecWithPrec(2, 2),
+		maxPeriod:  time.Hour,
+		fees:       types.Fees{CreateEscrow: fee, UpdateEscrow: fee, TransferToEscrow: fee, RefundEscrow: fee},
+	}
+}
+
+func TestMigrateParams(t *testing.T) {
+	test.SetConfig()
+	k, ctx, _, _, _ := test.NewTestKeeper(nil, true)
+	source := newParamsSource()
+
+	if err := keeper.NewMigrator(k, source).Migrate2to3(ctx); err != nil {
+		t.Fatalf("Migrate2to3() got error: %s", err)
+	}
+
+	params := k.GetParams(ctx)
+	assert.True(t, params.ModuleEnabled)
+	assert.Equal(t, source.broker, params.Broker)
+	assert.Equal(t, source.commission, params.Commission)
+	// the commission maximum defaults to the commission
+	assert.Equal(t, source.commission, params.CommissionMax)
+	assert.Equal(t, source.maxPeriod, params.MaxPeriod)
+	assert.Equal(t, test.Denom, params.PriceDenom)
+	assert.Equal(t, source.fees, params.Fees)
+}
+
+func TestMigrateParamsKeepsModuleDisabled(t *testing.T) {
+	test.SetConfig()
+	k, ctx, _, _, _ := test.NewTestKeeper(nil, false)
+
+	if err := keeper.NewMigrator(k, newParamsSource()).Migrate2to3(ctx); err != nil {
+		t.Fatalf("Migrate2to3() got error: %s", err)
+	}
+	assert.False(t, k.GetParams(ctx).ModuleEnabled)
+}
+
+func TestMigrateParamsInvalidSource(t *testing.T) {
+	test.SetConfig()
+	k, ctx, _, _, _ := test.NewTestKeeper(nil, true)
+	source := newParamsSource()
+	source.broker = ""
+
+	assert.Error(t, keeper.NewMigrator(k, source).Migrate2to3(ctx))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/gorilla/mux"
@@ -20,6 +21,7 @@ import (
 	"github.com/iov-one/starnamed/x/escrow/client/cli"
 	"github.com/iov-one/starnamed/x/escrow/client/rest"
 	"github.com/iov-one/starnamed/x/escrow/keeper"
+	v3 "github.com/iov-one/starnamed/x/escrow/migrations/v3"
 	"github.com/iov-one/starnamed/x/escrow/simulation"
 	"github.com/iov-one/starnamed/x/escrow/types"
 )
@@ -89,14 +91,17 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper       keeper.Keeper
+	paramsSource v3.ParamsSource
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+// NewAppModule creates a new AppModule object, the params source is only used to migrate the escrow parameters held
+// outside of the escrow module before version 3
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, paramsSource v3.ParamsSource) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
+		paramsSource:   paramsSource,
 	}
 }
 
@@ -104,6 +109,14 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper, am.paramsSource)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(sdkerrors.Wrapf(err, "Error while registering the escrow module migration from version 1 to 2"))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(sdkerrors.Wrapf(err, "Error while registering the escrow module migration from version 2 to 3"))
+	}
 }
 
 // RegisterInvariants registers the escrow module invariants.
@@ -182,4 +195,4 @@ func (am AppModule) WeightedOperations(module.SimulationState) []simtypes.Weight
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
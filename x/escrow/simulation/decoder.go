@@ -21,7 +21,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &escrow2)
 			return fmt.Sprintf("%v\n%v", escrow1, escrow2)
 
-		case bytes.Equal(kvA.Key[:1], keeper.DeadlineStoreKey):
+		case bytes.Equal(kvA.Key[:1], keeper.OfferStoreKey):
+			var offer1, offer2 types.Offer
+			cdc.MustUnmarshal(kvA.Value, &offer1)
+			cdc.MustUnmarshal(kvB.Value, &offer2)
+			return fmt.Sprintf("%v\n%v", offer1, offer2)
+
+		case bytes.Equal(kvA.Key[:1], keeper.DeadlineStoreKey), bytes.Equal(kvA.Key[:1], keeper.OfferDeadlineStoreKey):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		case bytes.Equal(kvA.Key[:1], keeper.ParamsStoreKey):
 			//TODO: factor in parameter name
@@ -6,8 +6,6 @@ import (
 	"testing"
 	"time"
 
-	configurationtypes "github.com/iov-one/starnamed/x/configuration/types"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -26,7 +24,6 @@ import (
 
 	"github.com/iov-one/starnamed/app"
 	"github.com/iov-one/starnamed/mock"
-	"github.com/iov-one/starnamed/x/configuration"
 	"github.com/iov-one/starnamed/x/escrow/keeper"
 	"github.com/iov-one/starnamed/x/escrow/types"
 )
@@ -82,13 +79,14 @@ func (gen *EscrowGenerator) NewTestEscrow(seller sdk.AccAddress, price sdk.Coins
 		panic(err)
 	}
 	return types.Escrow{
-		Id:               gen.NextID(),
-		Seller:           seller.String(),
-		Object:           packedObj,
-		Price:            price,
-		Deadline:         deadline,
-		BrokerCommission: sdk.ZeroDec(),
-		BrokerAddress:    gen.NewAccAddress().String(),
+		Id:                 gen.NextID(),
+		Seller:             seller.String(),
+		Object:             packedObj,
+		Price:              price,
+		Deadline:           deadline,
+		BrokerCommission:   sdk.ZeroDec(),
+		BrokerAddress:      gen.NewAccAddress().String(),
+		SellingBrokerShare: sdk.ZeroDec(),
 	}, obj
 }
 
@@ -106,6 +104,7 @@ func (gen *EscrowGenerator) NewEmptyEscrowGenesis() *types.GenesisState {
 		Escrows:       []types.Escrow{},
 		LastBlockTime: 0,
 		NextEscrowId:  0,
+		Params:        *NewTestParams(),
 	}
 }
 
@@ -122,6 +121,7 @@ func (gen *EscrowGenerator) NewEscrowGenesis(numEscrows int) *types.GenesisState
 		Escrows:       escrows,
 		LastBlockTime: now,
 		NextEscrowId:  gen.GetNextId(),
+		Params:        *NewTestParams(),
 	}
 }
 
@@ -160,22 +160,29 @@ func SetConfig() {
 	config.SetBech32PrefixForConsensusNode(app.Bech32PrefixConsAddr, app.Bech32PrefixConsPub)
 }
 
-func NewTestKeeper(coinHolders []sdk.AccAddress, isModuleEnabled bool) (keeper.Keeper, sdk.Context, crud.Store, map[string]sdk.Coins, sdk.StoreKey, types.ConfigurationKeeper) {
+// NewTestParams returns the parameters of the test keeper, with the module enabled and the prices and fees in Denom
+func NewTestParams() *types.Params {
+	params := types.DefaultParams()
+	params.PriceDenom = Denom
+	fee := sdk.NewCoins(sdk.NewInt64Coin(Denom, 1))
+	params.Fees = types.Fees{CreateEscrow: fee, UpdateEscrow: fee, TransferToEscrow: fee, RefundEscrow: fee}
+	return &params
+}
+
+func NewTestKeeper(coinHolders []sdk.AccAddress, isModuleEnabled bool) (keeper.Keeper, sdk.Context, crud.Store, map[string]sdk.Coins, sdk.StoreKey) {
 	cdc, legacyCdc := NewTestCodec()
 	// generate store
 	mdb := db.NewMemDB()
 	// generate multistore
 	ms := store.NewCommitMultiStore(mdb)
 	// generate store keys
-	escrowStoreKey := sdk.NewKVStoreKey(types.StoreKey)                // domain module store key
-	configurationStoreKey := sdk.NewKVStoreKey(configuration.StoreKey) // configuration module store key
-	paramStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)           // SDK parameter store key
-	tParamStoreKey := sdk.NewKVStoreKey("t" + paramstypes.StoreKey)    // SDK parameter transient store key
+	escrowStoreKey := sdk.NewKVStoreKey(types.StoreKey)             // domain module store key
+	paramStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)        // SDK parameter store key
+	tParamStoreKey := sdk.NewKVStoreKey("t" + paramstypes.StoreKey) // SDK parameter transient store key
 
 	// generate sub store for each module referenced by the keeper
-	ms.MountStoreWithDB(escrowStoreKey, sdk.StoreTypeIAVL, mdb)        // mount domain module
-	ms.MountStoreWithDB(configurationStoreKey, sdk.StoreTypeIAVL, mdb) // mount config module
-	ms.MountStoreWithDB(paramStoreKey, sdk.StoreTypeIAVL, mdb)         // mount params stores
+	ms.MountStoreWithDB(escrowStoreKey, sdk.StoreTypeIAVL, mdb) // mount domain module
+	ms.MountStoreWithDB(paramStoreKey, sdk.StoreTypeIAVL, mdb)  // mount params stores
 	ms.MountStoreWithDB(tParamStoreKey, sdk.StoreTypeIAVL, mdb)
 
 	// test no errors
@@ -199,34 +206,24 @@ func NewTestKeeper(coinHolders []sdk.AccAddress, isModuleEnabled bool) (keeper.K
 	}
 	// Create mock auth keeper
 	authMocker := mock.NewAccountKeeper()
-	// Create config keeper
-	configKeeper := configuration.NewKeeper(cdc, configurationStoreKey, nil)
 	// create context
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: TimeNow}, true, log.NewNopLogger())
 	// Create param subspace
 	paramsSubspace := paramstypes.NewSubspace(cdc, legacyCdc, paramStoreKey, tParamStoreKey, types.ModuleName)
 	if isModuleEnabled {
 		paramsSubspace = paramsSubspace.WithKeyTable(types.ParamKeyTable())
-		paramsSubspace.SetParamSet(ctx, &types.Params{ModuleEnabled: true})
+		paramsSubspace.SetParamSet(ctx, NewTestParams())
 	}
 
-	// Set default fees
-	defaultFees := configuration.NewFees()
-	defaultFees.SetDefaults(Denom)
-	configKeeper.SetFees(ctx, defaultFees)
-
-	defaultConfig := configurationtypes.DefaultGenesisState().Config
-	configKeeper.SetConfig(ctx, defaultConfig)
-
 	// register blocked addresses
 	blockedAddr := make(map[string]bool)
 	blockedAddr[authtypes.NewModuleAddress(types.ModuleName).String()] = true
 
-	k := keeper.NewKeeper(cdc, escrowStoreKey, paramsSubspace, authMocker.Mock(), bankMocker.Mock(), configKeeper, blockedAddr)
+	k := keeper.NewKeeper(cdc, escrowStoreKey, paramsSubspace, authMocker.Mock(), bankMocker.Mock(), blockedAddr)
 	k.RegisterCustomData(types.TypeIDTestObject, crudStore)
 	k.RegisterCustomData(types.TypeIDTestTimeConstrainedObject, wrapStoreForTimeContrainedObjects(crudStore))
 	k.SetLastBlockTime(ctx, uint64(ctx.BlockTime().Unix()))
-	return k, ctx, crudStore, balances, escrowStoreKey, configKeeper
+	return k, ctx, crudStore, balances, escrowStoreKey
 }
 
 type storeWrapper struct {
@@ -0,0 +1,338 @@
+package types
+
+import (
+	"crypto/sha256"
+	"math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Assert that ObjectBundle is a TransferableObject, an ObjectWithTimeConstraint, an ObjectWithSaleRecord, an
+// ObjectWithRoyalties and an ObjectWithDomain
+var _ TransferableObject = &ObjectBundle{}
+var _ ObjectWithTimeConstraint = &ObjectBundle{}
+var _ ObjectWithSaleRecord = &ObjectBundle{}
+var _ ObjectWithRoyalties = &ObjectBundle{}
+var _ ObjectWithDomain = &ObjectBundle{}
+
+const (
+	// BundleTypeID is the type ID of the object bundles, the highest type ID is reserved for them
+	BundleTypeID TypeID = math.MaxUint64
+	// MaxBundleSize is the maximum number of objects in a bundle
+	MaxBundleSize = 20
+)
+
+// BundleCustomData is the custom data given to the methods of an ObjectBundle, it returns the custom data
+// registered for the type of each object of the bundle
+type BundleCustomData func(TypeID) CustomData
+
+// NewObjectBundle creates a bundle of the given objects
+func NewObjectBundle(objects ...TransferableObject) (*ObjectBundle, error) {
+	bundle := &ObjectBundle{Objects: make([]*codectypes.Any, len(objects))}
+	for i, obj := range objects {
+		objectAny, err := codectypes.NewAnyWithValue(obj)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Objects[i] = objectAny
+	}
+	return bundle, bundle.ValidateBasic()
+}
+
+// UnpackInterfaces make sure the Anys included in ObjectBundle are unpacked (e.g the objects field)
+func (m *ObjectBundle) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, objectAny := range m.Objects {
+		var obj TransferableObject
+		if err := unpacker.UnpackAny(objectAny, &obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetObjects returns the objects of the bundle
+func (m *ObjectBundle) GetObjects() []TransferableObject {
+	objects := make([]TransferableObject, len(m.Objects))
+	for i, objectAny := range m.Objects {
+		objects[i] = objectAny.GetCachedValue().(TransferableObject)
+	}
+	return objects
+}
+
+// ValidateBasic checks that the bundle holds between one and MaxBundleSize distinct objects, which are not bundles and
+// whose transfer dependencies do not form a cycle
+func (m *ObjectBundle) ValidateBasic() error {
+	if len(m.Objects) == 0 || len(m.Objects) > MaxBundleSize {
+		return sdkerrors.Wrapf(ErrInvalidBundle, "a bundle must hold between 1 and %d objects", MaxBundleSize)
+	}
+	keys := make(map[string]struct{}, len(m.Objects))
+	for i, objectAny := range m.Objects {
+		obj, ok := objectAny.GetCachedValue().(TransferableObject)
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalidBundle, "the object %d of the bundle is not a TransferableObject", i)
+		}
+		if obj.GetObjectTypeID() == BundleTypeID {
+			return sdkerrors.Wrap(ErrInvalidBundle, "bundles cannot be nested")
+		}
+		key := string(GetEscrowObjectKey(obj))
+		if _, found := keys[key]; found {
+			return sdkerrors.Wrapf(ErrInvalidBundle, "the object %d of the bundle is duplicated", i)
+		}
+		keys[key] = struct{}{}
+	}
+	if _, err := transferOrder(m.GetObjects()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetObjectTypeID implements TransferableObject
+func (m *ObjectBundle) GetObjectTypeID() TypeID {
+	return BundleTypeID
+}
+
+// GetUniqueKey implements TransferableObject, the key is the hash of the keys of the objects of the bundle
+func (m *ObjectBundle) GetUniqueKey() []byte {
+	hash := sha256.New()
+	for _, obj := range m.GetObjects() {
+		key := GetEscrowObjectKey(obj)
+		hash.Write(sdk.Uint64ToBigEndian(uint64(len(key))))
+		hash.Write(key)
+	}
+	return hash.Sum(nil)
+}
+
+// IsOwnedBy implements TransferableObject, a bundle is owned by an account if it owns all the objects of the bundle
+func (m *ObjectBundle) IsOwnedBy(account sdk.AccAddress) (bool, error) {
+	if err := m.ValidateBasic(); err != nil {
+		return false, err
+	}
+	for _, obj := range m.GetObjects() {
+		owned, err := obj.IsOwnedBy(account)
+		if err != nil || !owned {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Transfer implements TransferableObject, it transfers all the objects of the bundle or none of them. The objects are
+// transferred in the bundle order, except that an object is always transferred before the objects depending on it.
+func (m *ObjectBundle) Transfer(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, data CustomData) error {
+	getData := extractBundleCustomData(data)
+	cacheCtx, write := ctx.CacheContext()
+	objects := m.GetObjects()
+	order, err := transferOrder(objects)
+	if err != nil {
+		return err
+	}
+	for _, i := range order {
+		obj := objects[i]
+		if err := obj.Transfer(cacheCtx, from, to, getData(obj.GetObjectTypeID())); err != nil {
+			return sdkerrors.Wrapf(err, "cannot transfer the object %d of the bundle", i)
+		}
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// The objects may have been updated by their transfer, pack them again
+	for i, obj := range objects {
+		objectAny, err := codectypes.NewAnyWithValue(obj)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "cannot sync the bundle objects with their cached value"))
+		}
+		m.Objects[i] = objectAny
+	}
+	return nil
+}
+
+// UpdateObjects applies an in place update to the objects of the bundle, the update returning true if it modified the
+// object, and packs the modified objects again. It returns true if an object was modified.
+func (m *ObjectBundle) UpdateObjects(update func(TransferableObject) bool) bool {
+	updated := false
+	for i, obj := range m.GetObjects() {
+		if !update(obj) {
+			continue
+		}
+		objectAny, err := codectypes.NewAnyWithValue(obj)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "cannot sync the bundle objects with their cached value"))
+		}
+		m.Objects[i] = objectAny
+		updated = true
+	}
+	return updated
+}
+
+// RecordSale implements ObjectWithSaleRecord, the price of the bundle is split evenly among its objects and the objects
+// keeping a record of their sales record their share of the price
+func (m *ObjectBundle) RecordSale(ctx sdk.Context, seller, buyer sdk.AccAddress, price sdk.Coins, data CustomData) {
+	getData := extractBundleCustomData(data)
+	objects := m.GetObjects()
+	shares := splitPrice(price, len(objects))
+	for i, obj := range objects {
+		if recorder, ok := obj.(ObjectWithSaleRecord); ok {
+			recorder.RecordSale(ctx, seller, buyer, shares[i], getData(obj.GetObjectTypeID()))
+		}
+	}
+}
+
+// GetRoyalties implements ObjectWithRoyalties. The share of the price of each object is unknown, so the price could
+// be put on the objects without royalties: each recipient is paid the highest rate of its royalties on the whole price,
+// as if the bundle was an unbundled sale of its object with that rate.
+func (m *ObjectBundle) GetRoyalties(ctx sdk.Context, data CustomData) []Royalty {
+	getData := extractBundleCustomData(data)
+	var royalties []Royalty
+	indexes := make(map[string]int)
+	for _, obj := range m.GetObjects() {
+		payer, ok := obj.(ObjectWithRoyalties)
+		if !ok {
+			continue
+		}
+		for _, royalty := range payer.GetRoyalties(ctx, getData(obj.GetObjectTypeID())) {
+			i, found := indexes[royalty.Recipient]
+			if !found {
+				indexes[royalty.Recipient] = len(royalties)
+				royalties = append(royalties, royalty)
+			} else if royalty.Rate.GT(royalties[i].Rate) {
+				royalties[i].Rate = royalty.Rate
+			}
+		}
+	}
+	return royalties
+}
+
+// GetDomain implements ObjectWithDomain, a bundle belongs to a domain if all its objects belong to that domain
+func (m *ObjectBundle) GetDomain() string {
+	domain := ""
+	for i, obj := range m.GetObjects() {
+		objDomain := GetObjectDomain(obj)
+		if objDomain == "" || (i > 0 && objDomain != domain) {
+			return ""
+		}
+		domain = objDomain
+	}
+	return domain
+}
+
+// ValidateDeadline implements ObjectWithTimeConstraint, the deadline must be validated by all the objects of the bundle.
+// An object that must be transferred before another object of the bundle is traded along with it, so it only checks
+// the deadline against itself and the object it depends on does the checks against the state.
+func (m *ObjectBundle) ValidateDeadline(ctx sdk.Context, deadline uint64, data CustomData) error {
+	getData := extractBundleCustomData(data)
+	objects := m.GetObjects()
+	for i, obj := range objects {
+		if hasDependency(objects, i) {
+			if err := ValidateObjectDeadlineBasic(obj, deadline); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := ValidateObjectDeadline(ctx, obj, deadline, getData(obj.GetObjectTypeID())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateDeadlineBasic implements ObjectWithTimeConstraint
+func (m *ObjectBundle) ValidateDeadlineBasic(deadline uint64) error {
+	for _, obj := range m.GetObjects() {
+		if err := ValidateObjectDeadlineBasic(obj, deadline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mustTransferBefore returns true if the object a must be transferred before the object b
+func mustTransferBefore(a, b TransferableObject) bool {
+	dependent, ok := a.(ObjectWithTransferDependency)
+	return ok && dependent.MustTransferBefore(b)
+}
+
+// hasDependency returns true if the object i must be transferred before another object of the given objects
+func hasDependency(objects []TransferableObject, i int) bool {
+	for j, obj := range objects {
+		if j != i && mustTransferBefore(objects[i], obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// transferOrder returns the indexes of the given objects in the order of their transfer: the first object not
+// waiting for the transfer of another object goes next, so the order of the objects is kept when they do not depend
+// on each other
+func transferOrder(objects []TransferableObject) ([]int, error) {
+	order := make([]int, 0, len(objects))
+	transferred := make([]bool, len(objects))
+	for len(order) < len(objects) {
+		next := -1
+		for i := range objects {
+			if transferred[i] {
+				continue
+			}
+			waiting := false
+			for j := range objects {
+				if !transferred[j] && j != i && mustTransferBefore(objects[j], objects[i]) {
+					waiting = true
+					break
+				}
+			}
+			if !waiting {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, sdkerrors.Wrap(ErrInvalidBundle, "the objects of the bundle depend on each other")
+		}
+		transferred[next] = true
+		order = append(order, next)
+	}
+	return order, nil
+}
+
+// splitPrice splits the price in n shares, the remainder of the division going to the first share
+func splitPrice(price sdk.Coins, n int) []sdk.Coins {
+	shares := make([]sdk.Coins, n)
+	for i := range shares {
+		shares[i] = sdk.NewCoins()
+	}
+	for _, coin := range price {
+		share := coin.Amount.QuoRaw(int64(n))
+		remainder := coin.Amount.Sub(share.MulRaw(int64(n)))
+		for i := range shares {
+			amount := share
+			if i == 0 {
+				amount = amount.Add(remainder)
+			}
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return shares
+}
+
+// getObjectIndexKeys returns the keys under which an object is indexed: its unique key and, for a bundle, the unique
+// keys of the objects of the bundle so that they can be looked up individually
+func getObjectIndexKeys(obj TransferableObject) [][]byte {
+	keys := [][]byte{obj.GetUniqueKey()}
+	if bundle, ok := obj.(*ObjectBundle); ok {
+		for _, o := range bundle.GetObjects() {
+			keys = append(keys, o.GetUniqueKey())
+		}
+	}
+	return keys
+}
+
+// Extracts the BundleCustomData from a CustomData object
+func extractBundleCustomData(data CustomData) BundleCustomData {
+	getData, ok := data.(BundleCustomData)
+	if !ok {
+		panic("Corrupted custom data: the data of a bundle should be a BundleCustomData")
+	}
+	return getData
+}
@@ -15,8 +15,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateEscrow{}, fmt.Sprintf("%s/UpdateEscrow", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgTransferToEscrow{}, fmt.Sprintf("%s/TransferToEscrow", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgRefundEscrow{}, fmt.Sprintf("%s/RefundEscrow", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCreateOffer{}, fmt.Sprintf("%s/CreateOffer", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgAcceptOffer{}, fmt.Sprintf("%s/AcceptOffer", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgWithdrawOffer{}, fmt.Sprintf("%s/WithdrawOffer", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCreateSwap{}, fmt.Sprintf("%s/CreateSwap", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCompleteSwap{}, fmt.Sprintf("%s/CompleteSwap", ModuleName), nil)
 
 	cdc.RegisterInterface((*TransferableObject)(nil), nil)
+	cdc.RegisterConcrete(&ObjectBundle{}, fmt.Sprintf("%s/ObjectBundle", ModuleName), nil)
 }
 
 // RegisterInterfaces registers implementations for the protobuf marshaler.
@@ -27,6 +33,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateEscrow{},
 		&MsgTransferToEscrow{},
 		&MsgRefundEscrow{},
+		&MsgCreateOffer{},
+		&MsgAcceptOffer{},
+		&MsgWithdrawOffer{},
+		&MsgCreateSwap{},
+		&MsgCompleteSwap{},
+	)
+	// Register the object bundle so that any TransferableObject can be sold in a bundle
+	registry.RegisterImplementations(
+		(*TransferableObject)(nil),
+		&ObjectBundle{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExponentialDecayHalvings is the number of times the difference between the start price and the floor price of an
+// exponentially declining escrow is halved between its start time and its deadline
+const ExponentialDecayHalvings = 10
+
+// IsDutch returns true if the price of the escrow declines over time
+func (e *Escrow) IsDutch() bool {
+	return e.PriceDecay != PriceDecay_None
+}
+
+// CurrentPrice returns the price of the escrow at the given time. The price of a dutch escrow declines from its price
+// at its start time to its floor price at its deadline, it is rounded up so that it never goes below the floor price.
+func (e *Escrow) CurrentPrice(now uint64) sdk.Coins {
+	if !e.IsDutch() || now <= e.StartTime {
+		return e.Price
+	}
+	if now >= e.Deadline {
+		return e.FloorPrice
+	}
+
+	remaining := decayFactor(e.PriceDecay, now-e.StartTime, e.Deadline-e.StartTime)
+	price := sdk.NewCoins()
+	for _, coin := range e.Price {
+		floor := e.FloorPrice.AmountOf(coin.Denom)
+		spread := coin.Amount.Sub(floor).ToDec().Mul(remaining).Ceil().TruncateInt()
+		price = price.Add(sdk.NewCoin(coin.Denom, floor.Add(spread)))
+	}
+	return price
+}
+
+// decayFactor returns the fraction, between 0 and 1, of the difference between the start price and the floor price
+// which remains after elapsed seconds out of duration
+func decayFactor(decay PriceDecay, elapsed, duration uint64) sdk.Dec {
+	progress := sdk.NewDec(int64(elapsed)).QuoInt64(int64(duration))
+	switch decay {
+	case PriceDecay_Linear:
+		return sdk.OneDec().Sub(progress)
+	case PriceDecay_Exponential:
+		// 2^-x with x = progress * ExponentialDecayHalvings, interpolated linearly between two halvings and
+		// rescaled so that it reaches 0 at the deadline
+		x := progress.MulInt64(ExponentialDecayHalvings)
+		halvings := x.TruncateInt64()
+		halved := sdk.OneDec().QuoInt64(1 << halvings)
+		factor := halved.Sub(halved.Mul(x.Sub(sdk.NewDec(halvings))).QuoInt64(2))
+		last := sdk.OneDec().QuoInt64(1 << ExponentialDecayHalvings)
+		return factor.Sub(last).Quo(sdk.OneDec().Sub(last))
+	default:
+		return sdk.OneDec()
+	}
+}
@@ -18,4 +18,10 @@ var (
 	ErrInvalidCommissionRate = sdkerrors.Register(ModuleName, 13, "The broker commission must be a number between 0 and 1")
 	ErrInvalidPrice          = sdkerrors.Register(ModuleName, 14, "The price is invalid")
 	ErrInvalidDeadline       = sdkerrors.Register(ModuleName, 15, "The deadline is invalid")
+	ErrOfferNotFound         = sdkerrors.Register(ModuleName, 16, "This offer does not exists")
+	ErrOfferExpired          = sdkerrors.Register(ModuleName, 17, "This offer is expired")
+	ErrInvalidBundle         = sdkerrors.Register(ModuleName, 18, "The object bundle is invalid")
+	ErrInvalidSwap           = sdkerrors.Register(ModuleName, 19, "The swap is invalid")
+	ErrInvalidBroker         = sdkerrors.Register(ModuleName, 20, "The broker is invalid")
+	ErrInvalidRecord         = sdkerrors.Register(ModuleName, 21, "The escrow record is invalid")
 )
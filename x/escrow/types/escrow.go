@@ -20,6 +20,11 @@ const (
 	// ObjectIndex represents the object as a secondary key of an escrow
 	// The object is represented by its primary key
 	ObjectIndex = 0x03
+	// WantedObjectIndex represents the object wanted in exchange by a swap escrow as a secondary key of an escrow
+	// The object is represented by its primary key
+	WantedObjectIndex = 0x04
+	// BuyerIndex represents the designated buyer of a private sale as a secondary key of an escrow
+	BuyerIndex = 0x05
 )
 
 // NewEscrow constructs a new escrow instance
@@ -37,14 +42,15 @@ func NewEscrow(
 		panic(err)
 	}
 	return Escrow{
-		Id:               id,
-		Seller:           seller.String(),
-		Object:           objectAny,
-		Price:            price,
-		State:            EscrowState_Open,
-		Deadline:         deadline,
-		BrokerAddress:    brokerAddress,
-		BrokerCommission: brokerCommission,
+		Id:                 id,
+		Seller:             seller.String(),
+		Object:             objectAny,
+		Price:              price,
+		State:              EscrowState_Open,
+		Deadline:           deadline,
+		BrokerAddress:      brokerAddress,
+		BrokerCommission:   brokerCommission,
+		SellingBrokerShare: sdk.ZeroDec(),
 	}
 }
 
@@ -59,7 +65,7 @@ func (e Escrow) SecondaryKeys() []crud.SecondaryKey {
 	if len(e.Id) == 0 {
 		return make([]crud.SecondaryKey, 0)
 	}
-	sks := make([]crud.SecondaryKey, 3)
+	sks := make([]crud.SecondaryKey, 2, 3)
 	sks[0] = crud.SecondaryKey{
 		ID:    StateIndex,
 		Value: sdk.Uint64ToBigEndian(uint64(e.State)),
@@ -71,9 +77,25 @@ func (e Escrow) SecondaryKeys() []crud.SecondaryKey {
 			Value: seller,
 		}
 	}
-	sks[2] = crud.SecondaryKey{
-		ID:    ObjectIndex,
-		Value: e.GetObject().GetUniqueKey(),
+	for _, key := range getObjectIndexKeys(e.GetObject()) {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    ObjectIndex,
+			Value: key,
+		})
+	}
+	if e.IsSwap() {
+		for _, key := range getObjectIndexKeys(e.GetWantedObject()) {
+			sks = append(sks, crud.SecondaryKey{
+				ID:    WantedObjectIndex,
+				Value: key,
+			})
+		}
+	}
+	if buyer, err := sdk.AccAddressFromBech32(e.Buyer); err == nil {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    BuyerIndex,
+			Value: buyer,
+		})
 	}
 	return sks
 }
@@ -82,7 +104,13 @@ func (e Escrow) SecondaryKeys() []crud.SecondaryKey {
 func (e *Escrow) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	if e.Object != nil {
 		var obj TransferableObject
-		return unpacker.UnpackAny(e.Object, &obj)
+		if err := unpacker.UnpackAny(e.Object, &obj); err != nil {
+			return err
+		}
+	}
+	if e.WantedObject != nil {
+		var obj TransferableObject
+		return unpacker.UnpackAny(e.WantedObject, &obj)
 	}
 
 	return nil
@@ -101,7 +129,7 @@ func (e Escrow) ValidateWithoutDeadlineAndObject(priceDenom string) error {
 	}
 
 	// Validate price
-	if err := ValidatePrice(e.Price, priceDenom); err != nil {
+	if err := e.ValidatePrice(priceDenom); err != nil {
 		return err
 	}
 
@@ -116,10 +144,37 @@ func (e Escrow) ValidateWithoutDeadlineAndObject(priceDenom string) error {
 		return err
 	}
 
+	// Validate selling broker share
+	if err := ValidateSellingBrokerShare(e.GetSellingBrokerShare()); err != nil {
+		return err
+	}
+
+	// Validate the designated buyer, if any
+	if err := ValidateDesignatedBuyer(e.Buyer, e.Seller); err != nil {
+		return err
+	}
+
 	// Validate state
 	return ValidateState(e.State)
 }
 
+// ValidatePrice validates the price of the escrow, which can be empty for a swap escrow, and its floor price.
+// If priceDenom is empty, does not validate the price denomination
+func (e Escrow) ValidatePrice(priceDenom string) error {
+	if e.IsSwap() {
+		if e.IsDutch() {
+			return sdkerrors.Wrap(ErrInvalidSwap, "The price of a swap escrow cannot decline")
+		}
+		if e.Price.Empty() {
+			return nil
+		}
+	}
+	if err := ValidatePrice(e.Price, priceDenom); err != nil {
+		return err
+	}
+	return ValidatePriceDecay(e.PriceDecay, e.Price, e.FloorPrice, priceDenom)
+}
+
 // Validate validates the escrow, if priceDenom is empty, does not validate the price denomination
 func (e Escrow) Validate(priceDenom string, lastBlockTime uint64) error {
 	// Validate all fields expect deadline and object
@@ -139,6 +194,12 @@ func (e Escrow) Validate(priceDenom string, lastBlockTime uint64) error {
 		return err
 	}
 
+	if e.IsSwap() {
+		if err := ValidateWantedObject(e.GetObject(), e.GetWantedObject(), seller); err != nil {
+			return err
+		}
+	}
+
 	// Validate deadline
 	return ValidateDeadline(e.Deadline, lastBlockTime)
 }
@@ -158,6 +219,33 @@ func (e *Escrow) GetObject() TransferableObject {
 	return e.Object.GetCachedValue().(TransferableObject)
 }
 
+// GetSellingBrokerShare returns the share of the broker commission paid to the selling broker, zero if it is not set
+func (e *Escrow) GetSellingBrokerShare() sdk.Dec {
+	if e.SellingBrokerShare.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return e.SellingBrokerShare
+}
+
+// IsDesignatedBuyer returns true if the given account can complete the escrow: the escrow is not restricted to a
+// designated buyer or the account is the designated buyer
+func (e *Escrow) IsDesignatedBuyer(account sdk.AccAddress) bool {
+	return len(e.Buyer) == 0 || e.Buyer == account.String()
+}
+
+// IsSwap returns true if the escrow exchanges its object for another object
+func (e *Escrow) IsSwap() bool {
+	return e.WantedObject != nil
+}
+
+// GetWantedObject returns the object wanted in exchange by a swap escrow, or nil if the escrow is not a swap
+func (e *Escrow) GetWantedObject() TransferableObject {
+	if e.WantedObject == nil {
+		return nil
+	}
+	return e.WantedObject.GetCachedValue().(TransferableObject)
+}
+
 func (e *Escrow) SyncObject() {
 	any, err := codectypes.NewAnyWithValue(e.GetObject())
 	if err != nil {
@@ -29,15 +29,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // EventCreatedEscrow is emitted when an escrow is created
 type EventCreatedEscrow struct {
-	Id               string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Seller           string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	FeePayer         string                                   `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
-	BrokerAddress    string                                   `protobuf:"bytes,4,opt,name=broker_address,json=brokerAddress,proto3" json:"broker_address,omitempty"`
-	BrokerCommission github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=broker_commission,json=brokerCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"broker_commission"`
-	Price            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
-	Object           *types1.Any                              `protobuf:"bytes,7,opt,name=object,proto3" json:"object,omitempty"`
-	Deadline         uint64                                   `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Fees             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	Id                 string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller             string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	FeePayer           string                                   `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	BrokerAddress      string                                   `protobuf:"bytes,4,opt,name=broker_address,json=brokerAddress,proto3" json:"broker_address,omitempty"`
+	BrokerCommission   github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=broker_commission,json=brokerCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"broker_commission"`
+	Price              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Object             *types1.Any                              `protobuf:"bytes,7,opt,name=object,proto3" json:"object,omitempty"`
+	Deadline           uint64                                   `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Fees               github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	PriceDecay         PriceDecay                               `protobuf:"varint,10,opt,name=price_decay,json=priceDecay,proto3,enum=starnamed.x.escrow.v1beta1.PriceDecay" json:"price_decay,omitempty"`
+	FloorPrice         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=floor_price,json=floorPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"floor_price"`
+	SellingBrokerShare github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,12,opt,name=selling_broker_share,json=sellingBrokerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"selling_broker_share"`
+	Buyer              string                                   `protobuf:"bytes,13,opt,name=buyer,proto3" json:"buyer,omitempty"`
 }
 
 func (m *EventCreatedEscrow) Reset()         { *m = EventCreatedEscrow{} }
@@ -75,13 +79,15 @@ var xxx_messageInfo_EventCreatedEscrow proto.InternalMessageInfo
 
 // EventUpdatedEscrow is emitted when an escrow is updated
 type EventUpdatedEscrow struct {
-	Id          string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Updater     string                                   `protobuf:"bytes,2,opt,name=updater,proto3" json:"updater,omitempty"`
-	FeePayer    string                                   `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
-	NewSeller   string                                   `protobuf:"bytes,4,opt,name=new_seller,json=newSeller,proto3" json:"new_seller,omitempty"`
-	NewPrice    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=new_price,json=newPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"new_price"`
-	NewDeadline uint64                                   `protobuf:"varint,6,opt,name=new_deadline,json=newDeadline,proto3" json:"new_deadline,omitempty"`
-	Fees        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	Id           string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Updater      string                                   `protobuf:"bytes,2,opt,name=updater,proto3" json:"updater,omitempty"`
+	FeePayer     string                                   `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	NewSeller    string                                   `protobuf:"bytes,4,opt,name=new_seller,json=newSeller,proto3" json:"new_seller,omitempty"`
+	NewPrice     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=new_price,json=newPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"new_price"`
+	NewDeadline  uint64                                   `protobuf:"varint,6,opt,name=new_deadline,json=newDeadline,proto3" json:"new_deadline,omitempty"`
+	Fees         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	NewBuyer     string                                   `protobuf:"bytes,8,opt,name=new_buyer,json=newBuyer,proto3" json:"new_buyer,omitempty"`
+	BuyerCleared bool                                     `protobuf:"varint,9,opt,name=buyer_cleared,json=buyerCleared,proto3" json:"buyer_cleared,omitempty"`
 }
 
 func (m *EventUpdatedEscrow) Reset()         { *m = EventUpdatedEscrow{} }
@@ -123,6 +129,12 @@ type EventCompletedEscrow struct {
 	FeePayer string                                   `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
 	Buyer    string                                   `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	Fees     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// price is the price paid by the buyer
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// royalties are the parts of the price paid to royalty recipients
+	Royalties []Royalty `protobuf:"bytes,6,rep,name=royalties,proto3" json:"royalties"`
+	// selling_broker is the broker which completed the sale, if any
+	SellingBroker string `protobuf:"bytes,7,opt,name=selling_broker,json=sellingBroker,proto3" json:"selling_broker,omitempty"`
 }
 
 func (m *EventCompletedEscrow) Reset()         { *m = EventCompletedEscrow{} }
@@ -199,55 +211,292 @@ func (m *EventRefundedEscrow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventRefundedEscrow proto.InternalMessageInfo
 
+// EventCreatedOffer is emitted when an offer is created
+type EventCreatedOffer struct {
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer    string                                   `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	FeePayer string                                   `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Object   *types1.Any                              `protobuf:"bytes,5,opt,name=object,proto3" json:"object,omitempty"`
+	Deadline uint64                                   `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Fees     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventCreatedOffer) Reset()         { *m = EventCreatedOffer{} }
+func (m *EventCreatedOffer) String() string { return proto.CompactTextString(m) }
+func (*EventCreatedOffer) ProtoMessage()    {}
+func (*EventCreatedOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4a85b720b7804c, []int{4}
+}
+func (m *EventCreatedOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatedOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatedOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreatedOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatedOffer.Merge(m, src)
+}
+func (m *EventCreatedOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatedOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatedOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatedOffer proto.InternalMessageInfo
+
+// EventAcceptedOffer is emitted when an offer is accepted
+type EventAcceptedOffer struct {
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeePayer string                                   `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Seller   string                                   `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Fees     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventAcceptedOffer) Reset()         { *m = EventAcceptedOffer{} }
+func (m *EventAcceptedOffer) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptedOffer) ProtoMessage()    {}
+func (*EventAcceptedOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4a85b720b7804c, []int{5}
+}
+func (m *EventAcceptedOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptedOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptedOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAcceptedOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptedOffer.Merge(m, src)
+}
+func (m *EventAcceptedOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptedOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptedOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAcceptedOffer proto.InternalMessageInfo
+
+// EventWithdrawnOffer is emitted when an offer is withdrawn
+type EventWithdrawnOffer struct {
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeePayer string                                   `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Buyer    string                                   `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Fees     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventWithdrawnOffer) Reset()         { *m = EventWithdrawnOffer{} }
+func (m *EventWithdrawnOffer) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawnOffer) ProtoMessage()    {}
+func (*EventWithdrawnOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4a85b720b7804c, []int{6}
+}
+func (m *EventWithdrawnOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawnOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawnOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawnOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawnOffer.Merge(m, src)
+}
+func (m *EventWithdrawnOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawnOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawnOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawnOffer proto.InternalMessageInfo
+
+// EventCreatedSwap is emitted when a swap escrow is created
+type EventCreatedSwap struct {
+	Id           string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller       string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	FeePayer     string                                   `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Object       *types1.Any                              `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`
+	WantedObject *types1.Any                              `protobuf:"bytes,5,opt,name=wanted_object,json=wantedObject,proto3" json:"wanted_object,omitempty"`
+	Price        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Deadline     uint64                                   `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Fees         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventCreatedSwap) Reset()         { *m = EventCreatedSwap{} }
+func (m *EventCreatedSwap) String() string { return proto.CompactTextString(m) }
+func (*EventCreatedSwap) ProtoMessage()    {}
+func (*EventCreatedSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4a85b720b7804c, []int{7}
+}
+func (m *EventCreatedSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatedSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatedSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreatedSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatedSwap.Merge(m, src)
+}
+func (m *EventCreatedSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatedSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatedSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatedSwap proto.InternalMessageInfo
+
+// EventCompletedSwap is emitted when a swap escrow is completed
+type EventCompletedSwap struct {
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeePayer string                                   `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Buyer    string                                   `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Fees     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventCompletedSwap) Reset()         { *m = EventCompletedSwap{} }
+func (m *EventCompletedSwap) String() string { return proto.CompactTextString(m) }
+func (*EventCompletedSwap) ProtoMessage()    {}
+func (*EventCompletedSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4a85b720b7804c, []int{8}
+}
+func (m *EventCompletedSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCompletedSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCompletedSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCompletedSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCompletedSwap.Merge(m, src)
+}
+func (m *EventCompletedSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCompletedSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCompletedSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCompletedSwap proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventCreatedEscrow)(nil), "starnamed.x.escrow.v1beta1.EventCreatedEscrow")
 	proto.RegisterType((*EventUpdatedEscrow)(nil), "starnamed.x.escrow.v1beta1.EventUpdatedEscrow")
 	proto.RegisterType((*EventCompletedEscrow)(nil), "starnamed.x.escrow.v1beta1.EventCompletedEscrow")
 	proto.RegisterType((*EventRefundedEscrow)(nil), "starnamed.x.escrow.v1beta1.EventRefundedEscrow")
+	proto.RegisterType((*EventCreatedOffer)(nil), "starnamed.x.escrow.v1beta1.EventCreatedOffer")
+	proto.RegisterType((*EventAcceptedOffer)(nil), "starnamed.x.escrow.v1beta1.EventAcceptedOffer")
+	proto.RegisterType((*EventWithdrawnOffer)(nil), "starnamed.x.escrow.v1beta1.EventWithdrawnOffer")
+	proto.RegisterType((*EventCreatedSwap)(nil), "starnamed.x.escrow.v1beta1.EventCreatedSwap")
+	proto.RegisterType((*EventCompletedSwap)(nil), "starnamed.x.escrow.v1beta1.EventCompletedSwap")
 }
 
 func init() { proto.RegisterFile("iov/escrow/v1beta1/events.proto", fileDescriptor_ed4a85b720b7804c) }
 
 var fileDescriptor_ed4a85b720b7804c = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8e, 0xd3, 0x24, 0x4d, 0xb6, 0xff, 0x5f, 0xc1, 0x12, 0x55, 0x6e, 0x10, 0x4e, 0xa8, 0x04,
-	0x8a, 0x84, 0xba, 0xa6, 0xe5, 0x09, 0x9a, 0xb6, 0x07, 0x4e, 0x54, 0x01, 0x2e, 0x70, 0x88, 0xd6,
-	0xde, 0x71, 0x6a, 0x6a, 0xef, 0x5a, 0xbb, 0x4e, 0xd2, 0x9c, 0x79, 0x01, 0x9e, 0x83, 0x33, 0x27,
-	0x78, 0x81, 0x8a, 0x53, 0x8f, 0x88, 0x43, 0x81, 0xf6, 0xc2, 0x63, 0x20, 0xef, 0xda, 0x06, 0x2a,
-	0x51, 0x21, 0xd4, 0x9c, 0xec, 0x6f, 0x76, 0x66, 0xf6, 0xdb, 0x6f, 0xbe, 0x5d, 0xd4, 0x0d, 0xc5,
-	0xd4, 0x05, 0xe5, 0x4b, 0x31, 0x73, 0xa7, 0x5b, 0x1e, 0xa4, 0x74, 0xcb, 0x85, 0x29, 0xf0, 0x54,
-	0x91, 0x44, 0x8a, 0x54, 0xe0, 0x8e, 0x4a, 0xa9, 0xe4, 0x34, 0x06, 0x46, 0x8e, 0x89, 0x49, 0x24,
-	0x79, 0x62, 0xc7, 0xf1, 0x85, 0x8a, 0x85, 0x72, 0x3d, 0xaa, 0xa0, 0xac, 0xf6, 0x45, 0xc8, 0x4d,
-	0x6d, 0xa7, 0x3d, 0x16, 0x63, 0xa1, 0x7f, 0xdd, 0xec, 0x2f, 0x8f, 0xae, 0x8f, 0x85, 0x18, 0x47,
-	0xe0, 0x6a, 0xe4, 0x4d, 0x02, 0x97, 0xf2, 0x79, 0xb1, 0x64, 0x1a, 0x8e, 0x4c, 0x8d, 0x01, 0x66,
-	0x69, 0xe3, 0x75, 0x0d, 0xe1, 0xfd, 0x8c, 0xd8, 0xae, 0x04, 0x9a, 0x02, 0xdb, 0xd7, 0x54, 0xf0,
-	0x2a, 0xaa, 0x86, 0xcc, 0xb6, 0x7a, 0x56, 0xbf, 0x35, 0xac, 0x86, 0x0c, 0xaf, 0xa1, 0x86, 0x82,
-	0x28, 0x02, 0x69, 0x57, 0x75, 0x2c, 0x47, 0xf8, 0x36, 0x6a, 0x05, 0x00, 0xa3, 0x84, 0xce, 0x41,
-	0xda, 0x4b, 0x7a, 0xa9, 0x19, 0x00, 0x1c, 0x64, 0x18, 0xdf, 0x43, 0xab, 0x9e, 0x14, 0x47, 0x20,
-	0x47, 0x94, 0x31, 0x09, 0x4a, 0xd9, 0x35, 0x9d, 0xf1, 0xbf, 0x89, 0xee, 0x98, 0x20, 0x7e, 0x89,
-	0x6e, 0xe6, 0x69, 0xbe, 0x88, 0xe3, 0x50, 0xa9, 0x50, 0x70, 0xbb, 0x9e, 0x65, 0x0e, 0xc8, 0xc9,
-	0x59, 0xb7, 0xf2, 0xf9, 0xac, 0x7b, 0x7f, 0x1c, 0xa6, 0x87, 0x13, 0x8f, 0xf8, 0x22, 0xce, 0xe9,
-	0xe7, 0x9f, 0x4d, 0xc5, 0x8e, 0xdc, 0x74, 0x9e, 0x80, 0x22, 0x7b, 0xe0, 0x0f, 0x6f, 0x98, 0x46,
-	0xbb, 0x65, 0x1f, 0x4c, 0x51, 0x3d, 0x91, 0xa1, 0x0f, 0x76, 0xa3, 0xb7, 0xd4, 0x5f, 0xd9, 0x5e,
-	0x27, 0xf9, 0xe9, 0x33, 0x6d, 0x0b, 0xc1, 0xc9, 0xae, 0x08, 0xf9, 0xe0, 0x61, 0xb6, 0xd7, 0xdb,
-	0x2f, 0xdd, 0xfe, 0x5f, 0xec, 0x95, 0x15, 0xa8, 0xa1, 0xe9, 0x8c, 0xf7, 0x50, 0x43, 0x78, 0xaf,
-	0xc0, 0x4f, 0xed, 0xe5, 0x9e, 0xd5, 0x5f, 0xd9, 0x6e, 0x13, 0x33, 0x09, 0x52, 0x4c, 0x82, 0xec,
-	0xf0, 0xf9, 0x60, 0xed, 0xe3, 0xbb, 0x4d, 0xfc, 0x4c, 0x52, 0xae, 0x02, 0x90, 0xd4, 0x8b, 0xe0,
-	0x89, 0xae, 0x19, 0xe6, 0xb5, 0xb8, 0x83, 0x9a, 0x0c, 0x28, 0x8b, 0x42, 0x0e, 0x76, 0xb3, 0x67,
-	0xf5, 0x6b, 0xc3, 0x12, 0xe3, 0x11, 0xaa, 0x05, 0x00, 0xca, 0x6e, 0x5d, 0xff, 0x19, 0x74, 0xe3,
-	0x8d, 0xef, 0xd5, 0xdc, 0x05, 0xcf, 0x13, 0x76, 0x85, 0x0b, 0x6c, 0xb4, 0x3c, 0xd1, 0x09, 0x85,
-	0x0d, 0x0a, 0x78, 0xb5, 0x0f, 0xee, 0x20, 0xc4, 0x61, 0x36, 0xca, 0x0d, 0x64, 0x3c, 0xd0, 0xe2,
-	0x30, 0x7b, 0x6a, 0x3c, 0x74, 0x88, 0x32, 0x30, 0x32, 0x63, 0xaa, 0x5f, 0xff, 0x11, 0x9b, 0x1c,
-	0x66, 0x07, 0x7a, 0x52, 0x77, 0xd1, 0x7f, 0xd9, 0x4e, 0xa5, 0xce, 0x0d, 0xad, 0xf3, 0x0a, 0x87,
-	0xd9, 0xde, 0x65, 0xa9, 0x97, 0x17, 0x25, 0xf5, 0x7b, 0x0b, 0xb5, 0xcd, 0x85, 0x13, 0x71, 0x12,
-	0xc1, 0x9f, 0xc5, 0xfe, 0x4d, 0xd2, 0xea, 0x25, 0x49, 0xdb, 0xa8, 0xee, 0x4d, 0x7e, 0x6a, 0x6d,
-	0x40, 0x49, 0xbe, 0xb6, 0x28, 0xf2, 0x1f, 0x2c, 0x74, 0x4b, 0x93, 0x1f, 0x42, 0x30, 0xe1, 0xec,
-	0xdf, 0xb8, 0xeb, 0xb7, 0x84, 0xb3, 0x92, 0x7c, 0x8e, 0x16, 0xce, 0x7e, 0xf0, 0xf8, 0xe4, 0x9b,
-	0x53, 0x39, 0x39, 0x77, 0xac, 0xd3, 0x73, 0xc7, 0xfa, 0x7a, 0xee, 0x58, 0x6f, 0x2e, 0x9c, 0xca,
-	0xe9, 0x85, 0x53, 0xf9, 0x74, 0xe1, 0x54, 0x5e, 0x3c, 0xf8, 0xa5, 0x5b, 0x28, 0xa6, 0x9b, 0x82,
-	0x83, 0x5b, 0x3e, 0xd2, 0xee, 0x71, 0xf1, 0x9a, 0xeb, 0xb6, 0x5e, 0x43, 0xdf, 0xed, 0x47, 0x3f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x15, 0xba, 0x52, 0xa5, 0xe8, 0x05, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0xf5, 0x67, 0x69, 0x65, 0x19, 0xc9, 0x56, 0x08, 0x18, 0x07, 0xa5, 0x55, 0x05, 0x0d,
+	0x04, 0x14, 0x26, 0x1b, 0xf7, 0x09, 0x2c, 0x3b, 0x08, 0x7a, 0x4a, 0x4a, 0xb7, 0x28, 0xd0, 0x1e,
+	0xd8, 0x25, 0x39, 0x94, 0xd9, 0x50, 0xbb, 0xc4, 0x92, 0x32, 0xa3, 0x87, 0x28, 0xd0, 0xe7, 0xc8,
+	0xb1, 0xe8, 0xa1, 0x3f, 0x2f, 0x60, 0xf4, 0xe4, 0x63, 0xd1, 0x43, 0xda, 0xda, 0xaf, 0xd1, 0x43,
+	0xb1, 0x3f, 0x62, 0xe4, 0x34, 0x72, 0x0b, 0x47, 0x4a, 0x73, 0x32, 0x67, 0x77, 0x76, 0x76, 0x67,
+	0xbe, 0xef, 0x9b, 0x91, 0xd1, 0x4e, 0xcc, 0x4e, 0x1c, 0xc8, 0x02, 0xce, 0x0a, 0xe7, 0xe4, 0xbe,
+	0x0f, 0x39, 0xb9, 0xef, 0xc0, 0x09, 0xd0, 0x3c, 0xb3, 0x53, 0xce, 0x72, 0x86, 0xb7, 0xb3, 0x9c,
+	0x70, 0x4a, 0x26, 0x10, 0xda, 0x4f, 0x6d, 0xe5, 0x68, 0x6b, 0xc7, 0x6d, 0x2b, 0x60, 0xd9, 0x84,
+	0x65, 0x8e, 0x4f, 0x32, 0x28, 0x4f, 0x07, 0x2c, 0xa6, 0xea, 0xec, 0x76, 0x6f, 0xcc, 0xc6, 0x4c,
+	0x7e, 0x3a, 0xe2, 0x4b, 0xaf, 0xde, 0x1e, 0x33, 0x36, 0x4e, 0xc0, 0x91, 0x96, 0x3f, 0x8d, 0x1c,
+	0x42, 0x67, 0xf3, 0x2d, 0x15, 0xd0, 0x53, 0x67, 0x94, 0xa1, 0xb7, 0xac, 0x57, 0x3c, 0x34, 0x9f,
+	0xa5, 0xa0, 0xf7, 0x07, 0xdf, 0x35, 0x11, 0x7e, 0x20, 0x1e, 0x7e, 0xc0, 0x81, 0xe4, 0x10, 0x3e,
+	0x90, 0xae, 0x78, 0x0b, 0x55, 0xe3, 0xd0, 0x34, 0xfa, 0xc6, 0xb0, 0xed, 0x56, 0xe3, 0x10, 0xdf,
+	0x42, 0xcd, 0x0c, 0x92, 0x04, 0xb8, 0x59, 0x95, 0x6b, 0xda, 0xc2, 0x77, 0x50, 0x3b, 0x02, 0xf0,
+	0x52, 0x32, 0x03, 0x6e, 0xd6, 0xe4, 0x56, 0x2b, 0x02, 0x78, 0x2c, 0x6c, 0xfc, 0x3e, 0xda, 0xf2,
+	0x39, 0x7b, 0x02, 0xdc, 0x23, 0x61, 0xc8, 0x21, 0xcb, 0xcc, 0xba, 0xf4, 0xe8, 0xaa, 0xd5, 0x7d,
+	0xb5, 0x88, 0xbf, 0x44, 0x37, 0xb5, 0x5b, 0xc0, 0x26, 0x93, 0x38, 0xcb, 0x62, 0x46, 0xcd, 0x86,
+	0xf0, 0x1c, 0xd9, 0xa7, 0xcf, 0x77, 0x2a, 0xbf, 0x3d, 0xdf, 0xb9, 0x37, 0x8e, 0xf3, 0xe3, 0xa9,
+	0x6f, 0x07, 0x6c, 0xa2, 0xd3, 0xd3, 0x7f, 0x76, 0xb3, 0xf0, 0x89, 0xce, 0xe7, 0x10, 0x02, 0xf7,
+	0x86, 0x0a, 0x74, 0x50, 0xc6, 0xc1, 0x04, 0x35, 0x52, 0x1e, 0x07, 0x60, 0x36, 0xfb, 0xb5, 0x61,
+	0x67, 0xef, 0xb6, 0xad, 0xab, 0x23, 0x6a, 0x3f, 0x07, 0xc4, 0x3e, 0x60, 0x31, 0x1d, 0x7d, 0x28,
+	0xee, 0x7a, 0xf6, 0xfb, 0xce, 0xf0, 0x3f, 0xdc, 0x25, 0x0e, 0x64, 0xae, 0x8a, 0x8c, 0x0f, 0x51,
+	0x93, 0xf9, 0x5f, 0x43, 0x90, 0x9b, 0x1b, 0x7d, 0x63, 0xd8, 0xd9, 0xeb, 0xd9, 0x0a, 0x29, 0x7b,
+	0x8e, 0x94, 0xbd, 0x4f, 0x67, 0xa3, 0x5b, 0xbf, 0x7c, 0xbf, 0x8b, 0x3f, 0xe5, 0x84, 0x66, 0x11,
+	0x70, 0xe2, 0x27, 0xf0, 0x48, 0x9e, 0x71, 0xf5, 0x59, 0xbc, 0x8d, 0x5a, 0x21, 0x90, 0x30, 0x89,
+	0x29, 0x98, 0xad, 0xbe, 0x31, 0xac, 0xbb, 0xa5, 0x8d, 0x3d, 0x54, 0x8f, 0x00, 0x32, 0xb3, 0xbd,
+	0xfa, 0x1c, 0x64, 0x60, 0xfc, 0x10, 0x75, 0x64, 0x2e, 0x5e, 0x08, 0x01, 0x99, 0x99, 0xa8, 0x6f,
+	0x0c, 0xb7, 0xf6, 0xee, 0xd9, 0xcb, 0x39, 0x6c, 0x3f, 0x16, 0xee, 0x87, 0xc2, 0xdb, 0x45, 0x69,
+	0xf9, 0x8d, 0x13, 0xd4, 0x89, 0x12, 0xc6, 0xb8, 0xa7, 0x8a, 0xde, 0x59, 0xfd, 0x83, 0x91, 0x8c,
+	0x2f, 0xaf, 0xc7, 0x5f, 0xa1, 0x9e, 0xe0, 0x61, 0x4c, 0xc7, 0x9e, 0x66, 0x50, 0x76, 0x4c, 0x38,
+	0x98, 0x9b, 0xd7, 0x22, 0x0f, 0xd6, 0xb1, 0x46, 0x32, 0xd4, 0x91, 0x88, 0x84, 0x7b, 0xa8, 0xe1,
+	0x4f, 0x05, 0xb7, 0xbb, 0x92, 0xb9, 0xca, 0x18, 0x3c, 0xab, 0x69, 0xd1, 0x7c, 0x96, 0x86, 0x57,
+	0x88, 0xc6, 0x44, 0x1b, 0x53, 0xe9, 0x30, 0x57, 0xcd, 0xdc, 0xbc, 0x5a, 0x36, 0xef, 0x22, 0x44,
+	0xa1, 0xf0, 0xb4, 0xde, 0x94, 0x64, 0xda, 0x14, 0x8a, 0x23, 0x25, 0xb9, 0x63, 0x24, 0x0c, 0x5d,
+	0xe0, 0xc6, 0xea, 0x0b, 0xdc, 0xa2, 0x50, 0xa8, 0xf2, 0xbe, 0x87, 0x36, 0xc5, 0x4d, 0x25, 0x2d,
+	0x9b, 0x92, 0x96, 0x1d, 0x0a, 0xc5, 0xe1, 0xcb, 0xcc, 0xdc, 0x58, 0x17, 0x33, 0xef, 0xa8, 0x6c,
+	0x15, 0x08, 0x2d, 0x55, 0x29, 0x0a, 0xc5, 0x48, 0xd8, 0xf8, 0x2e, 0xea, 0xca, 0x0d, 0x2f, 0x48,
+	0x80, 0x70, 0x08, 0xcd, 0x76, 0xdf, 0x18, 0xb6, 0xdc, 0x4d, 0xb9, 0x78, 0xa0, 0xd6, 0x06, 0xdf,
+	0xd4, 0x50, 0x4f, 0x75, 0x38, 0x36, 0x49, 0x13, 0x58, 0x0e, 0xd7, 0x25, 0x50, 0xaa, 0x2f, 0x81,
+	0x52, 0x12, 0xa1, 0xb6, 0x40, 0x84, 0x32, 0xfd, 0xfa, 0xba, 0xd2, 0x2f, 0xdb, 0x57, 0x63, 0x6d,
+	0xed, 0xeb, 0x21, 0x6a, 0x73, 0x36, 0x23, 0x49, 0x1e, 0x43, 0xa6, 0xbb, 0xe4, 0xdd, 0xab, 0x94,
+	0xef, 0x4a, 0xe7, 0xd9, 0xa8, 0x2e, 0x2e, 0x74, 0x5f, 0x9c, 0x15, 0xed, 0xfe, 0xb2, 0x1a, 0x65,
+	0x3f, 0x6c, 0xbb, 0xdd, 0x4b, 0xba, 0x1a, 0xfc, 0x6c, 0xa0, 0x77, 0x24, 0x1e, 0x2e, 0x44, 0x53,
+	0x1a, 0x5e, 0x0f, 0x0e, 0x39, 0x8f, 0x68, 0x58, 0xe2, 0xa1, 0xad, 0xb5, 0x03, 0x32, 0xf8, 0xab,
+	0x8a, 0x6e, 0x2e, 0xce, 0xcb, 0x47, 0x51, 0x04, 0xfc, 0x1f, 0x6f, 0x2f, 0xd9, 0x52, 0x5d, 0x64,
+	0xcb, 0x95, 0xaa, 0x2f, 0x91, 0xae, 0xbf, 0x81, 0x41, 0xd5, 0x58, 0xd1, 0xa0, 0x6a, 0x2e, 0x19,
+	0x54, 0xeb, 0x6a, 0x07, 0x83, 0x9f, 0x0c, 0xdd, 0x79, 0xf7, 0x83, 0x00, 0xd2, 0xa5, 0xf5, 0xff,
+	0x77, 0xee, 0x24, 0x49, 0x89, 0x81, 0xb6, 0xd6, 0xcf, 0x9d, 0x1f, 0xe7, 0xcc, 0xff, 0x3c, 0xce,
+	0x8f, 0x43, 0x4e, 0x0a, 0x7a, 0x8d, 0xd7, 0xff, 0x3f, 0x8d, 0x68, 0x70, 0x56, 0x43, 0x37, 0x16,
+	0x79, 0x7f, 0x54, 0x90, 0x74, 0x35, 0xbf, 0x12, 0x5f, 0xb0, 0xb2, 0xfe, 0x1a, 0xac, 0xfc, 0x04,
+	0x75, 0x0b, 0x42, 0x73, 0x08, 0xbd, 0xd7, 0xa0, 0xf8, 0xa6, 0x0a, 0xa1, 0xac, 0x37, 0xf1, 0xd3,
+	0x71, 0x51, 0x4b, 0x1b, 0x4b, 0xb4, 0xd4, 0x5a, 0x17, 0xa4, 0x3f, 0xcc, 0xb5, 0x54, 0x0e, 0xc6,
+	0x57, 0x82, 0xfa, 0x16, 0xb2, 0x71, 0xf4, 0xf1, 0xe9, 0x9f, 0x56, 0xe5, 0xf4, 0xdc, 0x32, 0xce,
+	0xce, 0x2d, 0xe3, 0x8f, 0x73, 0xcb, 0xf8, 0xf6, 0xc2, 0xaa, 0x9c, 0x5d, 0x58, 0x95, 0x5f, 0x2f,
+	0xac, 0xca, 0x17, 0x1f, 0x2c, 0x44, 0x8b, 0xd9, 0xc9, 0x2e, 0xa3, 0xe0, 0x94, 0x03, 0xcd, 0x79,
+	0x3a, 0xff, 0x77, 0x48, 0x86, 0xf5, 0x9b, 0x92, 0x19, 0x1f, 0xfd, 0x3d, 0x00, 0xb9, 0xa6, 0x40,
+	0x25, 0xd2, 0x0d, 0x00, 0x00,
 }
 
 func (m *EventCreatedEscrow) Marshal() (dAtA []byte, err error) {
@@ -270,6 +519,42 @@ func (m *EventCreatedEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size := m.SellingBrokerShare.Size()
+		i -= size
+		if _, err := m.SellingBrokerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.FloorPrice) > 0 {
+		for iNdEx := len(m.FloorPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FloorPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.PriceDecay != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PriceDecay))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Fees) > 0 {
 		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -376,6 +661,23 @@ func (m *EventUpdatedEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BuyerCleared {
+		i--
+		if m.BuyerCleared {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.NewBuyer) > 0 {
+		i -= len(m.NewBuyer)
+		copy(dAtA[i:], m.NewBuyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewBuyer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Fees) > 0 {
 		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -460,6 +762,41 @@ func (m *EventCompletedEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SellingBroker) > 0 {
+		i -= len(m.SellingBroker)
+		copy(dAtA[i:], m.SellingBroker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SellingBroker)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Fees) > 0 {
 		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -556,164 +893,1962 @@ func (m *EventRefundedEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCreatedOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreatedEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventCreatedOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatedOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.FeePayer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BrokerAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BrokerCommission.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.Price) > 0 {
-		for _, e := range m.Price {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	if m.Deadline != 0 {
-		n += 1 + sovEvents(uint64(m.Deadline))
+		i = encodeVarintEvents(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdatedEscrow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventAcceptedOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptedOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptedOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Updater)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.FeePayer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NewSeller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.NewPrice) > 0 {
-		for _, e := range m.NewPrice {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.NewDeadline != 0 {
-		n += 1 + sovEvents(uint64(m.NewDeadline))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawnOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawnOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawnOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCompletedEscrow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCreatedSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatedSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatedSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.FeePayer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Deadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.WantedObject != nil {
+		{
+			size, err := m.WantedObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCompletedSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCompletedSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompletedSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreatedEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BrokerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BrokerCommission.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovEvents(uint64(m.Deadline))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.PriceDecay != 0 {
+		n += 1 + sovEvents(uint64(m.PriceDecay))
+	}
+	if len(m.FloorPrice) > 0 {
+		for _, e := range m.FloorPrice {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.SellingBrokerShare.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdatedEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewSeller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.NewPrice) > 0 {
+		for _, e := range m.NewPrice {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.NewDeadline != 0 {
+		n += 1 + sovEvents(uint64(m.NewDeadline))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.NewBuyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BuyerCleared {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCompletedEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.SellingBroker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRefundedEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCreatedOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovEvents(uint64(m.Deadline))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAcceptedOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventWithdrawnOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCreatedSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WantedObject != nil {
+		l = m.WantedObject.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovEvents(uint64(m.Deadline))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCompletedSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatedEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BrokerCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &types1.Any{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecay", wireType)
+			}
+			m.PriceDecay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceDecay |= PriceDecay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorPrice = append(m.FloorPrice, types.Coin{})
+			if err := m.FloorPrice[len(m.FloorPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingBrokerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingBrokerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdatedEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdatedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSeller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSeller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPrice = append(m.NewPrice, types.Coin{})
+			if err := m.NewPrice[len(m.NewPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDeadline", wireType)
+			}
+			m.NewDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBuyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewBuyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerCleared", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BuyerCleared = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCompletedEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCompletedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, Royalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingBroker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellingBroker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRefundedEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRefundedEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRefundedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *EventRefundedEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.FeePayer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
+func (m *EventCreatedOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -736,10 +2871,10 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatedEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreatedOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreatedOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -776,7 +2911,7 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -804,7 +2939,7 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -840,9 +2975,9 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrokerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -852,29 +2987,31 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrokerAddress = string(dAtA[iNdEx:postIndex])
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrokerCommission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -884,29 +3021,50 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BrokerCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Object == nil {
+				m.Object = &types1.Any{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -933,16 +3091,66 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = append(m.Price, types.Coin{})
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAcceptedOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAcceptedOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAcceptedOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -952,33 +3160,61 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &types1.Any{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
-			m.Deadline = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -988,12 +3224,25 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
@@ -1048,7 +3297,7 @@ func (m *EventCreatedEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawnOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,10 +3320,10 @@ func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdatedEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdrawnOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdatedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdrawnOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1110,38 +3359,6 @@ func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Updater = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
@@ -1173,9 +3390,9 @@ func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
 			}
 			m.FeePayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSeller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1203,62 +3420,9 @@ func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewSeller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPrice", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewPrice = append(m.NewPrice, types.Coin{})
-			if err := m.NewPrice[len(m.NewPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDeadline", wireType)
-			}
-			m.NewDeadline = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewDeadline |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
@@ -1313,7 +3477,7 @@ func (m *EventUpdatedEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
+func (m *EventCreatedSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1336,10 +3500,10 @@ func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCompletedEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreatedSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCompletedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreatedSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1376,7 +3540,7 @@ func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1404,11 +3568,11 @@ func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1436,9 +3600,134 @@ func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &types1.Any{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WantedObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WantedObject == nil {
+				m.WantedObject = &types1.Any{}
+			}
+			if err := m.WantedObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
@@ -1493,7 +3782,7 @@ func (m *EventCompletedEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRefundedEscrow) Unmarshal(dAtA []byte) error {
+func (m *EventCompletedSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1516,10 +3805,10 @@ func (m *EventRefundedEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRefundedEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompletedSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRefundedEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompletedSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1588,7 +3877,7 @@ func (m *EventRefundedEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1616,7 +3905,7 @@ func (m *EventRefundedEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
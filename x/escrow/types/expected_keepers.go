@@ -2,8 +2,6 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"github.com/iov-one/starnamed/x/configuration"
 )
 
 // BankKeeper defines the expected bank keeper (noalias)
@@ -17,14 +15,9 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 }
 
-// FIXME this should not be a dependency of this module
-
-// ConfigurationKeeper defines the expected configuration keeper
-type ConfigurationKeeper interface {
-	// GetFees gets the fees
-	GetFees(ctx sdk.Context) *configuration.Fees
-	// GetConfiguration returns the configuration
-	GetConfiguration(ctx sdk.Context) configuration.Config
-
-	SetConfig(ctx sdk.Context, conf configuration.Config)
+// FeeCollector is the hook collecting the fees of the escrow operations, the fees are sent to the fee collector
+// module account when no FeeCollector is set on the keeper
+type FeeCollector interface {
+	// CollectFees collects the given fees from the payer for the given message
+	CollectFees(ctx sdk.Context, msg sdk.Msg, payer sdk.AccAddress, fees sdk.Coins) error
 }
@@ -10,9 +10,11 @@ import (
 )
 
 // NewGenesisState constructs a new GenesisState instance
-func NewGenesisState(escrows []Escrow, lastBlockTime, nextEscrowID uint64, params Params) *GenesisState {
+func NewGenesisState(escrows []Escrow, offers []Offer, records []EscrowRecord, lastBlockTime, nextEscrowID uint64, params Params) *GenesisState {
 	return &GenesisState{
 		Escrows:       escrows,
+		Offers:        offers,
+		Records:       records,
 		LastBlockTime: lastBlockTime,
 		NextEscrowId:  nextEscrowID,
 		Params:        params,
@@ -23,6 +25,8 @@ func NewGenesisState(escrows []Escrow, lastBlockTime, nextEscrowID uint64, param
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Escrows:       []Escrow{},
+		Offers:        []Offer{},
+		Records:       []EscrowRecord{},
 		LastBlockTime: 0,
 		NextEscrowId:  1,
 		Params:        DefaultParams(),
@@ -61,5 +65,42 @@ func ValidateGenesis(data GenesisState) error {
 		// Mark the escrow as seen
 		ids[escrow.Id] = true
 	}
+
+	for _, offer := range data.Offers {
+		// Offers share their ids with the escrows
+		if ids[offer.Id] {
+			return fmt.Errorf("found duplicate offer ID %s", offer.Id)
+		}
+
+		// The offer id must be issued before data.NextEscrowId
+		if bytes.Compare(GetEscrowKey(offer.Id), sdk.Uint64ToBigEndian(data.NextEscrowId)) >= 0 {
+			return fmt.Errorf("found offer ID greater than next escrow ID : %v", offer.Id)
+		}
+
+		// Expired offers are refunded in the same block, so they can not be exported
+		if err := offer.ValidateWithoutObject("", data.LastBlockTime); err != nil {
+			return sdkerrors.Wrapf(err, "invalid offer %v", offer.Id)
+		}
+
+		ids[offer.Id] = true
+	}
+
+	for _, record := range data.Records {
+		// Records are kept for the escrows that have been deleted, so they share their ids with the escrows
+		if ids[record.Id] {
+			return fmt.Errorf("found duplicate escrow record ID %s", record.Id)
+		}
+
+		// The record id must be issued before data.NextEscrowId
+		if bytes.Compare(GetEscrowKey(record.Id), sdk.Uint64ToBigEndian(data.NextEscrowId)) >= 0 {
+			return fmt.Errorf("found escrow record ID greater than next escrow ID : %v", record.Id)
+		}
+
+		if err := record.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid escrow record %v", record.Id)
+		}
+
+		ids[record.Id] = true
+	}
 	return nil
 }
@@ -25,10 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the Escrow module's genesis state
 type GenesisState struct {
-	Escrows       []Escrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
-	LastBlockTime uint64   `protobuf:"varint,2,opt,name=last_block_time,json=lastBlockTime,proto3" json:"last_block_time,omitempty"`
-	NextEscrowId  uint64   `protobuf:"varint,3,opt,name=next_escrow_id,json=nextEscrowId,proto3" json:"next_escrow_id,omitempty"`
-	Params        Params   `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	Escrows       []Escrow       `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
+	LastBlockTime uint64         `protobuf:"varint,2,opt,name=last_block_time,json=lastBlockTime,proto3" json:"last_block_time,omitempty"`
+	NextEscrowId  uint64         `protobuf:"varint,3,opt,name=next_escrow_id,json=nextEscrowId,proto3" json:"next_escrow_id,omitempty"`
+	Params        Params         `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	Offers        []Offer        `protobuf:"bytes,5,rep,name=offers,proto3" json:"offers"`
+	Records       []EscrowRecord `protobuf:"bytes,6,rep,name=records,proto3" json:"records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +94,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetOffers() []Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecords() []EscrowRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "starnamed.x.escrow.v1beta1.GenesisState")
 }
@@ -99,26 +115,29 @@ func init() {
 func init() { proto.RegisterFile("iov/escrow/v1beta1/genesis.proto", fileDescriptor_c0a61b802de1d754) }
 
 var fileDescriptor_c0a61b802de1d754 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4b, 0xf3, 0x30,
-	0x1c, 0xc6, 0x9b, 0x77, 0x63, 0x2f, 0xc4, 0xa9, 0x50, 0x3c, 0x94, 0x1e, 0xb2, 0x32, 0x44, 0x06,
-	0x62, 0xc2, 0xf4, 0x0b, 0x48, 0x61, 0x88, 0x37, 0x99, 0x9e, 0xbc, 0x94, 0x74, 0xfd, 0x53, 0x83,
-	0x6b, 0x53, 0x9a, 0x58, 0xeb, 0xb7, 0xf0, 0x63, 0xed, 0xb8, 0xa3, 0x27, 0x91, 0xf6, 0x8b, 0x48,
-	0x93, 0xce, 0xd3, 0xc4, 0x5b, 0xf2, 0xfc, 0x7f, 0xcf, 0xc3, 0xc3, 0x83, 0x03, 0x21, 0x2b, 0x06,
-	0x6a, 0x55, 0xca, 0x57, 0x56, 0xcd, 0x63, 0xd0, 0x7c, 0xce, 0x52, 0xc8, 0x41, 0x09, 0x45, 0x8b,
-	0x52, 0x6a, 0xe9, 0xfa, 0x4a, 0xf3, 0x32, 0xe7, 0x19, 0x24, 0xb4, 0xa6, 0x96, 0xa4, 0x3d, 0xe9,
-	0x9f, 0xa4, 0x32, 0x95, 0x06, 0x63, 0xdd, 0xcb, 0x3a, 0x7c, 0xb2, 0x27, 0x53, 0xbf, 0x15, 0xd0,
-	0x27, 0xfa, 0x93, 0x3d, 0xf7, 0x82, 0x97, 0x3c, 0xeb, 0x81, 0x69, 0x83, 0xf0, 0xf8, 0xc6, 0x96,
-	0xb8, 0xd7, 0x5c, 0x83, 0x1b, 0xe2, 0xff, 0x96, 0x57, 0x1e, 0x0a, 0x06, 0xb3, 0x83, 0xcb, 0x29,
-	0xfd, 0xbd, 0x15, 0x5d, 0x98, 0x6f, 0x38, 0xdc, 0x7c, 0x4e, 0x9c, 0xe5, 0xce, 0xe8, 0x9e, 0xe1,
-	0xe3, 0x35, 0x57, 0x3a, 0x8a, 0xd7, 0x72, 0xf5, 0x1c, 0x69, 0x91, 0x81, 0xf7, 0x2f, 0x40, 0xb3,
-	0xe1, 0xf2, 0xb0, 0x93, 0xc3, 0x4e, 0x7d, 0x10, 0x19, 0xb8, 0xa7, 0xf8, 0x28, 0x87, 0x5a, 0x47,
-	0xd6, 0x17, 0x89, 0xc4, 0x1b, 0x18, 0x6c, 0xdc, 0xa9, 0x36, 0xfa, 0x36, 0x71, 0xaf, 0xf1, 0xc8,
-	0x56, 0xf6, 0x86, 0x01, 0xfa, 0xab, 0xd0, 0x9d, 0x21, 0xfb, 0x42, 0xbd, 0x2f, 0x5c, 0x6c, 0x1a,
-	0x82, 0xb6, 0x0d, 0x41, 0x5f, 0x0d, 0x41, 0xef, 0x2d, 0x71, 0xb6, 0x2d, 0x71, 0x3e, 0x5a, 0xe2,
-	0x3c, 0x9e, 0xa7, 0x42, 0x3f, 0xbd, 0xc4, 0x74, 0x25, 0x33, 0x26, 0x64, 0x75, 0x21, 0x73, 0x60,
-	0x3f, 0xe9, 0xac, 0xde, 0x4d, 0x67, 0x26, 0x8d, 0x47, 0x66, 0xb2, 0xab, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3b, 0x7c, 0xe5, 0xf7, 0xc9, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0xdb, 0x6d, 0xff, 0x0e, 0xf2, 0x9f, 0x0a, 0xc5, 0x43, 0xe9, 0x21, 0xab, 0x43, 0x64,
+	0x20, 0x26, 0x4c, 0x1f, 0x40, 0x29, 0x0c, 0xf5, 0xa4, 0x4c, 0x4f, 0x5e, 0x4a, 0xda, 0x66, 0x35,
+	0xb8, 0x36, 0x23, 0x89, 0x73, 0xbe, 0x85, 0x8f, 0xb5, 0xe3, 0x8e, 0x9e, 0x44, 0xb6, 0xb3, 0xef,
+	0x20, 0x4d, 0x5a, 0x4f, 0xd3, 0xdd, 0xda, 0x5f, 0x3f, 0x9f, 0x6f, 0xbf, 0xc9, 0x0f, 0x04, 0x8c,
+	0xcf, 0x30, 0x95, 0x89, 0xe0, 0x2f, 0x78, 0x36, 0x88, 0xa9, 0x22, 0x03, 0x9c, 0xd1, 0x82, 0x4a,
+	0x26, 0xd1, 0x54, 0x70, 0xc5, 0x5d, 0x5f, 0x2a, 0x22, 0x0a, 0x92, 0xd3, 0x14, 0xcd, 0x91, 0x21,
+	0x51, 0x45, 0xfa, 0xfb, 0x19, 0xcf, 0xb8, 0xc6, 0x70, 0xf9, 0x64, 0x0c, 0x1f, 0x6e, 0xc8, 0x54,
+	0xaf, 0x53, 0x5a, 0x25, 0xfa, 0xdd, 0x0d, 0xdf, 0xa7, 0x44, 0x90, 0xbc, 0x02, 0x7a, 0x5f, 0x0d,
+	0xd0, 0xb9, 0x34, 0x25, 0xee, 0x14, 0x51, 0xd4, 0x0d, 0x41, 0xdb, 0xf0, 0xd2, 0xb3, 0x83, 0x66,
+	0xff, 0xff, 0x69, 0x0f, 0xfd, 0xde, 0x0a, 0x0d, 0xf5, 0x6b, 0xd8, 0x5a, 0x7c, 0x74, 0xad, 0x51,
+	0x2d, 0xba, 0x47, 0x60, 0x6f, 0x42, 0xa4, 0x8a, 0xe2, 0x09, 0x4f, 0x9e, 0x22, 0xc5, 0x72, 0xea,
+	0x35, 0x02, 0xbb, 0xdf, 0x1a, 0xed, 0x94, 0xe3, 0xb0, 0x9c, 0xde, 0xb3, 0x9c, 0xba, 0x87, 0x60,
+	0xb7, 0xa0, 0x73, 0x15, 0x19, 0x2f, 0x62, 0xa9, 0xd7, 0xd4, 0x58, 0xa7, 0x9c, 0x9a, 0xe8, 0xeb,
+	0xd4, 0xbd, 0x00, 0x8e, 0xa9, 0xec, 0xb5, 0x02, 0x7b, 0x5b, 0xa1, 0x5b, 0x4d, 0x56, 0x85, 0x2a,
+	0xcf, 0x3d, 0x07, 0x0e, 0x1f, 0x8f, 0xa9, 0x90, 0xde, 0x3f, 0x7d, 0xa4, 0x83, 0xbf, 0x12, 0x6e,
+	0x4a, 0xb2, 0x0e, 0x30, 0x9a, 0x7b, 0x05, 0xda, 0x82, 0x26, 0x5c, 0xa4, 0xd2, 0x73, 0x74, 0x42,
+	0x7f, 0xfb, 0xa5, 0x8c, 0xb4, 0x50, 0x5f, 0x4d, 0xa5, 0x87, 0xc3, 0xc5, 0x0a, 0xda, 0xcb, 0x15,
+	0xb4, 0x3f, 0x57, 0xd0, 0x7e, 0x5b, 0x43, 0x6b, 0xb9, 0x86, 0xd6, 0xfb, 0x1a, 0x5a, 0x0f, 0xc7,
+	0x19, 0x53, 0x8f, 0xcf, 0x31, 0x4a, 0x78, 0x8e, 0x19, 0x9f, 0x9d, 0xf0, 0x82, 0xe2, 0x9f, 0x9f,
+	0xe0, 0x79, 0xbd, 0x45, 0xbd, 0xdd, 0xd8, 0xd1, 0xdb, 0x3b, 0xfb, 0x1e, 0x00, 0x7e, 0x5b, 0x4a,
+	0x24, 0x54, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +160,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -209,6 +256,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -352,6 +411,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, EscrowRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
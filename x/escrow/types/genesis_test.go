@@ -160,6 +160,50 @@ func (s *GenesisTestSuite) TestValidate() {
 				state.Escrows = append(state.Escrows, escrow)
 			},
 		},
+		{
+			name: "valid genesis with records",
+			mutateGenesis: func(state *types.GenesisState) {
+				completed, _ := s.generator.NewRandomTestEscrow()
+				completed.State = types.EscrowState_Completed
+				refunded, _ := s.generator.NewRandomTestEscrow()
+				refunded.State = types.EscrowState_Refunded
+				state.Records = append(state.Records,
+					types.NewEscrowRecord(completed, s.generator.NewAccAddress(), completed.Price, s.generator.NowAfter(0)),
+					types.NewEscrowRecord(refunded, nil, nil, s.generator.NowAfter(0)),
+				)
+			},
+		},
+		{
+			name: "invalid genesis: Record with the ID of an escrow",
+			mutateGenesis: func(state *types.GenesisState) {
+				escrow := state.Escrows[0]
+				escrow.State = types.EscrowState_Refunded
+				state.Records = append(state.Records, types.NewEscrowRecord(escrow, nil, nil, s.generator.NowAfter(0)))
+			},
+		},
+		{
+			name: "invalid genesis: Record of an open escrow",
+			mutateGenesis: func(state *types.GenesisState) {
+				escrow, _ := s.generator.NewRandomTestEscrow()
+				state.Records = append(state.Records, types.NewEscrowRecord(escrow, nil, nil, s.generator.NowAfter(0)))
+			},
+		},
+		{
+			name: "invalid genesis: Record of a completed escrow without buyer",
+			mutateGenesis: func(state *types.GenesisState) {
+				escrow, _ := s.generator.NewRandomTestEscrow()
+				escrow.State = types.EscrowState_Completed
+				state.Records = append(state.Records, types.NewEscrowRecord(escrow, nil, escrow.Price, s.generator.NowAfter(0)))
+			},
+		},
+		{
+			name: "invalid genesis: Record of a refunded escrow with a price",
+			mutateGenesis: func(state *types.GenesisState) {
+				escrow, _ := s.generator.NewRandomTestEscrow()
+				escrow.State = types.EscrowState_Refunded
+				state.Records = append(state.Records, types.NewEscrowRecord(escrow, nil, escrow.Price, s.generator.NowAfter(0)))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
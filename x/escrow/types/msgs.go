@@ -15,6 +15,16 @@ const (
 	TypeMsgUpdateEscrow = "update_escrow"
 	// TypeMsgTransferToEscrow is the type for MsgTransferToEscrow
 	TypeMsgTransferToEscrow = "transfer_to_escrow"
+	// TypeMsgCreateOffer is the type for MsgCreateOffer
+	TypeMsgCreateOffer = "create_offer"
+	// TypeMsgAcceptOffer is the type for MsgAcceptOffer
+	TypeMsgAcceptOffer = "accept_offer"
+	// TypeMsgWithdrawOffer is the type for MsgWithdrawOffer
+	TypeMsgWithdrawOffer = "withdraw_offer"
+	// TypeMsgCreateSwap is the type for MsgCreateSwap
+	TypeMsgCreateSwap = "create_swap"
+	// TypeMsgCompleteSwap is the type for MsgCompleteSwap
+	TypeMsgCompleteSwap = "complete_swap"
 )
 
 var (
@@ -22,6 +32,11 @@ var (
 	_ sdk.Msg = &MsgRefundEscrow{}
 	_ sdk.Msg = &MsgUpdateEscrow{}
 	_ sdk.Msg = &MsgTransferToEscrow{}
+	_ sdk.Msg = &MsgCreateOffer{}
+	_ sdk.Msg = &MsgAcceptOffer{}
+	_ sdk.Msg = &MsgWithdrawOffer{}
+	_ sdk.Msg = &MsgCreateSwap{}
+	_ sdk.Msg = &MsgCompleteSwap{}
 )
 
 func validateFeePayer(feePayer string) error {
@@ -116,6 +131,18 @@ func (msg MsgCreateEscrow) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidatePriceDecay(msg.PriceDecay, msg.Price, msg.FloorPrice, ""); err != nil {
+		return err
+	}
+
+	if err := msg.validateBroker(); err != nil {
+		return err
+	}
+
+	if err := ValidateDesignatedBuyer(msg.Buyer, msg.Seller); err != nil {
+		return err
+	}
+
 	switch msg.Object.GetCachedValue().(type) {
 	case TransferableObject:
 		break
@@ -135,6 +162,39 @@ func (msg MsgCreateEscrow) ValidateBasic() error {
 	return ValidateObject(obj, seller)
 }
 
+// validateBroker validates the optional broker of the escrow, the commission and the selling broker share require it
+func (msg MsgCreateEscrow) validateBroker() error {
+	if len(msg.Broker) == 0 {
+		if !msg.GetBrokerCommission().IsZero() || !msg.GetSellingBrokerShare().IsZero() {
+			return sdkerrors.Wrap(ErrInvalidBroker, "A broker commission requires a broker")
+		}
+		return nil
+	}
+	if err := ValidateAddress(msg.Broker); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid broker address (%s)", err)
+	}
+	if err := ValidateCommission(msg.GetBrokerCommission()); err != nil {
+		return err
+	}
+	return ValidateSellingBrokerShare(msg.GetSellingBrokerShare())
+}
+
+// GetBrokerCommission returns the commission of the broker of the escrow, zero if it is not set
+func (msg MsgCreateEscrow) GetBrokerCommission() sdk.Dec {
+	if msg.BrokerCommission.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return msg.BrokerCommission
+}
+
+// GetSellingBrokerShare returns the share of the commission paid to the selling broker, zero if it is not set
+func (msg MsgCreateEscrow) GetSellingBrokerShare() sdk.Dec {
+	if msg.SellingBrokerShare.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return msg.SellingBrokerShare
+}
+
 // GetSignBytes implements Msg
 func (msg MsgCreateEscrow) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
@@ -236,6 +296,20 @@ func (msg MsgUpdateEscrow) ValidateBasic() error {
 		hasUpdate = true
 	}
 
+	if len(msg.Buyer) != 0 {
+		hasUpdate = true
+		if msg.ClearBuyer {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The designated buyer cannot be both set and cleared")
+		}
+		if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+		}
+	}
+
+	if msg.ClearBuyer {
+		hasUpdate = true
+	}
+
 	if !hasUpdate {
 		return ErrEmptyUpdate
 	}
@@ -282,6 +356,13 @@ func (msg MsgTransferToEscrow) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 
+	// The selling broker is optional
+	if len(msg.Broker) != 0 {
+		if err := ValidateAddress(msg.Broker); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid broker address (%s)", err)
+		}
+	}
+
 	return ValidatePrice(msg.Amount, "")
 }
 
@@ -300,3 +381,322 @@ func (msg MsgTransferToEscrow) GetFeePayer() sdk.AccAddress {
 func (msg MsgTransferToEscrow) GetSigners() []sdk.AccAddress {
 	return getSigners(msg.Sender, msg.FeePayer)
 }
+
+// -----------------------------------------------------------------------------
+
+// NewMsgCreateOffer creates a new MsgCreateOffer instance
+func NewMsgCreateOffer(
+	buyer string,
+	feePayer string,
+	object TransferableObject,
+	price sdk.Coins,
+	deadline uint64,
+) MsgCreateOffer {
+	packedObj, err := codectypes.NewAnyWithValue(object)
+	if err != nil {
+		panic(err)
+	}
+	return MsgCreateOffer{
+		Buyer:    buyer,
+		FeePayer: feePayer,
+		Object:   packedObj,
+		Price:    price,
+		Deadline: deadline,
+	}
+}
+
+// UnpackInterfaces make sure the Anys included in MsgCreateOffer are unpacked (e.g the object field)
+func (msg *MsgCreateOffer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.Object != nil {
+		var obj TransferableObject
+		return unpacker.UnpackAny(msg.Object, &obj)
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgCreateOffer) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateOffer) Type() string { return TypeMsgCreateOffer }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	if err := validateFeePayer(msg.FeePayer); err != nil {
+		return err
+	}
+
+	if err := ValidatePrice(msg.Price, ""); err != nil {
+		return err
+	}
+
+	obj, ok := msg.Object.GetCachedValue().(TransferableObject)
+	if !ok {
+		return sdkerrors.Wrapf(
+			ErrUnknownObject,
+			"The object should be of type TransferableObject but is of type %T",
+			msg.Object.GetCachedValue(),
+		)
+	}
+
+	return ValidateObjectDeadlineBasic(obj, msg.Deadline)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetFeePayer implements MsgWithFeePayer
+func (msg MsgCreateOffer) GetFeePayer() sdk.AccAddress {
+	return getFeePayer(msg.Buyer, msg.FeePayer)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateOffer) GetSigners() []sdk.AccAddress {
+	return getSigners(msg.Buyer, msg.FeePayer)
+}
+
+// -----------------------------------------------------------------------------
+
+// Route implements Msg
+func (msg MsgAcceptOffer) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAcceptOffer) Type() string { return TypeMsgAcceptOffer }
+
+// ValidateBasic implements Msg
+func (msg MsgAcceptOffer) ValidateBasic() error {
+	if err := ValidateID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := validateFeePayer(msg.FeePayer); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgAcceptOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetFeePayer implements MsgWithFeePayer
+func (msg MsgAcceptOffer) GetFeePayer() sdk.AccAddress {
+	return getFeePayer(msg.Seller, msg.FeePayer)
+}
+
+// GetSigners implements Msg
+func (msg MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	return getSigners(msg.Seller, msg.FeePayer)
+}
+
+// -----------------------------------------------------------------------------
+
+// Route implements Msg
+func (msg MsgWithdrawOffer) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgWithdrawOffer) Type() string { return TypeMsgWithdrawOffer }
+
+// ValidateBasic implements Msg
+func (msg MsgWithdrawOffer) ValidateBasic() error {
+	if err := ValidateID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := validateFeePayer(msg.FeePayer); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgWithdrawOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetFeePayer implements MsgWithFeePayer
+func (msg MsgWithdrawOffer) GetFeePayer() sdk.AccAddress {
+	return getFeePayer(msg.Buyer, msg.FeePayer)
+}
+
+// GetSigners implements Msg
+func (msg MsgWithdrawOffer) GetSigners() []sdk.AccAddress {
+	return getSigners(msg.Buyer, msg.FeePayer)
+}
+
+// -----------------------------------------------------------------------------
+
+// NewMsgCreateSwap creates a new MsgCreateSwap instance
+func NewMsgCreateSwap(
+	seller string,
+	feePayer string,
+	object TransferableObject,
+	wanted TransferableObject,
+	price sdk.Coins,
+	deadline uint64,
+) MsgCreateSwap {
+	packedObj, err := codectypes.NewAnyWithValue(object)
+	if err != nil {
+		panic(err)
+	}
+	packedWanted, err := codectypes.NewAnyWithValue(wanted)
+	if err != nil {
+		panic(err)
+	}
+	return MsgCreateSwap{
+		Seller:       seller,
+		FeePayer:     feePayer,
+		Object:       packedObj,
+		WantedObject: packedWanted,
+		Price:        price,
+		Deadline:     deadline,
+	}
+}
+
+// UnpackInterfaces make sure the Anys included in MsgCreateSwap are unpacked (e.g the object fields)
+func (msg *MsgCreateSwap) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.Object != nil {
+		var obj TransferableObject
+		if err := unpacker.UnpackAny(msg.Object, &obj); err != nil {
+			return err
+		}
+	}
+	if msg.WantedObject != nil {
+		var obj TransferableObject
+		return unpacker.UnpackAny(msg.WantedObject, &obj)
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgCreateSwap) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateSwap) Type() string { return TypeMsgCreateSwap }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateSwap) ValidateBasic() error {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address (%s)", err)
+	}
+
+	if err := validateFeePayer(msg.FeePayer); err != nil {
+		return err
+	}
+
+	// The price is optional for a swap
+	if !msg.Price.Empty() {
+		if err := ValidatePrice(msg.Price, ""); err != nil {
+			return err
+		}
+	}
+
+	obj, ok := msg.Object.GetCachedValue().(TransferableObject)
+	if !ok {
+		return sdkerrors.Wrapf(
+			ErrUnknownObject,
+			"The object should be of type TransferableObject but is of type %T",
+			msg.Object.GetCachedValue(),
+		)
+	}
+	wanted, ok := msg.WantedObject.GetCachedValue().(TransferableObject)
+	if !ok {
+		return sdkerrors.Wrapf(
+			ErrUnknownObject,
+			"The wanted object should be of type TransferableObject but is of type %T",
+			msg.WantedObject.GetCachedValue(),
+		)
+	}
+
+	if err := ValidateObjectDeadlineBasic(obj, msg.Deadline); err != nil {
+		return err
+	}
+
+	if err := ValidateObject(obj, seller); err != nil {
+		return err
+	}
+
+	return ValidateWantedObject(obj, wanted, seller)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetFeePayer implements MsgWithFeePayer
+func (msg MsgCreateSwap) GetFeePayer() sdk.AccAddress {
+	return getFeePayer(msg.Seller, msg.FeePayer)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateSwap) GetSigners() []sdk.AccAddress {
+	return getSigners(msg.Seller, msg.FeePayer)
+}
+
+// -----------------------------------------------------------------------------
+
+// Route implements Msg
+func (msg MsgCompleteSwap) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCompleteSwap) Type() string { return TypeMsgCompleteSwap }
+
+// ValidateBasic implements Msg
+func (msg MsgCompleteSwap) ValidateBasic() error {
+	if err := ValidateID(msg.Id); err != nil {
+		return err
+	}
+
+	if err := validateFeePayer(msg.FeePayer); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	// The amount is optional for a swap
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "the amount must be valid")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCompleteSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetFeePayer implements MsgWithFeePayer
+func (msg MsgCompleteSwap) GetFeePayer() sdk.AccAddress {
+	return getFeePayer(msg.Sender, msg.FeePayer)
+}
+
+// GetSigners implements Msg
+func (msg MsgCompleteSwap) GetSigners() []sdk.AccAddress {
+	return getSigners(msg.Sender, msg.FeePayer)
+}
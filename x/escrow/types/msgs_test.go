@@ -116,6 +116,50 @@ func (suite *MsgTestSuite) TestMsgValidate() {
 			name: "create: valid with fee payer",
 			msg:  completeMsgCreate(types.MsgCreateEscrow{FeePayer: suite.sender.String()}),
 		},
+		{
+			name: "create: valid with price decay",
+			msg: completeMsgCreate(types.MsgCreateEscrow{
+				PriceDecay: types.PriceDecay_Exponential,
+				FloorPrice: sdk.NewCoins(sdk.NewCoin("denom", sdk.NewInt(10))),
+			}),
+		},
+		{
+			name: "create: invalid floor price: above the price",
+			msg: completeMsgCreate(types.MsgCreateEscrow{
+				PriceDecay: types.PriceDecay_Linear,
+				FloorPrice: sdk.NewCoins(sdk.NewCoin("denom", sdk.NewInt(60))),
+			}),
+		},
+		{
+			name: "create: invalid floor price: without price decay",
+			msg: completeMsgCreate(types.MsgCreateEscrow{
+				FloorPrice: sdk.NewCoins(sdk.NewCoin("denom", sdk.NewInt(10))),
+			}),
+		},
+		{
+			name: "create: valid with broker",
+			msg: completeMsgCreate(types.MsgCreateEscrow{
+				Broker:             suite.sender.String(),
+				BrokerCommission:   sdk.NewDecWithPrec(2, 2),
+				SellingBrokerShare: sdk.NewDecWithPrec(5, 1),
+			}),
+		},
+		{
+			name: "create: invalid broker commission: without broker",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{BrokerCommission: sdk.NewDecWithPrec(2, 2)}),
+		},
+		{
+			name: "create: invalid broker commission: above one",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{Broker: suite.sender.String(), BrokerCommission: sdk.NewDec(2)}),
+		},
+		{
+			name: "create: invalid selling broker share: negative",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{Broker: suite.sender.String(), SellingBrokerShare: sdk.NewDec(-1)}),
+		},
+		{
+			name: "create: invalid broker: invalid bech32",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{Broker: invalidBech32Addr}),
+		},
 		{
 			name: "create: invalid seller address: invalid bech32",
 			msg:  completeMsgCreate(types.MsgCreateEscrow{Seller: invalidBech32Addr}),
@@ -132,6 +176,18 @@ func (suite *MsgTestSuite) TestMsgValidate() {
 			name: "create: invalid fee payer: invalid prefix",
 			msg:  completeMsgCreate(types.MsgCreateEscrow{FeePayer: invalidPrefixAddr}),
 		},
+		{
+			name: "create: valid with designated buyer",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{Buyer: suite.gen.NewAccAddress().String()}),
+		},
+		{
+			name: "create: invalid designated buyer: invalid bech32",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{Buyer: invalidBech32Addr}),
+		},
+		{
+			name: "create: invalid designated buyer: the seller",
+			msg:  completeMsgCreate(types.MsgCreateEscrow{Buyer: suite.sender.String()}),
+		},
 		{
 			name: "create: invalid price: negative",
 			msg:  completeMsgCreate(types.MsgCreateEscrow{Price: negativePrice}),
@@ -152,6 +208,22 @@ func (suite *MsgTestSuite) TestMsgValidate() {
 			name: "update: valid with fee payer",
 			msg:  completeMsgUpdate(types.MsgUpdateEscrow{FeePayer: suite.sender.String(), Seller: suite.sender.String()}),
 		},
+		{
+			name: "update: valid designated buyer",
+			msg:  completeMsgUpdate(types.MsgUpdateEscrow{Buyer: suite.gen.NewAccAddress().String()}),
+		},
+		{
+			name: "update: valid cleared buyer",
+			msg:  completeMsgUpdate(types.MsgUpdateEscrow{ClearBuyer: true}),
+		},
+		{
+			name: "update: invalid designated buyer: set and cleared",
+			msg:  completeMsgUpdate(types.MsgUpdateEscrow{Buyer: suite.gen.NewAccAddress().String(), ClearBuyer: true}),
+		},
+		{
+			name: "update: invalid designated buyer: invalid bech32",
+			msg:  completeMsgUpdate(types.MsgUpdateEscrow{Buyer: invalidBech32Addr}),
+		},
 		{
 			name: "update: invalid empty update",
 			msg:  completeMsgUpdate(types.MsgUpdateEscrow{}),
@@ -200,6 +272,14 @@ func (suite *MsgTestSuite) TestMsgValidate() {
 			name: "transfer: valid with fee payer",
 			msg:  completeMsgTransfer(types.MsgTransferToEscrow{FeePayer: suite.sender.String()}),
 		},
+		{
+			name: "transfer: valid with broker",
+			msg:  completeMsgTransfer(types.MsgTransferToEscrow{Broker: suite.sender.String()}),
+		},
+		{
+			name: "transfer: invalid broker: invalid prefix",
+			msg:  completeMsgTransfer(types.MsgTransferToEscrow{Broker: invalidPrefixAddr}),
+		},
 		{
 			name: "transfer: invalid sender: invalid bech32",
 			msg:  completeMsgTransfer(types.MsgTransferToEscrow{Sender: invalidBech32Addr}),
@@ -260,6 +340,121 @@ func (suite *MsgTestSuite) TestMsgValidate() {
 			name: "refund: invalid escrow ID: invalid length",
 			msg:  completeMsgRefund(types.MsgRefundEscrow{Id: invalidIDLength}),
 		},
+		{
+			name: "create offer: valid on an object owned by someone else",
+			msg: &types.MsgCreateOffer{
+				Buyer:    suite.sender.String(),
+				Object:   randomOwnerObj,
+				Price:    suite.msgCreate.Price,
+				Deadline: suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create offer: invalid buyer address: invalid prefix",
+			msg: &types.MsgCreateOffer{
+				Buyer:    invalidPrefixAddr,
+				Object:   randomOwnerObj,
+				Price:    suite.msgCreate.Price,
+				Deadline: suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create offer: invalid price: negative",
+			msg: &types.MsgCreateOffer{
+				Buyer:    suite.sender.String(),
+				Object:   randomOwnerObj,
+				Price:    negativePrice,
+				Deadline: suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create offer: invalid object: not a transferable object",
+			msg: &types.MsgCreateOffer{
+				Buyer:    suite.sender.String(),
+				Object:   invalidInterfaceObj,
+				Price:    suite.msgCreate.Price,
+				Deadline: suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "accept offer: valid",
+			msg:  &types.MsgAcceptOffer{Id: suite.msgRefund.Id, Seller: suite.sender.String()},
+		},
+		{
+			name: "accept offer: invalid offer ID: not hexadecimal",
+			msg:  &types.MsgAcceptOffer{Id: invalidIDHexa, Seller: suite.sender.String()},
+		},
+		{
+			name: "accept offer: invalid seller: not bech32",
+			msg:  &types.MsgAcceptOffer{Id: suite.msgRefund.Id, Seller: invalidBech32Addr},
+		},
+		{
+			name: "withdraw offer: valid",
+			msg:  &types.MsgWithdrawOffer{Id: suite.msgRefund.Id, Buyer: suite.sender.String()},
+		},
+		{
+			name: "withdraw offer: invalid offer ID: invalid length",
+			msg:  &types.MsgWithdrawOffer{Id: invalidIDLength, Buyer: suite.sender.String()},
+		},
+		{
+			name: "withdraw offer: invalid fee payer: invalid prefix",
+			msg:  &types.MsgWithdrawOffer{Id: suite.msgRefund.Id, Buyer: suite.sender.String(), FeePayer: invalidPrefixAddr},
+		},
+		{
+			name: "create swap: valid without price",
+			msg: &types.MsgCreateSwap{
+				Seller:       suite.sender.String(),
+				Object:       suite.msgCreate.Object,
+				WantedObject: randomOwnerObj,
+				Deadline:     suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create swap: valid with price",
+			msg: &types.MsgCreateSwap{
+				Seller:       suite.sender.String(),
+				Object:       suite.msgCreate.Object,
+				WantedObject: randomOwnerObj,
+				Price:        suite.msgCreate.Price,
+				Deadline:     suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create swap: invalid wanted object: swapped for itself",
+			msg: &types.MsgCreateSwap{
+				Seller:       suite.sender.String(),
+				Object:       suite.msgCreate.Object,
+				WantedObject: suite.msgCreate.Object,
+				Deadline:     suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create swap: invalid wanted object: not a transferable object",
+			msg: &types.MsgCreateSwap{
+				Seller:       suite.sender.String(),
+				Object:       suite.msgCreate.Object,
+				WantedObject: invalidInterfaceObj,
+				Deadline:     suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "create swap: invalid price: negative",
+			msg: &types.MsgCreateSwap{
+				Seller:       suite.sender.String(),
+				Object:       suite.msgCreate.Object,
+				WantedObject: randomOwnerObj,
+				Price:        negativePrice,
+				Deadline:     suite.msgCreate.Deadline,
+			},
+		},
+		{
+			name: "complete swap: valid",
+			msg:  &types.MsgCompleteSwap{Id: suite.msgRefund.Id, Sender: suite.sender.String()},
+		},
+		{
+			name: "complete swap: invalid sender: not bech32",
+			msg:  &types.MsgCompleteSwap{Id: suite.msgRefund.Id, Sender: invalidBech32Addr},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,103 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	crud "github.com/iov-one/cosmos-sdk-crud"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Check that the offer implements crud.Object
+var _ crud.Object = &Offer{}
+
+const (
+	// OfferBuyerIndex represents the buyer as a secondary key of an offer
+	OfferBuyerIndex = 0x01
+	// OfferObjectIndex represents the object as a secondary key of an offer
+	// The object is represented by its primary key
+	OfferObjectIndex = 0x02
+)
+
+// NewOffer constructs a new offer instance
+func NewOffer(
+	id string,
+	buyer sdk.AccAddress,
+	price sdk.Coins,
+	object TransferableObject,
+	deadline uint64,
+) Offer {
+	objectAny, err := codectypes.NewAnyWithValue(object)
+	if err != nil {
+		panic(err)
+	}
+	return Offer{
+		Id:       id,
+		Buyer:    buyer.String(),
+		Object:   objectAny,
+		Price:    price,
+		Deadline: deadline,
+	}
+}
+
+// PrimaryKey implements crud.Object
+func (o Offer) PrimaryKey() []byte {
+	return GetEscrowKey(o.Id)
+}
+
+// SecondaryKeys implements crud.Object
+func (o Offer) SecondaryKeys() []crud.SecondaryKey {
+	// If this is an empty object, return an empty array
+	if len(o.Id) == 0 {
+		return make([]crud.SecondaryKey, 0)
+	}
+	sks := make([]crud.SecondaryKey, 0, 2)
+	buyer, err := sdk.AccAddressFromBech32(o.Buyer)
+	if err == nil {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    OfferBuyerIndex,
+			Value: buyer,
+		})
+	}
+	for _, key := range getObjectIndexKeys(o.GetObject()) {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    OfferObjectIndex,
+			Value: key,
+		})
+	}
+	return sks
+}
+
+// UnpackInterfaces make sure the Anys included in Offer are unpacked (e.g the object field)
+func (o *Offer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if o.Object != nil {
+		var obj TransferableObject
+		return unpacker.UnpackAny(o.Object, &obj)
+	}
+
+	return nil
+}
+
+// ValidateWithoutObject validates the offer without validating the object, if priceDenom is empty, does not validate
+// the price denomination
+func (o Offer) ValidateWithoutObject(priceDenom string, lastBlockTime uint64) error {
+	if err := ValidateID(o.Id); err != nil {
+		return err
+	}
+	// Validate buyer address
+	if err := ValidateAddress(o.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	// Validate price
+	if err := ValidatePrice(o.Price, priceDenom); err != nil {
+		return err
+	}
+
+	// Validate deadline
+	return ValidateDeadline(o.Deadline, lastBlockTime)
+}
+
+func (o *Offer) GetObject() TransferableObject {
+	return o.Object.GetCachedValue().(TransferableObject)
+}
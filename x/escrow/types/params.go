@@ -2,18 +2,40 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Default parameter values
 const (
-	DefaultModuleEnabled bool = true
+	DefaultModuleEnabled bool          = true
+	DefaultBroker        string        = "star1nrnx8mft8mks3l2akduxdjlf8rwqs8r9l36a78" // IOV's multisig
+	DefaultMaxPeriod     time.Duration = 7890000 * time.Second                         // 3 months
+	DefaultPriceDenom    string        = "tiov"
+
+	DefaultMaxExpirationsPerBlock uint64 = 100
+)
+
+// Default parameter values that are not constants
+var (
+	DefaultCommission    = sdk.NewDecWithPrec(1, 2) // 1%
+	DefaultCommissionMax = sdk.NewDecWithPrec(5, 2) // 5%
 )
 
 // Parameter keys
 var (
 	KeyModuleEnabled = []byte("ModuleEnabled")
+	KeyBroker        = []byte("Broker")
+	KeyCommission    = []byte("Commission")
+	KeyCommissionMax = []byte("CommissionMax")
+	KeyMaxPeriod     = []byte("MaxPeriod")
+	KeyPriceDenom    = []byte("PriceDenom")
+	KeyFees          = []byte("Fees")
+
+	KeyMaxExpirationsPerBlock = []byte("MaxExpirationsPerBlock")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -29,13 +51,33 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyModuleEnabled, &p.ModuleEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyBroker, &p.Broker, validateBroker),
+		paramtypes.NewParamSetPair(KeyCommission, &p.Commission, validateCommission),
+		paramtypes.NewParamSetPair(KeyCommissionMax, &p.CommissionMax, validateCommission),
+		paramtypes.NewParamSetPair(KeyMaxPeriod, &p.MaxPeriod, validateMaxPeriod),
+		paramtypes.NewParamSetPair(KeyPriceDenom, &p.PriceDenom, validatePriceDenom),
+		paramtypes.NewParamSetPair(KeyFees, &p.Fees, validateFees),
+		paramtypes.NewParamSetPair(KeyMaxExpirationsPerBlock, &p.MaxExpirationsPerBlock, validateMaxExpirationsPerBlock),
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(DefaultPriceDenom, 1))
 	return Params{
 		ModuleEnabled: DefaultModuleEnabled,
+		Broker:        DefaultBroker,
+		Commission:    DefaultCommission,
+		CommissionMax: DefaultCommissionMax,
+		MaxPeriod:     DefaultMaxPeriod,
+		PriceDenom:    DefaultPriceDenom,
+		Fees: Fees{
+			CreateEscrow:     fee,
+			UpdateEscrow:     fee,
+			TransferToEscrow: fee,
+			RefundEscrow:     fee,
+		},
+		MaxExpirationsPerBlock: DefaultMaxExpirationsPerBlock,
 	}
 }
 
@@ -47,10 +89,113 @@ func validateIsBool(i interface{}) error {
 	return nil
 }
 
+func validateBroker(i interface{}) error {
+	broker, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected string", i)
+	}
+	// The bech32 prefix is not checked as the parameters can be validated before the address prefixes are configured
+	_, addr, err := bech32.DecodeAndConvert(broker)
+	if err != nil {
+		return fmt.Errorf("invalid broker address: %s", err)
+	}
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return fmt.Errorf("invalid broker address: %s", err)
+	}
+	return nil
+}
+
+func validateCommission(i interface{}) error {
+	commission, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected sdk.Dec", i)
+	}
+	if commission.IsNil() || commission.IsNegative() || commission.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid commission: not in interval [0;1]")
+	}
+	return nil
+}
+
+func validateMaxPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected time.Duration", i)
+	}
+	if period <= 0 {
+		return fmt.Errorf("invalid maximum period: must be positive")
+	}
+	return nil
+}
+
+func validatePriceDenom(i interface{}) error {
+	denom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected string", i)
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+func validateMaxExpirationsPerBlock(i interface{}) error {
+	max, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected uint64", i)
+	}
+	if max == 0 {
+		return fmt.Errorf("invalid maximum expirations per block: must be positive")
+	}
+	return nil
+}
+
+func validateFees(i interface{}) error {
+	fees, ok := i.(Fees)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected Fees", i)
+	}
+	return fees.Validate()
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateIsBool(p.ModuleEnabled); err != nil {
 		return err
 	}
+	if err := validateBroker(p.Broker); err != nil {
+		return err
+	}
+	if err := validateCommission(p.Commission); err != nil {
+		return err
+	}
+	if err := validateCommission(p.CommissionMax); err != nil {
+		return err
+	}
+	if p.CommissionMax.LT(p.Commission) {
+		return fmt.Errorf("invalid commission maximum: lower than the commission")
+	}
+	if err := validateMaxPeriod(p.MaxPeriod); err != nil {
+		return err
+	}
+	if err := validatePriceDenom(p.PriceDenom); err != nil {
+		return err
+	}
+	if err := validateMaxExpirationsPerBlock(p.MaxExpirationsPerBlock); err != nil {
+		return err
+	}
+	return p.Fees.Validate()
+}
+
+// Validate checks that the fees are valid coins
+func (f Fees) Validate() error {
+	if err := f.CreateEscrow.Validate(); err != nil {
+		return fmt.Errorf("invalid create_escrow fee: %s", err)
+	}
+	if err := f.UpdateEscrow.Validate(); err != nil {
+		return fmt.Errorf("invalid update_escrow fee: %s", err)
+	}
+	if err := f.TransferToEscrow.Validate(); err != nil {
+		return fmt.Errorf("invalid transfer_to_escrow fee: %s", err)
+	}
+	if err := f.RefundEscrow.Validate(); err != nil {
+		return fmt.Errorf("invalid refund_escrow fee: %s", err)
+	}
 	return nil
 }
@@ -7,18 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +34,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters of the escrow module
 type Params struct {
 	ModuleEnabled bool `protobuf:"varint,1,opt,name=module_enabled,json=moduleEnabled,proto3" json:"module_enabled,omitempty"`
+	// Broker defines the address that receives the commission of the escrows
+	// listed without a broker of their own
+	Broker string `protobuf:"bytes,2,opt,name=broker,proto3" json:"broker,omitempty" yaml:"broker"`
+	// Commission defines the commission taken by the broker on a completed
+	// escrow, between 0 (no commission) and 1 (100% commission)
+	Commission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission" yaml:"commission"`
+	// CommissionMax defines the maximum commission the marketplaces listing
+	// escrows with their own broker can set
+	CommissionMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_max,json=commissionMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_max" yaml:"commission_max"`
+	// MaxPeriod defines the maximum duration of an escrow
+	MaxPeriod time.Duration `protobuf:"bytes,5,opt,name=max_period,json=maxPeriod,proto3,stdduration" json:"max_period" yaml:"max_period"`
+	// PriceDenom defines the denomination of the prices of the escrows
+	PriceDenom string `protobuf:"bytes,6,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty" yaml:"price_denom"`
+	// Fees defines the fees paid for the escrow operations
+	Fees Fees `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees" yaml:"fees"`
+	// MaxExpirationsPerBlock defines the maximum number of escrows, and of
+	// offers, with a passed deadline processed at the beginning of a block, the
+	// remaining ones are processed in the following blocks
+	MaxExpirationsPerBlock uint64 `protobuf:"varint,8,opt,name=max_expirations_per_block,json=maxExpirationsPerBlock,proto3" json:"max_expirations_per_block,omitempty" yaml:"max_expirations_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -64,29 +88,101 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Fees defines the fees paid for the escrow operations
+type Fees struct {
+	// CreateEscrow is the fee paid to create an escrow, a swap or an offer
+	CreateEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=create_escrow,json=createEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"create_escrow" yaml:"create_escrow"`
+	// UpdateEscrow is the fee paid to update an escrow
+	UpdateEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=update_escrow,json=updateEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"update_escrow" yaml:"update_escrow"`
+	// TransferToEscrow is the fee paid to complete an escrow, a swap or an offer
+	TransferToEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=transfer_to_escrow,json=transferToEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transfer_to_escrow" yaml:"transfer_to_escrow"`
+	// RefundEscrow is the fee paid to refund an escrow or withdraw an offer
+	RefundEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=refund_escrow,json=refundEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund_escrow" yaml:"refund_escrow"`
+}
+
+func (m *Fees) Reset()         { *m = Fees{} }
+func (m *Fees) String() string { return proto.CompactTextString(m) }
+func (*Fees) ProtoMessage()    {}
+func (*Fees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e4af5fc7b8f17f7, []int{1}
+}
+func (m *Fees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fees.Merge(m, src)
+}
+func (m *Fees) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fees) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fees proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "starnamed.x.escrow.v1beta1.Params")
+	proto.RegisterType((*Fees)(nil), "starnamed.x.escrow.v1beta1.Fees")
 }
 
 func init() { proto.RegisterFile("iov/escrow/v1beta1/params.proto", fileDescriptor_8e4af5fc7b8f17f7) }
 
 var fileDescriptor_8e4af5fc7b8f17f7 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x50, 0x3f, 0x4b, 0xc4, 0x30,
-	0x14, 0x6f, 0x96, 0x43, 0x0a, 0x3a, 0x1c, 0x0e, 0xda, 0x21, 0x8a, 0x20, 0x08, 0x62, 0x1e, 0x87,
-	0xdf, 0x40, 0x70, 0x70, 0x13, 0x47, 0x97, 0x23, 0x69, 0x9f, 0xb1, 0xd0, 0xe4, 0x95, 0x26, 0xad,
-	0x77, 0xdf, 0xc2, 0x8f, 0x75, 0xe3, 0x8d, 0x8e, 0xda, 0x7e, 0x11, 0xb9, 0xbc, 0xbb, 0xdb, 0x7e,
-	0x7f, 0xc3, 0x2f, 0x2f, 0xbf, 0xaa, 0x69, 0x00, 0x0c, 0x65, 0x47, 0x5f, 0x30, 0x2c, 0x0c, 0x46,
-	0xbd, 0x80, 0x56, 0x77, 0xda, 0x05, 0xd5, 0x76, 0x14, 0x69, 0x5e, 0x84, 0xa8, 0x3b, 0xaf, 0x1d,
-	0x56, 0x6a, 0xa5, 0x38, 0xa8, 0xf6, 0xc1, 0x42, 0x96, 0x14, 0x1c, 0x05, 0x30, 0x3a, 0xe0, 0xb1,
-	0x5d, 0x52, 0xed, 0xb9, 0x5b, 0x9c, 0x5b, 0xb2, 0x94, 0x20, 0xec, 0xd0, 0x5e, 0xbd, 0xb4, 0x44,
-	0xb6, 0x41, 0x48, 0xcc, 0xf4, 0x1f, 0xa0, 0xfd, 0xfa, 0x60, 0xf1, 0x83, 0x4b, 0xee, 0x30, 0x61,
-	0xeb, 0x06, 0xf2, 0xd9, 0x6b, 0xda, 0x35, 0xbf, 0xcd, 0xcf, 0x1c, 0x55, 0x7d, 0x83, 0x4b, 0xf4,
-	0xda, 0x34, 0x58, 0x5d, 0x88, 0x6b, 0x71, 0x77, 0xf2, 0x76, 0xca, 0xea, 0x33, 0x8b, 0x4f, 0x2f,
-	0x9b, 0x3f, 0x99, 0x6d, 0x46, 0x29, 0xb6, 0xa3, 0x14, 0xbf, 0xa3, 0x14, 0xdf, 0x93, 0xcc, 0xb6,
-	0x93, 0xcc, 0x7e, 0x26, 0x99, 0xbd, 0xdf, 0xdb, 0x3a, 0x7e, 0xf6, 0x46, 0x95, 0xe4, 0xa0, 0xa6,
-	0xe1, 0x81, 0x3c, 0xc2, 0xf1, 0xa7, 0xb0, 0x3a, 0x9c, 0x24, 0xae, 0x5b, 0x0c, 0x66, 0x96, 0x26,
-	0x3c, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0xee, 0xa9, 0x6a, 0x2f, 0x2d, 0x01, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0xd6, 0x37, 0xb7, 0x9d, 0xde, 0x54, 0xb7, 0xbe, 0xbd, 0x95, 0x53, 0x09, 0xdb,
+	0xb2, 0x00, 0x05, 0xa1, 0xda, 0x2a, 0x2c, 0x90, 0x58, 0xba, 0x2d, 0xd0, 0x45, 0xa5, 0xca, 0x42,
+	0x42, 0x62, 0x63, 0x8d, 0xed, 0x49, 0xb0, 0x9a, 0xf1, 0xb1, 0x66, 0x9c, 0xe2, 0xbe, 0x01, 0x4b,
+	0x56, 0x88, 0x67, 0xe0, 0x29, 0x58, 0x76, 0xd9, 0x25, 0x62, 0x91, 0x42, 0xbb, 0x66, 0x93, 0x27,
+	0x40, 0x9e, 0x99, 0xd4, 0x69, 0x01, 0x41, 0x56, 0xf1, 0xf9, 0xf3, 0x7d, 0xf3, 0x9b, 0x39, 0x33,
+	0x41, 0x76, 0x06, 0xc7, 0x3e, 0xe1, 0x09, 0x83, 0xd7, 0xfe, 0xf1, 0x76, 0x4c, 0x4a, 0xbc, 0xed,
+	0x17, 0x98, 0x61, 0xca, 0xbd, 0x82, 0x41, 0x09, 0xc6, 0x26, 0x2f, 0x31, 0xcb, 0x31, 0x25, 0xa9,
+	0x57, 0x79, 0xb2, 0xd1, 0x53, 0x8d, 0x9b, 0x56, 0x02, 0x9c, 0x02, 0xf7, 0x63, 0xcc, 0xc9, 0x95,
+	0x3a, 0x81, 0x2c, 0x97, 0xda, 0xcd, 0xf5, 0x01, 0x0c, 0x40, 0x7c, 0xfa, 0xf5, 0x97, 0xca, 0x76,
+	0x07, 0x00, 0x83, 0x21, 0xf1, 0x45, 0x14, 0x8f, 0xfa, 0x3e, 0xce, 0x4f, 0x54, 0xc9, 0xba, 0x59,
+	0x4a, 0x47, 0x0c, 0x97, 0x19, 0x4c, 0x0d, 0xbb, 0x72, 0xc1, 0x48, 0x7a, 0xca, 0x40, 0x96, 0xdc,
+	0x6f, 0x3a, 0x6a, 0x1f, 0x0a, 0x70, 0xe3, 0x0e, 0x5a, 0xa5, 0x90, 0x8e, 0x86, 0x24, 0x22, 0x39,
+	0x8e, 0x87, 0x24, 0x35, 0x35, 0x47, 0xeb, 0x2d, 0x85, 0x1d, 0x99, 0xdd, 0x93, 0x49, 0xe3, 0x1e,
+	0x6a, 0xc7, 0x0c, 0x8e, 0x08, 0x33, 0x17, 0x1c, 0xad, 0xb7, 0x1c, 0xac, 0x4d, 0xc6, 0x76, 0xe7,
+	0x04, 0xd3, 0xe1, 0x63, 0x57, 0xe6, 0xdd, 0x50, 0x35, 0x18, 0x09, 0x42, 0x09, 0x50, 0x9a, 0x71,
+	0x9e, 0x41, 0x6e, 0x2e, 0x8a, 0xf6, 0x9d, 0xd3, 0xb1, 0xdd, 0xfa, 0x3c, 0xb6, 0xef, 0x0e, 0xb2,
+	0xf2, 0xd5, 0x28, 0xf6, 0x12, 0xa0, 0x8a, 0x48, 0xfd, 0x6c, 0xf1, 0xf4, 0xc8, 0x2f, 0x4f, 0x0a,
+	0xc2, 0xbd, 0x5d, 0x92, 0x4c, 0xc6, 0xf6, 0x9a, 0x34, 0x6f, 0x9c, 0xdc, 0x70, 0xc6, 0xd6, 0xc8,
+	0xd1, 0x6a, 0x13, 0x45, 0x14, 0x57, 0xa6, 0x2e, 0x16, 0x7a, 0x3a, 0xf7, 0x42, 0xff, 0xdf, 0x5c,
+	0xa8, 0x76, 0x73, 0xc3, 0x4e, 0x93, 0x38, 0xc0, 0x95, 0xf1, 0x02, 0x21, 0x8a, 0xab, 0xa8, 0x20,
+	0x2c, 0x83, 0xd4, 0xfc, 0xcb, 0xd1, 0x7a, 0x2b, 0x0f, 0xba, 0x9e, 0x9c, 0x80, 0x37, 0x9d, 0x80,
+	0xb7, 0xab, 0x26, 0x10, 0xdc, 0xaa, 0x31, 0x9a, 0x5d, 0x34, 0x52, 0xf7, 0xfd, 0xb9, 0xad, 0x85,
+	0xcb, 0x14, 0x57, 0x87, 0x22, 0x36, 0x1e, 0xa1, 0x95, 0x82, 0x65, 0x09, 0x89, 0x52, 0x92, 0x03,
+	0x35, 0xdb, 0x62, 0x17, 0x1b, 0x93, 0xb1, 0x6d, 0x48, 0xe9, 0x4c, 0xd1, 0x0d, 0x91, 0x88, 0x76,
+	0xeb, 0xc0, 0xd8, 0x47, 0x7a, 0x9f, 0x10, 0x6e, 0xfe, 0x2d, 0x58, 0x1c, 0xef, 0xd7, 0x57, 0xcf,
+	0x7b, 0x42, 0x08, 0x0f, 0xfe, 0x53, 0x48, 0x2b, 0xd2, 0xb7, 0xd6, 0xba, 0xa1, 0xb0, 0x30, 0x22,
+	0xd4, 0xad, 0x09, 0x49, 0x55, 0x64, 0x92, 0x9f, 0xd7, 0xb4, 0x51, 0x3c, 0x84, 0xe4, 0xc8, 0x5c,
+	0x72, 0xb4, 0x9e, 0x1e, 0xdc, 0x9e, 0x8c, 0x6d, 0xa7, 0xd9, 0xcc, 0x4f, 0x5b, 0xdd, 0x70, 0x83,
+	0xe2, 0x6a, 0xaf, 0x29, 0x1d, 0x12, 0x16, 0x88, 0xc2, 0x47, 0x1d, 0xe9, 0x35, 0x84, 0xf1, 0x46,
+	0x43, 0x9d, 0x84, 0x11, 0x5c, 0x92, 0x48, 0x32, 0x9a, 0x9a, 0xb3, 0x28, 0x8e, 0x52, 0xdd, 0xcf,
+	0xfa, 0x75, 0x5c, 0x71, 0xef, 0x40, 0x96, 0x07, 0xcf, 0x14, 0xf7, 0xba, 0x9a, 0xd3, 0xac, 0xda,
+	0xfd, 0x70, 0x6e, 0xf7, 0xfe, 0x60, 0xd2, 0xb5, 0x11, 0x0f, 0xff, 0x91, 0xda, 0x3d, 0x21, 0x15,
+	0x28, 0xa3, 0x22, 0x9d, 0x41, 0x59, 0x98, 0x13, 0xe5, 0x9a, 0x7a, 0x4e, 0x14, 0xa9, 0x55, 0x28,
+	0xef, 0x34, 0x64, 0x94, 0x0c, 0xe7, 0xbc, 0x4f, 0x58, 0x54, 0xc2, 0x94, 0x67, 0xf1, 0x77, 0x3c,
+	0x07, 0x8a, 0xa7, 0x2b, 0x79, 0x7e, 0xb4, 0x98, 0x0f, 0xea, 0xdf, 0xa9, 0xc1, 0x73, 0x98, 0x39,
+	0x23, 0x46, 0xfa, 0xa3, 0x3c, 0x9d, 0x32, 0xe9, 0x73, 0x9e, 0xd1, 0x35, 0xf5, 0x9c, 0x67, 0x24,
+	0xb5, 0x12, 0x25, 0xd8, 0x3f, 0xfd, 0x6a, 0xb5, 0x4e, 0x2f, 0x2c, 0xed, 0xec, 0xc2, 0xd2, 0xbe,
+	0x5c, 0x58, 0xda, 0xdb, 0x4b, 0xab, 0x75, 0x76, 0x69, 0xb5, 0x3e, 0x5d, 0x5a, 0xad, 0x97, 0xf7,
+	0x67, 0x5c, 0x33, 0x38, 0xde, 0x82, 0x9c, 0xf8, 0x57, 0x0f, 0xc2, 0xaf, 0xa6, 0x7f, 0xda, 0xc2,
+	0x3e, 0x6e, 0x8b, 0xf7, 0xfa, 0xf0, 0xfb, 0x00, 0x00, 0x7c, 0x6f, 0x05, 0xcf, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +205,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExpirationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpirationsPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CommissionMax.Size()
+		i -= size
+		if _, err := m.CommissionMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ModuleEnabled {
 		i--
 		if m.ModuleEnabled {
@@ -122,6 +275,85 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Fees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundEscrow) > 0 {
+		for iNdEx := len(m.RefundEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TransferToEscrow) > 0 {
+		for iNdEx := len(m.TransferToEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferToEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UpdateEscrow) > 0 {
+		for iNdEx := len(m.UpdateEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CreateEscrow) > 0 {
+		for iNdEx := len(m.CreateEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -142,6 +374,58 @@ func (m *Params) Size() (n int) {
 	if m.ModuleEnabled {
 		n += 2
 	}
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommissionMax.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxExpirationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpirationsPerBlock))
+	}
+	return n
+}
+
+func (m *Fees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreateEscrow) > 0 {
+		for _, e := range m.CreateEscrow {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.UpdateEscrow) > 0 {
+		for _, e := range m.UpdateEscrow {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TransferToEscrow) > 0 {
+		for _, e := range m.TransferToEscrow {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RefundEscrow) > 0 {
+		for _, e := range m.RefundEscrow {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -200,6 +484,409 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ModuleEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpirationsPerBlock", wireType)
+			}
+			m.MaxExpirationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpirationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateEscrow = append(m.CreateEscrow, types.Coin{})
+			if err := m.CreateEscrow[len(m.CreateEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateEscrow = append(m.UpdateEscrow, types.Coin{})
+			if err := m.UpdateEscrow[len(m.UpdateEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferToEscrow = append(m.TransferToEscrow, types.Coin{})
+			if err := m.TransferToEscrow[len(m.TransferToEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundEscrow = append(m.RefundEscrow, types.Coin{})
+			if err := m.RefundEscrow[len(m.RefundEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
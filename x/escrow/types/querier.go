@@ -5,6 +5,9 @@ import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 const (
 	QueryEscrow  = "escrow"  // query an escrow
 	QueryEscrows = "escrows" // query multiple escrows
+	QueryOffer   = "offer"   // query an offer
+	QueryOffers  = "offers"  // query multiple offers
+	QueryRecords = "records" // query multiple escrow records
 )
 
 // QueryEscrowParams defines the params to query an escrow
@@ -17,6 +20,41 @@ type QueryEscrowsParams struct {
 	Seller                            string
 	State                             string
 	ObjectKey                         string
+	WantedObjectKey                   string
+	Buyer                             string
+	ObjectType                        uint64
+	Domain                            string
+	PriceMin, PriceMax                string
+	DeadlineMin, DeadlineMax          uint64
+	OrderBy                           string
+	Descending                        bool
+	PaginationStart, PaginationLength uint64
+}
+
+// QueryOfferParams defines the params to query an offer
+type QueryOfferParams struct {
+	Id string
+}
+
+// QueryOffersParams defines the parameters to query multiple offers
+type QueryOffersParams struct {
+	Buyer                             string
+	ObjectKey                         string
+	PaginationStart, PaginationLength uint64
+}
+
+// QueryRecordsParams defines the parameters to query multiple escrow records
+type QueryRecordsParams struct {
+	Seller                            string
+	Buyer                             string
+	State                             string
+	ObjectKey                         string
+	ObjectType                        uint64
+	Domain                            string
+	PriceMin, PriceMax                string
+	TimeMin, TimeMax                  uint64
+	OrderBy                           string
+	Descending                        bool
 	PaginationStart, PaginationLength uint64
 }
 
@@ -41,3 +79,23 @@ func (q *QueryEscrowsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 
 	return nil
 }
+
+// UnpackInterfaces make sure the Anys included in QueryOfferResponse are unpacked (e.g the object field)
+func (q *QueryOfferResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if q.Offer != nil {
+		return q.Offer.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces make sure the Anys included in the QueryOffersResponse are unpacked (e.g the object field)
+func (q *QueryOffersResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range q.Offers {
+		if err := q.Offers[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -77,6 +79,12 @@ func (m *QueryEscrowRequest) GetId() string {
 // QueryEscrowResponse is the response type for the Query/Escrow RPC method
 type QueryEscrowResponse struct {
 	Escrow *Escrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	// current_price is the price to pay to complete the escrow at the time of
+	// the query
+	CurrentPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=current_price,json=currentPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_price"`
+	// royalties are the royalties that would be paid if the escrow was
+	// completed at the current price
+	Royalties []Royalty `protobuf:"bytes,3,rep,name=royalties,proto3" json:"royalties"`
 }
 
 func (m *QueryEscrowResponse) Reset()         { *m = QueryEscrowResponse{} }
@@ -119,6 +127,20 @@ func (m *QueryEscrowResponse) GetEscrow() *Escrow {
 	return nil
 }
 
+func (m *QueryEscrowResponse) GetCurrentPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentPrice
+	}
+	return nil
+}
+
+func (m *QueryEscrowResponse) GetRoyalties() []Royalty {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
 // QueryEscrowsRequest is the request type for the Query/Escrows RPC method
 type QueryEscrowsRequest struct {
 	Seller           string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
@@ -126,6 +148,16 @@ type QueryEscrowsRequest struct {
 	ObjectKey        string `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
 	PaginationStart  uint64 `protobuf:"varint,4,opt,name=pagination_start,json=paginationStart,proto3" json:"pagination_start,omitempty"`
 	PaginationLength uint64 `protobuf:"varint,5,opt,name=pagination_length,json=paginationLength,proto3" json:"pagination_length,omitempty"`
+	WantedObjectKey  string `protobuf:"bytes,6,opt,name=wanted_object_key,json=wantedObjectKey,proto3" json:"wanted_object_key,omitempty"`
+	ObjectType       uint64 `protobuf:"varint,7,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	Domain           string `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain,omitempty"`
+	PriceMin         string `protobuf:"bytes,9,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`
+	PriceMax         string `protobuf:"bytes,10,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
+	DeadlineMin      uint64 `protobuf:"varint,11,opt,name=deadline_min,json=deadlineMin,proto3" json:"deadline_min,omitempty"`
+	DeadlineMax      uint64 `protobuf:"varint,12,opt,name=deadline_max,json=deadlineMax,proto3" json:"deadline_max,omitempty"`
+	OrderBy          string `protobuf:"bytes,13,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending       bool   `protobuf:"varint,14,opt,name=descending,proto3" json:"descending,omitempty"`
+	Buyer            string `protobuf:"bytes,15,opt,name=buyer,proto3" json:"buyer,omitempty"`
 }
 
 func (m *QueryEscrowsRequest) Reset()         { *m = QueryEscrowsRequest{} }
@@ -196,6 +228,76 @@ func (m *QueryEscrowsRequest) GetPaginationLength() uint64 {
 	return 0
 }
 
+func (m *QueryEscrowsRequest) GetWantedObjectKey() string {
+	if m != nil {
+		return m.WantedObjectKey
+	}
+	return ""
+}
+
+func (m *QueryEscrowsRequest) GetObjectType() uint64 {
+	if m != nil {
+		return m.ObjectType
+	}
+	return 0
+}
+
+func (m *QueryEscrowsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *QueryEscrowsRequest) GetPriceMin() string {
+	if m != nil {
+		return m.PriceMin
+	}
+	return ""
+}
+
+func (m *QueryEscrowsRequest) GetPriceMax() string {
+	if m != nil {
+		return m.PriceMax
+	}
+	return ""
+}
+
+func (m *QueryEscrowsRequest) GetDeadlineMin() uint64 {
+	if m != nil {
+		return m.DeadlineMin
+	}
+	return 0
+}
+
+func (m *QueryEscrowsRequest) GetDeadlineMax() uint64 {
+	if m != nil {
+		return m.DeadlineMax
+	}
+	return 0
+}
+
+func (m *QueryEscrowsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *QueryEscrowsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *QueryEscrowsRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
 // QueryEscrowsResponse is the response type for the Query/Escrows RPC method
 type QueryEscrowsResponse struct {
 	Escrows []Escrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
@@ -241,410 +343,2737 @@ func (m *QueryEscrowsResponse) GetEscrows() []Escrow {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryEscrowRequest)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowRequest")
-	proto.RegisterType((*QueryEscrowResponse)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowResponse")
-	proto.RegisterType((*QueryEscrowsRequest)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowsRequest")
-	proto.RegisterType((*QueryEscrowsResponse)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowsResponse")
+// QueryEscrowRecordsRequest is the request type for the Query/EscrowRecords
+// RPC method
+type QueryEscrowRecordsRequest struct {
+	Seller           string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer            string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	State            string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ObjectKey        string `protobuf:"bytes,4,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	ObjectType       uint64 `protobuf:"varint,5,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	Domain           string `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	PriceMin         string `protobuf:"bytes,7,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`
+	PriceMax         string `protobuf:"bytes,8,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
+	TimeMin          uint64 `protobuf:"varint,9,opt,name=time_min,json=timeMin,proto3" json:"time_min,omitempty"`
+	TimeMax          uint64 `protobuf:"varint,10,opt,name=time_max,json=timeMax,proto3" json:"time_max,omitempty"`
+	OrderBy          string `protobuf:"bytes,11,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending       bool   `protobuf:"varint,12,opt,name=descending,proto3" json:"descending,omitempty"`
+	PaginationStart  uint64 `protobuf:"varint,13,opt,name=pagination_start,json=paginationStart,proto3" json:"pagination_start,omitempty"`
+	PaginationLength uint64 `protobuf:"varint,14,opt,name=pagination_length,json=paginationLength,proto3" json:"pagination_length,omitempty"`
 }
 
-func init() { proto.RegisterFile("iov/escrow/v1beta1/query.proto", fileDescriptor_7e53d70ef3e4e87e) }
-
-var fileDescriptor_7e53d70ef3e4e87e = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0x13, 0x31,
-	0x18, 0x8e, 0xaf, 0x49, 0xaa, 0xbe, 0x48, 0x94, 0xba, 0x01, 0x4e, 0x07, 0x1c, 0xd1, 0x89, 0xa1,
-	0xa8, 0xc2, 0xa6, 0x65, 0x63, 0x8c, 0xd4, 0x09, 0x96, 0x86, 0xad, 0x4b, 0xe5, 0x24, 0xaf, 0xae,
-	0x86, 0xd4, 0xbe, 0x9e, 0x9d, 0xd0, 0x08, 0xb1, 0x20, 0xc1, 0x8c, 0xc4, 0xc2, 0x6f, 0xe1, 0x17,
-	0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x0f, 0x41, 0xb1, 0x7d, 0x90, 0x08, 0xa8, 0x32, 0x9d,
-	0xfd, 0xf8, 0xf9, 0xf2, 0xc7, 0x41, 0x2a, 0xf5, 0x98, 0xa3, 0xe9, 0x97, 0xfa, 0x35, 0x1f, 0xef,
-	0xf5, 0xd0, 0x8a, 0x3d, 0x7e, 0x36, 0xc2, 0x72, 0xc2, 0x8a, 0x52, 0x5b, 0x4d, 0x13, 0x63, 0x45,
-	0xa9, 0xc4, 0x29, 0x0e, 0xd8, 0x39, 0xf3, 0x3c, 0x16, 0x78, 0xc9, 0xdd, 0x5c, 0xeb, 0x7c, 0x88,
-	0x5c, 0x14, 0x92, 0x0b, 0xa5, 0xb4, 0x15, 0x56, 0x6a, 0x65, 0xbc, 0x32, 0xf9, 0x97, 0xb3, 0x9d,
-	0x14, 0x58, 0xad, 0xb7, 0x72, 0x9d, 0x6b, 0x37, 0xe4, 0xf3, 0x91, 0x47, 0xb3, 0x07, 0x40, 0x0f,
-	0xe7, 0xf1, 0x07, 0x4e, 0xd8, 0xc5, 0xb3, 0x11, 0x1a, 0x4b, 0xaf, 0x43, 0x24, 0x07, 0x31, 0x69,
-	0x93, 0x9d, 0x8d, 0x6e, 0x24, 0x07, 0xd9, 0x21, 0x6c, 0x2f, 0xb1, 0x4c, 0xa1, 0x95, 0x41, 0xfa,
-	0x14, 0x9a, 0x3e, 0xd0, 0x51, 0xaf, 0xed, 0x67, 0xec, 0xff, 0xed, 0x59, 0xd0, 0x06, 0x45, 0xf6,
-	0x85, 0x2c, 0x79, 0x9a, 0x2a, 0xfa, 0x16, 0x34, 0x0d, 0x0e, 0x87, 0x58, 0x86, 0xf8, 0x30, 0xa3,
-	0x2d, 0x68, 0x18, 0x2b, 0x2c, 0xc6, 0x91, 0x83, 0xfd, 0x84, 0xde, 0x03, 0xd0, 0xbd, 0x97, 0xd8,
-	0xb7, 0xc7, 0xaf, 0x70, 0x12, 0xaf, 0xb9, 0xa5, 0x0d, 0x8f, 0x3c, 0xc3, 0x09, 0x7d, 0x08, 0x37,
-	0x0a, 0x91, 0x4b, 0xe5, 0x0e, 0xea, 0x78, 0x5e, 0xce, 0xc6, 0xf5, 0x36, 0xd9, 0xa9, 0x77, 0x37,
-	0xff, 0xe0, 0x2f, 0xe6, 0x30, 0xdd, 0x85, 0xad, 0x05, 0xea, 0x10, 0x55, 0x6e, 0x4f, 0xe2, 0x86,
-	0xe3, 0x2e, 0x78, 0x3c, 0x77, 0x78, 0x76, 0x04, 0xad, 0xe5, 0xee, 0xe1, 0x40, 0x3a, 0xb0, 0xee,
-	0xb7, 0x67, 0x62, 0xd2, 0x5e, 0x5b, 0xed, 0x44, 0x3a, 0xf5, 0x8b, 0xef, 0xf7, 0x6b, 0xdd, 0x4a,
-	0xb8, 0xff, 0x39, 0x82, 0x86, 0x33, 0xa7, 0x1f, 0x08, 0x34, 0x3d, 0x87, 0xb2, 0xab, 0x7c, 0xfe,
-	0xbe, 0xc0, 0x84, 0xaf, 0xcc, 0xf7, 0xcd, 0xb3, 0x3b, 0xef, 0xbe, 0xfe, 0xfc, 0x14, 0xdd, 0xa4,
-	0xdb, 0xd5, 0x13, 0x0a, 0x9f, 0x37, 0x72, 0xf0, 0x96, 0xbe, 0x27, 0xb0, 0x1e, 0xb6, 0x4a, 0x57,
-	0x75, 0xae, 0x2e, 0x34, 0x79, 0xbc, 0xba, 0x20, 0x74, 0xb9, 0xed, 0xba, 0x6c, 0xd1, 0xcd, 0xe5,
-	0x2e, 0xa6, 0x73, 0x70, 0x31, 0x4d, 0xc9, 0xe5, 0x34, 0x25, 0x3f, 0xa6, 0x29, 0xf9, 0x38, 0x4b,
-	0x6b, 0x97, 0xb3, 0xb4, 0xf6, 0x6d, 0x96, 0xd6, 0x8e, 0x76, 0x73, 0x69, 0x4f, 0x46, 0x3d, 0xd6,
-	0xd7, 0xa7, 0x5c, 0xea, 0xf1, 0x23, 0xad, 0x90, 0xff, 0x8e, 0xe5, 0xe7, 0x95, 0x91, 0xfb, 0x1f,
-	0x7a, 0x4d, 0xf7, 0xf4, 0x9f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xd9, 0x6e, 0xbf, 0x8c,
-	0x03, 0x00, 0x00,
+func (m *QueryEscrowRecordsRequest) Reset()         { *m = QueryEscrowRecordsRequest{} }
+func (m *QueryEscrowRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowRecordsRequest) ProtoMessage()    {}
+func (*QueryEscrowRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e53d70ef3e4e87e, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Escrow queries the escrow by the specified id
-	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
-	// Escrows queries escrows by the specified key-value pairs
-	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+func (m *QueryEscrowRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEscrowRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEscrowRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowRecordsRequest.Merge(m, src)
+}
+func (m *QueryEscrowRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowRecordsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
-	out := new(QueryEscrowResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/Escrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowRecordsRequest) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
-	out := new(QueryEscrowsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/Escrows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEscrowRecordsRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Escrow queries the escrow by the specified id
-	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
-	// Escrows queries escrows by the specified key-value pairs
-	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+func (m *QueryEscrowRecordsRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEscrowRecordsRequest) GetObjectKey() string {
+	if m != nil {
+		return m.ObjectKey
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
-}
-func (*UnimplementedQueryServer) Escrows(ctx context.Context, req *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+func (m *QueryEscrowRecordsRequest) GetObjectType() uint64 {
+	if m != nil {
+		return m.ObjectType
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryEscrowRecordsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Escrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.escrow.v1beta1.Query/Escrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+func (m *QueryEscrowRecordsRequest) GetPriceMin() string {
+	if m != nil {
+		return m.PriceMin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Escrows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.escrow.v1beta1.Query/Escrows",
+func (m *QueryEscrowRecordsRequest) GetPriceMax() string {
+	if m != nil {
+		return m.PriceMax
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	return ""
+}
+
+func (m *QueryEscrowRecordsRequest) GetTimeMin() uint64 {
+	if m != nil {
+		return m.TimeMin
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "starnamed.x.escrow.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Escrow",
-			Handler:    _Query_Escrow_Handler,
-		},
-		{
-			MethodName: "Escrows",
-			Handler:    _Query_Escrows_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "iov/escrow/v1beta1/query.proto",
+func (m *QueryEscrowRecordsRequest) GetTimeMax() uint64 {
+	if m != nil {
+		return m.TimeMax
+	}
+	return 0
 }
 
-func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEscrowRecordsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEscrowRecordsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
 }
 
-func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEscrowRecordsRequest) GetPaginationStart() uint64 {
+	if m != nil {
+		return m.PaginationStart
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEscrowRecordsRequest) GetPaginationLength() uint64 {
+	if m != nil {
+		return m.PaginationLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEscrowRecordsResponse is the response type for the Query/EscrowRecords
+// RPC method
+type QueryEscrowRecordsResponse struct {
+	Records []EscrowRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 }
 
-func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Escrow != nil {
-		{
-			size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEscrowRecordsResponse) Reset()         { *m = QueryEscrowRecordsResponse{} }
+func (m *QueryEscrowRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowRecordsResponse) ProtoMessage()    {}
+func (*QueryEscrowRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e53d70ef3e4e87e, []int{5}
+}
+func (m *QueryEscrowRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEscrowRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowRecordsResponse.Merge(m, src)
+}
+func (m *QueryEscrowRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowRecordsResponse) GetRecords() []EscrowRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEscrowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOfferRequest is the request type for the Query/Offer RPC method
+type QueryOfferRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryEscrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PaginationLength != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationLength))
-		i--
-		dAtA[i] = 0x28
+func (m *QueryOfferRequest) Reset()         { *m = QueryOfferRequest{} }
+func (m *QueryOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOfferRequest) ProtoMessage()    {}
+func (*QueryOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e53d70ef3e4e87e, []int{6}
+}
+func (m *QueryOfferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOfferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PaginationStart != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationStart))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *QueryOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOfferRequest.Merge(m, src)
+}
+func (m *QueryOfferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOfferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOfferRequest proto.InternalMessageInfo
+
+func (m *QueryOfferRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.ObjectKey) > 0 {
-		i -= len(m.ObjectKey)
-		copy(dAtA[i:], m.ObjectKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectKey)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+// QueryOfferResponse is the response type for the Query/Offer RPC method
+type QueryOfferResponse struct {
+	Offer *Offer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (m *QueryOfferResponse) Reset()         { *m = QueryOfferResponse{} }
+func (m *QueryOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOfferResponse) ProtoMessage()    {}
+func (*QueryOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e53d70ef3e4e87e, []int{7}
+}
+func (m *QueryOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOfferResponse.Merge(m, src)
+}
+func (m *QueryOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOfferResponse proto.InternalMessageInfo
+
+func (m *QueryOfferResponse) GetOffer() *Offer {
+	if m != nil {
+		return m.Offer
 	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// QueryOffersRequest is the request type for the Query/Offers RPC method
+type QueryOffersRequest struct {
+	Buyer            string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	ObjectKey        string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	PaginationStart  uint64 `protobuf:"varint,3,opt,name=pagination_start,json=paginationStart,proto3" json:"pagination_start,omitempty"`
+	PaginationLength uint64 `protobuf:"varint,4,opt,name=pagination_length,json=paginationLength,proto3" json:"pagination_length,omitempty"`
+}
+
+func (m *QueryOffersRequest) Reset()         { *m = QueryOffersRequest{} }
+func (m *QueryOffersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersRequest) ProtoMessage()    {}
+func (*QueryOffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e53d70ef3e4e87e, []int{8}
+}
+func (m *QueryOffersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersRequest.Merge(m, src)
+}
+func (m *QueryOffersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryOffersRequest proto.InternalMessageInfo
+
+func (m *QueryOffersRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *QueryOffersRequest) GetObjectKey() string {
+	if m != nil {
+		return m.ObjectKey
+	}
+	return ""
+}
+
+func (m *QueryOffersRequest) GetPaginationStart() uint64 {
+	if m != nil {
+		return m.PaginationStart
+	}
+	return 0
+}
+
+func (m *QueryOffersRequest) GetPaginationLength() uint64 {
+	if m != nil {
+		return m.PaginationLength
+	}
+	return 0
+}
+
+// QueryOffersResponse is the response type for the Query/Offers RPC method
+type QueryOffersResponse struct {
+	Offers []Offer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers"`
+}
+
+func (m *QueryOffersResponse) Reset()         { *m = QueryOffersResponse{} }
+func (m *QueryOffersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersResponse) ProtoMessage()    {}
+func (*QueryOffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e53d70ef3e4e87e, []int{9}
+}
+func (m *QueryOffersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersResponse.Merge(m, src)
+}
+func (m *QueryOffersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffersResponse proto.InternalMessageInfo
+
+func (m *QueryOffersResponse) GetOffers() []Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryEscrowRequest)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowRequest")
+	proto.RegisterType((*QueryEscrowResponse)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowResponse")
+	proto.RegisterType((*QueryEscrowsRequest)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowsRequest")
+	proto.RegisterType((*QueryEscrowsResponse)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowsResponse")
+	proto.RegisterType((*QueryEscrowRecordsRequest)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowRecordsRequest")
+	proto.RegisterType((*QueryEscrowRecordsResponse)(nil), "starnamed.x.escrow.v1beta1.QueryEscrowRecordsResponse")
+	proto.RegisterType((*QueryOfferRequest)(nil), "starnamed.x.escrow.v1beta1.QueryOfferRequest")
+	proto.RegisterType((*QueryOfferResponse)(nil), "starnamed.x.escrow.v1beta1.QueryOfferResponse")
+	proto.RegisterType((*QueryOffersRequest)(nil), "starnamed.x.escrow.v1beta1.QueryOffersRequest")
+	proto.RegisterType((*QueryOffersResponse)(nil), "starnamed.x.escrow.v1beta1.QueryOffersResponse")
+}
+
+func init() { proto.RegisterFile("iov/escrow/v1beta1/query.proto", fileDescriptor_7e53d70ef3e4e87e) }
+
+var fileDescriptor_7e53d70ef3e4e87e = []byte{
+	// 972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x8f, 0xdb, 0x44,
+	0x18, 0x5e, 0xe7, 0x3b, 0x6f, 0xf6, 0xa3, 0x3b, 0xbb, 0x14, 0xaf, 0x0b, 0xde, 0xd4, 0xe5, 0x10,
+	0xa8, 0xd6, 0x6e, 0x17, 0x01, 0x12, 0x17, 0xa4, 0xa0, 0x0a, 0x24, 0xa8, 0x0a, 0x01, 0x71, 0xe8,
+	0x25, 0x72, 0xec, 0xd9, 0x74, 0x68, 0xe2, 0x49, 0x3d, 0x93, 0x6d, 0x2c, 0xc4, 0x81, 0x4a, 0x70,
+	0xe2, 0x80, 0xc4, 0x81, 0x33, 0x57, 0x7e, 0x02, 0xfc, 0x81, 0x1e, 0x2b, 0x71, 0xe1, 0x04, 0x68,
+	0x97, 0x1f, 0xc0, 0x4f, 0xa8, 0xe6, 0xc3, 0x59, 0x3b, 0x4d, 0x52, 0xef, 0x29, 0x99, 0x77, 0xde,
+	0x67, 0xde, 0xe7, 0x7d, 0x9f, 0x67, 0x26, 0x01, 0x9b, 0xd0, 0x53, 0x0f, 0xb3, 0x20, 0xa6, 0x8f,
+	0xbd, 0xd3, 0xdb, 0x03, 0xcc, 0xfd, 0xdb, 0xde, 0xa3, 0x29, 0x8e, 0x13, 0x77, 0x12, 0x53, 0x4e,
+	0x91, 0xc5, 0xb8, 0x1f, 0x47, 0xfe, 0x18, 0x87, 0xee, 0xcc, 0x55, 0x79, 0xae, 0xce, 0xb3, 0xec,
+	0x80, 0xb2, 0x31, 0x65, 0xde, 0xc0, 0x67, 0x78, 0x0e, 0x0e, 0x28, 0x89, 0x14, 0xd6, 0x7a, 0x6d,
+	0x48, 0xe9, 0x70, 0x84, 0x3d, 0x7f, 0x42, 0x3c, 0x3f, 0x8a, 0x28, 0xf7, 0x39, 0xa1, 0x11, 0xd3,
+	0xbb, 0xcb, 0x2a, 0xf3, 0x64, 0x82, 0xd3, 0xfd, 0xfd, 0x21, 0x1d, 0x52, 0xf9, 0xd5, 0x13, 0xdf,
+	0x54, 0xd4, 0x79, 0x03, 0xd0, 0xe7, 0x82, 0xde, 0x1d, 0x09, 0xec, 0xe1, 0x47, 0x53, 0xcc, 0x38,
+	0xda, 0x86, 0x12, 0x09, 0x4d, 0xa3, 0x6d, 0x74, 0x9a, 0xbd, 0x12, 0x09, 0x9d, 0x1f, 0x4b, 0xb0,
+	0x97, 0x4b, 0x63, 0x13, 0x1a, 0x31, 0x8c, 0xde, 0x87, 0x9a, 0xaa, 0x28, 0x73, 0x5b, 0xc7, 0x8e,
+	0xbb, 0xba, 0x3d, 0x57, 0x63, 0x35, 0x02, 0x4d, 0x60, 0x2b, 0x98, 0xc6, 0x31, 0x8e, 0x78, 0x7f,
+	0x12, 0x93, 0x00, 0x9b, 0xa5, 0x76, 0xb9, 0xd3, 0x3a, 0x3e, 0x70, 0xd5, 0x14, 0x5c, 0x31, 0x85,
+	0x39, 0xf6, 0x43, 0x4a, 0xa2, 0xee, 0xad, 0xa7, 0x7f, 0x1f, 0x6e, 0xfc, 0xf6, 0xcf, 0x61, 0x67,
+	0x48, 0xf8, 0x83, 0xe9, 0xc0, 0x0d, 0xe8, 0xd8, 0xd3, 0x23, 0x53, 0x1f, 0x47, 0x2c, 0x7c, 0xa8,
+	0x7b, 0x16, 0x00, 0xd6, 0xdb, 0xd4, 0x15, 0x3e, 0x13, 0x05, 0xd0, 0x47, 0xd0, 0x8c, 0x69, 0xe2,
+	0x8f, 0x38, 0xc1, 0xcc, 0x2c, 0xcb, 0x6a, 0x37, 0xd6, 0x11, 0xee, 0xc9, 0xe4, 0xa4, 0x5b, 0x11,
+	0x75, 0x7b, 0x17, 0x58, 0xe7, 0xff, 0x72, 0x6e, 0x1c, 0x2c, 0x1d, 0xdb, 0x55, 0xa8, 0x31, 0x3c,
+	0x1a, 0xe1, 0x58, 0x8f, 0x4e, 0xaf, 0xd0, 0x3e, 0x54, 0x19, 0xf7, 0xb9, 0x68, 0x51, 0x84, 0xd5,
+	0x02, 0xbd, 0x0e, 0x40, 0x07, 0x5f, 0xe3, 0x80, 0xf7, 0x1f, 0xe2, 0xc4, 0x2c, 0xcb, 0xad, 0xa6,
+	0x8a, 0x7c, 0x82, 0x13, 0xf4, 0x26, 0x5c, 0x99, 0xf8, 0x43, 0x12, 0x49, 0x91, 0xfb, 0x82, 0x26,
+	0x37, 0x2b, 0x6d, 0xa3, 0x53, 0xe9, 0xed, 0x5c, 0xc4, 0xbf, 0x10, 0x61, 0x74, 0x13, 0x76, 0x33,
+	0xa9, 0x23, 0x1c, 0x0d, 0xf9, 0x03, 0xb3, 0x2a, 0x73, 0x33, 0x67, 0x7c, 0x2a, 0xe3, 0xe8, 0x2d,
+	0xd8, 0x7d, 0xec, 0x47, 0x1c, 0x87, 0xfd, 0x4c, 0xf5, 0x9a, 0xac, 0xbe, 0xa3, 0x36, 0xee, 0xcd,
+	0x39, 0x1c, 0x42, 0x4b, 0x27, 0x89, 0xa9, 0x9a, 0x75, 0x79, 0xa4, 0x66, 0xfd, 0x65, 0x32, 0xc1,
+	0xa2, 0xe3, 0x90, 0x8e, 0x7d, 0x12, 0x99, 0x0d, 0xd5, 0xb1, 0x5a, 0xa1, 0x6b, 0xd0, 0x94, 0xa2,
+	0xf6, 0xc7, 0x24, 0x32, 0x9b, 0x72, 0xab, 0x21, 0x03, 0x77, 0x73, 0x9b, 0xfe, 0xcc, 0x84, 0xec,
+	0xa6, 0x3f, 0x43, 0xd7, 0x61, 0x33, 0xc4, 0x7e, 0x38, 0x22, 0x91, 0x02, 0xb7, 0x64, 0xcd, 0x56,
+	0x1a, 0x13, 0xf8, 0x5c, 0x8a, 0x3f, 0x33, 0x37, 0x17, 0x52, 0xfc, 0x19, 0x3a, 0x80, 0x06, 0x8d,
+	0x43, 0x1c, 0xf7, 0x07, 0x89, 0xb9, 0x25, 0x2b, 0xd4, 0xe5, 0xba, 0x9b, 0x20, 0x1b, 0x20, 0xc4,
+	0x2c, 0xc0, 0x51, 0x48, 0xa2, 0xa1, 0xb9, 0xdd, 0x36, 0x3a, 0x8d, 0x5e, 0x26, 0x22, 0xc4, 0x1a,
+	0x4c, 0x13, 0x1c, 0x9b, 0x3b, 0x4a, 0x2c, 0xb9, 0x70, 0xee, 0xc3, 0x7e, 0x5e, 0x71, 0x7d, 0x03,
+	0xba, 0x50, 0x57, 0xae, 0x61, 0xa6, 0xd1, 0x2e, 0x17, 0xbb, 0x02, 0xda, 0x50, 0x29, 0xd0, 0xf9,
+	0xbd, 0x0c, 0x07, 0xb9, 0xdb, 0x15, 0xd0, 0x38, 0x2c, 0x62, 0x2a, 0xc5, 0xb3, 0x94, 0xe1, 0x79,
+	0x61, 0xb5, 0xf2, 0x6a, 0xab, 0x55, 0x16, 0xad, 0xb6, 0x20, 0x73, 0x75, 0x8d, 0xcc, 0xb5, 0xd5,
+	0x32, 0xd7, 0xd7, 0xc9, 0xdc, 0x58, 0x90, 0xf9, 0x00, 0x1a, 0x9c, 0x8c, 0x2f, 0xfc, 0x51, 0xe9,
+	0xd5, 0xc5, 0x5a, 0xe0, 0xe6, 0x5b, 0xda, 0x1d, 0xe9, 0xd6, 0x82, 0xac, 0xad, 0x75, 0xb2, 0x6e,
+	0xbe, 0x20, 0xeb, 0xb2, 0xeb, 0xb4, 0x75, 0x89, 0xeb, 0xb4, 0xbd, 0xfc, 0x3a, 0x39, 0x27, 0x60,
+	0x2d, 0xd3, 0x4e, 0xdb, 0xe3, 0x63, 0xa8, 0xc7, 0x2a, 0xa4, 0xed, 0xd1, 0x29, 0xf0, 0x42, 0x4a,
+	0x40, 0x6a, 0x12, 0x0d, 0x77, 0x6e, 0xc0, 0xae, 0xac, 0x73, 0xef, 0xe4, 0x04, 0xc7, 0xab, 0xde,
+	0xe9, 0xbb, 0x80, 0xb2, 0x49, 0x9a, 0xc4, 0x7b, 0x50, 0xa5, 0x22, 0xa0, 0x1f, 0xe9, 0xeb, 0xeb,
+	0x28, 0x28, 0xa4, 0xca, 0x77, 0x7e, 0x35, 0xb2, 0xe7, 0xcd, 0x1d, 0x39, 0x77, 0x9e, 0x91, 0x75,
+	0x5e, 0xde, 0x63, 0xa5, 0x22, 0xcf, 0x59, 0xf9, 0x12, 0xf3, 0xaf, 0xac, 0x98, 0xff, 0x57, 0xb0,
+	0x97, 0xa3, 0xa8, 0x7b, 0xfe, 0x00, 0x6a, 0xb2, 0x87, 0x74, 0xee, 0x2f, 0x6f, 0x5a, 0x0f, 0x5c,
+	0xc3, 0x8e, 0xff, 0xa8, 0x42, 0x55, 0x1e, 0x8c, 0x7e, 0x30, 0xa0, 0xa6, 0x94, 0x41, 0xee, 0xba,
+	0x53, 0x5e, 0xfc, 0x1d, 0xb5, 0xbc, 0xc2, 0xf9, 0x8a, 0xb6, 0x73, 0xed, 0xc9, 0x9f, 0xff, 0xfd,
+	0x5c, 0x7a, 0x05, 0xed, 0xa5, 0xbf, 0xe4, 0xfa, 0xe3, 0x1b, 0x12, 0x7e, 0x8b, 0xbe, 0x37, 0xa0,
+	0xae, 0xf2, 0x19, 0x2a, 0x7a, 0x72, 0x2a, 0x9a, 0x75, 0xab, 0x38, 0x40, 0x73, 0x79, 0x55, 0x72,
+	0xd9, 0x45, 0x3b, 0x79, 0x2e, 0x0c, 0xfd, 0x62, 0xc0, 0x56, 0xce, 0xee, 0xe8, 0x9d, 0xc2, 0x7d,
+	0x66, 0x9f, 0x36, 0xeb, 0xdd, 0xcb, 0xc2, 0x56, 0x31, 0xd3, 0x97, 0x04, 0x3d, 0x31, 0xa0, 0x2a,
+	0xc5, 0x44, 0x47, 0x2f, 0x3d, 0x3a, 0x7b, 0x91, 0x2c, 0xb7, 0x68, 0xba, 0x66, 0x60, 0x49, 0x06,
+	0xfb, 0x08, 0xa5, 0x0c, 0xa4, 0x6b, 0x94, 0x4c, 0xdf, 0x19, 0x50, 0x93, 0xd9, 0x0c, 0x15, 0x3c,
+	0x96, 0x15, 0xf7, 0x4b, 0xde, 0xe6, 0xce, 0x55, 0xc9, 0xe3, 0x0a, 0xda, 0xce, 0xf1, 0x60, 0xdd,
+	0x3b, 0x4f, 0xcf, 0x6c, 0xe3, 0xd9, 0x99, 0x6d, 0xfc, 0x7b, 0x66, 0x1b, 0x3f, 0x9d, 0xdb, 0x1b,
+	0xcf, 0xce, 0xed, 0x8d, 0xbf, 0xce, 0xed, 0x8d, 0xfb, 0x37, 0x33, 0x7f, 0x9e, 0x08, 0x3d, 0x3d,
+	0xa2, 0x11, 0xf6, 0xe6, 0x45, 0xbd, 0x59, 0x7a, 0x8e, 0xfc, 0x17, 0x35, 0xa8, 0xc9, 0x3f, 0x89,
+	0x6f, 0x3f, 0x1f, 0x00, 0x48, 0x7c, 0x97, 0xde, 0xd6, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Escrow queries the escrow by the specified id
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// Escrows queries escrows by the specified key-value pairs
+	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+	// EscrowRecords queries the records of the completed and refunded escrows
+	EscrowRecords(ctx context.Context, in *QueryEscrowRecordsRequest, opts ...grpc.CallOption) (*QueryEscrowRecordsResponse, error)
+	// Offer queries the offer by the specified id
+	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
+	// Offers queries offers by buyer and by object
+	Offers(ctx context.Context, in *QueryOffersRequest, opts ...grpc.CallOption) (*QueryOffersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/Escrow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEscrowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
+	out := new(QueryEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/Escrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEscrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Escrows) > 0 {
-		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+func (c *queryClient) EscrowRecords(ctx context.Context, in *QueryEscrowRecordsRequest, opts ...grpc.CallOption) (*QueryEscrowRecordsResponse, error) {
+	out := new(QueryEscrowRecordsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/EscrowRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
+	out := new(QueryOfferResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/Offer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Offers(ctx context.Context, in *QueryOffersRequest, opts ...grpc.CallOption) (*QueryOffersResponse, error) {
+	out := new(QueryOffersResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.escrow.v1beta1.Query/Offers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Escrow queries the escrow by the specified id
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// Escrows queries escrows by the specified key-value pairs
+	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+	// EscrowRecords queries the records of the completed and refunded escrows
+	EscrowRecords(context.Context, *QueryEscrowRecordsRequest) (*QueryEscrowRecordsResponse, error)
+	// Offer queries the offer by the specified id
+	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
+	// Offers queries offers by buyer and by object
+	Offers(context.Context, *QueryOffersRequest) (*QueryOffersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (*UnimplementedQueryServer) Escrows(ctx context.Context, req *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+}
+func (*UnimplementedQueryServer) EscrowRecords(ctx context.Context, req *QueryEscrowRecordsRequest) (*QueryEscrowRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecords not implemented")
+}
+func (*UnimplementedQueryServer) Offer(ctx context.Context, req *QueryOfferRequest) (*QueryOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offer not implemented")
+}
+func (*UnimplementedQueryServer) Offers(ctx context.Context, req *QueryOffersRequest) (*QueryOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.escrow.v1beta1.Query/Escrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.escrow.v1beta1.Query/Escrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.escrow.v1beta1.Query/EscrowRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowRecords(ctx, req.(*QueryEscrowRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.escrow.v1beta1.Query/Offer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Offers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.escrow.v1beta1.Query/Offers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offers(ctx, req.(*QueryOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "starnamed.x.escrow.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Escrows",
+			Handler:    _Query_Escrows_Handler,
+		},
+		{
+			MethodName: "EscrowRecords",
+			Handler:    _Query_EscrowRecords_Handler,
+		},
+		{
+			MethodName: "Offer",
+			Handler:    _Query_Offer_Handler,
+		},
+		{
+			MethodName: "Offers",
+			Handler:    _Query_Offers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iov/escrow/v1beta1/query.proto",
+}
+
+func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CurrentPrice) > 0 {
+		for iNdEx := len(m.CurrentPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Escrow != nil {
+		{
+			size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Descending {
+		i--
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DeadlineMax != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeadlineMax))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DeadlineMin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeadlineMin))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PriceMax) > 0 {
+		i -= len(m.PriceMax)
+		copy(dAtA[i:], m.PriceMax)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceMax)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PriceMin) > 0 {
+		i -= len(m.PriceMin)
+		copy(dAtA[i:], m.PriceMin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceMin)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ObjectType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObjectType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WantedObjectKey) > 0 {
+		i -= len(m.WantedObjectKey)
+		copy(dAtA[i:], m.WantedObjectKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WantedObjectKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PaginationLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PaginationStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectKey) > 0 {
+		i -= len(m.ObjectKey)
+		copy(dAtA[i:], m.ObjectKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaginationLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationLength))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PaginationStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationStart))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Descending {
+		i--
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TimeMax != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeMax))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeMin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeMin))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PriceMax) > 0 {
+		i -= len(m.PriceMax)
+		copy(dAtA[i:], m.PriceMax)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceMax)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PriceMin) > 0 {
+		i -= len(m.PriceMin)
+		copy(dAtA[i:], m.PriceMin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceMin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ObjectType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObjectType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObjectKey) > 0 {
+		i -= len(m.ObjectKey)
+		copy(dAtA[i:], m.ObjectKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offer != nil {
+		{
+			size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaginationLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PaginationStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaginationStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ObjectKey) > 0 {
+		i -= len(m.ObjectKey)
+		copy(dAtA[i:], m.ObjectKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Escrow != nil {
+		l = m.Escrow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CurrentPrice) > 0 {
+		for _, e := range m.CurrentPrice {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEscrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PaginationStart != 0 {
+		n += 1 + sovQuery(uint64(m.PaginationStart))
+	}
+	if m.PaginationLength != 0 {
+		n += 1 + sovQuery(uint64(m.PaginationLength))
+	}
+	l = len(m.WantedObjectKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ObjectType != 0 {
+		n += 1 + sovQuery(uint64(m.ObjectType))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceMin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceMax)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DeadlineMin != 0 {
+		n += 1 + sovQuery(uint64(m.DeadlineMin))
+	}
+	if m.DeadlineMax != 0 {
+		n += 1 + sovQuery(uint64(m.DeadlineMax))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Descending {
+		n += 2
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEscrowRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ObjectType != 0 {
+		n += 1 + sovQuery(uint64(m.ObjectType))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceMin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceMax)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeMin != 0 {
+		n += 1 + sovQuery(uint64(m.TimeMin))
+	}
+	if m.TimeMax != 0 {
+		n += 1 + sovQuery(uint64(m.TimeMax))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Descending {
+		n += 2
+	}
+	if m.PaginationStart != 0 {
+		n += 1 + sovQuery(uint64(m.PaginationStart))
+	}
+	if m.PaginationLength != 0 {
+		n += 1 + sovQuery(uint64(m.PaginationLength))
+	}
+	return n
+}
+
+func (m *QueryEscrowRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offer != nil {
+		l = m.Offer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PaginationStart != 0 {
+		n += 1 + sovQuery(uint64(m.PaginationStart))
+	}
+	if m.PaginationLength != 0 {
+		n += 1 + sovQuery(uint64(m.PaginationLength))
+	}
+	return n
+}
+
+func (m *QueryOffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Escrow == nil {
+				m.Escrow = &Escrow{}
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPrice = append(m.CurrentPrice, types.Coin{})
+			if err := m.CurrentPrice[len(m.CurrentPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, Royalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaginationStart", wireType)
+			}
+			m.PaginationStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaginationStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaginationLength", wireType)
+			}
+			m.PaginationLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaginationLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WantedObjectKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WantedObjectKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectType", wireType)
+			}
+			m.ObjectType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineMin", wireType)
+			}
+			m.DeadlineMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineMin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineMax", wireType)
+			}
+			m.DeadlineMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectType", wireType)
+			}
+			m.ObjectType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMin", wireType)
+			}
+			m.TimeMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeMin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMax", wireType)
+			}
+			m.TimeMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaginationStart", wireType)
+			}
+			m.PaginationStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaginationStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaginationLength", wireType)
+			}
+			m.PaginationLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaginationLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryEscrowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Escrow != nil {
-		l = m.Escrow.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryEscrowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PaginationStart != 0 {
-		n += 1 + sovQuery(uint64(m.PaginationStart))
-	}
-	if m.PaginationLength != 0 {
-		n += 1 + sovQuery(uint64(m.PaginationLength))
-	}
-	return n
-}
 
-func (m *QueryEscrowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Escrows) > 0 {
-		for _, e := range m.Escrows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -667,17 +3096,17 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -687,23 +3116,25 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, EscrowRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -726,7 +3157,7 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOfferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -749,17 +3180,17 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOfferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -769,27 +3200,23 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Escrow == nil {
-				m.Escrow = &Escrow{}
-			}
-			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -812,7 +3239,7 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -835,17 +3262,17 @@ func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -855,27 +3282,81 @@ func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			if m.Offer == nil {
+				m.Offer = &Offer{}
+			}
+			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -903,9 +3384,9 @@ func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectKey", wireType)
 			}
@@ -937,7 +3418,7 @@ func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ObjectKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PaginationStart", wireType)
 			}
@@ -956,7 +3437,7 @@ func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PaginationLength", wireType)
 			}
@@ -996,7 +3477,7 @@ func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOffersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1019,15 +3500,15 @@ func (m *QueryEscrowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1054,8 +3535,8 @@ func (m *QueryEscrowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Escrows = append(m.Escrows, Escrow{})
-			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,103 @@
+package types
+
+import (
+	crud "github.com/iov-one/cosmos-sdk-crud"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Check that the escrow record implements crud.Object
+var _ crud.Object = &EscrowRecord{}
+
+const (
+	// RecordSellerIndex represents the seller as a secondary key of an escrow record
+	RecordSellerIndex = 0x01
+	// RecordBuyerIndex represents the buyer as a secondary key of an escrow record, refunded escrows have no buyer
+	RecordBuyerIndex = 0x02
+	// RecordObjectIndex represents the object as a secondary key of an escrow record
+	// The object is represented by its primary key and, for a bundle, by the primary keys of its objects
+	RecordObjectIndex = 0x03
+)
+
+// NewEscrowRecord constructs the record of a completed or refunded escrow at the given time.
+// The buyer is nil and the price is empty for a refunded escrow.
+func NewEscrowRecord(escrow Escrow, buyer sdk.AccAddress, price sdk.Coins, time uint64) EscrowRecord {
+	object := escrow.GetObject()
+	record := EscrowRecord{
+		Id:         escrow.Id,
+		State:      escrow.State,
+		Seller:     escrow.Seller,
+		Price:      price,
+		ObjectType: object.GetObjectTypeID(),
+		ObjectKeys: getObjectIndexKeys(object),
+		Domain:     GetObjectDomain(object),
+		Time:       time,
+	}
+	if buyer != nil {
+		record.Buyer = buyer.String()
+	}
+	return record
+}
+
+// PrimaryKey implements crud.Object
+func (r EscrowRecord) PrimaryKey() []byte {
+	return GetEscrowKey(r.Id)
+}
+
+// SecondaryKeys implements crud.Object
+func (r EscrowRecord) SecondaryKeys() []crud.SecondaryKey {
+	// If this is an empty object, return an empty array
+	if len(r.Id) == 0 {
+		return make([]crud.SecondaryKey, 0)
+	}
+	sks := make([]crud.SecondaryKey, 0, 2+len(r.ObjectKeys))
+	if seller, err := sdk.AccAddressFromBech32(r.Seller); err == nil {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    RecordSellerIndex,
+			Value: seller,
+		})
+	}
+	if buyer, err := sdk.AccAddressFromBech32(r.Buyer); err == nil {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    RecordBuyerIndex,
+			Value: buyer,
+		})
+	}
+	for _, key := range r.ObjectKeys {
+		sks = append(sks, crud.SecondaryKey{
+			ID:    RecordObjectIndex,
+			Value: key,
+		})
+	}
+	return sks
+}
+
+// Validate validates the fields of the record, it does not check that the escrow did exist
+func (r EscrowRecord) Validate() error {
+	if err := ValidateID(r.Id); err != nil {
+		return err
+	}
+	if err := ValidateAddress(r.Seller); err != nil {
+		return sdkerrors.Wrap(err, "invalid seller address")
+	}
+	switch r.State {
+	case EscrowState_Completed:
+		if err := ValidateAddress(r.Buyer); err != nil {
+			return sdkerrors.Wrap(err, "invalid buyer address")
+		}
+	case EscrowState_Refunded:
+		if len(r.Buyer) != 0 || !r.Price.Empty() {
+			return sdkerrors.Wrap(ErrInvalidRecord, "a refunded escrow has no buyer and no price")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidRecord, "the state of a record must be completed or refunded, got %v", r.State)
+	}
+	if !r.Price.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidPrice, r.Price.String())
+	}
+	if len(r.ObjectKeys) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRecord, "the object key is missing")
+	}
+	return nil
+}
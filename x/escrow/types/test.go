@@ -8,8 +8,9 @@ import (
 	crud "github.com/iov-one/cosmos-sdk-crud"
 )
 
-// Assert that TestObject is a TransferableObject
+// Assert that TestObject is a TransferableObject and an ObjectWithRoyalties
 var _ TransferableObject = &TestObject{}
+var _ ObjectWithRoyalties = &TestObject{}
 
 // Assert that TestTimeConstrainedObject is a TransferableObject and an ObjectWithTimeConstraint
 var _ TransferableObject = &TestTimeConstrainedObject{}
@@ -44,6 +45,8 @@ func (m *TestObject) IsOwnedBy(account sdk.AccAddress) (bool, error) {
 
 func (m *TestObject) Transfer(_ sdk.Context, from sdk.AccAddress, to sdk.AccAddress, data CustomData) error {
 	store := data.(crud.Store)
+	// Unmarshalling appends to repeated fields, clear them before reading the object
+	m.Royalties = nil
 	if err := store.Read(m.PrimaryKey(), m); err != nil {
 		return sdkerrors.Wrap(err, "The object is not synchronized with the store")
 	}
@@ -66,6 +69,10 @@ func (m *TestObject) Transfer(_ sdk.Context, from sdk.AccAddress, to sdk.AccAddr
 	return nil
 }
 
+func (m *TestObject) GetRoyalties(_ sdk.Context, _ CustomData) []Royalty {
+	return append([]Royalty(nil), m.Royalties...)
+}
+
 type expectedData interface {
 	GetDeadlineOrDefault(sdk.Context, TransferableObject, uint64) uint64
 	GetCrudStore() crud.Store
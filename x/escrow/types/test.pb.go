@@ -29,6 +29,7 @@ type TestObject struct {
 	Id                  uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Owner               github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
 	NumAllowedTransfers int64                                         `protobuf:"varint,3,opt,name=num_allowed_transfers,json=numAllowedTransfers,proto3" json:"num_allowed_transfers,omitempty"`
+	Royalties           []Royalty                                     `protobuf:"bytes,4,rep,name=royalties,proto3" json:"royalties"`
 }
 
 func (m *TestObject) Reset()         { *m = TestObject{} }
@@ -113,27 +114,30 @@ func init() {
 func init() { proto.RegisterFile("iov/escrow/v1beta1/test.proto", fileDescriptor_869357724f579c88) }
 
 var fileDescriptor_869357724f579c88 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x91, 0xbd, 0x4e, 0x02, 0x41,
-	0x14, 0x85, 0x77, 0x00, 0x2d, 0x26, 0xc6, 0x62, 0xd5, 0x04, 0x49, 0x1c, 0x09, 0x15, 0x89, 0x61,
-	0x27, 0xe8, 0x13, 0x80, 0x85, 0xb1, 0x32, 0xd9, 0x50, 0xd9, 0x90, 0xd9, 0x9d, 0x2b, 0x8e, 0xb2,
-	0x73, 0xc9, 0xdc, 0xe1, 0xc7, 0xb7, 0xb0, 0xb0, 0xf1, 0x8d, 0x28, 0x29, 0xad, 0x8c, 0xc2, 0x5b,
-	0x58, 0x19, 0x76, 0xc1, 0xf0, 0x02, 0x56, 0x33, 0xb9, 0xe7, 0x9c, 0x9c, 0x93, 0x7c, 0xfc, 0xcc,
-	0xe0, 0x44, 0x02, 0xa5, 0x0e, 0xa7, 0x72, 0xd2, 0x4e, 0xc0, 0xab, 0xb6, 0xf4, 0x40, 0x3e, 0x1a,
-	0x39, 0xf4, 0x18, 0xd6, 0xc8, 0x2b, 0x67, 0x55, 0x06, 0x3a, 0x9a, 0x45, 0x85, 0x2d, 0xda, 0xd8,
-	0x6a, 0xc7, 0x03, 0x1c, 0x60, 0x6e, 0x93, 0xeb, 0x5f, 0x91, 0x68, 0xbc, 0x33, 0xce, 0x7b, 0x40,
-	0xfe, 0x2e, 0x79, 0x82, 0xd4, 0x87, 0x87, 0xbc, 0x64, 0x74, 0x95, 0xd5, 0x59, 0xb3, 0x12, 0x97,
-	0x8c, 0x0e, 0x6f, 0xf8, 0x1e, 0x4e, 0x2d, 0xb8, 0x6a, 0xa9, 0xce, 0x9a, 0x07, 0xdd, 0xf6, 0xcf,
-	0xe7, 0x79, 0x6b, 0x60, 0xfc, 0xe3, 0x38, 0x89, 0x52, 0xcc, 0x64, 0x8a, 0x94, 0x21, 0x6d, 0x9e,
-	0x16, 0xe9, 0x67, 0xe9, 0x5f, 0x46, 0x40, 0x51, 0x27, 0x4d, 0x3b, 0x5a, 0x3b, 0x20, 0x8a, 0x8b,
-	0x7c, 0x78, 0xc9, 0x4f, 0xec, 0x38, 0xeb, 0xab, 0xe1, 0x10, 0xa7, 0xa0, 0xfb, 0xde, 0x29, 0x4b,
-	0x0f, 0xe0, 0xa8, 0x5a, 0xae, 0xb3, 0x66, 0x39, 0x3e, 0xb2, 0xe3, 0xac, 0x53, 0x68, 0xbd, 0xad,
-	0xd4, 0x78, 0x63, 0xfc, 0x74, 0xbd, 0xad, 0x67, 0x32, 0xb8, 0x46, 0x4b, 0xde, 0x29, 0x63, 0x41,
-	0xff, 0xf7, 0x54, 0xc1, 0x39, 0xcc, 0x46, 0xc6, 0x29, 0x6f, 0xd0, 0xe6, 0xfb, 0x2a, 0xf1, 0xce,
-	0xa5, 0x7b, 0x3b, 0xff, 0x16, 0xc1, 0x7c, 0x29, 0xd8, 0x62, 0x29, 0xd8, 0xd7, 0x52, 0xb0, 0xd7,
-	0x95, 0x08, 0x16, 0x2b, 0x11, 0x7c, 0xac, 0x44, 0x70, 0x7f, 0xb1, 0xd3, 0x69, 0x70, 0xd2, 0x42,
-	0x0b, 0xf2, 0x8f, 0x8a, 0x9c, 0x6d, 0xe1, 0xe5, 0xe5, 0xc9, 0x7e, 0x0e, 0xe1, 0xea, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x41, 0x99, 0xf7, 0xed, 0xd7, 0x01, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x91, 0xbd, 0x6e, 0xdb, 0x30,
+	0x14, 0x85, 0x45, 0x5b, 0x2d, 0x50, 0xb6, 0xe8, 0xa0, 0xb6, 0x80, 0x6a, 0xa0, 0xb4, 0xe0, 0x2e,
+	0x02, 0x0a, 0x4b, 0xb0, 0xfb, 0x04, 0x76, 0x07, 0xa3, 0x53, 0x00, 0xc1, 0x53, 0x16, 0x83, 0x12,
+	0x6f, 0x1c, 0x26, 0x16, 0xaf, 0x41, 0xd2, 0x7f, 0x6f, 0x91, 0x21, 0x0f, 0xe5, 0xd1, 0x63, 0x26,
+	0x23, 0x91, 0xdf, 0x22, 0x53, 0x60, 0xc9, 0x4e, 0x3c, 0x24, 0x63, 0x26, 0x12, 0x3c, 0xe7, 0xdc,
+	0x8b, 0x8f, 0x87, 0xfe, 0x92, 0x38, 0x8f, 0xc1, 0x64, 0x1a, 0x17, 0xf1, 0xbc, 0x93, 0x82, 0xe5,
+	0x9d, 0xd8, 0x82, 0xb1, 0xd1, 0x54, 0xa3, 0x45, 0xaf, 0x61, 0x2c, 0xd7, 0x8a, 0xe7, 0x20, 0xa2,
+	0x65, 0x54, 0xd9, 0xa2, 0x83, 0xad, 0xf1, 0x7d, 0x8c, 0x63, 0x2c, 0x6d, 0xf1, 0xfe, 0x56, 0x25,
+	0x1a, 0xec, 0xb5, 0x81, 0xab, 0x29, 0x98, 0x4a, 0x6f, 0x15, 0x84, 0xd2, 0x21, 0x18, 0x7b, 0x96,
+	0x5e, 0x41, 0x66, 0xbd, 0xaf, 0xb4, 0x26, 0x85, 0x4f, 0x02, 0x12, 0xba, 0x49, 0x4d, 0x0a, 0x6f,
+	0x40, 0x3f, 0xe0, 0x42, 0x81, 0xf6, 0x6b, 0x01, 0x09, 0xbf, 0xf4, 0x3b, 0x8f, 0xdb, 0x66, 0x7b,
+	0x2c, 0xed, 0xe5, 0x2c, 0x8d, 0x32, 0xcc, 0xe3, 0x0c, 0x4d, 0x8e, 0xe6, 0x70, 0xb4, 0x8d, 0xb8,
+	0x3e, 0xcc, 0xee, 0x65, 0x59, 0x4f, 0x08, 0x0d, 0xc6, 0x24, 0x55, 0xde, 0xeb, 0xd2, 0x1f, 0x6a,
+	0x96, 0x8f, 0xf8, 0x64, 0x82, 0x0b, 0x10, 0x23, 0xab, 0xb9, 0x32, 0x17, 0xa0, 0x8d, 0x5f, 0x0f,
+	0x48, 0x58, 0x4f, 0xbe, 0xa9, 0x59, 0xde, 0xab, 0xb4, 0xe1, 0x51, 0xf2, 0x06, 0xf4, 0x93, 0xc6,
+	0x15, 0x9f, 0x58, 0x09, 0xc6, 0x77, 0x83, 0x7a, 0xf8, 0xb9, 0xfb, 0x3b, 0x7a, 0xfb, 0x07, 0xa2,
+	0xa4, 0x34, 0xaf, 0xfa, 0xee, 0x7a, 0xdb, 0x74, 0x92, 0x97, 0x6c, 0xeb, 0x96, 0xd0, 0x9f, 0x7b,
+	0xc8, 0xa1, 0xcc, 0xe1, 0x1f, 0x2a, 0x63, 0x35, 0x97, 0x0a, 0xc4, 0x7b, 0x33, 0x33, 0x4a, 0x61,
+	0x39, 0x95, 0x9a, 0x5b, 0x89, 0xaa, 0x04, 0x75, 0x93, 0x93, 0x97, 0xfe, 0xff, 0xf5, 0x03, 0x73,
+	0xd6, 0x05, 0x23, 0x9b, 0x82, 0x91, 0xfb, 0x82, 0x91, 0x9b, 0x1d, 0x73, 0x36, 0x3b, 0xe6, 0xdc,
+	0xed, 0x98, 0x73, 0xfe, 0xe7, 0x64, 0xa7, 0xc4, 0x79, 0x1b, 0x15, 0xc4, 0xcf, 0xf0, 0xf1, 0xf2,
+	0x58, 0x6a, 0xb9, 0x3c, 0xfd, 0x58, 0xb6, 0xf9, 0xf7, 0x69, 0x00, 0x99, 0x7f, 0xd8, 0xde, 0x40,
+	0x02, 0x00, 0x00,
 }
 
 func (m *TestObject) Marshal() (dAtA []byte, err error) {
@@ -156,6 +160,20 @@ func (m *TestObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.NumAllowedTransfers != 0 {
 		i = encodeVarintTest(dAtA, i, uint64(m.NumAllowedTransfers))
 		i--
@@ -243,6 +261,12 @@ func (m *TestObject) Size() (n int) {
 	if m.NumAllowedTransfers != 0 {
 		n += 1 + sovTest(uint64(m.NumAllowedTransfers))
 	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovTest(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -372,6 +396,40 @@ func (m *TestObject) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, Royalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTest(dAtA[iNdEx:])
@@ -21,9 +21,8 @@ type CustomData interface{}
 // ObjectWithCustomFees is an object (that should be a TransferableObject in the context of this module) that
 // retrieve the fees for several operations.
 type ObjectWithCustomFees interface {
-	// GetCreationFees returns the fees (as used in the starnamed/x/configuration package) of a creation operation
-	// for this object.
-	GetCreationFees() sdk.Dec
+	// GetCreationFees returns the fees of a creation operation for this object.
+	GetCreationFees() sdk.Coins
 }
 
 // ObjectWithTimeConstraint is an object that should be a TransferableObject in the context of this module) that can
@@ -39,6 +38,45 @@ type ObjectWithTimeConstraint interface {
 	ValidateDeadlineBasic(deadline uint64) error
 }
 
+// ObjectWithSaleRecord is an object (that should be a TransferableObject in the context of this module) that
+// keeps a record of its sales. RecordSale is called once the object and the coins of an escrow have been swapped.
+type ObjectWithSaleRecord interface {
+	// RecordSale records the sale of this object from the seller to the buyer for the given price
+	RecordSale(ctx sdk.Context, seller, buyer sdk.AccAddress, price sdk.Coins, data CustomData)
+}
+
+// ObjectWithRoyalties is an object (that should be a TransferableObject in the context of this module) whose sales
+// pay royalties. The royalties are paid out of the price of the sale, before the broker commission.
+type ObjectWithRoyalties interface {
+	// GetRoyalties returns the recipients and the rates of the royalties on a sale of this object, the amounts are
+	// computed by the keeper from the price of the sale
+	GetRoyalties(ctx sdk.Context, data CustomData) []Royalty
+}
+
+// ObjectWithDomain is an object (that should be a TransferableObject in the context of this module) that belongs to a
+// domain, the escrows and their records can be looked up by domain.
+type ObjectWithDomain interface {
+	// GetDomain returns the name of the domain this object belongs to
+	GetDomain() string
+}
+
+// ObjectWithTransferDependency is an object (that should be a TransferableObject in the context of this module) whose
+// transfer depends on another object of the same bundle, e.g. an account of a closed domain can only be transferred by
+// the admin of its domain, so it must be transferred before its domain.
+type ObjectWithTransferDependency interface {
+	// MustTransferBefore returns true if this object must be transferred before the given object when both are
+	// transferred together
+	MustTransferBefore(other TransferableObject) bool
+}
+
+// GetObjectDomain returns the domain of the given object, or an empty string if it does not belong to a domain
+func GetObjectDomain(object TransferableObject) string {
+	if obj, ok := object.(ObjectWithDomain); ok {
+		return obj.GetDomain()
+	}
+	return ""
+}
+
 // TransferableObject is the object type that is used in escrows.
 // It is an object that can be marshalled, transferred and that has a unique type ID.
 type TransferableObject interface {
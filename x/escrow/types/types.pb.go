@@ -64,6 +64,39 @@ func (EscrowState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_06970306f8aa7966, []int{0}
 }
 
+// PriceDecay defines how the price of an escrow declines over time
+type PriceDecay int32
+
+const (
+	// PRICE_DECAY_NONE_UNSPECIFIED defines a fixed price.
+	PriceDecay_None PriceDecay = 0
+	// PRICE_DECAY_LINEAR defines a price declining linearly to the floor price.
+	PriceDecay_Linear PriceDecay = 1
+	// PRICE_DECAY_EXPONENTIAL defines a price declining exponentially to the
+	// floor price.
+	PriceDecay_Exponential PriceDecay = 2
+)
+
+var PriceDecay_name = map[int32]string{
+	0: "PRICE_DECAY_NONE_UNSPECIFIED",
+	1: "PRICE_DECAY_LINEAR",
+	2: "PRICE_DECAY_EXPONENTIAL",
+}
+
+var PriceDecay_value = map[string]int32{
+	"PRICE_DECAY_NONE_UNSPECIFIED": 0,
+	"PRICE_DECAY_LINEAR":           1,
+	"PRICE_DECAY_EXPONENTIAL":      2,
+}
+
+func (x PriceDecay) String() string {
+	return proto.EnumName(PriceDecay_name, int32(x))
+}
+
+func (PriceDecay) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_06970306f8aa7966, []int{1}
+}
+
 // Escrow defines the struct of an escrow
 type Escrow struct {
 	Id     string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -77,6 +110,21 @@ type Escrow struct {
 	Deadline         uint64                                   `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	BrokerAddress    string                                   `protobuf:"bytes,7,opt,name=broker_address,json=brokerAddress,proto3" json:"broker_address,omitempty"`
 	BrokerCommission github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,8,opt,name=broker_commission,json=brokerCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"broker_commission"`
+	// wanted_object is set for swap escrows: the object is exchanged for the
+	// wanted object, plus the price if it is not empty
+	WantedObject *types.Any `protobuf:"bytes,10,opt,name=wanted_object,json=wantedObject,proto3" json:"wanted_object,omitempty"`
+	// price_decay is set for dutch escrows, whose price declines from price at
+	// start_time to floor_price at the deadline
+	PriceDecay PriceDecay                               `protobuf:"varint,11,opt,name=price_decay,json=priceDecay,proto3,enum=starnamed.x.escrow.v1beta1.PriceDecay" json:"price_decay,omitempty"`
+	FloorPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=floor_price,json=floorPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"floor_price"`
+	StartTime  uint64                                   `protobuf:"varint,13,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// selling_broker_share is the share of the broker commission paid to the
+	// selling broker when the escrow is completed through another broker than
+	// the listing broker_address
+	SellingBrokerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=selling_broker_share,json=sellingBrokerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"selling_broker_share"`
+	// buyer is set for private sales: only the designated buyer can complete
+	// the escrow, anybody can if it is empty
+	Buyer string `protobuf:"bytes,15,opt,name=buyer,proto3" json:"buyer,omitempty"`
 }
 
 func (m *Escrow) Reset()         { *m = Escrow{} }
@@ -112,53 +160,262 @@ func (m *Escrow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Escrow proto.InternalMessageInfo
 
+// Royalty defines a share of the price of an escrow paid to a recipient other
+// than the seller when the escrow is completed
+type Royalty struct {
+	Recipient string                                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Rate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// amount is the part of the price paid to the recipient
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06970306f8aa7966, []int{1}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+// Offer defines the struct of an offer made by a buyer on an object which is
+// not necessarily listed in an escrow, the price is locked in the offer
+// account until the offer is accepted, withdrawn or expired
+type Offer struct {
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer    string                                   `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Object   *types.Any                               `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Deadline uint64                                   `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *Offer) Reset()         { *m = Offer{} }
+func (m *Offer) String() string { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()    {}
+func (*Offer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06970306f8aa7966, []int{2}
+}
+func (m *Offer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Offer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Offer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Offer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offer.Merge(m, src)
+}
+func (m *Offer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Offer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Offer proto.InternalMessageInfo
+
+// ObjectBundle defines a transferable object made of several transferable
+// objects, which are sold together in a single escrow
+type ObjectBundle struct {
+	Objects []*types.Any `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (m *ObjectBundle) Reset()         { *m = ObjectBundle{} }
+func (m *ObjectBundle) String() string { return proto.CompactTextString(m) }
+func (*ObjectBundle) ProtoMessage()    {}
+func (*ObjectBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06970306f8aa7966, []int{3}
+}
+func (m *ObjectBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBundle.Merge(m, src)
+}
+func (m *ObjectBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBundle proto.InternalMessageInfo
+
+// EscrowRecord defines the compact record of a completed or refunded escrow,
+// which is kept once the escrow is removed
+type EscrowRecord struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// state is either completed or refunded
+	State  EscrowState `protobuf:"varint,2,opt,name=state,proto3,enum=starnamed.x.escrow.v1beta1.EscrowState" json:"state,omitempty"`
+	Seller string      `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is empty for a refunded escrow
+	Buyer string `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the price paid, empty for a refunded escrow
+	Price      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	ObjectType TypeID                                   `protobuf:"varint,6,opt,name=object_type,json=objectType,proto3,casttype=TypeID" json:"object_type,omitempty"`
+	// object_keys are the key of the object followed by the keys of the objects
+	// of a bundle
+	ObjectKeys [][]byte `protobuf:"bytes,7,rep,name=object_keys,json=objectKeys,proto3" json:"object_keys,omitempty"`
+	// domain is the domain of the object, if it belongs to one
+	Domain string `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain,omitempty"`
+	// time is the block time of the completion or refund, as a unix timestamp
+	Time uint64 `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *EscrowRecord) Reset()         { *m = EscrowRecord{} }
+func (m *EscrowRecord) String() string { return proto.CompactTextString(m) }
+func (*EscrowRecord) ProtoMessage()    {}
+func (*EscrowRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06970306f8aa7966, []int{4}
+}
+func (m *EscrowRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowRecord.Merge(m, src)
+}
+func (m *EscrowRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("starnamed.x.escrow.v1beta1.EscrowState", EscrowState_name, EscrowState_value)
+	proto.RegisterEnum("starnamed.x.escrow.v1beta1.PriceDecay", PriceDecay_name, PriceDecay_value)
 	proto.RegisterType((*Escrow)(nil), "starnamed.x.escrow.v1beta1.Escrow")
+	proto.RegisterType((*Royalty)(nil), "starnamed.x.escrow.v1beta1.Royalty")
+	proto.RegisterType((*Offer)(nil), "starnamed.x.escrow.v1beta1.Offer")
+	proto.RegisterType((*ObjectBundle)(nil), "starnamed.x.escrow.v1beta1.ObjectBundle")
+	proto.RegisterType((*EscrowRecord)(nil), "starnamed.x.escrow.v1beta1.EscrowRecord")
 }
 
 func init() { proto.RegisterFile("iov/escrow/v1beta1/types.proto", fileDescriptor_06970306f8aa7966) }
 
 var fileDescriptor_06970306f8aa7966 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0x24, 0x4d, 0xd3, 0x0b, 0xad, 0xc2, 0xa9, 0x54, 0xae, 0x25, 0x5c, 0x0b, 0xa9,
-	0x34, 0x50, 0xf5, 0x4c, 0xcb, 0xcc, 0xd0, 0xc4, 0x57, 0x29, 0x12, 0x34, 0x91, 0x93, 0x0a, 0x04,
-	0x43, 0x64, 0xfb, 0xde, 0x86, 0xa3, 0x89, 0x2f, 0xf2, 0xb9, 0xa5, 0xfd, 0x06, 0x28, 0x13, 0x5f,
-	0x20, 0x13, 0x1b, 0x33, 0x03, 0x12, 0x5f, 0xa0, 0x62, 0xea, 0x88, 0x18, 0x0a, 0xb4, 0x5f, 0x04,
-	0xd9, 0xe7, 0x44, 0xed, 0x80, 0xc4, 0xe4, 0x7b, 0xdf, 0xfb, 0x3d, 0xef, 0x9f, 0xc7, 0x36, 0x32,
-	0xb9, 0x38, 0xb1, 0x41, 0x06, 0x91, 0x78, 0x6f, 0x9f, 0x6c, 0xfb, 0x10, 0x7b, 0xdb, 0x76, 0x7c,
-	0x36, 0x02, 0x49, 0x46, 0x91, 0x88, 0x05, 0x36, 0x64, 0xec, 0x45, 0xa1, 0x37, 0x04, 0x46, 0x4e,
-	0x89, 0xe2, 0x48, 0xc6, 0x19, 0x66, 0x20, 0xe4, 0x50, 0x48, 0xdb, 0xf7, 0x24, 0xcc, 0xc4, 0x81,
-	0xe0, 0xa1, 0xd2, 0x1a, 0xcb, 0x7d, 0xd1, 0x17, 0xe9, 0xd1, 0x4e, 0x4e, 0x59, 0x76, 0xb5, 0x2f,
-	0x44, 0x7f, 0x00, 0x76, 0x1a, 0xf9, 0xc7, 0x87, 0xb6, 0x17, 0x9e, 0x4d, 0xaf, 0x54, 0xc1, 0x9e,
-	0xd2, 0xa8, 0x40, 0x5d, 0x3d, 0xf8, 0x5a, 0x40, 0x25, 0x9a, 0xb6, 0xc7, 0x4b, 0x28, 0xcf, 0x99,
-	0xae, 0x59, 0x5a, 0x6d, 0xc1, 0xcd, 0x73, 0x86, 0x57, 0x50, 0x49, 0xc2, 0x60, 0x00, 0x91, 0x9e,
-	0x4f, 0x73, 0x59, 0x84, 0x1d, 0x54, 0x12, 0xfe, 0x3b, 0x08, 0x62, 0xbd, 0x60, 0x69, 0xb5, 0xca,
-	0xce, 0x32, 0x51, 0x9d, 0xc9, 0xb4, 0x33, 0xd9, 0x0d, 0xcf, 0xea, 0x2b, 0xdf, 0xbf, 0x6c, 0xe1,
-	0x6e, 0xe4, 0x85, 0xf2, 0x10, 0x22, 0xcf, 0x1f, 0x40, 0x2b, 0xd5, 0xb8, 0x99, 0x16, 0x7b, 0x68,
-	0x6e, 0x14, 0xf1, 0x00, 0xf4, 0xa2, 0x55, 0xa8, 0x55, 0x76, 0x56, 0x49, 0x36, 0x56, 0xb2, 0xf4,
-	0xd4, 0x09, 0xd2, 0x10, 0x3c, 0xac, 0x3f, 0x39, 0xbf, 0x5c, 0xcb, 0x7d, 0xfe, 0xb5, 0x56, 0xeb,
-	0xf3, 0xf8, 0xed, 0xb1, 0x4f, 0x02, 0x31, 0xcc, 0x76, 0xc8, 0x1e, 0x5b, 0x92, 0x1d, 0x65, 0xe6,
-	0x26, 0x02, 0xe9, 0xaa, 0xca, 0xf8, 0x19, 0x9a, 0x93, 0xb1, 0x17, 0x83, 0x3e, 0x67, 0x69, 0xb5,
-	0xa5, 0x9d, 0x0d, 0xf2, 0x6f, 0xcf, 0x89, 0xf2, 0xa0, 0x93, 0xe0, 0xae, 0x52, 0x61, 0x03, 0x95,
-	0x19, 0x78, 0x6c, 0xc0, 0x43, 0xd0, 0x4b, 0x96, 0x56, 0x2b, 0xba, 0xb3, 0x18, 0xaf, 0xa3, 0x25,
-	0x3f, 0x12, 0x47, 0x10, 0xf5, 0x3c, 0xc6, 0x22, 0x90, 0x52, 0x9f, 0x4f, 0x3d, 0x5a, 0x54, 0xd9,
-	0x5d, 0x95, 0xc4, 0x6f, 0xd0, 0xdd, 0x0c, 0x0b, 0xc4, 0x70, 0xc8, 0xa5, 0xe4, 0x22, 0xd4, 0xcb,
-	0x09, 0x59, 0x27, 0xc9, 0x56, 0x3f, 0x2f, 0xd7, 0x1e, 0xfe, 0xc7, 0x56, 0x0e, 0x04, 0x6e, 0x55,
-	0x15, 0x6a, 0xcc, 0xea, 0x3c, 0xfe, 0xa6, 0xa1, 0xca, 0x8d, 0xb1, 0xf1, 0x26, 0xba, 0x4f, 0x3b,
-	0x0d, 0xb7, 0xf5, 0xb2, 0xd7, 0xe9, 0xee, 0x76, 0x69, 0xaf, 0xd5, 0xa6, 0xfb, 0xbd, 0x83, 0xfd,
-	0x4e, 0x9b, 0x36, 0x9a, 0x7b, 0x4d, 0xea, 0x54, 0x73, 0x46, 0x79, 0x3c, 0xb1, 0x8a, 0xad, 0x11,
-	0x84, 0xf8, 0x11, 0x5a, 0xb9, 0x05, 0x37, 0x5a, 0x2f, 0xda, 0xcf, 0x69, 0x97, 0x3a, 0x55, 0xcd,
-	0x58, 0x1c, 0x4f, 0xac, 0x85, 0x86, 0x18, 0x8e, 0x06, 0x10, 0x03, 0xc3, 0x1b, 0xe8, 0xde, 0x2d,
-	0xd4, 0xa5, 0x7b, 0x07, 0xfb, 0x0e, 0x75, 0xaa, 0x79, 0xe3, 0xce, 0x78, 0x62, 0x95, 0x5d, 0x38,
-	0x3c, 0x0e, 0x19, 0x30, 0xbc, 0x8e, 0x96, 0x6f, 0x81, 0xf4, 0x55, 0xbb, 0xe9, 0x52, 0xa7, 0x5a,
-	0x30, 0x2a, 0xe3, 0x89, 0x35, 0x4f, 0x4f, 0x47, 0x3c, 0x02, 0x66, 0x14, 0x3f, 0x7c, 0x32, 0xb5,
-	0x7a, 0xf3, 0xfc, 0x8f, 0x99, 0x3b, 0xbf, 0x32, 0xb5, 0x8b, 0x2b, 0x53, 0xfb, 0x7d, 0x65, 0x6a,
-	0x1f, 0xaf, 0xcd, 0xdc, 0xc5, 0xb5, 0x99, 0xfb, 0x71, 0x6d, 0xe6, 0x5e, 0x6f, 0xde, 0x70, 0x85,
-	0x8b, 0x93, 0x2d, 0x11, 0x82, 0x3d, 0x7b, 0x7b, 0xf6, 0xe9, 0xf4, 0xcf, 0x4a, 0xed, 0xf1, 0x4b,
-	0xe9, 0x87, 0xf7, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x13, 0xa2, 0x24, 0xc6, 0x74, 0x03,
-	0x00, 0x00,
+	// 1004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x3f, 0x73, 0xe3, 0x54,
+	0x10, 0xb7, 0xfc, 0x2f, 0xc9, 0xda, 0xc9, 0x99, 0x37, 0x21, 0xe8, 0x34, 0x77, 0x8e, 0xc6, 0x33,
+	0x77, 0x67, 0x12, 0x22, 0x73, 0xa1, 0xa6, 0xf0, 0x1f, 0x85, 0xf1, 0x10, 0x6c, 0xa3, 0xf8, 0xe0,
+	0x80, 0x42, 0xc8, 0xd2, 0xda, 0x27, 0x22, 0xbf, 0xe7, 0x91, 0xe4, 0x5c, 0xfc, 0x0d, 0x18, 0x57,
+	0xf7, 0x05, 0x5c, 0xd1, 0x51, 0xd3, 0xf1, 0x05, 0x32, 0x54, 0x29, 0x81, 0x22, 0x40, 0xd2, 0x50,
+	0x53, 0x52, 0x31, 0x7a, 0x4f, 0x76, 0x9c, 0x39, 0x60, 0x20, 0x73, 0x14, 0x54, 0xd6, 0xee, 0xfb,
+	0xed, 0xbe, 0xdd, 0xdf, 0xee, 0xfb, 0x8d, 0xa1, 0xe8, 0xb2, 0x93, 0x0a, 0x06, 0xb6, 0xcf, 0x9e,
+	0x57, 0x4e, 0x1e, 0xf7, 0x30, 0xb4, 0x1e, 0x57, 0xc2, 0xc9, 0x08, 0x03, 0x6d, 0xe4, 0xb3, 0x90,
+	0x11, 0x25, 0x08, 0x2d, 0x9f, 0x5a, 0x43, 0x74, 0xb4, 0x53, 0x4d, 0xe0, 0xb4, 0x18, 0xa7, 0x14,
+	0x6d, 0x16, 0x0c, 0x59, 0x50, 0xe9, 0x59, 0x01, 0x2e, 0x82, 0x6d, 0xe6, 0x52, 0x11, 0xab, 0x6c,
+	0x0e, 0xd8, 0x80, 0xf1, 0xcf, 0x4a, 0xf4, 0x15, 0x7b, 0xef, 0x0e, 0x18, 0x1b, 0x78, 0x58, 0xe1,
+	0x56, 0x6f, 0xdc, 0xaf, 0x58, 0x74, 0x32, 0x3f, 0x12, 0x09, 0x4d, 0x11, 0x23, 0x0c, 0x71, 0x54,
+	0xfa, 0x21, 0x0b, 0x59, 0x9d, 0x5f, 0x4f, 0x36, 0x20, 0xe9, 0x3a, 0xb2, 0xa4, 0x4a, 0xe5, 0x35,
+	0x23, 0xe9, 0x3a, 0x64, 0x0b, 0xb2, 0x01, 0x7a, 0x1e, 0xfa, 0x72, 0x92, 0xfb, 0x62, 0x8b, 0x34,
+	0x20, 0xcb, 0x7a, 0x5f, 0xa0, 0x1d, 0xca, 0x29, 0x55, 0x2a, 0xe7, 0xf6, 0x37, 0x35, 0x71, 0xb3,
+	0x36, 0xbf, 0x59, 0xab, 0xd2, 0x49, 0x6d, 0xeb, 0xbb, 0x6f, 0xf6, 0x48, 0xd7, 0xb7, 0x68, 0xd0,
+	0x47, 0xdf, 0xea, 0x79, 0xd8, 0xe6, 0x31, 0x46, 0x1c, 0x4b, 0x2c, 0xc8, 0x8c, 0x7c, 0xd7, 0x46,
+	0x39, 0xad, 0xa6, 0xca, 0xb9, 0xfd, 0xbb, 0x5a, 0x5c, 0x56, 0xd4, 0xf4, 0x9c, 0x09, 0xad, 0xce,
+	0x5c, 0x5a, 0x7b, 0xfb, 0xec, 0x62, 0x3b, 0xf1, 0xf5, 0x4f, 0xdb, 0xe5, 0x81, 0x1b, 0x3e, 0x1b,
+	0xf7, 0x34, 0x9b, 0x0d, 0xe3, 0x1e, 0xe2, 0x9f, 0xbd, 0xc0, 0x39, 0x8e, 0xc9, 0x8d, 0x02, 0x02,
+	0x43, 0x64, 0x26, 0xef, 0x42, 0x26, 0x08, 0xad, 0x10, 0xe5, 0x8c, 0x2a, 0x95, 0x37, 0xf6, 0x1f,
+	0x69, 0x7f, 0xcd, 0xb9, 0x26, 0x38, 0x38, 0x8a, 0xe0, 0x86, 0x88, 0x22, 0x0a, 0xac, 0x3a, 0x68,
+	0x39, 0x9e, 0x4b, 0x51, 0xce, 0xaa, 0x52, 0x39, 0x6d, 0x2c, 0x6c, 0xf2, 0x00, 0x36, 0x7a, 0x3e,
+	0x3b, 0x46, 0xdf, 0xb4, 0x1c, 0xc7, 0xc7, 0x20, 0x90, 0x57, 0x38, 0x47, 0xeb, 0xc2, 0x5b, 0x15,
+	0x4e, 0xf2, 0x19, 0xbc, 0x16, 0xc3, 0x6c, 0x36, 0x1c, 0xba, 0x41, 0xe0, 0x32, 0x2a, 0xaf, 0x46,
+	0xc8, 0x9a, 0x16, 0x75, 0xf5, 0xe3, 0xc5, 0xf6, 0xc3, 0x7f, 0xd0, 0x55, 0x03, 0x6d, 0xa3, 0x20,
+	0x12, 0xd5, 0x17, 0x79, 0xc8, 0x87, 0xb0, 0xfe, 0xdc, 0xa2, 0x21, 0x3a, 0x66, 0x3c, 0x0e, 0xb8,
+	0xc5, 0x38, 0xf2, 0x22, 0x85, 0xb0, 0xc8, 0x7b, 0x90, 0xe3, 0xd4, 0x99, 0x0e, 0xda, 0xd6, 0x44,
+	0xce, 0x71, 0xde, 0x1e, 0xfe, 0x1d, 0x6f, 0x9d, 0x08, 0xde, 0x88, 0xd0, 0x06, 0x8c, 0x16, 0xdf,
+	0xc4, 0x83, 0x5c, 0xdf, 0x63, 0xcc, 0x37, 0xc5, 0x8c, 0xf3, 0xaf, 0x7e, 0xc6, 0xc0, 0xf3, 0xf3,
+	0xeb, 0xc9, 0x7d, 0x80, 0xa8, 0xc4, 0xd0, 0x0c, 0xdd, 0x21, 0xca, 0xeb, 0x7c, 0x56, 0x6b, 0xdc,
+	0xd3, 0x75, 0x87, 0x48, 0x3e, 0x87, 0xcd, 0x68, 0x75, 0x5d, 0x3a, 0x30, 0xe3, 0x69, 0x04, 0xcf,
+	0x2c, 0x1f, 0xe5, 0x8d, 0x5b, 0x0d, 0x82, 0xc4, 0xb9, 0x6a, 0x3c, 0xd5, 0x51, 0x94, 0x89, 0x6c,
+	0x42, 0xa6, 0x37, 0x9e, 0xa0, 0x2f, 0xdf, 0xe1, 0x5b, 0x20, 0x8c, 0xd2, 0xb9, 0x04, 0x2b, 0x06,
+	0x9b, 0x58, 0x5e, 0x38, 0x21, 0xf7, 0x60, 0xcd, 0x47, 0xdb, 0x1d, 0xb9, 0x48, 0xc3, 0xf8, 0x8d,
+	0x5d, 0x3b, 0x48, 0x0d, 0xd2, 0x7e, 0xb4, 0xa8, 0xc9, 0x5b, 0x55, 0xc4, 0x63, 0x89, 0x0d, 0x59,
+	0x6b, 0xc8, 0xc6, 0x34, 0x7a, 0x96, 0xaf, 0x9c, 0xed, 0x38, 0x75, 0xe9, 0x37, 0x09, 0x32, 0xed,
+	0x7e, 0x1f, 0xfd, 0x97, 0xd4, 0x62, 0x41, 0x41, 0x72, 0x89, 0x82, 0xff, 0x8f, 0x56, 0x2c, 0x3f,
+	0xf6, 0xcc, 0xcd, 0xc7, 0x5e, 0xfa, 0x08, 0xf2, 0xa2, 0xa0, 0xda, 0x98, 0x3a, 0x1e, 0x92, 0x03,
+	0x58, 0x11, 0x85, 0x05, 0xb2, 0xa4, 0xa6, 0xfe, 0x75, 0x57, 0xf3, 0xe0, 0xd2, 0xaf, 0x49, 0xc8,
+	0x0b, 0xdd, 0x31, 0xd0, 0x66, 0xbe, 0xf3, 0x12, 0xa7, 0x0b, 0x01, 0x4b, 0xde, 0x4a, 0xc0, 0xae,
+	0x05, 0x3c, 0x75, 0x43, 0xc0, 0x17, 0xa3, 0x4a, 0x2f, 0x8f, 0x6a, 0x41, 0x72, 0xe6, 0x3f, 0x23,
+	0x79, 0x17, 0x72, 0xa2, 0x77, 0x33, 0x3a, 0x14, 0xa2, 0x5a, 0x83, 0xdf, 0x2f, 0xb6, 0xb3, 0xdd,
+	0xc9, 0x08, 0x9b, 0x0d, 0x03, 0xc4, 0x71, 0x64, 0x91, 0xed, 0x05, 0xf8, 0x18, 0x27, 0x91, 0xbe,
+	0xa6, 0xca, 0xf9, 0x39, 0xe0, 0x7d, 0x9c, 0x04, 0x51, 0x7b, 0x0e, 0x1b, 0x5a, 0x6e, 0xac, 0xa8,
+	0x46, 0x6c, 0x11, 0x02, 0x69, 0xae, 0x03, 0x6b, 0x7c, 0x8c, 0xfc, 0x7b, 0xe7, 0x5b, 0x09, 0x72,
+	0x4b, 0x0c, 0x91, 0x5d, 0xb8, 0xaf, 0x1f, 0xd5, 0x8d, 0xf6, 0xc7, 0xe6, 0x51, 0xb7, 0xda, 0xd5,
+	0xcd, 0x76, 0x47, 0x6f, 0x99, 0x4f, 0x5a, 0x47, 0x1d, 0xbd, 0xde, 0x3c, 0x68, 0xea, 0x8d, 0x42,
+	0x42, 0x59, 0x9d, 0xce, 0xd4, 0x74, 0x7b, 0x84, 0x94, 0xbc, 0x09, 0x5b, 0x37, 0xc0, 0xf5, 0xf6,
+	0x07, 0x9d, 0x43, 0xbd, 0xab, 0x37, 0x0a, 0x92, 0xb2, 0x3e, 0x9d, 0xa9, 0x6b, 0x75, 0x36, 0x1c,
+	0x79, 0x18, 0xa2, 0x43, 0x1e, 0xc1, 0xeb, 0x37, 0xa0, 0x86, 0x7e, 0xf0, 0xa4, 0xd5, 0xd0, 0x1b,
+	0x85, 0xa4, 0x92, 0x9f, 0xce, 0xd4, 0x55, 0x03, 0xfb, 0x63, 0xea, 0xa0, 0x43, 0x1e, 0xc0, 0xe6,
+	0x0d, 0xa0, 0xfe, 0xb4, 0xd3, 0x34, 0xf4, 0x46, 0x21, 0xa5, 0xe4, 0xa6, 0x33, 0x75, 0x45, 0x3f,
+	0x1d, 0xb9, 0x3e, 0x3a, 0x4a, 0xfa, 0xcb, 0xaf, 0x8a, 0xd2, 0xce, 0x0b, 0x09, 0xe0, 0x5a, 0x68,
+	0xc9, 0x0e, 0xdc, 0xeb, 0x18, 0xcd, 0xba, 0x6e, 0x36, 0xf4, 0x7a, 0xf5, 0x13, 0xb3, 0xd5, 0x6e,
+	0xe9, 0x7f, 0x56, 0x7b, 0x8b, 0x51, 0x24, 0x25, 0x20, 0xcb, 0xd8, 0xc3, 0x66, 0x4b, 0xaf, 0x1a,
+	0x05, 0x49, 0x81, 0xe9, 0x4c, 0xcd, 0x1e, 0xba, 0x14, 0x2d, 0x9f, 0xbc, 0x05, 0x6f, 0x2c, 0x63,
+	0xf4, 0xa7, 0x9d, 0x76, 0x4b, 0x6f, 0x75, 0x9b, 0xd5, 0xc3, 0x42, 0x52, 0xb9, 0x33, 0x9d, 0xa9,
+	0x39, 0xfd, 0x74, 0xc4, 0x28, 0xd2, 0xd0, 0xb5, 0x3c, 0x51, 0x52, 0xad, 0x79, 0xf6, 0x4b, 0x31,
+	0x71, 0x76, 0x59, 0x94, 0xce, 0x2f, 0x8b, 0xd2, 0xcf, 0x97, 0x45, 0xe9, 0xc5, 0x55, 0x31, 0x71,
+	0x7e, 0x55, 0x4c, 0x7c, 0x7f, 0x55, 0x4c, 0x7c, 0xba, 0xbb, 0xb4, 0x19, 0x2e, 0x3b, 0xd9, 0x63,
+	0x14, 0x2b, 0x8b, 0xdd, 0xad, 0x9c, 0xce, 0xff, 0x18, 0xf1, 0x15, 0xe9, 0x65, 0xf9, 0xab, 0x79,
+	0xe7, 0x8f, 0x01, 0x00, 0xd9, 0x36, 0x01, 0x1b, 0x33, 0x09, 0x00, 0x00,
 }
 
 func (m *Escrow) Marshal() (dAtA []byte, err error) {
@@ -181,6 +438,59 @@ func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.SellingBrokerShare.Size()
+		i -= size
+		if _, err := m.SellingBrokerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.FloorPrice) > 0 {
+		for iNdEx := len(m.FloorPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FloorPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PriceDecay != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceDecay))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.WantedObject != nil {
+		{
+			size, err := m.WantedObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size := m.BrokerCommission.Size()
 		i -= size
@@ -215,99 +525,1380 @@ func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Offer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Offer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ObjectKeys) > 0 {
+		for iNdEx := len(m.ObjectKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectKeys[iNdEx])
+			copy(dAtA[i:], m.ObjectKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ObjectType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ObjectType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.State != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	l = len(m.BrokerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BrokerCommission.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WantedObject != nil {
+		l = m.WantedObject.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PriceDecay != 0 {
+		n += 1 + sovTypes(uint64(m.PriceDecay))
+	}
+	if len(m.FloorPrice) > 0 {
+		for _, e := range m.FloorPrice {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	l = m.SellingBrokerShare.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Offer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *ObjectBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ObjectType != 0 {
+		n += 1 + sovTypes(uint64(m.ObjectType))
+	}
+	if len(m.ObjectKeys) > 0 {
+		for _, b := range m.ObjectKeys {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTypes(uint64(m.Time))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &types.Any{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types1.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= EscrowState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BrokerCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WantedObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WantedObject == nil {
+				m.WantedObject = &types.Any{}
+			}
+			if err := m.WantedObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecay", wireType)
+			}
+			m.PriceDecay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceDecay |= PriceDecay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorPrice = append(m.FloorPrice, types1.Coin{})
+			if err := m.FloorPrice[len(m.FloorPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingBrokerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingBrokerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Offer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Offer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &types.Any{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types1.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Object != nil {
-		{
-			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Escrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Price) > 0 {
-		for _, e := range m.Price {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+func (m *ObjectBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &types.Any{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.State != 0 {
-		n += 1 + sovTypes(uint64(m.State))
-	}
-	if m.Deadline != 0 {
-		n += 1 + sovTypes(uint64(m.Deadline))
-	}
-	l = len(m.BrokerAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.BrokerCommission.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Escrow) Unmarshal(dAtA []byte) error {
+func (m *EscrowRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -330,10 +1921,10 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EscrowRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EscrowRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -369,6 +1960,25 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= EscrowState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
@@ -400,11 +2010,11 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			}
 			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -414,29 +2024,25 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &types.Any{}
-			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
@@ -470,11 +2076,11 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectType", wireType)
 			}
-			m.State = 0
+			m.ObjectType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -484,16 +2090,16 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= EscrowState(b&0x7F) << shift
+				m.ObjectType |= TypeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectKeys", wireType)
 			}
-			m.Deadline = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -503,14 +2109,27 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectKeys = append(m.ObjectKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ObjectKeys[len(m.ObjectKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrokerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -538,13 +2157,13 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrokerAddress = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrokerCommission", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -554,26 +2173,11 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Time |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BrokerCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
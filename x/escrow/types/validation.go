@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"strconv"
 
@@ -25,6 +26,28 @@ func ValidatePrice(price sdk.Coins, denom string) error {
 	return nil
 }
 
+// ValidatePriceDecay verifies that the floor price is valid for the given price decay: a fixed price has no floor
+// price and a declining price must have a valid floor price, in the same denominations and strictly below the price.
+// If denom is empty, does not validate the denomination
+func ValidatePriceDecay(decay PriceDecay, price, floorPrice sdk.Coins, denom string) error {
+	if _, known := PriceDecay_name[int32(decay)]; !known {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "unknown price decay %d", decay)
+	}
+	if decay == PriceDecay_None {
+		if !floorPrice.Empty() {
+			return sdkerrors.Wrap(ErrInvalidPrice, "a floor price requires a price decay")
+		}
+		return nil
+	}
+	if err := ValidatePrice(floorPrice, denom); err != nil {
+		return sdkerrors.Wrap(err, "invalid floor price")
+	}
+	if price.Len() != floorPrice.Len() || !price.IsAllGT(floorPrice) {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "the floor price %s must be below the price %s", floorPrice, price)
+	}
+	return nil
+}
+
 // ValidateID verifies whether the given ID lock is legal
 func ValidateID(id string) error {
 	if len(id) != EscrowIDLength {
@@ -48,6 +71,25 @@ func ValidateObject(object TransferableObject, seller sdk.AccAddress) error {
 	return nil
 }
 
+// ValidateWantedObject checks that the object wanted in exchange by a swap escrow is not the swapped object itself
+// and does not already belong to the seller
+func ValidateWantedObject(object, wanted TransferableObject, seller sdk.AccAddress) error {
+	if wanted == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The wanted object is nil")
+	}
+	if wanted.GetObjectTypeID() == object.GetObjectTypeID() && bytes.Equal(wanted.GetUniqueKey(), object.GetUniqueKey()) {
+		return sdkerrors.Wrap(ErrInvalidSwap, "An object cannot be swapped for itself")
+	}
+	ownedBySeller, err := wanted.IsOwnedBy(seller)
+	if err != nil {
+		return err
+	}
+	if ownedBySeller {
+		return sdkerrors.Wrapf(ErrInvalidSwap, "The wanted object already belongs to %s", seller)
+	}
+	return nil
+}
+
 // validateObjectDeadline checks, if the object is an ObjectWithTimeConstraint, that the given deadline is validated
 // by the object. If the provided context is not null, then a context-aware validation is done. It is not meant to be called
 // directly but rather through the ValidateObjectDeadline or ValidateObjectDeadlineBasic methods.
@@ -104,3 +146,25 @@ func ValidateCommission(commission sdk.Dec) error {
 	}
 	return nil
 }
+
+// ValidateSellingBrokerShare validates that the share of the commission paid to the selling broker is in [0;1]
+func ValidateSellingBrokerShare(share sdk.Dec) error {
+	if share.IsNegative() || share.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidBroker, "The selling broker share must be a number between 0 and 1")
+	}
+	return nil
+}
+
+// ValidateDesignatedBuyer validates the optional designated buyer of an escrow, which cannot be its seller
+func ValidateDesignatedBuyer(buyer, seller string) error {
+	if len(buyer) == 0 {
+		return nil
+	}
+	if err := ValidateAddress(buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+	if buyer == seller {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The seller cannot be the designated buyer of the escrow")
+	}
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 )
 
 // EndBlocker refresh the fees sliding sum in order to make yield queries faster
+// and removes the registration commitments that have expired
 func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.RemoveExpiredCommitments(ctx)
 	// Refresh the value of the fees sum
 	k.RefreshBlockSumCache(ctx, keeper.NumBlocksInAWeek) // TODO: review bug of cms
 }
@@ -3,10 +3,14 @@ package cli
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/iov-one/starnamed/x/starname/types"
@@ -28,6 +32,13 @@ func GetQueryCmd() *cobra.Command {
 		getQueryOwnerAccounts(),
 		getQueryOwnerDomains(),
 		getQueryResourceAccounts(),
+		getQueryIssuerAccounts(),
+		getQueryVerifyCertificate(),
+		getQueryDomainPolicy(),
+		getQueryStarnameHistory(),
+		getQueryResolveAt(),
+		getQueryProvenance(),
+		getQuerySearchStarnames(),
 		getQueryYield(),
 	)
 	return domainQueryCmd
@@ -66,6 +77,248 @@ func getQueryResolveDomain() *cobra.Command {
 	return cmd
 }
 
+func getQueryDomainPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "domain-policy",
+		Aliases: []string{"dp", "policy"},
+		Short:   "get the policy of a domain",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).DomainPolicy(
+				context.Background(),
+				&types.QueryDomainPolicyRequest{
+					Domain: domain,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("domain", "d", "", "the name of the domain")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func getQueryStarnameHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "starname-history",
+		Aliases: []string{"sh", "history"},
+		Short:   "get the recorded states of a starname in a time range",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			starname, err := cmd.Flags().GetString("starname")
+			if err != nil {
+				return err
+			}
+			from, err := getTime(cmd, "from")
+			if err != nil {
+				return err
+			}
+			to, err := getTime(cmd, "to")
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).StarnameHistory(
+				context.Background(),
+				&types.QueryStarnameHistoryRequest{
+					Starname: starname,
+					From:     from,
+					To:       to,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("starname", "s", "", "the starname of the form name*domain")
+	cmd.Flags().String("from", "", "start of the time range, as RFC3339 date or unix timestamp, optional")
+	cmd.Flags().String("to", "", "end of the time range, as RFC3339 date or unix timestamp, optional")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func getQueryResolveAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resolve-at",
+		Aliases: []string{"rat"},
+		Short:   "get the state a starname resolved to at a point in time",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			starname, err := cmd.Flags().GetString("starname")
+			if err != nil {
+				return err
+			}
+			at, err := getTime(cmd, "time")
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).ResolveAt(
+				context.Background(),
+				&types.QueryResolveAtRequest{
+					Starname: starname,
+					Time:     at,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("starname", "s", "", "the starname of the form name*domain")
+	cmd.Flags().StringP("time", "t", "", "the point in time, as RFC3339 date or unix timestamp")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// getTime returns the unix timestamp of a time flag given either as RFC3339 date or as unix timestamp
+func getTime(cmd *cobra.Command, flag string) (int64, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return 0, err
+	}
+	if value == "" {
+		return 0, nil
+	}
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return unix, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid %s: %s", flag, err)
+	}
+	return t.Unix(), nil
+}
+
+func getQueryProvenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provenance",
+		Aliases: []string{"prov"},
+		Short:   "get the chain of custody of a domain or an account",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			starname, err := cmd.Flags().GetString("starname")
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).Provenance(
+				context.Background(),
+				&types.QueryProvenanceRequest{
+					Starname:   starname,
+					Pagination: pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("starname", "s", "", "the domain name or the starname of the form name*domain")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "provenance")
+	return cmd
+}
+
+func getQuerySearchStarnames() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "search",
+		Aliases: []string{"find"},
+		Short:   "search the domains and accounts whose name starts with a prefix",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			prefix, err := cmd.Flags().GetString("prefix")
+			if err != nil {
+				return err
+			}
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			typ, err := cmd.Flags().GetString("type")
+			if err != nil {
+				return err
+			}
+			substring, err := cmd.Flags().GetBool("substring")
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			starnameType := types.StarnameType_Unspecified
+			switch typ {
+			case "":
+			case "domain":
+				starnameType = types.StarnameType_Domain
+			case "account":
+				starnameType = types.StarnameType_Account
+			default:
+				return sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid type %s, expected domain or account", typ)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).SearchStarnames(
+				context.Background(),
+				&types.QuerySearchStarnamesRequest{
+					Prefix:     prefix,
+					Domain:     domain,
+					Type:       starnameType,
+					Substring:  substring,
+					Pagination: pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("prefix", "p", "", "the prefix of the names to search for")
+	cmd.Flags().StringP("domain", "d", "", "the domain to search accounts in, optional")
+	cmd.Flags().StringP("type", "t", "", "domain or account to search only domains or accounts, optional")
+	cmd.Flags().Bool("substring", false, "match the names containing the prefix anywhere, slower")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "search")
+	return cmd
+}
+
 func getQueryDomainAccounts() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "accounts-by-domain",
@@ -209,6 +462,10 @@ func getQueryResolveAccount() *cobra.Command {
 
 				starname = strings.Join([]string{name, domain}, types.StarnameSeparator)
 			}
+			resolve, err := cmd.Flags().GetBool("resolve")
+			if err != nil {
+				return err
+			}
 			// TODO: Validate() that starname is well formed
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -218,6 +475,7 @@ func getQueryResolveAccount() *cobra.Command {
 				context.Background(),
 				&types.QueryStarnameRequest{
 					Starname: starname,
+					Resolve:  resolve,
 				},
 			)
 			if err != nil {
@@ -230,6 +488,7 @@ func getQueryResolveAccount() *cobra.Command {
 	cmd.Flags().StringP("starname", "s", "", "the starname representation of the account, eg antoine*iov")
 	cmd.Flags().StringP("domain", "d", "", "the domain of the account")
 	cmd.Flags().StringP("name", "n", "", "the name of the account")
+	cmd.Flags().Bool("resolve", false, "merge the account resources with the default resources of its domain")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "resolve account")
 	return cmd
@@ -250,6 +509,10 @@ func getQueryResourceAccounts() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			inherited, err := cmd.Flags().GetBool("include-inherited")
+			if err != nil {
+				return err
+			}
 			pagination, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -261,9 +524,10 @@ func getQueryResourceAccounts() *cobra.Command {
 			res, err := types.NewQueryClient(clientCtx).ResourceAccounts(
 				context.Background(),
 				&types.QueryResourceAccountsRequest{
-					Uri:        uri,
-					Resource:   resource,
-					Pagination: pagination,
+					Uri:              uri,
+					Resource:         resource,
+					Pagination:       pagination,
+					IncludeInherited: inherited,
 				},
 			)
 			if err != nil {
@@ -275,11 +539,105 @@ func getQueryResourceAccounts() *cobra.Command {
 	// add flags
 	cmd.Flags().String("uri", "", "the resource uri")
 	cmd.Flags().String("resource", "", "resource")
+	cmd.Flags().Bool("include-inherited", false, "include the accounts inheriting the resource from the default resources of their domain")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "resource accounts")
 	return cmd
 }
 
+func getQueryIssuerAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accounts-by-issuer",
+		Aliases: []string{"abi", "issuer-accounts", "ia"},
+		Short:   "get accounts holding a certificate signed by an issuer",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			issuer, err := cmd.Flags().GetString("issuer")
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).IssuerAccounts(
+				context.Background(),
+				&types.QueryIssuerAccountsRequest{
+					Issuer:     issuer,
+					Pagination: pagination,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("issuer", "i", "", "the issuer of the certificates, jwk:<key thumbprint> or x509:<root public key hash>")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "issuer accounts")
+	return cmd
+}
+
+func getQueryVerifyCertificate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "verify-certificate",
+		Aliases: []string{"vc", "certificate-verify", "cv"},
+		Short:   "verify a certificate of an account; either use the --certificate or --certificate-file flag",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get flags
+			starname, err := cmd.Flags().GetString("starname")
+			if err != nil {
+				return err
+			}
+			cert, err := cmd.Flags().GetBytesBase64("certificate")
+			if err != nil {
+				return err
+			}
+			certFile, err := cmd.Flags().GetString("certificate-file")
+			if err != nil {
+				return err
+			}
+			switch {
+			case len(cert) == 0 && len(certFile) == 0:
+				return ErrCertificateNotProvided
+			case len(cert) != 0 && len(certFile) != 0:
+				return ErrCertificateProvideOnlyOne
+			case len(certFile) != 0:
+				if cert, err = ioutil.ReadFile(certFile); err != nil {
+					return sdkerrors.Wrapf(ErrInvalidCertificate, "err: %s", err)
+				}
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).VerifyCertificate(
+				context.Background(),
+				&types.QueryVerifyCertificateRequest{
+					Starname:    starname,
+					Certificate: cert,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("starname", "s", "", "the starname representation of the account, eg antoine*iov")
+	cmd.Flags().BytesBase64P("certificate", "c", []byte{}, "certificate to verify in base64 encoded format")
+	cmd.Flags().StringP("certificate-file", "f", "", "file containing the certificate to verify")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func getQueryYield() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "yield-estimate",
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	escrowcli "github.com/iov-one/starnamed/x/escrow/client/cli"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// FlagResolveURI is the flag selecting the resource starname arguments are resolved to instead of their owner
+const FlagResolveURI = "resolve-uri"
+
+// annotationStarnameAddress marks the positional arguments of a command and the flags which are addresses and
+// accept starnames, the positional arguments are listed by their comma separated indexes
+const annotationStarnameAddress = "starname-address"
+
+// starnameArg matches the arguments that are starnames, the account name can be empty to refer to a domain
+var starnameArg = regexp.MustCompile(`^[\p{L}\p{M}\p{N}_.-]*\*[\p{L}\p{M}\p{N}_-]+$`)
+
+// IsStarname returns true if the provided argument is a starname, such as name*domain or *domain
+func IsStarname(arg string) bool {
+	return starnameArg.MatchString(arg)
+}
+
+// MarkStarnameAddressArgs makes the positional arguments of the command at the provided indexes accept starnames
+func MarkStarnameAddressArgs(cmd *cobra.Command, indexes ...int) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	marked := strings.Split(cmd.Annotations[annotationStarnameAddress], ",")
+	for _, i := range indexes {
+		marked = append(marked, strconv.Itoa(i))
+	}
+	cmd.Annotations[annotationStarnameAddress] = strings.Trim(strings.Join(marked, ","), ",")
+}
+
+// MarkStarnameAddressFlags makes the provided flags of the command accept starnames, it panics if a flag does not exist
+func MarkStarnameAddressFlags(cmd *cobra.Command, names ...string) {
+	for _, name := range names {
+		if err := cmd.Flags().SetAnnotation(name, annotationStarnameAddress, []string{"true"}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// MarkEscrowAddressFlags makes the address flags of the escrow transaction commands accept starnames, the escrow
+// module does not depend on the starname module so its commands are marked from here
+func MarkEscrowAddressFlags(escrowTxCmd *cobra.Command) {
+	for use, names := range map[string][]string{
+		"transfer": {escrowcli.FlagBroker},
+		"update":   {escrowcli.FlagSeller, escrowcli.FlagBuyer},
+	} {
+		if sub, _, err := escrowTxCmd.Find([]string{use}); err == nil && sub != escrowTxCmd {
+			MarkStarnameAddressFlags(sub, names...)
+		}
+	}
+}
+
+// ResolveStarname returns the address of the owner of a starname or, if uri is not empty,
+// the resource of the starname with the provided uri
+func ResolveStarname(clientCtx client.Context, starname, uri string) (string, error) {
+	res, err := types.NewQueryClient(clientCtx).Starname(
+		context.Background(),
+		&types.QueryStarnameRequest{Starname: starname},
+	)
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, "unable to resolve %s", starname)
+	}
+	return resolvedAddress(res, starname, uri)
+}
+
+// registeredAccount returns the account of a starname query response, a starname which is not registered and
+// resolves to the wildcard account of its domain is refused as it may resolve to someone else once registered
+func registeredAccount(res *types.QueryStarnameResponse, starname string) (*types.Account, error) {
+	if res.Wildcard {
+		return nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "%s is not registered and only matches the wildcard account of its domain", starname)
+	}
+	return res.Account, nil
+}
+
+// resolvedAddress returns the address a starname query response resolves to, the owner of the account or the
+// resource of the given uri
+func resolvedAddress(res *types.QueryStarnameResponse, starname, uri string) (string, error) {
+	account, err := registeredAccount(res, starname)
+	if err != nil {
+		return "", err
+	}
+	if uri == "" {
+		return account.Owner.String(), nil
+	}
+	for _, resource := range account.Resources {
+		if resource.URI == uri {
+			return resource.Resource, nil
+		}
+	}
+	return "", sdkerrors.Wrapf(types.ErrResourceDoesNotExist, "%s has no resource with uri %s", starname, uri)
+}
+
+// ResolveStarnameArgs replaces the starnames given as the marked arguments or flag values of a command with the
+// address they resolve to, then prints every resolution and asks the user to confirm it unless --yes is set
+func ResolveStarnameArgs(cmd *cobra.Command, args []string) ([]string, error) {
+	uri, _ := cmd.Flags().GetString(FlagResolveURI)
+	var clientCtx *client.Context
+	return resolveStarnameArgs(cmd, args, func(starname string) (string, error) {
+		if clientCtx == nil {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return "", err
+			}
+			clientCtx = &ctx
+		}
+		return ResolveStarname(*clientCtx, starname, uri)
+	})
+}
+
+func resolveStarnameArgs(cmd *cobra.Command, args []string, resolve func(starname string) (string, error)) ([]string, error) {
+	var resolutions []string
+	resolveArg := func(arg string) (string, error) {
+		if !IsStarname(arg) {
+			return arg, nil
+		}
+		address, err := resolve(arg)
+		if err != nil {
+			return "", err
+		}
+		resolutions = append(resolutions, fmt.Sprintf("%s resolves to %s", arg, address))
+		return address, nil
+	}
+
+	resolved := append([]string(nil), args...)
+	if marked := cmd.Annotations[annotationStarnameAddress]; marked != "" {
+		for _, index := range strings.Split(marked, ",") {
+			i, err := strconv.Atoi(index)
+			if err != nil {
+				return nil, err
+			}
+			if i >= len(resolved) {
+				continue
+			}
+			if resolved[i], err = resolveArg(resolved[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var err error
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if _, ok := flag.Annotations[annotationStarnameAddress]; !ok || err != nil {
+			return
+		}
+		switch value := flag.Value.(type) {
+		case pflag.SliceValue:
+			values := value.GetSlice()
+			for i := range values {
+				if values[i], err = resolveArg(values[i]); err != nil {
+					return
+				}
+			}
+			err = value.Replace(values)
+		default:
+			var address string
+			if address, err = resolveArg(flag.Value.String()); err != nil {
+				return
+			}
+			err = flag.Value.Set(address)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resolutions) == 0 {
+		return resolved, nil
+	}
+	for _, resolution := range resolutions {
+		fmt.Fprintln(cmd.ErrOrStderr(), resolution)
+	}
+	if skip, _ := cmd.Flags().GetBool(flags.FlagSkipConfirmation); skip {
+		return resolved, nil
+	}
+	ok, err := input.GetConfirmation("use the resolved addresses", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("starname resolution cancelled")
+	}
+	return resolved, nil
+}
+
+// AddStarnameResolver makes the commands of the provided command tree accept starnames for their arguments and
+// flags marked with MarkStarnameAddressArgs and MarkStarnameAddressFlags, the starnames are resolved to the address
+// of their owner or to one of their resources with the --resolve-uri flag before the command runs
+func AddStarnameResolver(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(FlagResolveURI, "", "resolve starname arguments to their resource with this uri instead of their owner")
+	wrapWithStarnameResolver(cmd)
+}
+
+func wrapWithStarnameResolver(cmd *cobra.Command) {
+	for _, child := range cmd.Commands() {
+		wrapWithStarnameResolver(child)
+	}
+	if cmd.HasSubCommands() || cmd.RunE == nil || !hasStarnameAddress(cmd) {
+		return
+	}
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		resolved, err := ResolveStarnameArgs(cmd, args)
+		if err != nil {
+			return err
+		}
+		return runE(cmd, resolved)
+	}
+}
+
+// hasStarnameAddress returns true if an argument or a flag of the command accepts starnames
+func hasStarnameAddress(cmd *cobra.Command) bool {
+	if cmd.Annotations[annotationStarnameAddress] != "" {
+		return true
+	}
+	marked := false
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		_, ok := flag.Annotations[annotationStarnameAddress]
+		marked = marked || ok
+	})
+	return marked
+}
@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	escrowcli "github.com/iov-one/starnamed/x/escrow/client/cli"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func TestIsStarname(t *testing.T) {
+	cases := map[string]bool{
+		"name*domain":   true,
+		"*domain":       true,
+		"name.sub*iov":  true,
+		"domain":        false,
+		"name*":         false,
+		"a*b*c":         false,
+		"star1qqqqqqqq": false,
+	}
+	for arg, want := range cases {
+		if got := IsStarname(arg); got != want {
+			t.Errorf("IsStarname(%q) = %t, want %t", arg, got, want)
+		}
+	}
+}
+
+func TestResolvedAddress(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	account := &types.Account{
+		Owner:     owner,
+		Resources: []*types.Resource{{URI: "asset:btc", Resource: "bc1address"}},
+	}
+	cases := map[string]struct {
+		res     *types.QueryStarnameResponse
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		"owner":            {res: &types.QueryStarnameResponse{Account: account}, want: owner.String()},
+		"resource":         {res: &types.QueryStarnameResponse{Account: account}, uri: "asset:btc", want: "bc1address"},
+		"missing resource": {res: &types.QueryStarnameResponse{Account: account}, uri: "asset:eth", wantErr: true},
+		"wildcard":         {res: &types.QueryStarnameResponse{Account: account, Wildcard: true}, wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolvedAddress(tc.res, "name*domain", tc.uri)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRegisteredAccount(t *testing.T) {
+	account := &types.Account{Owner: sdk.AccAddress("owner")}
+	got, err := registeredAccount(&types.QueryStarnameResponse{Account: account}, "name*domain")
+	if err != nil || got != account {
+		t.Fatalf("want the account, got %v: %v", got, err)
+	}
+	// an offer or an escrow on an unregistered starname must not target the wildcard account of its domain
+	if _, err := registeredAccount(&types.QueryStarnameResponse{Account: account, Wildcard: true}, "name*domain"); err == nil {
+		t.Fatal("want an error on a wildcard account")
+	}
+}
+
+// newResolverTestCmd returns a command whose second argument and new-owner flag accept starnames
+func newResolverTestCmd(input string) (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().String("new-owner", "", "")
+	cmd.Flags().StringSlice("owners", nil, "")
+	cmd.Flags().String(flags.FlagNote, "", "")
+	cmd.Flags().Bool(flags.FlagSkipConfirmation, false, "")
+	MarkStarnameAddressArgs(cmd, 1)
+	MarkStarnameAddressFlags(cmd, "new-owner", "owners")
+	out := new(bytes.Buffer)
+	cmd.SetIn(strings.NewReader(input))
+	cmd.SetErr(out)
+	return cmd, out
+}
+
+func TestResolveStarnameArgs(t *testing.T) {
+	resolve := func(starname string) (string, error) {
+		if starname == "missing*iov" {
+			return "", fmt.Errorf("%s does not exist", starname)
+		}
+		return "address-of-" + starname, nil
+	}
+
+	t.Run("only marked arguments and flags are resolved", func(t *testing.T) {
+		cmd, out := newResolverTestCmd("y\n")
+		if err := cmd.Flags().Parse([]string{"--new-owner", "alice*iov", "--owners", "bob*iov,addr", "--note", "memo*iov"}); err != nil {
+			t.Fatal(err)
+		}
+		args, err := resolveStarnameArgs(cmd, []string{"from*iov", "to*iov", "10tiov"}, resolve)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"from*iov", "address-of-to*iov", "10tiov"}; strings.Join(args, " ") != strings.Join(want, " ") {
+			t.Fatalf("want args %v, got %v", want, args)
+		}
+		if got, _ := cmd.Flags().GetString("new-owner"); got != "address-of-alice*iov" {
+			t.Fatalf("unexpected new owner %s", got)
+		}
+		if got, _ := cmd.Flags().GetStringSlice("owners"); strings.Join(got, ",") != "address-of-bob*iov,addr" {
+			t.Fatalf("unexpected owners %v", got)
+		}
+		if got, _ := cmd.Flags().GetString(flags.FlagNote); got != "memo*iov" {
+			t.Fatalf("unmarked flag resolved to %s", got)
+		}
+		if !strings.Contains(out.String(), "to*iov resolves to address-of-to*iov") {
+			t.Fatalf("resolution not printed: %s", out.String())
+		}
+	})
+
+	t.Run("declined confirmation", func(t *testing.T) {
+		cmd, _ := newResolverTestCmd("n\n")
+		if _, err := resolveStarnameArgs(cmd, []string{"from", "to*iov"}, resolve); err == nil {
+			t.Fatal("expected an error when the resolution is not confirmed")
+		}
+	})
+
+	t.Run("confirmation skipped", func(t *testing.T) {
+		cmd, out := newResolverTestCmd("")
+		if err := cmd.Flags().Parse([]string{"--" + flags.FlagSkipConfirmation}); err != nil {
+			t.Fatal(err)
+		}
+		args, err := resolveStarnameArgs(cmd, []string{"from", "to*iov"}, resolve)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if args[1] != "address-of-to*iov" || !strings.Contains(out.String(), "resolves to") {
+			t.Fatalf("unexpected resolution %v: %s", args, out.String())
+		}
+	})
+
+	t.Run("nothing to resolve", func(t *testing.T) {
+		cmd, out := newResolverTestCmd("")
+		args, err := resolveStarnameArgs(cmd, []string{"from", "addr"}, resolve)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if args[1] != "addr" || out.Len() != 0 {
+			t.Fatalf("unexpected resolution %v: %s", args, out.String())
+		}
+	})
+
+	t.Run("resolution error", func(t *testing.T) {
+		cmd, _ := newResolverTestCmd("y\n")
+		if _, err := resolveStarnameArgs(cmd, []string{"from", "missing*iov"}, resolve); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestResolveEscrowAddressFlags(t *testing.T) {
+	resolve := func(starname string) (string, error) {
+		return "address-of-" + starname, nil
+	}
+	escrowTxCmd := escrowcli.NewTxCmd()
+	MarkEscrowAddressFlags(escrowTxCmd)
+	find := func(use string) *cobra.Command {
+		cmd, _, err := escrowTxCmd.Find([]string{use})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cmd
+	}
+
+	cases := map[string]struct {
+		cmd   *cobra.Command
+		flags []string
+	}{
+		"escrow transfer":       {cmd: find("transfer"), flags: []string{escrowcli.FlagBroker}},
+		"escrow update":         {cmd: find("update"), flags: []string{escrowcli.FlagSeller, escrowcli.FlagBuyer}},
+		"account escrow create": {cmd: getCmdCreateAccountEscrow(), flags: []string{escrowcli.FlagBroker, escrowcli.FlagBuyer}},
+		"domain escrow create":  {cmd: getCmdCreateDomainEscrow(), flags: []string{escrowcli.FlagBroker, escrowcli.FlagBuyer}},
+		"bundle escrow create":  {cmd: getCmdCreateBundleEscrow(), flags: []string{escrowcli.FlagBroker, escrowcli.FlagBuyer}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if !hasStarnameAddress(tc.cmd) {
+				t.Fatal("the command must be wrapped by the starname resolver")
+			}
+			args := []string{"--" + flags.FlagSkipConfirmation}
+			for _, flag := range tc.flags {
+				args = append(args, "--"+flag, flag+"*iov")
+			}
+			if err := tc.cmd.Flags().Parse(args); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := resolveStarnameArgs(tc.cmd, nil, resolve); err != nil {
+				t.Fatal(err)
+			}
+			for _, flag := range tc.flags {
+				if got, _ := tc.cmd.Flags().GetString(flag); got != "address-of-"+flag+"*iov" {
+					t.Fatalf("unexpected %s %s", flag, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAddStarnameResolver(t *testing.T) {
+	var got []string
+	unmarked := &cobra.Command{Use: "unmarked", RunE: func(cmd *cobra.Command, args []string) error {
+		got = args
+		return nil
+	}}
+	marked := &cobra.Command{Use: "marked", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+	MarkStarnameAddressArgs(marked, 0)
+	root := &cobra.Command{Use: "root"}
+	root.AddCommand(unmarked, marked)
+	unmarkedRunE := fmt.Sprintf("%p", unmarked.RunE)
+	markedRunE := fmt.Sprintf("%p", marked.RunE)
+
+	AddStarnameResolver(root)
+
+	if fmt.Sprintf("%p", unmarked.RunE) != unmarkedRunE {
+		t.Fatal("a command without starname addresses must not be wrapped")
+	}
+	if fmt.Sprintf("%p", marked.RunE) == markedRunE {
+		t.Fatal("a command with starname addresses must be wrapped")
+	}
+	root.SetArgs([]string{"unmarked", "name*iov"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0] != "name*iov" {
+		t.Fatalf("unexpected arguments %v", got)
+	}
+}
@@ -2,12 +2,14 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	escrowcli "github.com/iov-one/starnamed/x/escrow/client/cli"
+	escrowtypes "github.com/iov-one/starnamed/x/escrow/types"
 	"github.com/iov-one/starnamed/x/starname/types"
 	"github.com/spf13/cobra"
 )
@@ -30,20 +33,28 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	domainTxCmd.AddCommand(
+		getCmdCommitRegistration(),
 		getCmdRegisterDomain(),
 		getCmdAddAccountCertificate(),
 		getCmdTransferAccount(),
 		getCmdTransferDomain(),
 		getmCmdSetAccountResources(),
+		getCmdUpsertAccountResources(),
+		getCmdRemoveAccountResources(),
 		getCmdDeleteDomain(),
 		getCmdDeleteAccount(),
 		getCmdRenewDomain(),
 		getCmdRenewAccount(),
+		getCmdSetDomainPolicy(),
 		getCmdDeleteAccountCertificate(),
 		getCmdRegisterAccount(),
 		getCmdSetAccountMetadata(),
 		getCmdCreateAccountEscrow(),
 		getCmdCreateDomainEscrow(),
+		getCmdCreateBundleEscrow(),
+		getCmdCreateSwap(),
+		getCmdCreateAccountOffer(),
+		getCmdCreateDomainOffer(),
 	)
 	return domainTxCmd
 }
@@ -105,13 +116,14 @@ func getCmdTransferDomain() *cobra.Command {
 	}
 	// add flags
 	cmd.Flags().StringP("domain", "d", "", "the domain name to transfer")
-	cmd.Flags().StringP("new-owner", "w", "", "the new owner address in bech32 format")
+	cmd.Flags().StringP("new-owner", "w", "", "the new owner address in bech32 format or a starname")
 	cmd.Flags().IntP("transfer-flag", "t", types.TransferResetNone, fmt.Sprintf(`the transfer mechanism
 	0 == delete all accounts except the "" account; transfer "" to the new owner
 	1 == transfer all accounts owned by the old owner to the new owner; leave others intact
 	2 == leave all accounts intact except the "" account; transfer "" to the new owner`))
 	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
 	flags.AddTxFlagsToCmd(cmd)
+	MarkStarnameAddressFlags(cmd, "new-owner")
 	return cmd
 }
 
@@ -182,10 +194,11 @@ func getCmdTransferAccount() *cobra.Command {
 	// add flags
 	cmd.Flags().StringP("domain", "d", "", "the domain name of account")
 	cmd.Flags().StringP("name", "n", "", "the name of the account you want to transfer")
-	cmd.Flags().StringP("new-owner", "w", "", "the new owner address in bech32 format")
+	cmd.Flags().StringP("new-owner", "w", "", "the new owner address in bech32 format or a starname")
 	cmd.Flags().StringP("reset", "r", "false", "true: reset all data associated with the account, false: preserves the data")
 	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
 	flags.AddTxFlagsToCmd(cmd)
+	MarkStarnameAddressFlags(cmd, "new-owner")
 	return cmd
 }
 
@@ -259,6 +272,130 @@ func getmCmdSetAccountResources() *cobra.Command {
 	return cmd
 }
 
+func getCmdUpsertAccountResources() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-resources-upsert",
+		Aliases: []string{"aru", "upsert-resources", "ur"},
+		Short:   "add resources to an account or update the resources with the same URI",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			resourcesPath, err := cmd.Flags().GetString("src")
+			if err != nil {
+				return err
+			}
+			// open resources file
+			f, err := os.Open(resourcesPath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			// unmarshal resources
+			var resources []*types.Resource
+			err = json.NewDecoder(f).Decode(&resources)
+			if err != nil {
+				return err
+			}
+			feePayerStr, err := cmd.Flags().GetString("payer")
+			if err != nil {
+				return err
+			}
+			if feePayerStr != "" {
+				_, err = sdk.AccAddressFromBech32(feePayerStr)
+				if err != nil {
+					return err
+				}
+			}
+			// build msg
+			msg := &types.MsgUpsertAccountResources{
+				Domain:    domain,
+				Name:      name,
+				Resources: resources,
+				Owner:     clientCtx.GetFromAddress().String(),
+				Payer:     feePayerStr,
+			}
+			// check if valid
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("domain", "d", "", "the domain name of account")
+	cmd.Flags().StringP("name", "n", "", "the name of the account whose resources you want to upsert")
+	cmd.Flags().StringP("src", "r", "resources.json", "the file containing the resources to upsert in json format")
+	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdRemoveAccountResources() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-resources-remove [uri...]",
+		Aliases: []string{"arr", "remove-resources"},
+		Short:   "remove the resources of an account with the given URIs",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			feePayerStr, err := cmd.Flags().GetString("payer")
+			if err != nil {
+				return err
+			}
+			if feePayerStr != "" {
+				_, err = sdk.AccAddressFromBech32(feePayerStr)
+				if err != nil {
+					return err
+				}
+			}
+			// build msg
+			msg := &types.MsgRemoveAccountResources{
+				Domain: domain,
+				Name:   name,
+				URIs:   args,
+				Owner:  clientCtx.GetFromAddress().String(),
+				Payer:  feePayerStr,
+			}
+			// check if valid
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// add flags
+	cmd.Flags().StringP("domain", "d", "", "the domain name of account")
+	cmd.Flags().StringP("name", "n", "", "the name of the account whose resources you want to remove")
+	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getCmdDeleteDomain() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "domain-delete",
@@ -571,6 +708,10 @@ func getCmdAddAccountCertificate() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			certType, err := cmd.Flags().GetString("certificate-type")
+			if err != nil {
+				return err
+			}
 
 			var c json.RawMessage
 			switch {
@@ -589,6 +730,11 @@ func getCmdAddAccountCertificate() *cobra.Command {
 				if err != nil {
 					return sdkerrors.Wrapf(ErrInvalidCertificate, "err: %s", err)
 				}
+				// typed certificates are read as is since they are not necessarily json
+				if types.CertificateType(certType) != types.OpaqueCertificate {
+					c = cfb
+					break
+				}
 				if err := json.Unmarshal(cfb, &c); err != nil {
 					return sdkerrors.Wrapf(ErrInvalidCertificate, "err: %s", err)
 				}
@@ -605,11 +751,12 @@ func getCmdAddAccountCertificate() *cobra.Command {
 			}
 			// build msg
 			msg := &types.MsgAddAccountCertificate{
-				Domain:         domain,
-				Name:           name,
-				Owner:          clientCtx.GetFromAddress().String(),
-				NewCertificate: c,
-				Payer:          feePayerStr,
+				Domain:          domain,
+				Name:            name,
+				Owner:           clientCtx.GetFromAddress().String(),
+				NewCertificate:  c,
+				Payer:           feePayerStr,
+				CertificateType: types.CertificateType(certType),
 			}
 			// check if valid
 			if err = msg.ValidateBasic(); err != nil {
@@ -624,6 +771,7 @@ func getCmdAddAccountCertificate() *cobra.Command {
 	cmd.Flags().StringP("name", "n", "", "name of the account")
 	cmd.Flags().BytesBase64P("certificate", "c", []byte{}, "certificate json you want to add in base64 encoded format")
 	cmd.Flags().StringP("certificate-file", "f", "", "directory of certificate file in json format")
+	cmd.Flags().StringP("certificate-type", "t", "", "type of the certificate (jws, x509 or vc), optional; typed certificates are verified on insert")
 	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -683,6 +831,14 @@ func getCmdRegisterAccount() *cobra.Command {
 					return err
 				}
 			}
+			salt, err := getSalt(cmd)
+			if err != nil {
+				return err
+			}
+			wildcard, err := cmd.Flags().GetBool("wildcard")
+			if err != nil {
+				return err
+			}
 			// build msg
 			msg := &types.MsgRegisterAccount{
 				Domain:     domain,
@@ -691,6 +847,8 @@ func getCmdRegisterAccount() *cobra.Command {
 				Registerer: clientCtx.GetFromAddress().String(),
 				Payer:      feePayerStr,
 				Broker:     brokerStr,
+				Salt:       salt,
+				Wildcard:   wildcard,
 			}
 			// check if valid
 			if err = msg.ValidateBasic(); err != nil {
@@ -702,10 +860,13 @@ func getCmdRegisterAccount() *cobra.Command {
 	}
 	cmd.Flags().StringP("domain", "d", "", "the existing domain for your account")
 	cmd.Flags().StringP("name", "n", "", "the name of your account")
-	cmd.Flags().StringP("owner", "w", "", "the address of the owner, if no owner provided signer is the owner")
+	cmd.Flags().StringP("owner", "w", "", "the address of the owner or a starname, if no owner provided signer is the owner")
 	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
 	cmd.Flags().StringP("broker", "r", "", "address of the broker, optional")
+	cmd.Flags().String("salt", "", "hex encoded salt of the registration commitment, required if commit-reveal registration is enabled")
+	cmd.Flags().Bool("wildcard", false, "register the account as the catch-all account of the domain, the unregistered names of the domain resolve to it")
 	flags.AddTxFlagsToCmd(cmd)
+	MarkStarnameAddressFlags(cmd, "owner")
 	return cmd
 }
 
@@ -751,12 +912,17 @@ func getCmdRegisterDomain() *cobra.Command {
 					return err
 				}
 			}
+			salt, err := getSalt(cmd)
+			if err != nil {
+				return err
+			}
 			msg := &types.MsgRegisterDomain{
 				Name:       domain,
 				Admin:      clientCtx.GetFromAddress().String(),
 				DomainType: types.DomainType(dType),
 				Broker:     brokerStr,
 				Payer:      feePayerStr,
+				Salt:       salt,
 			}
 			// check if valid
 			if err = msg.ValidateBasic(); err != nil {
@@ -772,10 +938,263 @@ func getCmdRegisterDomain() *cobra.Command {
 	cmd.Flags().StringP("type", "t", types.ClosedDomain, "type of the domain")
 	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
 	cmd.Flags().StringP("broker", "r", "", "address of the broker, optional")
+	cmd.Flags().String("salt", "", "hex encoded salt of the registration commitment, required if commit-reveal registration is enabled")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// getCmdCommitRegistration is the cli command to commit to a future domain or account registration
+func getCmdCommitRegistration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "registration-commit",
+		Aliases: []string{"rc", "commit-registration", "cr", "commit"},
+		Short:   "commit to a future domain or account registration",
+		Long:    "commit to the registration of a domain, or of an account if --name is provided; the registration must then be sent with the same --salt by the same signer",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			salt, err := getSalt(cmd)
+			if err != nil {
+				return err
+			}
+			if len(salt) == 0 {
+				return sdkerrors.Wrap(types.ErrInvalidRequest, "salt is required")
+			}
+			feePayerStr, err := cmd.Flags().GetString("payer")
+			if err != nil {
+				return err
+			}
+			if feePayerStr != "" {
+				_, err = sdk.AccAddressFromBech32(feePayerStr)
+				if err != nil {
+					return err
+				}
+			}
+			msg := &types.MsgCommitRegistration{
+				Commitment: types.ComputeCommitment(domain, name, clientCtx.GetFromAddress(), salt),
+				Owner:      clientCtx.GetFromAddress().String(),
+				Payer:      feePayerStr,
+			}
+			// check if valid
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// add flags
+	cmd.Flags().StringP("domain", "d", "", "name of the domain you want to register")
+	cmd.Flags().StringP("name", "n", "", "name of the account you want to register, empty for a domain")
+	cmd.Flags().String("salt", "", "hex encoded secret salt, it must be provided again on registration")
+	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// getCmdSetDomainPolicy is the cli command to set the policy of a domain
+func getCmdSetDomainPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "domain-policy-set",
+		Aliases: []string{"dps", "set-domain-policy", "sdp"},
+		Short:   "set the policy of a domain",
+		Long:    "set the limits and periods tightening the configuration for the accounts of the domain, the royalty on account resales and, for open domains only, the price paid to the domain admin to register and renew accounts and the addresses allowed or denied to register accounts; the previous policy is replaced",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			registrationPrice, err := getCoins(cmd, "registration-price")
+			if err != nil {
+				return err
+			}
+			renewalPrice, err := getCoins(cmd, "renewal-price")
+			if err != nil {
+				return err
+			}
+			allowlist, err := getAddresses(cmd, "allowlist")
+			if err != nil {
+				return err
+			}
+			denylist, err := getAddresses(cmd, "denylist")
+			if err != nil {
+				return err
+			}
+			feePayerStr, err := cmd.Flags().GetString("payer")
+			if err != nil {
+				return err
+			}
+			if feePayerStr != "" {
+				_, err = sdk.AccAddressFromBech32(feePayerStr)
+				if err != nil {
+					return err
+				}
+			}
+			accountRenewalPeriod, err := getDuration(cmd, "account-renewal-period")
+			if err != nil {
+				return err
+			}
+			accountGracePeriod, err := getDuration(cmd, "account-grace-period")
+			if err != nil {
+				return err
+			}
+			resourcesMax, err := cmd.Flags().GetUint32("resources-max")
+			if err != nil {
+				return err
+			}
+			certificateCountMax, err := cmd.Flags().GetUint32("certificate-count-max")
+			if err != nil {
+				return err
+			}
+			metadataSizeMax, err := cmd.Flags().GetUint64("metadata-size-max")
+			if err != nil {
+				return err
+			}
+			validAccountName, err := cmd.Flags().GetString("valid-account-name")
+			if err != nil {
+				return err
+			}
+			royaltyRecipientStr, err := cmd.Flags().GetString("royalty-recipient")
+			if err != nil {
+				return err
+			}
+			var royaltyRecipient sdk.AccAddress
+			if royaltyRecipientStr != "" {
+				royaltyRecipient, err = sdk.AccAddressFromBech32(royaltyRecipientStr)
+				if err != nil {
+					return err
+				}
+			}
+			royaltyRateStr, err := cmd.Flags().GetString("royalty-rate")
+			if err != nil {
+				return err
+			}
+			royaltyRate := sdk.ZeroDec()
+			if royaltyRateStr != "" {
+				royaltyRate, err = sdk.NewDecFromStr(royaltyRateStr)
+				if err != nil {
+					return err
+				}
+			}
+			msg := &types.MsgSetDomainPolicy{
+				Domain: domain,
+				Admin:  clientCtx.GetFromAddress().String(),
+				Payer:  feePayerStr,
+				Policy: types.DomainPolicy{
+					Domain:               domain,
+					RegistrationPrice:    registrationPrice,
+					RenewalPrice:         renewalPrice,
+					Allowlist:            allowlist,
+					Denylist:             denylist,
+					AccountRenewalPeriod: accountRenewalPeriod,
+					AccountGracePeriod:   accountGracePeriod,
+					ResourcesMax:         resourcesMax,
+					CertificateCountMax:  certificateCountMax,
+					MetadataSizeMax:      metadataSizeMax,
+					ValidAccountName:     validAccountName,
+					RoyaltyRecipient:     royaltyRecipient,
+					RoyaltyRate:          royaltyRate,
+				},
+			}
+			// check if valid
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// add flags
+	cmd.Flags().StringP("domain", "d", "", "name of the open domain")
+	cmd.Flags().String("registration-price", "", "price paid to the domain admin to register an account, e.g. 10uiov")
+	cmd.Flags().String("renewal-price", "", "price paid to the domain admin to renew an account, e.g. 10uiov")
+	cmd.Flags().StringSlice("allowlist", nil, "comma separated addresses allowed to register accounts, everyone if empty")
+	cmd.Flags().StringSlice("denylist", nil, "comma separated addresses not allowed to register accounts")
+	cmd.Flags().Duration("account-renewal-period", 0, "account renewal period of the domain, shorter than the configured one, optional")
+	cmd.Flags().Duration("account-grace-period", 0, "account grace period of the domain, shorter than the configured one, optional")
+	cmd.Flags().Uint32("resources-max", 0, "maximum number of resources of an account, lower than the configured one, optional")
+	cmd.Flags().Uint32("certificate-count-max", 0, "maximum number of certificates of an account, lower than the configured one, optional")
+	cmd.Flags().Uint64("metadata-size-max", 0, "maximum size of the metadata of an account, lower than the configured one, optional")
+	cmd.Flags().String("valid-account-name", "", "regular expression account names must match in addition to the configured one, optional")
+	cmd.Flags().String("royalty-recipient", "", "address receiving the royalties on the escrow sales of the accounts of the domain, optional")
+	cmd.Flags().String("royalty-rate", "", "fraction of the price of the escrow sales of the accounts paid to the royalty recipient, e.g. 0.05, lower than the configured maximum, optional")
+	cmd.Flags().StringP("payer", "p", "", "address of the fee payer, optional")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// getCoins returns the parsed value of a coins flag
+func getCoins(cmd *cobra.Command, flag string) (sdk.Coins, error) {
+	coinsStr, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if coinsStr == "" {
+		return nil, nil
+	}
+	return sdk.ParseCoinsNormalized(coinsStr)
+}
+
+// getDuration returns the value of a duration flag, nil if the flag was not set
+func getDuration(cmd *cobra.Command, flag string) (*time.Duration, error) {
+	if !cmd.Flags().Changed(flag) {
+		return nil, nil
+	}
+	duration, err := cmd.Flags().GetDuration(flag)
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// getAddresses returns the parsed value of an addresses flag
+func getAddresses(cmd *cobra.Command, flag string) ([]sdk.AccAddress, error) {
+	addrsStr, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]sdk.AccAddress, 0, len(addrsStr))
+	for _, addrStr := range addrsStr {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// getSalt returns the hex decoded value of the salt flag
+func getSalt(cmd *cobra.Command) ([]byte, error) {
+	saltStr, err := cmd.Flags().GetString("salt")
+	if err != nil {
+		return nil, err
+	}
+	salt, err := hex.DecodeString(saltStr)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid salt: %s", err)
+	}
+	return salt, nil
+}
+
 func getCmdSetAccountMetadata() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "account-metadata-set",
@@ -858,17 +1277,22 @@ func getCmdCreateAccountEscrow() *cobra.Command {
 				return fmt.Errorf("a sender address must be provided with the --from flag")
 			}
 
+			starname := strings.Join([]string{name, domain}, types.StarnameSeparator)
 			res, err := types.NewQueryClient(clientCtx).Starname(
 				context.Background(),
 				&types.QueryStarnameRequest{
-					Starname: strings.Join([]string{name, domain}, types.StarnameSeparator),
+					Starname: starname,
 				},
 			)
 			if err != nil {
 				return sdkerrors.Wrapf(err, "Error while resolving the starname")
 			}
+			account, err := registeredAccount(res, starname)
+			if err != nil {
+				return err
+			}
 
-			msg, err := escrowcli.NewMsgCreateEscrow(clientCtx, cmd, res.Account)
+			msg, err := escrowcli.NewMsgCreateEscrow(clientCtx, cmd, account)
 			if err != nil {
 				return err
 			}
@@ -882,6 +1306,10 @@ func getCmdCreateAccountEscrow() *cobra.Command {
 	}
 	// add flags
 	escrowcli.AddCreateEscrowFlags(cmd)
+	escrowcli.AddPriceDecayFlags(cmd)
+	escrowcli.AddBrokerFlags(cmd)
+	escrowcli.AddDesignatedBuyerFlag(cmd)
+	MarkStarnameAddressFlags(cmd, escrowcli.FlagBroker, escrowcli.FlagBuyer)
 	cmd.Flags().StringP("domain", "d", "", "the domain name of account")
 	cmd.Flags().StringP("name", "n", "", "the name of the account whose resources you want to replace")
 	flags.AddTxFlagsToCmd(cmd)
@@ -929,7 +1357,256 @@ func getCmdCreateDomainEscrow() *cobra.Command {
 	}
 	// add flags
 	escrowcli.AddCreateEscrowFlags(cmd)
+	escrowcli.AddPriceDecayFlags(cmd)
+	escrowcli.AddBrokerFlags(cmd)
+	escrowcli.AddDesignatedBuyerFlag(cmd)
+	MarkStarnameAddressFlags(cmd, escrowcli.FlagBroker, escrowcli.FlagBuyer)
 	cmd.Flags().StringP("domain", "d", "", "the domain name of account")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// flagStarnames is the flag listing the domains and accounts sold in a bundle escrow
+const flagStarnames = "starnames"
+
+func getCmdCreateBundleEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bundle-escrow-create",
+		Aliases: []string{"cbe", "escrow-create-bundle", "ecb", "create-bundle-escrow"},
+		Short:   "creates an escrow for several domains and accounts",
+		Long:    "Creates an escrow to sell several domains and accounts together at a fixed price, a domain is given as *domain",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			starnames, err := cmd.Flags().GetStringSlice(flagStarnames)
+			if err != nil {
+				return err
+			}
+
+			if len(clientCtx.FromAddress) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			objects := make([]escrowtypes.TransferableObject, len(starnames))
+			for i, starname := range starnames {
+				if objects[i], err = queryTransferableObject(clientCtx, starname); err != nil {
+					return err
+				}
+			}
+
+			bundle, err := escrowtypes.NewObjectBundle(objects...)
+			if err != nil {
+				return err
+			}
+			msg, err := escrowcli.NewMsgCreateEscrow(clientCtx, cmd, bundle)
+			if err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// add flags
+	escrowcli.AddCreateEscrowFlags(cmd)
+	escrowcli.AddPriceDecayFlags(cmd)
+	escrowcli.AddBrokerFlags(cmd)
+	escrowcli.AddDesignatedBuyerFlag(cmd)
+	MarkStarnameAddressFlags(cmd, escrowcli.FlagBroker, escrowcli.FlagBuyer)
+	cmd.Flags().StringSlice(flagStarnames, nil, "the accounts (name*domain) and domains (*domain) to sell together")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryTransferableObject queries the domain (*domain) or the account (name*domain) designated by the given starname
+func queryTransferableObject(clientCtx client.Context, starname string) (escrowtypes.TransferableObject, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	if strings.HasPrefix(starname, types.StarnameSeparator) {
+		res, err := queryClient.Domain(
+			context.Background(),
+			&types.QueryDomainRequest{
+				Name: strings.TrimPrefix(starname, types.StarnameSeparator),
+			},
+		)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "Error while querying the domain %s", starname)
+		}
+		return res.Domain, nil
+	}
+	res, err := queryClient.Starname(
+		context.Background(),
+		&types.QueryStarnameRequest{
+			Starname: starname,
+		},
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "Error while resolving the starname %s", starname)
+	}
+	account, err := registeredAccount(res, starname)
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+const (
+	// flagStarname is the flag of the domain or account given in a swap escrow
+	flagStarname = "starname"
+	// flagWanted is the flag of the domain or account wanted in exchange in a swap escrow
+	flagWanted = "wanted"
+)
+
+func getCmdCreateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-create",
+		Aliases: []string{"cs", "create-swap"},
+		Short:   "creates an escrow swapping a domain or an account for another one",
+		Long: "Creates an escrow exchanging a domain or an account for another domain or account, plus an optional price" +
+			" paid by the counterparty, a domain is given as *domain",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			starname, err := cmd.Flags().GetString(flagStarname)
+			if err != nil {
+				return err
+			}
+			wantedStarname, err := cmd.Flags().GetString(flagWanted)
+			if err != nil {
+				return err
+			}
+
+			if len(clientCtx.FromAddress) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			object, err := queryTransferableObject(clientCtx, starname)
+			if err != nil {
+				return err
+			}
+			wanted, err := queryTransferableObject(clientCtx, wantedStarname)
+			if err != nil {
+				return err
+			}
+
+			msg, err := escrowcli.NewMsgCreateSwap(clientCtx, cmd, object, wanted)
+			if err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// add flags
+	escrowcli.AddCreateEscrowFlags(cmd)
+	cmd.Flags().String(flagStarname, "", "the account (name*domain) or domain (*domain) to give")
+	cmd.Flags().String(flagWanted, "", "the account (name*domain) or domain (*domain) wanted in exchange")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdCreateAccountOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-offer-create",
+		Aliases: []string{"cao", "offer-create-account", "oca", "create-account-offer"},
+		Short:   "makes an offer on an account",
+		Long:    "Locks coins in an offer to buy an account, the offer can be accepted by the account owner until it expires",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+
+			if len(clientCtx.FromAddress) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			starname := strings.Join([]string{name, domain}, types.StarnameSeparator)
+			res, err := types.NewQueryClient(clientCtx).Starname(
+				context.Background(),
+				&types.QueryStarnameRequest{
+					Starname: starname,
+				},
+			)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "Error while resolving the starname")
+			}
+			account, err := registeredAccount(res, starname)
+			if err != nil {
+				return err
+			}
+
+			msg, err := escrowcli.NewMsgCreateOffer(clientCtx, cmd, account)
+			if err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// add flags
+	escrowcli.AddCreateEscrowFlags(cmd)
+	cmd.Flags().StringP("domain", "d", "", "the domain name of account")
+	cmd.Flags().StringP("name", "n", "", "the name of the account you want to buy")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdCreateDomainOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "domain-offer-create",
+		Aliases: []string{"cdo", "offer-create-domain", "ocd", "create-domain-offer"},
+		Short:   "makes an offer on a domain",
+		Long:    "Locks coins in an offer to buy a domain, the offer can be accepted by the domain admin until it expires",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// get flags
+			domain, err := cmd.Flags().GetString("domain")
+			if err != nil {
+				return err
+			}
+
+			if len(clientCtx.FromAddress) == 0 {
+				return fmt.Errorf("a sender address must be provided with the --from flag")
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Domain(
+				context.Background(),
+				&types.QueryDomainRequest{
+					Name: domain,
+				},
+			)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "Error while querying the domain")
+			}
+
+			msg, err := escrowcli.NewMsgCreateOffer(clientCtx, cmd, res.Domain)
+			if err != nil {
+				return err
+			}
+			// broadcast request
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// add flags
+	escrowcli.AddCreateEscrowFlags(cmd)
+	cmd.Flags().StringP("domain", "d", "", "the domain name you want to buy")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -16,6 +16,7 @@ import (
 // txRouteList clubs together all the transaction routes, which are the transactions
 // // that return the bytes to sign to send a request that modifies state to the domain module
 var txRoutesList = map[string]func(cliCtx client.Context) http.HandlerFunc{
+	"commitRegistration":      commitRegistrationHandler,
 	"registerDomain":          registerDomainHandler,
 	"addAccountCertificates":  addAccountCertificatesHandler,
 	"delAccountCertificates":  delAccountCertificateHandler,
@@ -25,6 +26,9 @@ var txRoutesList = map[string]func(cliCtx client.Context) http.HandlerFunc{
 	"renewAccount":            renewAccountHandler,
 	"renewDomain":             renewDomainHandler,
 	"replaceAccountResources": replaceAccountResourcesHandler,
+	"upsertAccountResources":  upsertAccountResourcesHandler,
+	"removeAccountResources":  removeAccountResourcesHandler,
+	"setDomainPolicy":         setDomainPolicyHandler,
 	"transferAccount":         transferAccountHandler,
 	"transferDomain":          transferDomainHandler,
 	"setAccountMetadata":      setAccountMetadataHandler,
@@ -43,6 +43,40 @@ func registerDomainHandler(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// commitRegistration is the request model for commitRegistrationHandler
+type commitRegistration struct {
+	BaseReq rest.BaseReq                 `json:"base_req"`
+	Message *types.MsgCommitRegistration `json:"message"`
+}
+
+// commitRegistrationHandler builds the transaction to sign to commit to a registration
+func commitRegistrationHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var req commitRegistration
+		if !rest.ReadRESTReq(writer, request, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(writer, http.StatusBadRequest, "failed to parse request")
+		}
+		handleTxRequest(cliCtx, req.BaseReq, req.Message, writer)
+	}
+}
+
+// setDomainPolicy is the request model for setDomainPolicyHandler
+type setDomainPolicy struct {
+	BaseReq rest.BaseReq              `json:"base_req"`
+	Message *types.MsgSetDomainPolicy `json:"message"`
+}
+
+// setDomainPolicyHandler builds the transaction to sign to set the policy of a domain
+func setDomainPolicyHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var req setDomainPolicy
+		if !rest.ReadRESTReq(writer, request, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(writer, http.StatusBadRequest, "failed to parse request")
+		}
+		handleTxRequest(cliCtx, req.BaseReq, req.Message, writer)
+	}
+}
+
 // addAccountCertificates is the request model for addAccountCertificatesHandler
 type addAccountCertificates struct {
 	BaseReq rest.BaseReq                    `json:"base_req"`
@@ -179,6 +213,40 @@ func replaceAccountResourcesHandler(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// upsertAccountResources is the request model for upsertAccountResources
+type upsertAccountResources struct {
+	BaseReq rest.BaseReq                     `json:"base_req"`
+	Message *types.MsgUpsertAccountResources `json:"message"`
+}
+
+// upsertAccountResourcesHandler builds the transaction to sign to add or update account resources
+func upsertAccountResourcesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var req upsertAccountResources
+		if !rest.ReadRESTReq(writer, request, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		handleTxRequest(cliCtx, req.BaseReq, req.Message, writer)
+	}
+}
+
+// removeAccountResources is the request model for removeAccountResources
+type removeAccountResources struct {
+	BaseReq rest.BaseReq                     `json:"base_req"`
+	Message *types.MsgRemoveAccountResources `json:"message"`
+}
+
+// removeAccountResourcesHandler builds the transaction to sign to remove account resources
+func removeAccountResourcesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var req removeAccountResources
+		if !rest.ReadRESTReq(writer, request, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		handleTxRequest(cliCtx, req.BaseReq, req.Message, writer)
+	}
+}
+
 // transferAccount is the request model for transferAccountHandler
 type transferAccount struct {
 	BaseReq rest.BaseReq              `json:"base_req"`
@@ -2,8 +2,11 @@ package starname
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iov-one/starnamed/x/configuration"
 	"github.com/iov-one/starnamed/x/starname/types"
 )
 
@@ -12,20 +15,218 @@ func NewGenesisState(domains []types.Domain, accounts []types.Account) types.Gen
 	return types.GenesisState{Domains: domains, Accounts: accounts}
 }
 
-// ValidateGenesis validates a genesis state
-// checking for domain validity and no domain name repetitions
+// genesisErrors aggregates the errors found while validating a genesis state so that they are all reported at once
+type genesisErrors []string
+
+func (e *genesisErrors) add(format string, args ...interface{}) {
+	*e = append(*e, fmt.Sprintf(format, args...))
+}
+
+func (e genesisErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid %s genesis state, %d error(s):\n%s", types.ModuleName, len(e), strings.Join(e, "\n"))
+}
+
+// ValidateGenesis validates a genesis state on its own, checking that domains and accounts are well formed
+// and declared once, that every account belongs to a declared domain, that every domain has exactly one empty
+// account, owned by the admin if the domain is closed, and that the other records reference valid starnames
 func ValidateGenesis(data types.GenesisState) error {
-	namesSet := make(map[string]struct{}, len(data.Domains))
+	var errs genesisErrors
+	validateGenesis(data, &errs)
+	return errs.err()
+}
+
+// ValidateGenesisWithConfig validates a genesis state as ValidateGenesis does and also checks that the names,
+// resources, certificates and metadata of the domains and accounts satisfy the provided configuration,
+// which is the configuration of the same genesis
+func ValidateGenesisWithConfig(data types.GenesisState, conf configuration.Config) error {
+	var errs genesisErrors
+	validateGenesis(data, &errs)
+	validDomainName, err := regexp.Compile(conf.ValidDomainName)
+	if err != nil {
+		errs.add("invalid domain name regexp in configuration: %s", err)
+		return errs.err()
+	}
+	validAccountName, err := regexp.Compile(conf.ValidAccountName)
+	if err != nil {
+		errs.add("invalid account name regexp in configuration: %s", err)
+		return errs.err()
+	}
+	validURI, err := regexp.Compile(conf.ValidURI)
+	if err != nil {
+		errs.add("invalid uri regexp in configuration: %s", err)
+		return errs.err()
+	}
+	validResource, err := regexp.Compile(conf.ValidResource)
+	if err != nil {
+		errs.add("invalid resource regexp in configuration: %s", err)
+		return errs.err()
+	}
 	for _, domain := range data.Domains {
-		if _, ok := namesSet[domain.Name]; ok {
-			return fmt.Errorf("domain name %s declared twice", domain.Name)
+		if !validDomainName.MatchString(domain.Name) {
+			errs.add("domain %s: invalid name", domain.Name)
+		} else if err := types.ValidateNormalizedName(domain.Name, conf.AllowedNameScripts); err != nil {
+			errs.add("domain %s: %s", domain.Name, err)
 		}
-		namesSet[domain.Name] = struct{}{}
-		if err := validateDomain(domain); err != nil {
-			return err
+	}
+	for _, account := range data.Accounts {
+		if account.Name == nil {
+			continue
+		}
+		starname := account.GetStarname()
+		if *account.Name != types.EmptyAccountName {
+			if !validAccountName.MatchString(*account.Name) {
+				errs.add("account %s: invalid name", starname)
+			} else if err := types.ValidateNormalizedName(*account.Name, conf.AllowedNameScripts); err != nil {
+				errs.add("account %s: %s", starname, err)
+			}
+		}
+		if uint32(len(account.Resources)) > conf.ResourcesMax {
+			errs.add("account %s: %d resources exceed the limit of %d", starname, len(account.Resources), conf.ResourcesMax)
+		}
+		for _, resource := range account.Resources {
+			if resource == nil {
+				errs.add("account %s: nil resource", starname)
+				continue
+			}
+			if !validURI.MatchString(resource.URI) {
+				errs.add("account %s: invalid resource uri %s", starname, resource.URI)
+			}
+			if !validResource.MatchString(resource.Resource) {
+				errs.add("account %s: invalid resource %s", starname, resource.Resource)
+			}
+		}
+		if count := len(account.Certificates) + len(account.TypedCertificates); uint32(count) > conf.CertificateCountMax {
+			errs.add("account %s: %d certificates exceed the limit of %d", starname, count, conf.CertificateCountMax)
+		}
+		for _, cert := range account.Certificates {
+			if uint64(len(cert)) > conf.CertificateSizeMax {
+				errs.add("account %s: certificate size %d exceeds the limit of %d", starname, len(cert), conf.CertificateSizeMax)
+			}
+		}
+		for _, cert := range account.TypedCertificates {
+			if uint64(len(cert.Data)) > conf.CertificateSizeMax {
+				errs.add("account %s: certificate size %d exceeds the limit of %d", starname, len(cert.Data), conf.CertificateSizeMax)
+			}
+		}
+		if uint64(len(account.MetadataURI)) > conf.MetadataSizeMax {
+			errs.add("account %s: metadata size %d exceeds the limit of %d", starname, len(account.MetadataURI), conf.MetadataSizeMax)
+		}
+	}
+	return errs.err()
+}
+
+// validateGenesis adds to errs the errors found in a genesis state which do not depend on the configuration
+func validateGenesis(data types.GenesisState, errs *genesisErrors) {
+	domains := make(map[string]types.Domain, len(data.Domains))
+	for _, domain := range data.Domains {
+		if _, ok := domains[domain.Name]; ok {
+			errs.add("domain %s: declared twice", domain.Name)
+			continue
+		}
+		domains[domain.Name] = domain
+		if domain.Name == "" {
+			errs.add("domain with an empty name")
+		}
+		if err := sdk.VerifyAddressFormat(domain.Admin); err != nil {
+			errs.add("domain %s: invalid admin: %s", domain.Name, err)
+		}
+		if err := types.ValidateDomainType(domain.Type); err != nil {
+			errs.add("domain %s: %s", domain.Name, err)
+		}
+	}
+	accounts := make(map[string]struct{}, len(data.Accounts))
+	emptyAccounts := make(map[string]struct{}, len(data.Domains))
+	wildcardAccounts := make(map[string]struct{}, len(data.Domains))
+	for _, account := range data.Accounts {
+		if account.Name == nil {
+			errs.add("account without name in domain %s", account.Domain)
+			continue
+		}
+		starname := account.GetStarname()
+		if _, ok := accounts[starname]; ok {
+			errs.add("account %s: declared twice", starname)
+			continue
+		}
+		accounts[starname] = struct{}{}
+		if err := sdk.VerifyAddressFormat(account.Owner); err != nil {
+			errs.add("account %s: invalid owner: %s", starname, err)
+		}
+		if account.Wildcard {
+			if _, ok := wildcardAccounts[account.Domain]; ok {
+				errs.add("account %s: domain %s has another wildcard account", starname, account.Domain)
+			}
+			wildcardAccounts[account.Domain] = struct{}{}
+			if *account.Name == types.EmptyAccountName {
+				errs.add("account %s: the empty account can not be the wildcard account", starname)
+			}
+		}
+		domain, ok := domains[account.Domain]
+		if !ok {
+			errs.add("account %s: domain %s is not declared", starname, account.Domain)
+			continue
+		}
+		if *account.Name != types.EmptyAccountName {
+			continue
+		}
+		emptyAccounts[account.Domain] = struct{}{}
+		if domain.Type == types.ClosedDomain && !account.Owner.Equals(domain.Admin) {
+			errs.add("account %s: the empty account of closed domain %s must be owned by its admin %s", starname, domain.Name, domain.Admin)
+		}
+	}
+	for _, domain := range data.Domains {
+		if _, ok := emptyAccounts[domain.Name]; !ok {
+			errs.add("domain %s: missing empty account", domain.Name)
+		}
+	}
+	commitmentsSet := make(map[string]struct{}, len(data.Commitments))
+	for _, commitment := range data.Commitments {
+		if len(commitment.Hash) != types.CommitmentSize {
+			errs.add("invalid commitment %x", commitment.Hash)
+			continue
+		}
+		key := commitment.Owner.String() + string(commitment.Hash)
+		if _, ok := commitmentsSet[key]; ok {
+			errs.add("commitment %x of %s declared twice", commitment.Hash, commitment.Owner)
+		}
+		commitmentsSet[key] = struct{}{}
+	}
+	policiesSet := make(map[string]struct{}, len(data.DomainPolicies))
+	for _, policy := range data.DomainPolicies {
+		if _, ok := policiesSet[policy.Domain]; ok {
+			errs.add("policy of domain %s declared twice", policy.Domain)
+			continue
+		}
+		policiesSet[policy.Domain] = struct{}{}
+		if _, ok := domains[policy.Domain]; !ok {
+			errs.add("policy of domain %s references a missing domain", policy.Domain)
+		}
+		if err := policy.Validate(); err != nil {
+			errs.add("policy of domain %s: %s", policy.Domain, err)
+		}
+	}
+	historySet := make(map[string]struct{}, len(data.AccountHistory))
+	for _, entry := range data.AccountHistory {
+		key := fmt.Sprintf("%s%s%s@%d", entry.Name, types.StarnameSeparator, entry.Domain, entry.Time)
+		if _, ok := historySet[key]; ok {
+			errs.add("history entry %s declared twice", key)
+		}
+		historySet[key] = struct{}{}
+	}
+	for _, entry := range data.Provenance {
+		if entry.Starname == "" {
+			errs.add("provenance entry without starname")
+			continue
+		}
+		if _, ok := types.ProvenanceEvent_name[int32(entry.Event)]; !ok || entry.Event == types.ProvenanceEvent_Unspecified {
+			errs.add("provenance entry of %s has an invalid event %d", entry.Starname, entry.Event)
+		}
+		if err := entry.Price.Validate(); err != nil {
+			errs.add("provenance entry of %s has an invalid price: %s", entry.Starname, err)
 		}
 	}
-	return nil
 }
 
 // DefaultGenesisState creates an empty genesis state for the domain module
@@ -40,11 +241,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, domain := range data.Domains {
 		// create domains
 		ds.Create(&domain)
+		keeper.IndexDomain(ctx, domain.Name)
 	}
 	// insert accounts
 	as := keeper.AccountStore(ctx)
 	for _, account := range data.Accounts {
 		as.Create(&account)
+		// the search index is not exported, it is rebuilt from the accounts, empty accounts are not searchable
+		if account.Name != nil && *account.Name != types.EmptyAccountName {
+			keeper.IndexAccount(ctx, account.Domain, *account.Name)
+		}
+	}
+	// insert registration commitments
+	for _, commitment := range data.Commitments {
+		keeper.SetCommitment(ctx, commitment)
+	}
+	// insert domain policies
+	for _, policy := range data.DomainPolicies {
+		keeper.SetDomainPolicy(ctx, policy)
+	}
+	// insert account history
+	for _, entry := range data.AccountHistory {
+		keeper.SetAccountHistoryEntry(ctx, entry)
+	}
+	// insert provenance, the entries of each starname are appended in their exported order
+	for _, entry := range data.Provenance {
+		keeper.AppendProvenanceEntry(ctx, entry)
 	}
 }
 
@@ -82,14 +304,40 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 		accounts = append(accounts, *account)
 	}
 
+	// registration commitments
+	var commitments []types.Commitment
+	k.IterateCommitments(ctx, func(commitment types.Commitment) bool {
+		commitments = append(commitments, commitment)
+		return false
+	})
+
+	// domain policies
+	var policies []types.DomainPolicy
+	k.IterateDomainPolicies(ctx, func(policy types.DomainPolicy) bool {
+		policies = append(policies, policy)
+		return false
+	})
+
+	// account history
+	var history []types.AccountHistoryEntry
+	k.IterateAccountHistory(ctx, func(entry types.AccountHistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+
+	// provenance
+	var provenance []types.ProvenanceEntry
+	k.IterateProvenance(ctx, func(entry types.ProvenanceEntry) bool {
+		provenance = append(provenance, entry)
+		return false
+	})
+
 	return &types.GenesisState{
-		Domains:  domains,
-		Accounts: accounts,
+		Domains:        domains,
+		Accounts:       accounts,
+		Commitments:    commitments,
+		DomainPolicies: policies,
+		AccountHistory: history,
+		Provenance:     provenance,
 	}
 }
-
-// validateDomain checks if a domain is valid or not
-func validateDomain(d types.Domain) error {
-	// TODO validate domain against the configuration module's domain constraints
-	return nil
-}
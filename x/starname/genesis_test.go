@@ -2,9 +2,11 @@ package starname
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/configuration"
 	"github.com/iov-one/starnamed/x/starname/keeper"
 	"github.com/iov-one/starnamed/x/starname/types"
 )
@@ -36,3 +38,170 @@ func TestExportGenesis(t *testing.T) {
 		t.Fatalf("unexpected genesis state:\nGot: %s\nWanted: %s", b, expected)
 	}
 }
+
+func TestValidateGenesis(t *testing.T) {
+	conf := configuration.Config{
+		ValidDomainName:     "^[a-z0-9]{4,16}$",
+		ValidAccountName:    "^[a-z0-9]{1,16}$",
+		ValidURI:            "^[a-z:]+$",
+		ValidResource:       "^[a-z0-9]+$",
+		ResourcesMax:        1,
+		CertificateCountMax: 1,
+		CertificateSizeMax:  4,
+		MetadataSizeMax:     8,
+	}
+	validState := func() types.GenesisState {
+		return types.GenesisState{
+			Domains: []types.Domain{
+				{Name: "open", Admin: keeper.AliceKey, Type: types.OpenDomain},
+				{Name: "closed", Admin: keeper.BobKey, Type: types.ClosedDomain},
+			},
+			Accounts: []types.Account{
+				{Domain: "open", Name: utils.StrPtr(""), Owner: keeper.AliceKey},
+				{Domain: "open", Name: utils.StrPtr("alice"), Owner: keeper.CharlieKey, Resources: []*types.Resource{{URI: "a:b", Resource: "c"}}},
+				{Domain: "closed", Name: utils.StrPtr(""), Owner: keeper.BobKey},
+				{Domain: "closed", Name: utils.StrPtr("bob"), Owner: keeper.CharlieKey, Certificates: [][]byte{[]byte("cert")}},
+			},
+		}
+	}
+	cases := map[string]struct {
+		Mutate   func(state *types.GenesisState)
+		Expected []string
+	}{
+		"valid": {
+			Mutate: func(state *types.GenesisState) {},
+		},
+		"duplicate domain": {
+			Mutate: func(state *types.GenesisState) {
+				state.Domains = append(state.Domains, state.Domains[0])
+			},
+			Expected: []string{"domain open: declared twice"},
+		},
+		"duplicate account": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts = append(state.Accounts, state.Accounts[1])
+			},
+			Expected: []string{"account alice*open: declared twice"},
+		},
+		"missing domain": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts = append(state.Accounts, types.Account{Domain: "none", Name: utils.StrPtr("x"), Owner: keeper.AliceKey})
+			},
+			Expected: []string{"account x*none: domain none is not declared"},
+		},
+		"missing empty account": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts = state.Accounts[1:]
+			},
+			Expected: []string{"domain open: missing empty account"},
+		},
+		"closed domain empty account owner": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts[2].Owner = keeper.AliceKey
+			},
+			Expected: []string{"empty account of closed domain closed must be owned by its admin"},
+		},
+		"wildcard account": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts[1].Wildcard = true
+				state.Accounts[3].Wildcard = true
+			},
+		},
+		"wildcard accounts": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts[1].Wildcard = true
+				state.Accounts[0].Wildcard = true
+				state.Accounts = append(state.Accounts, types.Account{Domain: "open", Name: utils.StrPtr("other"), Owner: keeper.AliceKey, Wildcard: true})
+			},
+			Expected: []string{
+				"account *open: the empty account can not be the wildcard account",
+				"account other*open: domain open has another wildcard account",
+			},
+		},
+		"invalid names": {
+			Mutate: func(state *types.GenesisState) {
+				state.Domains[0].Name = "Open"
+				state.Accounts[0].Domain, state.Accounts[1].Domain = "Open", "Open"
+				state.Accounts[3].Name = utils.StrPtr("b*b")
+			},
+			Expected: []string{"domain Open: invalid name", "account b*b*closed: invalid name"},
+		},
+		"limits exceeded": {
+			Mutate: func(state *types.GenesisState) {
+				state.Accounts[1].Resources = append(state.Accounts[1].Resources, &types.Resource{URI: "A", Resource: "d"})
+				state.Accounts[3].Certificates = append(state.Accounts[3].Certificates, []byte("toolong"))
+				state.Accounts[3].MetadataURI = "metadata uri"
+			},
+			Expected: []string{
+				"account alice*open: 2 resources exceed the limit of 1",
+				"account alice*open: invalid resource uri A",
+				"account bob*closed: 2 certificates exceed the limit of 1",
+				"account bob*closed: certificate size 7 exceeds the limit of 4",
+				"account bob*closed: metadata size 12 exceeds the limit of 8",
+			},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := validState()
+			c.Mutate(&state)
+			err := ValidateGenesisWithConfig(state, conf)
+			if len(c.Expected) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, expected := range c.Expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Fatalf("expected error to contain %q, got: %s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestExportValidateGenesisWithWildcard(t *testing.T) {
+	conf := configuration.Config{
+		ValidDomainName:     "^[a-z0-9]{4,16}$",
+		ValidAccountName:    "^[a-z0-9]{1,16}$",
+		ValidURI:            "^[a-z:]+$",
+		ValidResource:       "^[a-z0-9]+$",
+		ResourcesMax:        1,
+		CertificateCountMax: 1,
+		CertificateSizeMax:  4,
+		MetadataSizeMax:     8,
+	}
+	k, ctx, _ := keeper.NewTestKeeper(t, true)
+	accounts := k.AccountStore(ctx)
+	domains := k.DomainStore(ctx)
+	keeper.NewDomainExecutor(ctx, types.Domain{
+		Name:       "acme",
+		Admin:      keeper.AliceKey,
+		ValidUntil: 100,
+		Type:       types.OpenDomain,
+	}).WithAccounts(&accounts).WithDomains(&domains).Create()
+	keeper.NewAccountExecutor(ctx, types.Account{
+		Domain:     "acme",
+		Name:       utils.StrPtr("catchall"),
+		Owner:      keeper.AliceKey,
+		ValidUntil: 100,
+		Wildcard:   true,
+	}).WithAccounts(&accounts).Create()
+
+	state := ExportGenesis(ctx, k)
+	if err := ValidateGenesisWithConfig(*state, conf); err != nil {
+		t.Fatalf("exported genesis state is not valid: %s", err)
+	}
+
+	// the wildcard index is rebuilt when the exported state is imported
+	imported, importedCtx, _ := keeper.NewTestKeeper(t, true)
+	InitGenesis(importedCtx, imported, *state)
+	account, wildcard, err := imported.ResolveStarname(importedCtx, "acme", "anything")
+	if err != nil || !wildcard || *account.Name != "catchall" {
+		t.Fatalf("ResolveStarname() got %v, wildcard %t, error %v", account, wildcard, err)
+	}
+}
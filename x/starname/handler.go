@@ -23,6 +23,9 @@ func NewHandler(k *Keeper) sdk.Handler {
 			err error
 		)
 		switch msg := msg.(type) {
+		// registration msgs
+		case *types.MsgCommitRegistration:
+			res, err = msgServer.CommitRegistration(sdk.WrapSDKContext(ctx), msg)
 		// domain msgs
 		case *types.MsgDeleteDomain:
 			res, err = msgServer.DeleteDomain(sdk.WrapSDKContext(ctx), msg)
@@ -47,6 +50,12 @@ func NewHandler(k *Keeper) sdk.Handler {
 			res, err = msgServer.ReplaceAccountMetadata(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgReplaceAccountResources:
 			res, err = msgServer.ReplaceAccountResources(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUpsertAccountResources:
+			res, err = msgServer.UpsertAccountResources(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRemoveAccountResources:
+			res, err = msgServer.RemoveAccountResources(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSetDomainPolicy:
+			res, err = msgServer.SetDomainPolicy(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgTransferAccount:
 			res, err = msgServer.TransferAccount(sdk.WrapSDKContext(ctx), msg)
 		default:
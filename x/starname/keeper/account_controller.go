@@ -28,6 +28,7 @@ type AccountController struct {
 	name, domain string
 	account      *types.Account
 	conf         *configuration.Config
+	policy       *types.DomainPolicy
 
 	ctx        sdk.Context
 	store      *crud.Store
@@ -102,6 +103,15 @@ func (a *AccountController) CertificateSizeNotExceeded(cert []byte) *AccountCont
 	return a
 }
 
+// ValidCertificate asserts that the certificate of the provided type can be parsed and that its signature
+// is valid, if typed is not nil the verified certificate is saved in it
+func (a *AccountController) ValidCertificate(typ types.CertificateType, cert []byte, typed *types.TypedCertificate) *AccountController {
+	a.validators = append(a.validators, func(ctrl *AccountController) error {
+		return ctrl.validCertificate(typ, cert, typed)
+	})
+	return a
+}
+
 // CertificateLimitNotExceeded asserts that the numbers of certificates in an account was not exceeded
 func (a *AccountController) CertificateLimitNotExceeded() *AccountController {
 	a.validators = append(a.validators, func(ctrl *AccountController) error {
@@ -165,6 +175,32 @@ func (a *AccountController) ResourceLimitNotExceeded(resources []*types.Resource
 	return a
 }
 
+// UpsertResourceLimitNotExceeded checks if the number of resources of the account after upserting
+// the provided ones exceeds the configuration limit
+func (a *AccountController) UpsertResourceLimitNotExceeded(resources []*types.Resource) *AccountController {
+	a.validators = append(a.validators, func(ctrl *AccountController) error {
+		return ctrl.upsertResourceLimitNotExceeded(resources)
+	})
+	return a
+}
+
+// WildcardRegistrableBy asserts that the wildcard account can be registered by the provided address,
+// the domain admin, and that the domain has no wildcard account yet
+func (a *AccountController) WildcardRegistrableBy(addr sdk.AccAddress) *AccountController {
+	a.validators = append(a.validators, func(ctrl *AccountController) error {
+		return ctrl.wildcardRegistrableBy(addr)
+	})
+	return a
+}
+
+// ResourcesExist asserts that the account has resources with all the provided URIs
+func (a *AccountController) ResourcesExist(uris []string) *AccountController {
+	a.validators = append(a.validators, func(ctrl *AccountController) error {
+		return ctrl.resourcesExist(uris)
+	})
+	return a
+}
+
 // MetadataSizeNotExceeded asserts that the metadata size of an account was not exceeded
 func (a *AccountController) MetadataSizeNotExceeded(metadata string) *AccountController {
 	a.validators = append(a.validators, func(ctrl *AccountController) error {
@@ -205,12 +241,25 @@ func (a *AccountController) WithDomainController(dom *DomainController) *Account
 	return a
 }
 
-// WithConfiguration allows to specify a cached config
+// WithConfiguration allows to specify a cached config, tightened by the domain policy if it was specified
 func (a *AccountController) WithConfiguration(cfg configuration.Config) *AccountController {
+	if a.policy != nil {
+		cfg = EffectiveConfiguration(cfg, *a.policy)
+	}
 	a.conf = &cfg
 	return a
 }
 
+// WithDomainPolicy allows to specify the policy of the domain, the cached config is tightened accordingly
+func (a *AccountController) WithDomainPolicy(policy types.DomainPolicy) *AccountController {
+	a.policy = &policy
+	if a.conf != nil {
+		cfg := EffectiveConfiguration(*a.conf, policy)
+		a.conf = &cfg
+	}
+	return a
+}
+
 // WithAccounts allows to specify a cached crud store
 func (a *AccountController) WithAccounts(store *crud.Store) *AccountController {
 	a.store = store
@@ -279,6 +328,14 @@ func (a *AccountController) validName() error {
 	if !regexp.MustCompile(a.conf.ValidAccountName).MatchString(a.name) {
 		return sdkerrors.Wrapf(types.ErrInvalidAccountName, "invalid name: %s", a.name)
 	}
+	// the domain policy can restrict the valid names further
+	if a.policy != nil && a.policy.ValidAccountName != "" && !regexp.MustCompile(a.policy.ValidAccountName).MatchString(a.name) {
+		return sdkerrors.Wrapf(types.ErrInvalidAccountName, "invalid name in domain %s: %s", a.domain, a.name)
+	}
+	// assert the name is normalized and written in the allowed scripts
+	if err := types.ValidateNormalizedName(a.name, a.conf.AllowedNameScripts); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidAccountName, err.Error())
+	}
 	return nil
 }
 
@@ -356,6 +413,36 @@ func (a *AccountController) certNotExist(newCert []byte, indexPointer *int) erro
 			return sdkerrors.Wrapf(types.ErrCertificateExists, "certificate is already present")
 		}
 	}
+	// typed certificates are indexed after the opaque ones
+	for i, cert := range a.account.TypedCertificates {
+		if bytes.Equal(cert.Data, newCert) {
+			if indexPointer != nil {
+				*indexPointer = len(a.account.Certificates) + i
+			}
+			return sdkerrors.Wrapf(types.ErrCertificateExists, "certificate is already present")
+		}
+	}
+	return nil
+}
+
+func (a *AccountController) validCertificate(typ types.CertificateType, cert []byte, typed *types.TypedCertificate) error {
+	if err := a.requireAccount(); err != nil {
+		panic("validation check is not allowed on a non existing account")
+	}
+	if err := types.ValidateCertificateType(typ); err != nil {
+		return err
+	}
+	// opaque certificates are not verified
+	if typ == types.OpaqueCertificate {
+		return nil
+	}
+	verified, err := types.NewTypedCertificate(typ, cert, a.account.GetStarname(), a.ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	if typed != nil {
+		*typed = *verified
+	}
 	return nil
 }
 
@@ -377,7 +464,7 @@ func (a *AccountController) certLimitNotExceeded() error {
 		panic("validation check is not allowed on a non existing account")
 	}
 	a.requireConfiguration()
-	if uint32(len(a.account.Certificates)) >= a.conf.CertificateCountMax {
+	if uint32(len(a.account.Certificates)+len(a.account.TypedCertificates)) >= a.conf.CertificateCountMax {
 		return sdkerrors.Wrapf(types.ErrCertificateLimitReached, "max certificate limit %d reached, cannot add more", a.conf.CertificateCountMax)
 	}
 	return nil
@@ -507,6 +594,32 @@ func (a *AccountController) resourceLimitNotExceeded(resources []*types.Resource
 	return nil
 }
 
+func (a *AccountController) upsertResourceLimitNotExceeded(resources []*types.Resource) error {
+	if err := a.requireAccount(); err != nil {
+		panic("validation check is not allowed on a non existing account")
+	}
+	return a.resourceLimitNotExceeded(types.UpsertResources(a.account.Resources, resources))
+}
+
+func (a *AccountController) resourcesExist(uris []string) error {
+	if err := a.requireAccount(); err != nil {
+		panic("validation check is not allowed on a non existing account")
+	}
+	for _, uri := range uris {
+		found := false
+		for _, resource := range a.account.Resources {
+			if resource.URI == uri {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return sdkerrors.Wrapf(types.ErrResourceDoesNotExist, "no resource with URI %s", uri)
+		}
+	}
+	return nil
+}
+
 func (a *AccountController) metadataSizeNotExceeded(metadata string) error {
 	// assert domain exists
 	if err := a.requireAccount(); err != nil {
@@ -530,11 +643,35 @@ func (a *AccountController) registrableBy(addr sdk.AccAddress) error {
 		return a.domainCtrl.
 			Admin(addr).
 			Validate()
+	// if domain is open then the registerer must be allowed by the domain policy, if any
 	default:
+		if a.policy == nil || a.domainCtrl.Domain().Admin.Equals(addr) {
+			return nil
+		}
+		if !a.policy.AllowsRegistrant(addr) {
+			return sdkerrors.Wrapf(types.ErrRegistrantNotAllowed, "%s is not allowed to register accounts in %s", addr, a.domain)
+		}
 		return nil
 	}
 }
 
+func (a *AccountController) wildcardRegistrableBy(addr sdk.AccAddress) error {
+	if err := a.requireDomain(); err != nil {
+		panic("validation check is not allowed on a non existing domain")
+	}
+	if err := a.domainCtrl.Admin(addr).Validate(); err != nil {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "only the domain admin can register the wildcard account")
+	}
+	cursor, err := (*a.store).Query().Where().Index(types.AccountWildcardIndex).Equals([]byte(a.domain)).Do()
+	if err != nil {
+		return err
+	}
+	if cursor.Valid() {
+		return sdkerrors.Wrapf(types.ErrWildcardAccountExists, "domain %s already has a wildcard account", a.domain)
+	}
+	return nil
+}
+
 // Account returns the cached account, if the account existence
 // was not asserted before, it panics.
 func (a *AccountController) Account() types.Account {
@@ -22,6 +22,7 @@ type AccountExecutor struct {
 	account *types.Account
 	ctx     sdk.Context
 	conf    *configuration.Config
+	keeper  *Keeper
 }
 
 // WithAccounts allows to specify a cached accounts store
@@ -36,17 +37,41 @@ func (a *AccountExecutor) WithConfiguration(cfg configuration.Config) *AccountEx
 	return a
 }
 
+// WithKeeper allows to specify the keeper used to record the account history
+func (a *AccountExecutor) WithKeeper(k Keeper) *AccountExecutor {
+	a.keeper = &k
+	return a
+}
+
+// record records the account state in the account history, if the keeper was specified
+func (a *AccountExecutor) record(deleted bool) {
+	if a.keeper == nil {
+		return
+	}
+	a.keeper.RecordAccountHistory(a.ctx, *a.account, deleted)
+}
+
+// provenance appends an entry to the account provenance log, if the keeper was specified
+func (a *AccountExecutor) provenance(event types.ProvenanceEvent, from, to sdk.AccAddress) {
+	if a.keeper == nil {
+		return
+	}
+	a.keeper.RecordAccountProvenance(a.ctx, a.account.Domain, *a.account.Name, event, from, to, nil)
+}
+
 // Transfer transfers the account to the provided owner with information reset if reset is true
 func (a *AccountExecutor) Transfer(newOwner sdk.AccAddress, reset bool) {
 	if a.account == nil {
 		panic("cannot transfer non specified account")
 	}
+	oldOwner := a.account.Owner
 	// apply account changes
 	// update owner
 	a.account.Owner = newOwner
 	// if reset is required then clear the account
 	if reset {
 		a.account.Certificates = nil
+		a.account.TypedCertificates = nil
 		a.account.Resources = nil
 		a.account.MetadataURI = ""
 	}
@@ -55,6 +80,8 @@ func (a *AccountExecutor) Transfer(newOwner sdk.AccAddress, reset bool) {
 		panic("store is missing")
 	}
 	(*a.store).Update(a.account)
+	a.record(false)
+	a.provenance(types.ProvenanceEvent_Transfer, oldOwner, newOwner)
 }
 
 // UpdateMetadata updates account's metadata
@@ -79,6 +106,23 @@ func (a *AccountExecutor) ReplaceResources(newTargets []*types.Resource) {
 		panic("store is missing")
 	}
 	(*a.store).Update(a.account)
+	a.record(false)
+}
+
+// UpsertResources adds the provided resources to the account or updates the ones with the same URI
+func (a *AccountExecutor) UpsertResources(resources []*types.Resource) {
+	if a.account == nil {
+		panic("cannot upsert targets on non specified account")
+	}
+	a.ReplaceResources(types.UpsertResources(a.account.Resources, resources))
+}
+
+// RemoveResources removes the account's resources with the provided URIs
+func (a *AccountExecutor) RemoveResources(uris []string) {
+	if a.account == nil {
+		panic("cannot remove targets on non specified account")
+	}
+	a.ReplaceResources(types.RemoveResources(a.account.Resources, uris))
 }
 
 // Renew renews an account
@@ -95,6 +139,7 @@ func (a *AccountExecutor) Renew() {
 		panic("store is missing")
 	}
 	(*a.store).Update(a.account)
+	a.record(false)
 }
 
 // Create creates an account
@@ -106,6 +151,11 @@ func (a *AccountExecutor) Create() {
 		panic("store is missing")
 	}
 	(*a.store).Create(a.account)
+	a.record(false)
+	a.provenance(types.ProvenanceEvent_Register, nil, a.account.Owner)
+	if a.keeper != nil {
+		a.keeper.IndexAccount(a.ctx, a.account.Domain, *a.account.Name)
+	}
 }
 
 // Delete deletes the account
@@ -117,14 +167,25 @@ func (a *AccountExecutor) Delete() {
 		panic("store is missing")
 	}
 	(*a.store).Delete(a.account.PrimaryKey())
+	a.record(true)
+	a.provenance(types.ProvenanceEvent_Delete, a.account.Owner, nil)
+	if a.keeper != nil {
+		a.keeper.UnindexAccount(a.ctx, a.account.Domain, *a.account.Name)
+	}
 }
 
-// DeleteCertificate deletes the certificate of the account at the provided index
+// DeleteCertificate deletes the certificate of the account at the provided index,
+// typed certificates are indexed after the opaque ones
 func (a *AccountExecutor) DeleteCertificate(index int) {
 	if a.account == nil {
 		panic("cannot delete certificate on a non specified account")
 	}
-	a.account.Certificates = append(a.account.Certificates[:index], a.account.Certificates[index+1:]...)
+	if index < len(a.account.Certificates) {
+		a.account.Certificates = append(a.account.Certificates[:index], a.account.Certificates[index+1:]...)
+	} else {
+		index -= len(a.account.Certificates)
+		a.account.TypedCertificates = append(a.account.TypedCertificates[:index], a.account.TypedCertificates[index+1:]...)
+	}
 	if a.store == nil {
 		panic("store is missing")
 	}
@@ -143,6 +204,18 @@ func (a *AccountExecutor) AddCertificate(cert []byte) {
 	(*a.store).Update(a.account)
 }
 
+// AddTypedCertificate adds a verified certificate to the account
+func (a *AccountExecutor) AddTypedCertificate(cert types.TypedCertificate) {
+	if a.account == nil {
+		panic("cannot add certificate on a non specified account")
+	}
+	a.account.TypedCertificates = append(a.account.TypedCertificates, &cert)
+	if a.store == nil {
+		panic("store is missing")
+	}
+	(*a.store).Update(a.account)
+}
+
 // State returns the current state of the account
 func (a *AccountExecutor) State() types.Account {
 	if a.account == nil {
@@ -84,6 +84,48 @@ func TestAccount_ReplaceResources(t *testing.T) {
 	}
 }
 
+func TestAccount_UpsertAndRemoveResources(t *testing.T) {
+	testKeeper, testCtx, _ := NewTestExecutorKeeper(t, false)
+	as := testKeeper.AccountStore(testCtx)
+	resourceAccounts := func(uri, resource string) int {
+		cursor, err := as.Query().Where().Index(types.AccountResourcesIndex).Equals(types.GetResourceKey(uri, resource)).Do()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for ; cursor.Valid(); cursor.Next() {
+			n++
+		}
+		return n
+	}
+	account := new(types.Account)
+	as.Read(testAccount.PrimaryKey(), account)
+	NewAccountExecutor(testCtx, *account).WithAccounts(&as).UpsertResources([]*types.Resource{
+		{URI: "a-super-uri", Resource: "a-new-res"},
+		{URI: "another-uri", Resource: "another-res"},
+	})
+	account = new(types.Account)
+	as.Read(testAccount.PrimaryKey(), account)
+	if !reflect.DeepEqual(account.Resources, []*types.Resource{
+		{URI: "a-super-uri", Resource: "a-new-res"},
+		{URI: "another-uri", Resource: "another-res"},
+	}) {
+		t.Fatalf("unexpected resources: %v", account.Resources)
+	}
+	if resourceAccounts("a-super-uri", "a-super-res") != 0 || resourceAccounts("a-super-uri", "a-new-res") != 1 {
+		t.Fatal("resource index not updated on upsert")
+	}
+	NewAccountExecutor(testCtx, *account).WithAccounts(&as).RemoveResources([]string{"a-super-uri"})
+	account = new(types.Account)
+	as.Read(testAccount.PrimaryKey(), account)
+	if !reflect.DeepEqual(account.Resources, []*types.Resource{{URI: "another-uri", Resource: "another-res"}}) {
+		t.Fatalf("unexpected resources: %v", account.Resources)
+	}
+	if resourceAccounts("a-super-uri", "a-new-res") != 0 || resourceAccounts("another-uri", "another-res") != 1 {
+		t.Fatal("resource index not updated on remove")
+	}
+}
+
 func TestAccount_State(t *testing.T) {
 	// TODO
 }
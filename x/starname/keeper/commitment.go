@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+var (
+	// commitmentPrefix is the prefix of the registration commitments, keyed by owner and hash
+	commitmentPrefix = []byte{0x3}
+	// commitmentQueuePrefix is the prefix of the registration commitments ordered by creation time
+	commitmentQueuePrefix = []byte{0x4}
+)
+
+// commitmentKey returns the key of a commitment, made of its length prefixed owner and its hash so that a commitment
+// copied from the mempool by another account neither replaces nor blocks the original one
+func commitmentKey(owner sdk.AccAddress, hash []byte) []byte {
+	return append(address.MustLengthPrefix(owner), hash...)
+}
+
+// commitmentQueueKey returns the key of a commitment in the creation time ordered queue
+func commitmentQueueKey(commitment types.Commitment) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(commitment.CreatedAt))
+	return append(key, commitmentKey(commitment.Owner, commitment.Hash)...)
+}
+
+// GetCommitment returns the registration commitment of the provided owner with the provided hash, if it exists
+func (k Keeper) GetCommitment(ctx sdk.Context, owner sdk.AccAddress, hash []byte) (types.Commitment, bool) {
+	return k.getCommitmentByKey(ctx, commitmentKey(owner, hash))
+}
+
+func (k Keeper) getCommitmentByKey(ctx sdk.Context, key []byte) (types.Commitment, bool) {
+	var commitment types.Commitment
+	bz := prefix.NewStore(ctx.KVStore(k.StoreKey), commitmentPrefix).Get(key)
+	if bz == nil {
+		return commitment, false
+	}
+	k.Cdc.MustUnmarshal(bz, &commitment)
+	return commitment, true
+}
+
+// SetCommitment saves a registration commitment
+func (k Keeper) SetCommitment(ctx sdk.Context, commitment types.Commitment) {
+	store := ctx.KVStore(k.StoreKey)
+	prefix.NewStore(store, commitmentPrefix).Set(commitmentKey(commitment.Owner, commitment.Hash), k.Cdc.MustMarshal(&commitment))
+	prefix.NewStore(store, commitmentQueuePrefix).Set(commitmentQueueKey(commitment), []byte{})
+}
+
+// DeleteCommitment removes a registration commitment
+func (k Keeper) DeleteCommitment(ctx sdk.Context, commitment types.Commitment) {
+	store := ctx.KVStore(k.StoreKey)
+	prefix.NewStore(store, commitmentPrefix).Delete(commitmentKey(commitment.Owner, commitment.Hash))
+	prefix.NewStore(store, commitmentQueuePrefix).Delete(commitmentQueueKey(commitment))
+}
+
+// IterateCommitments iterates over all the registration commitments, from the oldest to the newest,
+// until the provided function returns true
+func (k Keeper) IterateCommitments(ctx sdk.Context, f func(commitment types.Commitment) (stop bool)) {
+	queue := prefix.NewStore(ctx.KVStore(k.StoreKey), commitmentQueuePrefix)
+	iterator := queue.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		commitment, ok := k.getCommitmentByKey(ctx, iterator.Key()[8:])
+		if !ok {
+			panic("commitment queue references a missing commitment")
+		}
+		if f(commitment) {
+			return
+		}
+	}
+}
+
+// RevealCommitment checks that the registration of the provided domain, or account if name is not empty,
+// was committed by the owner within the configured window and consumes the commitment
+func (k Keeper) RevealCommitment(ctx sdk.Context, conf configuration.Config, domain, name string, owner sdk.AccAddress, salt []byte) error {
+	commitment, ok := k.GetCommitment(ctx, owner, types.ComputeCommitment(domain, name, owner, salt))
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrCommitmentDoesNotExist, "no commitment for %s*%s", name, domain)
+	}
+	createdAt := utils.SecondsToTime(commitment.CreatedAt)
+	if ctx.BlockTime().Before(createdAt.Add(conf.CommitmentMinDelay)) {
+		return sdkerrors.Wrapf(types.ErrCommitmentTooRecent, "commitment can be revealed after %s", createdAt.Add(conf.CommitmentMinDelay))
+	}
+	if !ctx.BlockTime().Before(createdAt.Add(conf.CommitmentMaxWindow)) {
+		return sdkerrors.Wrapf(types.ErrCommitmentExpired, "commitment expired at %s", createdAt.Add(conf.CommitmentMaxWindow))
+	}
+	k.DeleteCommitment(ctx, commitment)
+	return nil
+}
+
+// RemoveExpiredCommitments removes the registration commitments that can no longer be revealed, at most
+// types.MaxExpiredCommitmentsPerBlock of them, the oldest first, and returns the number of removed commitments.
+// The removed commitments leave the queue, so the next call starts from the ones left behind.
+func (k Keeper) RemoveExpiredCommitments(ctx sdk.Context) int {
+	window := k.ConfigurationKeeper.GetConfiguration(ctx).CommitmentMaxWindow
+	var expired []types.Commitment
+	k.IterateCommitments(ctx, func(commitment types.Commitment) bool {
+		// commitments are ordered by creation time, the first one still valid ends the iteration
+		if ctx.BlockTime().Before(utils.SecondsToTime(commitment.CreatedAt).Add(window)) {
+			return true
+		}
+		expired = append(expired, commitment)
+		return len(expired) == types.MaxExpiredCommitmentsPerBlock
+	})
+	for _, commitment := range expired {
+		k.DeleteCommitment(ctx, commitment)
+	}
+	return len(expired)
+}
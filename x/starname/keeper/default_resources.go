@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// GetDomainDefaultResources returns the default resources of a domain, which are the resources
+// of the domain's empty account
+func (k Keeper) GetDomainDefaultResources(ctx sdk.Context, domain string) []*types.Resource {
+	account := new(types.Account)
+	key := (&types.Account{Domain: domain, Name: utils.StrPtr(types.EmptyAccountName)}).PrimaryKey()
+	if err := k.AccountStore(ctx).Read(key, account); err != nil {
+		return nil
+	}
+	return account.Resources
+}
+
+// ResolveAccount returns a copy of the account whose resources are merged with the default
+// resources of its domain, the account resources take precedence over the defaults
+func (k Keeper) ResolveAccount(ctx sdk.Context, account types.Account) types.Account {
+	if account.Name == nil || *account.Name == types.EmptyAccountName {
+		return account
+	}
+	account.Resources = types.InheritResources(account.Resources, k.GetDomainDefaultResources(ctx, account.Domain))
+	return account
+}
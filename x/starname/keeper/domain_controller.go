@@ -252,6 +252,10 @@ func (c *DomainController) validName() error {
 	if !validator.MatchString(c.domainName) {
 		return sdkerrors.Wrap(types.ErrInvalidDomainName, c.domainName)
 	}
+	// assert domain name is normalized and written in the allowed scripts
+	if err := types.ValidateNormalizedName(c.domainName, c.conf.AllowedNameScripts); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDomainName, err.Error())
+	}
 	// success
 	return nil
 }
@@ -17,6 +17,7 @@ type DomainExecutor struct {
 	domains  *crud.Store
 	accounts *crud.Store
 	conf     *configuration.Config
+	keeper   *Keeper
 }
 
 // NewDomainExecutor returns is domain's constructor
@@ -45,6 +46,45 @@ func (d *DomainExecutor) WithConfiguration(cfg configuration.Config) *DomainExec
 	return d
 }
 
+// WithKeeper allows to specify the keeper used to record the history of the domain accounts
+func (d *DomainExecutor) WithKeeper(k Keeper) *DomainExecutor {
+	d.keeper = &k
+	return d
+}
+
+// accountExecutor returns an executor of the provided account of the domain
+func (d *DomainExecutor) accountExecutor(account types.Account) *AccountExecutor {
+	ex := NewAccountExecutor(d.ctx, account).WithAccounts(d.accounts)
+	if d.keeper != nil {
+		ex.WithKeeper(*d.keeper)
+	}
+	return ex
+}
+
+// recordAccount records the state of an account of the domain in the account history, if the keeper was specified
+func (d *DomainExecutor) recordAccount(account types.Account, deleted bool) {
+	if d.keeper == nil {
+		return
+	}
+	d.keeper.RecordAccountHistory(d.ctx, account, deleted)
+}
+
+// provenance appends an entry to the domain provenance log, if the keeper was specified
+func (d *DomainExecutor) provenance(event types.ProvenanceEvent, from, to sdk.AccAddress) {
+	if d.keeper == nil {
+		return
+	}
+	d.keeper.RecordProvenance(d.ctx, d.domain.Name, event, from, to, nil)
+}
+
+// accountProvenance appends an entry to the provenance log of an account of the domain, if the keeper was specified
+func (d *DomainExecutor) accountProvenance(account types.Account, event types.ProvenanceEvent, from, to sdk.AccAddress) {
+	if d.keeper == nil {
+		return
+	}
+	d.keeper.RecordAccountProvenance(d.ctx, account.Domain, *account.Name, event, from, to, nil)
+}
+
 // Renew renews a domain based on the configuration or accValidUntil
 func (d *DomainExecutor) Renew(accValidUntil ...int64) {
 	if d.domain == nil {
@@ -74,6 +114,7 @@ func (d *DomainExecutor) Renew(accValidUntil ...int64) {
 	account, cursor := d.getEmptyNameAccount()
 	account.ValidUntil = d.domain.ValidUntil
 	(*cursor).Update(account)
+	d.recordAccount(*account, false)
 }
 
 // Delete deletes a domain from the kvstore
@@ -89,14 +130,27 @@ func (d *DomainExecutor) Delete() {
 		panic(err)
 	}
 	for ; cursor.Valid(); cursor.Next() {
+		account := new(types.Account)
+		if err = cursor.Read(account); err != nil {
+			panic(err)
+		}
 		if err = cursor.Delete(); err != nil {
 			panic(err)
 		}
+		d.recordAccount(*account, true)
+		d.accountProvenance(*account, types.ProvenanceEvent_Delete, account.Owner, nil)
+		if d.keeper != nil {
+			d.keeper.UnindexAccount(d.ctx, account.Domain, *account.Name)
+		}
 	}
 	if d.domains == nil {
 		panic("domains is missing")
 	}
 	(*d.domains).Delete(d.domain.PrimaryKey())
+	d.provenance(types.ProvenanceEvent_Delete, d.domain.Admin, nil)
+	if d.keeper != nil {
+		d.keeper.UnindexDomain(d.ctx, d.domain.Name)
+	}
 }
 
 // Transfer transfers a domain given a flag and an owner
@@ -114,9 +168,10 @@ func (d *DomainExecutor) Transfer(flag types.TransferFlag, newOwner sdk.AccAddre
 	var oldOwner = d.domain.Admin // cache it for future uses
 	d.domain.Admin = newOwner
 	(*d.domains).Update(d.domain)
+	d.provenance(types.ProvenanceEvent_Transfer, oldOwner, newOwner)
 	// transfer empty account
 	account, _ := d.getEmptyNameAccount()
-	executor := NewAccountExecutor(d.ctx, *account).WithAccounts(d.accounts)
+	executor := d.accountExecutor(*account)
 	executor.Transfer(newOwner, false)
 	// transfer accounts of the domain based on the transfer flag
 	switch flag {
@@ -134,7 +189,7 @@ func (d *DomainExecutor) Transfer(flag types.TransferFlag, newOwner sdk.AccAddre
 			if err = cursor.Read(account); err != nil {
 				panic(err)
 			}
-			ex := NewAccountExecutor(d.ctx, *account).WithAccounts(d.accounts)
+			ex := d.accountExecutor(*account)
 			// reset the empty account...
 			if *account.Name == types.EmptyAccountName {
 				ex.Transfer(newOwner, true)
@@ -156,7 +211,7 @@ func (d *DomainExecutor) Transfer(flag types.TransferFlag, newOwner sdk.AccAddre
 			if err = cursor.Read(account); err != nil {
 				panic(err)
 			}
-			ex := NewAccountExecutor(d.ctx, *account).WithAccounts(d.accounts)
+			ex := d.accountExecutor(*account)
 			// transfer accounts without reset
 			ex.Transfer(newOwner, false)
 		}
@@ -175,6 +230,10 @@ func (d *DomainExecutor) Create() {
 		panic("domains is missing")
 	}
 	(*d.domains).Create(d.domain)
+	d.provenance(types.ProvenanceEvent_Register, nil, d.domain.Admin)
+	if d.keeper != nil {
+		d.keeper.IndexDomain(d.ctx, d.domain.Name)
+	}
 	emptyAccount := &types.Account{
 		Domain:       d.domain.Name,
 		Name:         utils.StrPtr(types.EmptyAccountName),
@@ -186,6 +245,8 @@ func (d *DomainExecutor) Create() {
 		MetadataURI:  "",
 	}
 	(*d.accounts).Create(emptyAccount)
+	d.recordAccount(*emptyAccount, false)
+	d.accountProvenance(*emptyAccount, types.ProvenanceEvent_Register, nil, emptyAccount.Owner)
 }
 
 // Gets the empty name account and cursor
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// domainPolicyPrefix is the prefix of the domain policies, keyed by domain name
+var domainPolicyPrefix = []byte{0x5}
+
+// GetDomainPolicy returns the registration policy of the provided domain, if it exists
+func (k Keeper) GetDomainPolicy(ctx sdk.Context, domain string) (types.DomainPolicy, bool) {
+	var policy types.DomainPolicy
+	bz := prefix.NewStore(ctx.KVStore(k.StoreKey), domainPolicyPrefix).Get([]byte(domain))
+	if bz == nil {
+		return policy, false
+	}
+	k.Cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// SetDomainPolicy saves the registration policy of a domain
+func (k Keeper) SetDomainPolicy(ctx sdk.Context, policy types.DomainPolicy) {
+	prefix.NewStore(ctx.KVStore(k.StoreKey), domainPolicyPrefix).Set([]byte(policy.Domain), k.Cdc.MustMarshal(&policy))
+}
+
+// DeleteDomainPolicy removes the registration policy of a domain
+func (k Keeper) DeleteDomainPolicy(ctx sdk.Context, domain string) {
+	prefix.NewStore(ctx.KVStore(k.StoreKey), domainPolicyPrefix).Delete([]byte(domain))
+}
+
+// IterateDomainPolicies iterates over all the domain policies until the provided function returns true
+func (k Keeper) IterateDomainPolicies(ctx sdk.Context, f func(policy types.DomainPolicy) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.StoreKey), domainPolicyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.DomainPolicy
+		k.Cdc.MustUnmarshal(iterator.Value(), &policy)
+		if f(policy) {
+			return
+		}
+	}
+}
+
+// GetAccountConfiguration returns the configuration that applies to the accounts of the provided domain,
+// that is the module configuration tightened by the domain policy, if any
+func (k Keeper) GetAccountConfiguration(ctx sdk.Context, domain string) configuration.Config {
+	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	policy, ok := k.GetDomainPolicy(ctx, domain)
+	if !ok {
+		return conf
+	}
+	return EffectiveConfiguration(conf, policy)
+}
+
+// EffectiveConfiguration returns the configuration tightened by the overrides of the domain policy,
+// overrides that would loosen the configuration are ignored
+func EffectiveConfiguration(conf configuration.Config, policy types.DomainPolicy) configuration.Config {
+	if policy.AccountRenewalPeriod != nil && *policy.AccountRenewalPeriod < conf.AccountRenewalPeriod {
+		conf.AccountRenewalPeriod = *policy.AccountRenewalPeriod
+	}
+	if policy.AccountGracePeriod != nil && *policy.AccountGracePeriod < conf.AccountGracePeriod {
+		conf.AccountGracePeriod = *policy.AccountGracePeriod
+	}
+	if policy.ResourcesMax != 0 && policy.ResourcesMax < conf.ResourcesMax {
+		conf.ResourcesMax = policy.ResourcesMax
+	}
+	if policy.CertificateCountMax != 0 && policy.CertificateCountMax < conf.CertificateCountMax {
+		conf.CertificateCountMax = policy.CertificateCountMax
+	}
+	if policy.MetadataSizeMax != 0 && policy.MetadataSizeMax < conf.MetadataSizeMax {
+		conf.MetadataSizeMax = policy.MetadataSizeMax
+	}
+	return conf
+}
+
+// validatePolicyDomainType checks that the policy of a closed domain sets no registration prices nor registrant lists,
+// which do not apply as the accounts of a closed domain are registered by its admin
+func validatePolicyDomainType(domain types.Domain, policy types.DomainPolicy) error {
+	if domain.Type == types.OpenDomain {
+		return nil
+	}
+	if !policy.RegistrationPrice.IsZero() || !policy.RenewalPrice.IsZero() || len(policy.Allowlist) != 0 || len(policy.Denylist) != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "closed domain %s cannot set registration prices or registrant lists", domain.Name)
+	}
+	return nil
+}
+
+// validatePolicyOverrides checks that the overrides of the domain policy only tighten the configuration
+func validatePolicyOverrides(conf configuration.Config, policy types.DomainPolicy) error {
+	if policy.AccountRenewalPeriod != nil && *policy.AccountRenewalPeriod > conf.AccountRenewalPeriod {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "account renewal period cannot exceed %s", conf.AccountRenewalPeriod)
+	}
+	if policy.AccountGracePeriod != nil && *policy.AccountGracePeriod > conf.AccountGracePeriod {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "account grace period cannot exceed %s", conf.AccountGracePeriod)
+	}
+	if policy.ResourcesMax > conf.ResourcesMax {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "resources max cannot exceed %d", conf.ResourcesMax)
+	}
+	if policy.CertificateCountMax > conf.CertificateCountMax {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "certificate count max cannot exceed %d", conf.CertificateCountMax)
+	}
+	if policy.MetadataSizeMax > conf.MetadataSizeMax {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "metadata size max cannot exceed %d", conf.MetadataSizeMax)
+	}
+	if policy.GetRoyaltyRate().GT(conf.GetEscrowRoyaltyMax()) {
+		return sdkerrors.Wrapf(types.ErrInvalidDomainPolicy, "royalty rate cannot exceed %s", conf.GetEscrowRoyaltyMax())
+	}
+	return nil
+}
+
+// GetRoyalty returns the recipient and the rate of the royalty on the escrow sales of the accounts of the provided
+// domain, the rate is zero if the domain has no royalty and is capped by the configured maximum royalty rate
+func (k Keeper) GetRoyalty(ctx sdk.Context, domain string) (sdk.AccAddress, sdk.Dec) {
+	policy, ok := k.GetDomainPolicy(ctx, domain)
+	if !ok || !policy.GetRoyaltyRate().IsPositive() {
+		return nil, sdk.ZeroDec()
+	}
+	return policy.RoyaltyRecipient, sdk.MinDec(policy.GetRoyaltyRate(), k.ConfigurationKeeper.GetConfiguration(ctx).GetEscrowRoyaltyMax())
+}
+
+// collectDomainPrice sends the price set by the domain policy, if any, from the fee payer to the domain admin
+func (k Keeper) collectDomainPrice(ctx sdk.Context, msg types.MsgWithFeePayer, domain types.Domain) error {
+	if domain.Type != types.OpenDomain {
+		return nil
+	}
+	policy, ok := k.GetDomainPolicy(ctx, domain.Name)
+	if !ok {
+		return nil
+	}
+	var price sdk.Coins
+	switch msg.(type) {
+	case *types.MsgRegisterAccountInternal:
+		price = policy.RegistrationPrice
+	case *types.MsgRenewAccountInternal:
+		price = policy.RenewalPrice
+	}
+	// the admin does not pay itself
+	if price.IsZero() || msg.FeePayer().Equals(domain.Admin) {
+		return nil
+	}
+	return k.SupplyKeeper.SendCoins(ctx, msg.FeePayer(), domain.Admin, price)
+}
@@ -0,0 +1,390 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func Test_setDomainPolicy(t *testing.T) {
+	price := sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10))
+	renewalPrice := sdk.NewCoins(sdk.NewInt64Coin("testcoin", 5))
+	before := func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+		GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+			ValidAccountName:       RegexMatchAll,
+			AccountRenewalPeriod:   1000 * time.Hour,
+			AccountRenewalCountMax: 2,
+		})
+		domains := k.DomainStore(ctx)
+		accounts := k.AccountStore(ctx)
+		NewDomainExecutor(ctx, types.Domain{
+			Name:       "open",
+			ValidUntil: utils.TimeToSeconds(time.Now().Add(100000 * time.Hour)),
+			Admin:      BobKey,
+			Type:       types.OpenDomain,
+		}).WithDomains(&domains).WithAccounts(&accounts).Create()
+		NewDomainExecutor(ctx, types.Domain{
+			Name:       "closed",
+			ValidUntil: utils.TimeToSeconds(time.Now().Add(100000 * time.Hour)),
+			Admin:      BobKey,
+			Type:       types.ClosedDomain,
+		}).WithDomains(&domains).WithAccounts(&accounts).Create()
+	}
+	setPolicy := func(ctx sdk.Context, k Keeper, domain string, admin sdk.AccAddress, policy types.DomainPolicy) error {
+		policy.Domain = domain
+		_, err := setDomainPolicy(ctx, k, types.MsgSetDomainPolicy{
+			Domain: domain,
+			Admin:  admin.String(),
+			Policy: policy,
+		}.ToInternal())
+		return err
+	}
+	register := func(ctx sdk.Context, k Keeper, name string, registerer sdk.AccAddress) error {
+		_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+			Domain:     "open",
+			Name:       name,
+			Owner:      registerer.String(),
+			Registerer: registerer.String(),
+		}.ToInternal())
+		return err
+	}
+	cases := map[string]SubTest{
+		"only domain admin can set the policy": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				if err := setPolicy(ctx, k, "open", AliceKey, types.DomainPolicy{}); !errors.Is(err, types.ErrUnauthorized) {
+					t.Fatalf("setDomainPolicy() expected error: %s, got: %s", types.ErrUnauthorized, err)
+				}
+			},
+		},
+		"closed domains only tighten their configuration": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				for _, policy := range []types.DomainPolicy{
+					{RegistrationPrice: price},
+					{RenewalPrice: renewalPrice},
+					{Allowlist: []sdk.AccAddress{AliceKey}},
+					{Denylist: []sdk.AccAddress{AliceKey}},
+				} {
+					if err := setPolicy(ctx, k, "closed", BobKey, policy); !errors.Is(err, types.ErrInvalidDomainPolicy) {
+						t.Fatalf("setDomainPolicy() expected error: %s, got: %s", types.ErrInvalidDomainPolicy, err)
+					}
+				}
+				if err := setPolicy(ctx, k, "closed", BobKey, types.DomainPolicy{ValidAccountName: "^[a-z]{3,}$"}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "closed",
+					Name:       "a1",
+					Owner:      AliceKey.String(),
+					Registerer: BobKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrInvalidAccountName) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrInvalidAccountName, err)
+				}
+			},
+		},
+		"denied registrant": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, "open", BobKey, types.DomainPolicy{Denylist: []sdk.AccAddress{AliceKey}}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				if err := register(ctx, k, "alice", AliceKey); !errors.Is(err, types.ErrRegistrantNotAllowed) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrRegistrantNotAllowed, err)
+				}
+				if err := register(ctx, k, "charlie", CharlieKey); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+			},
+		},
+		"allowlisted registrants and admin": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, "open", BobKey, types.DomainPolicy{Allowlist: []sdk.AccAddress{AliceKey}}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				if err := register(ctx, k, "charlie", CharlieKey); !errors.Is(err, types.ErrRegistrantNotAllowed) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrRegistrantNotAllowed, err)
+				}
+				if err := register(ctx, k, "alice", AliceKey); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+				if err := register(ctx, k, "bob", BobKey); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+			},
+		},
+		"registration and renewal prices are paid to the admin": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, "open", BobKey, types.DomainPolicy{RegistrationPrice: price, RenewalPrice: renewalPrice}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				paid := sdk.NewCoins()
+				mocks.Supply.SetSendCoins(func(_ sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+					if !from.Equals(AliceKey) || !to.Equals(BobKey) {
+						t.Fatalf("SendCoins() unexpected transfer from %s to %s", from, to)
+					}
+					paid = paid.Add(coins...)
+					return nil
+				})
+				if err := register(ctx, k, "alice", AliceKey); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+				if !paid.IsEqual(price) {
+					t.Fatalf("registerAccount() expected price %s, got %s", price, paid)
+				}
+				_, err := renewAccount(ctx, k, types.MsgRenewAccount{
+					Domain: "open",
+					Name:   "alice",
+					Signer: AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("renewAccount() got error: %s", err)
+				}
+				if expected := price.Add(renewalPrice...); !paid.IsEqual(expected) {
+					t.Fatalf("renewAccount() expected price %s, got %s", expected, paid)
+				}
+				// the admin does not pay itself
+				if err := register(ctx, k, "bob", BobKey); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+			},
+		},
+		"policy is removed when the domain is transferred": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, "open", BobKey, types.DomainPolicy{RegistrationPrice: price, Allowlist: []sdk.AccAddress{AliceKey}}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := transferDomain(ctx, k, types.MsgTransferDomain{
+					Domain:       "open",
+					Owner:        BobKey.String(),
+					NewAdmin:     AliceKey.String(),
+					TransferFlag: types.TransferResetNone,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("transferDomain() got error: %s", err)
+				}
+				if _, ok := k.GetDomainPolicy(ctx, "open"); ok {
+					t.Fatal("GetDomainPolicy() policy of the previous admin still exists")
+				}
+			},
+		},
+		"policy is removed with the domain": {
+			TestBlockTime: time.Now().Add(200000 * time.Hour).Unix(),
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, "open", BobKey, types.DomainPolicy{RegistrationPrice: price}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := deleteDomain(ctx, k, types.MsgDeleteDomain{
+					Domain: "open",
+					Owner:  BobKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("deleteDomain() got error: %s", err)
+				}
+				if _, ok := k.GetDomainPolicy(ctx, "open"); ok {
+					t.Fatal("GetDomainPolicy() policy of deleted domain still exists")
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
+
+func Test_domainPolicyOverrides(t *testing.T) {
+	shortPeriod := 10 * time.Hour
+	before := func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+		GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+			ValidAccountName:     RegexMatchAll,
+			AccountRenewalPeriod: 1000 * time.Hour,
+			AccountGracePeriod:   100 * time.Hour,
+			ResourcesMax:         5,
+			MetadataSizeMax:      100,
+			CertificateCountMax:  5,
+		})
+		domains := k.DomainStore(ctx)
+		accounts := k.AccountStore(ctx)
+		NewDomainExecutor(ctx, types.Domain{
+			Name:       "open",
+			ValidUntil: utils.TimeToSeconds(time.Now().Add(100000 * time.Hour)),
+			Admin:      BobKey,
+			Type:       types.OpenDomain,
+		}).WithDomains(&domains).WithAccounts(&accounts).Create()
+	}
+	setPolicy := func(ctx sdk.Context, k Keeper, policy types.DomainPolicy) error {
+		policy.Domain = "open"
+		_, err := setDomainPolicy(ctx, k, types.MsgSetDomainPolicy{
+			Domain: "open",
+			Admin:  BobKey.String(),
+			Policy: policy,
+		}.ToInternal())
+		return err
+	}
+	cases := map[string]SubTest{
+		"overrides cannot loosen the configuration": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				longPeriod := 2000 * time.Hour
+				for _, policy := range []types.DomainPolicy{
+					{AccountRenewalPeriod: &longPeriod},
+					{AccountGracePeriod: &longPeriod},
+					{ResourcesMax: 6},
+					{CertificateCountMax: 6},
+					{MetadataSizeMax: 101},
+				} {
+					if err := setPolicy(ctx, k, policy); !errors.Is(err, types.ErrInvalidDomainPolicy) {
+						t.Fatalf("setDomainPolicy() expected error: %s, got: %s", types.ErrInvalidDomainPolicy, err)
+					}
+				}
+			},
+		},
+		"stricter account names": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, types.DomainPolicy{ValidAccountName: "^[a-z]{3,}$"}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "open",
+					Name:       "a1",
+					Owner:      AliceKey.String(),
+					Registerer: AliceKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrInvalidAccountName) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrInvalidAccountName, err)
+				}
+			},
+		},
+		"shorter account validity and fewer resources": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, types.DomainPolicy{AccountRenewalPeriod: &shortPeriod, ResourcesMax: 1}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "open",
+					Name:       "alice",
+					Owner:      AliceKey.String(),
+					Registerer: AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+				account := new(types.Account)
+				if err := k.AccountStore(ctx).Read((&types.Account{Domain: "open", Name: utils.StrPtr("alice")}).PrimaryKey(), account); err != nil {
+					t.Fatalf("account not found: %s", err)
+				}
+				if expected := ctx.BlockTime().Add(shortPeriod).Unix(); account.ValidUntil != expected {
+					t.Fatalf("registerAccount() expected valid until %d, got %d", expected, account.ValidUntil)
+				}
+				_, err = replaceAccountResources(ctx, k, types.MsgReplaceAccountResources{
+					Domain: "open",
+					Name:   "alice",
+					Owner:  AliceKey.String(),
+					NewResources: []*types.Resource{
+						{URI: "a", Resource: "1"},
+						{URI: "b", Resource: "2"},
+					},
+				}.ToInternal())
+				if !errors.Is(err, types.ErrResourceLimitExceeded) {
+					t.Fatalf("replaceAccountResources() expected error: %s, got: %s", types.ErrResourceLimitExceeded, err)
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
+
+func Test_domainPolicyRoyalty(t *testing.T) {
+	before := func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+		GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+			ValidAccountName: RegexMatchAll,
+			EscrowRoyaltyMax: sdk.NewDecWithPrec(1, 1),
+		})
+		domains := k.DomainStore(ctx)
+		accounts := k.AccountStore(ctx)
+		NewDomainExecutor(ctx, types.Domain{
+			Name:       "open",
+			ValidUntil: utils.TimeToSeconds(time.Now().Add(100000 * time.Hour)),
+			Admin:      BobKey,
+			Type:       types.OpenDomain,
+		}).WithDomains(&domains).WithAccounts(&accounts).Create()
+	}
+	setPolicy := func(ctx sdk.Context, k Keeper, policy types.DomainPolicy) error {
+		policy.Domain = "open"
+		_, err := setDomainPolicy(ctx, k, types.MsgSetDomainPolicy{
+			Domain: "open",
+			Admin:  BobKey.String(),
+			Policy: policy,
+		}.ToInternal())
+		return err
+	}
+	cases := map[string]SubTest{
+		"royalty rate cannot exceed the configured maximum": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				err := setPolicy(ctx, k, types.DomainPolicy{RoyaltyRecipient: CharlieKey, RoyaltyRate: sdk.NewDecWithPrec(2, 1)})
+				if !errors.Is(err, types.ErrInvalidDomainPolicy) {
+					t.Fatalf("setDomainPolicy() expected error: %s, got: %s", types.ErrInvalidDomainPolicy, err)
+				}
+			},
+		},
+		"royalty on account sales": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				before(t, k, ctx, mocks)
+				if err := setPolicy(ctx, k, types.DomainPolicy{RoyaltyRecipient: CharlieKey, RoyaltyRate: sdk.NewDecWithPrec(5, 2)}); err != nil {
+					t.Fatalf("setDomainPolicy() got error: %s", err)
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				account := &types.Account{Domain: "open", Name: utils.StrPtr("alice")}
+				royalties := account.GetRoyalties(ctx, k)
+				if len(royalties) != 1 || royalties[0].Recipient != CharlieKey.String() || !royalties[0].Rate.Equal(sdk.NewDecWithPrec(5, 2)) {
+					t.Fatalf("GetRoyalties() unexpected royalties: %+v", royalties)
+				}
+				// lowering the configured maximum caps the royalty rate of the domain
+				GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{EscrowRoyaltyMax: sdk.NewDecWithPrec(1, 2)})
+				if _, rate := k.GetRoyalty(ctx, "open"); !rate.Equal(sdk.NewDecWithPrec(1, 2)) {
+					t.Fatalf("GetRoyalty() expected rate 0.01, got %s", rate)
+				}
+				// accounts of domains without royalty do not pay any
+				if royalties := (&types.Account{Domain: "other", Name: utils.StrPtr("alice")}).GetRoyalties(ctx, k); len(royalties) != 0 {
+					t.Fatalf("GetRoyalties() expected no royalty, got %+v", royalties)
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
+
+func TestEffectiveConfiguration(t *testing.T) {
+	short, long := time.Hour, 100*time.Hour
+	conf := configuration.Config{AccountRenewalPeriod: 10 * time.Hour, AccountGracePeriod: 10 * time.Hour, ResourcesMax: 5}
+	got := EffectiveConfiguration(conf, types.DomainPolicy{AccountRenewalPeriod: &short, AccountGracePeriod: &long, ResourcesMax: 3})
+	if got.AccountRenewalPeriod != short || got.AccountGracePeriod != conf.AccountGracePeriod || got.ResourcesMax != 3 {
+		t.Fatalf("unexpected effective configuration: %+v", got)
+	}
+}
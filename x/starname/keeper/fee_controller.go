@@ -173,7 +173,7 @@ func (f feeApplier) getFeeParam(msg sdk.Msg) sdk.Dec {
 		return f.transferAccount()
 	case *types.MsgRenewAccountInternal:
 		return f.renewAccount()
-	case *types.MsgReplaceAccountResourcesInternal:
+	case *types.MsgReplaceAccountResourcesInternal, *types.MsgUpsertAccountResourcesInternal, *types.MsgRemoveAccountResourcesInternal:
 		return f.replaceResources()
 	case *types.MsgDeleteAccountCertificateInternal:
 		return f.delCert()
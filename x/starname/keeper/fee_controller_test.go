@@ -124,6 +124,14 @@ func Test_FeeApplier(t *testing.T) {
 			Msg:         &types.MsgReplaceAccountResourcesInternal{},
 			ExpectedFee: sdk.NewDec(8),
 		},
+		"upsert resources": {
+			Msg:         &types.MsgUpsertAccountResourcesInternal{},
+			ExpectedFee: sdk.NewDec(8),
+		},
+		"remove resources": {
+			Msg:         &types.MsgRemoveAccountResourcesInternal{},
+			ExpectedFee: sdk.NewDec(8),
+		},
 		"transfer account closed": {
 			Msg:         &types.MsgTransferAccountInternal{},
 			Domain:      types.Domain{Type: types.ClosedDomain},
@@ -9,7 +9,8 @@ import (
 	"github.com/iov-one/starnamed/x/starname/types"
 )
 
-// CollectProductFee takes the product fee from the payer and sends it to the distribution module for validators and delegators
+// CollectProductFee takes the product fee from the payer and sends it to the distribution module for validators and delegators,
+// account registrations and renewals in open domains also pay the price set by the domain policy to the domain admin
 func (k Keeper) CollectProductFee(ctx sdk.Context, msg types.MsgWithFeePayer, withs ...interface{}) error {
 	feeConf := k.ConfigurationKeeper.GetFees(ctx)
 	feeCtrl := NewFeeController(ctx, feeConf)
@@ -18,6 +19,9 @@ func (k Keeper) CollectProductFee(ctx sdk.Context, msg types.MsgWithFeePayer, wi
 			switch with.(type) {
 			case *types.Domain:
 				feeCtrl.WithDomain(with.(*types.Domain))
+				if err := k.collectDomainPrice(ctx, msg, *with.(*types.Domain)); err != nil {
+					return err
+				}
 			case func(sdk.Context) crud.Store: // can't pass in k.AccountStore(ctx) since its a storeWrapper
 				accounts := k.AccountStore(ctx)
 				feeCtrl.WithAccounts(&accounts)
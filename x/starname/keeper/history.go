@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// accountHistoryPrefix is the prefix of the account history entries, keyed by starname and time
+var accountHistoryPrefix = []byte{0x6}
+
+// accountHistoryStarnameKey returns the length prefixed starname the history entries of an account are keyed by,
+// the length prefix prevents the entries of a starname from being iterated with the ones of a longer starname
+func accountHistoryStarnameKey(domain, name string) []byte {
+	starname := strings.Join([]string{name, domain}, types.StarnameSeparator)
+	key := make([]byte, 2, 2+len(starname))
+	binary.BigEndian.PutUint16(key, uint16(len(starname)))
+	return append(key, starname...)
+}
+
+// accountHistoryTimeKey returns the time part of the key of an history entry
+func accountHistoryTimeKey(time int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(time))
+	return key
+}
+
+// accountHistoryStore returns the store of the history entries of an account
+func (k Keeper) accountHistoryStore(ctx sdk.Context, domain, name string) prefix.Store {
+	return prefix.NewStore(prefix.NewStore(ctx.KVStore(k.StoreKey), accountHistoryPrefix), accountHistoryStarnameKey(domain, name))
+}
+
+// SetAccountHistoryEntry saves an account history entry and prunes the oldest entries of the account
+// beyond types.AccountHistoryMax, an entry recorded in the same block as the previous one replaces it
+func (k Keeper) SetAccountHistoryEntry(ctx sdk.Context, entry types.AccountHistoryEntry) {
+	store := k.accountHistoryStore(ctx, entry.Domain, entry.Name)
+	store.Set(accountHistoryTimeKey(entry.Time), k.Cdc.MustMarshal(&entry))
+	// prune the oldest entries
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for i := 0; i < len(keys)-types.AccountHistoryMax; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// RecordAccountHistory records the current state of an account in its history
+func (k Keeper) RecordAccountHistory(ctx sdk.Context, account types.Account, deleted bool) {
+	entry := types.AccountHistoryEntry{
+		Domain:     account.Domain,
+		Time:       ctx.BlockTime().Unix(),
+		Owner:      account.Owner,
+		Resources:  account.Resources,
+		ValidUntil: account.ValidUntil,
+		Deleted:    deleted,
+	}
+	if account.Name != nil {
+		entry.Name = *account.Name
+	}
+	k.SetAccountHistoryEntry(ctx, entry)
+}
+
+// GetAccountHistory returns the history entries of an account recorded between from and to included,
+// from the oldest, to equal to zero means no upper bound
+func (k Keeper) GetAccountHistory(ctx sdk.Context, domain, name string, from, to int64) []types.AccountHistoryEntry {
+	var end []byte
+	if to != 0 {
+		end = accountHistoryTimeKey(to + 1)
+	}
+	iterator := k.accountHistoryStore(ctx, domain, name).Iterator(accountHistoryTimeKey(from), end)
+	defer iterator.Close()
+	var entries []types.AccountHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.AccountHistoryEntry
+		k.Cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// GetAccountHistoryAt returns the history entry of an account that was effective at the provided time, if any
+func (k Keeper) GetAccountHistoryAt(ctx sdk.Context, domain, name string, time int64) (types.AccountHistoryEntry, bool) {
+	var entry types.AccountHistoryEntry
+	iterator := k.accountHistoryStore(ctx, domain, name).ReverseIterator(nil, accountHistoryTimeKey(time+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return entry, false
+	}
+	k.Cdc.MustUnmarshal(iterator.Value(), &entry)
+	return entry, true
+}
+
+// RenameAccountHistory moves the history entries of an account to the provided domain and name
+func (k Keeper) RenameAccountHistory(ctx sdk.Context, domain, name, newDomain, newName string) {
+	entries := k.GetAccountHistory(ctx, domain, name, 0, 0)
+	store := k.accountHistoryStore(ctx, domain, name)
+	newStore := k.accountHistoryStore(ctx, newDomain, newName)
+	for _, entry := range entries {
+		store.Delete(accountHistoryTimeKey(entry.Time))
+		entry.Domain, entry.Name = newDomain, newName
+		newStore.Set(accountHistoryTimeKey(entry.Time), k.Cdc.MustMarshal(&entry))
+	}
+}
+
+// IterateAccountHistory iterates over the history entries of all the accounts until the provided function returns true
+func (k Keeper) IterateAccountHistory(ctx sdk.Context, f func(entry types.AccountHistoryEntry) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.StoreKey), accountHistoryPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.AccountHistoryEntry
+		k.Cdc.MustUnmarshal(iterator.Value(), &entry)
+		if f(entry) {
+			return
+		}
+	}
+}
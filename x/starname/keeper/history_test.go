@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func TestAccountHistory(t *testing.T) {
+	k, ctx, _ := NewTestKeeper(t, true)
+	GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+		ValidDomainName:      RegexMatchAll,
+		ValidAccountName:     RegexMatchAll,
+		DomainRenewalPeriod:  1000 * time.Hour,
+		AccountRenewalPeriod: 1000 * time.Hour,
+		ResourcesMax:         5,
+	})
+	fees := configuration.NewFees()
+	fees.SetDefaults("testcoin")
+	GetConfigSetter(k.ConfigurationKeeper).SetFees(ctx, fees)
+	at := func(seconds int64) sdk.Context {
+		return ctx.WithBlockTime(time.Unix(seconds, 0))
+	}
+	querier := NewQuerier(&k)
+
+	// register, update, transfer and delete an account at different times
+	if _, err := registerDomain(at(100), k, types.MsgRegisterDomain{
+		Name:       "acme",
+		Admin:      BobKey.String(),
+		DomainType: types.ClosedDomain,
+	}.ToInternal()); err != nil {
+		t.Fatalf("registerDomain() got error: %s", err)
+	}
+	if _, err := registerAccount(at(200), k, types.MsgRegisterAccount{
+		Domain:     "acme",
+		Name:       "alice",
+		Owner:      AliceKey.String(),
+		Registerer: BobKey.String(),
+		Resources:  []*types.Resource{{URI: "btc", Resource: "first"}},
+	}.ToInternal()); err != nil {
+		t.Fatalf("registerAccount() got error: %s", err)
+	}
+	if _, err := replaceAccountResources(at(300), k, types.MsgReplaceAccountResources{
+		Domain:       "acme",
+		Name:         "alice",
+		Owner:        AliceKey.String(),
+		NewResources: []*types.Resource{{URI: "btc", Resource: "second"}},
+	}.ToInternal()); err != nil {
+		t.Fatalf("replaceAccountResources() got error: %s", err)
+	}
+	if _, err := transferAccount(at(400), k, types.MsgTransferAccount{
+		Domain:   "acme",
+		Name:     "alice",
+		Owner:    BobKey.String(),
+		NewOwner: CharlieKey.String(),
+	}.ToInternal()); err != nil {
+		t.Fatalf("transferAccount() got error: %s", err)
+	}
+	if _, err := deleteAccount(at(500), k, types.MsgDeleteAccount{
+		Domain: "acme",
+		Name:   "alice",
+		Owner:  BobKey.String(),
+	}.ToInternal()); err != nil {
+		t.Fatalf("deleteAccount() got error: %s", err)
+	}
+
+	t.Run("history", func(t *testing.T) {
+		res, err := querier.StarnameHistory(sdk.WrapSDKContext(ctx), &types.QueryStarnameHistoryRequest{Starname: "alice*acme", From: 250, To: 450})
+		if err != nil {
+			t.Fatalf("StarnameHistory() got error: %s", err)
+		}
+		if len(res.Entries) != 2 || res.Entries[0].Time != 300 || res.Entries[1].Time != 400 {
+			t.Fatalf("StarnameHistory() unexpected entries: %+v", res.Entries)
+		}
+		if !res.Entries[1].Owner.Equals(CharlieKey) {
+			t.Fatalf("StarnameHistory() expected owner %s, got %s", CharlieKey, res.Entries[1].Owner)
+		}
+		res, err = querier.StarnameHistory(sdk.WrapSDKContext(ctx), &types.QueryStarnameHistoryRequest{Starname: "alice*acme"})
+		if err != nil {
+			t.Fatalf("StarnameHistory() got error: %s", err)
+		}
+		if len(res.Entries) != 4 || !res.Entries[3].Deleted {
+			t.Fatalf("StarnameHistory() unexpected entries: %+v", res.Entries)
+		}
+	})
+	t.Run("resolve at", func(t *testing.T) {
+		res, err := querier.ResolveAt(sdk.WrapSDKContext(ctx), &types.QueryResolveAtRequest{Starname: "alice*acme", Time: 350})
+		if err != nil {
+			t.Fatalf("ResolveAt() got error: %s", err)
+		}
+		if !res.Entry.Owner.Equals(AliceKey) || res.Entry.Resources[0].Resource != "second" {
+			t.Fatalf("ResolveAt() unexpected entry: %+v", res.Entry)
+		}
+		for _, time := range []int64{150, 600} {
+			if _, err := querier.ResolveAt(sdk.WrapSDKContext(ctx), &types.QueryResolveAtRequest{Starname: "alice*acme", Time: time}); !errors.Is(err, types.ErrAccountDoesNotExist) {
+				t.Fatalf("ResolveAt() at %d expected error: %s, got: %s", time, types.ErrAccountDoesNotExist, err)
+			}
+		}
+		// the empty account of the domain is recorded too
+		if _, err := querier.ResolveAt(sdk.WrapSDKContext(ctx), &types.QueryResolveAtRequest{Starname: "*acme", Time: 100}); err != nil {
+			t.Fatalf("ResolveAt() got error: %s", err)
+		}
+	})
+	t.Run("pruning", func(t *testing.T) {
+		for i := int64(0); i < types.AccountHistoryMax+10; i++ {
+			k.SetAccountHistoryEntry(ctx, types.AccountHistoryEntry{Domain: "prune", Name: "test", Time: i})
+		}
+		entries := k.GetAccountHistory(ctx, "prune", "test", 0, 0)
+		if len(entries) != types.AccountHistoryMax || entries[0].Time != 10 {
+			t.Fatalf("expected %d entries from time 10, got %d from %d", types.AccountHistoryMax, len(entries), entries[0].Time)
+		}
+	})
+}
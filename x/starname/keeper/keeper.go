@@ -28,6 +28,7 @@ type ParamSubspace interface {
 // and then distribute the fees
 type SupplyKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, addr sdk.AccAddress, moduleName string, coins sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
@@ -62,12 +63,15 @@ type ConfigurationKeeper interface {
 	GetDomainRenewDuration(ctx sdk.Context) time.Duration
 	// GetDomainGracePeriod returns the grace period duration
 	GetDomainGracePeriod(ctx sdk.Context) time.Duration
+	// GetReservation returns the exact name or pattern that reserves the provided name, if any
+	GetReservation(ctx sdk.Context, name string) (configuration.ReservedName, bool)
 }
 
-// EscrowKeeper defines the behaviour of the escrow keeper, used to add stores to the module
-// and register custom data for transfer handlers
+// EscrowKeeper defines the behaviour of the escrow keeper, used to add stores to the module,
+// register custom data for transfer handlers and rename the escrowed domains and accounts
 type EscrowKeeper interface {
 	RegisterCustomData(id escrowtypes.TypeID, data escrowtypes.CustomData)
+	UpdateEscrowedObjects(ctx sdk.Context, update func(obj escrowtypes.TransferableObject) bool) error
 }
 
 // Keeper of the domain store
@@ -261,7 +265,7 @@ func (k Keeper) DoAccountTransfer(
 	}
 
 	// transfer account
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
 	ex.Transfer(newOwner, toReset)
 	return accountCtrl.account, domainCtrl.domain, nil
 }
@@ -288,7 +292,11 @@ func (k Keeper) DoDomainTransfer(
 	}
 	// transfer
 	accounts := k.AccountStore(ctx)
-	ex := NewDomainExecutor(ctx, c.Domain()).WithDomains(&domains).WithAccounts(&accounts)
+	ex := NewDomainExecutor(ctx, c.Domain()).WithDomains(&domains).WithAccounts(&accounts).WithKeeper(k)
 	ex.Transfer(transferFlag, newOwner)
+	// the policy set by the previous admin, its prices, registrant lists and royalty, does not bind the new admin
+	if !newOwner.Equals(currentOwner) {
+		k.DeleteDomainPolicy(ctx, domain)
+	}
 	return nil
 }
@@ -19,49 +19,95 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 }
 
 func (m msgServer) AddAccountCertificate(goCtx context.Context, msg *types.MsgAddAccountCertificate) (*types.MsgAddAccountCertificateResponse, error) {
-	return addAccountCertificate(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return addAccountCertificate(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
+}
+
+func (m msgServer) CommitRegistration(goCtx context.Context, msg *types.MsgCommitRegistration) (*types.MsgCommitRegistrationResponse, error) {
+	return commitRegistration(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
 }
 
 func (m msgServer) DeleteAccount(goCtx context.Context, msg *types.MsgDeleteAccount) (*types.MsgDeleteAccountResponse, error) {
-	return deleteAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return deleteAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) DeleteAccountCertificate(goCtx context.Context, msg *types.MsgDeleteAccountCertificate) (*types.MsgDeleteAccountCertificateResponse, error) {
-	return deleteAccountCertificate(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return deleteAccountCertificate(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) DeleteDomain(goCtx context.Context, msg *types.MsgDeleteDomain) (*types.MsgDeleteDomainResponse, error) {
-	return deleteDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain)
+	return deleteDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) RegisterAccount(goCtx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
-	return registerAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return registerAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) RegisterDomain(goCtx context.Context, msg *types.MsgRegisterDomain) (*types.MsgRegisterDomainResponse, error) {
-	return registerDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Name)
+	return registerDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) RenewAccount(goCtx context.Context, msg *types.MsgRenewAccount) (*types.MsgRenewAccountResponse, error) {
-	return renewAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return renewAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) RenewDomain(goCtx context.Context, msg *types.MsgRenewDomain) (*types.MsgRenewDomainResponse, error) {
-	return renewDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain)
+	return renewDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) ReplaceAccountMetadata(goCtx context.Context, msg *types.MsgReplaceAccountMetadata) (*types.MsgReplaceAccountMetadataResponse, error) {
-	return replaceAccountMetadata(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return replaceAccountMetadata(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) ReplaceAccountResources(goCtx context.Context, msg *types.MsgReplaceAccountResources) (*types.MsgReplaceAccountResourcesResponse, error) {
-	return replaceAccountResources(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return replaceAccountResources(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
+}
+
+func (m msgServer) UpsertAccountResources(goCtx context.Context, msg *types.MsgUpsertAccountResources) (*types.MsgUpsertAccountResourcesResponse, error) {
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return upsertAccountResources(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
+}
+
+func (m msgServer) RemoveAccountResources(goCtx context.Context, msg *types.MsgRemoveAccountResources) (*types.MsgRemoveAccountResourcesResponse, error) {
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return removeAccountResources(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
+}
+
+func (m msgServer) SetDomainPolicy(goCtx context.Context, msg *types.MsgSetDomainPolicy) (*types.MsgSetDomainPolicyResponse, error) {
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Policy.Domain)
+	return setDomainPolicy(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) TransferAccount(goCtx context.Context, msg *types.MsgTransferAccount) (*types.MsgTransferAccountResponse, error) {
-	return transferAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain, &internal.Name)
+	return transferAccount(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
 
 func (m msgServer) TransferDomain(goCtx context.Context, msg *types.MsgTransferDomain) (*types.MsgTransferDomainResponse, error) {
-	return transferDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, msg.ToInternal())
+	internal := msg.ToInternal()
+	types.NormalizeNames(&internal.Domain)
+	return transferDomain(sdk.UnwrapSDKContext(goCtx), *m.keeper, internal)
 }
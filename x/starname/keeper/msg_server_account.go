@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -31,8 +32,9 @@ func addAccountCertificate(ctx sdk.Context, k Keeper, msg *types.MsgAddAccountCe
 
 	// perform account checks
 	accounts := k.AccountStore(ctx)
-	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
 	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
+	typed := new(types.TypedCertificate)
 	if err := accountCtrl.
 		MustExist().
 		NotExpired().
@@ -40,6 +42,7 @@ func addAccountCertificate(ctx sdk.Context, k Keeper, msg *types.MsgAddAccountCe
 		CertificateLimitNotExceeded().
 		CertificateSizeNotExceeded(msg.NewCertificate).
 		CertificateNotExist(msg.NewCertificate).
+		ValidCertificate(msg.CertificateType, msg.NewCertificate, typed).
 		Validate(); err != nil {
 		return nil, err
 	}
@@ -50,8 +53,12 @@ func addAccountCertificate(ctx sdk.Context, k Keeper, msg *types.MsgAddAccountCe
 	}
 
 	// add certificate
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts)
-	ex.AddCertificate(msg.NewCertificate)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
+	if msg.CertificateType == types.OpaqueCertificate {
+		ex.AddCertificate(msg.NewCertificate)
+	} else {
+		ex.AddTypedCertificate(*typed)
+	}
 
 	// success
 	ctx.EventManager().EmitEvent(
@@ -61,6 +68,8 @@ func addAccountCertificate(ctx sdk.Context, k Keeper, msg *types.MsgAddAccountCe
 			sdk.NewAttribute(types.AttributeKeyDomainName, msg.Domain),
 			sdk.NewAttribute(types.AttributeKeyAccountName, msg.Name),
 			sdk.NewAttribute(types.AttributeKeyNewCertificate, fmt.Sprintf("%x", msg.NewCertificate)),
+			sdk.NewAttribute(types.AttributeKeyCertificateType, string(msg.CertificateType)),
+			sdk.NewAttribute(types.AttributeKeyCertificateIssuer, typed.Issuer),
 			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
 		),
 	)
@@ -97,7 +106,7 @@ func deleteAccountCertificate(ctx sdk.Context, k Keeper, msg *types.MsgDeleteAcc
 	}
 
 	// delete cert
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
 	ex.DeleteCertificate(*certIndex)
 
 	// success
@@ -125,7 +134,7 @@ func deleteAccount(ctx sdk.Context, k Keeper, msg *types.MsgDeleteAccountInterna
 
 	// perform account checks
 	accounts := k.AccountStore(ctx)
-	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
 	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
 	if err := accountCtrl.
 		MustExist().
@@ -140,7 +149,7 @@ func deleteAccount(ctx sdk.Context, k Keeper, msg *types.MsgDeleteAccountInterna
 	}
 
 	// delete account
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
 	ex.Delete()
 
 	// success
@@ -171,8 +180,16 @@ func registerAccount(ctx sdk.Context, k Keeper, msg *types.MsgRegisterAccountInt
 	// perform account checks
 	accounts := k.AccountStore(ctx)
 	d := domainCtrl.Domain()
-	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
 	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
+	if policy, ok := k.GetDomainPolicy(ctx, msg.Domain); ok {
+		accountCtrl.WithDomainPolicy(policy)
+	}
+	// the wildcard account can be registered only by the domain admin
+	wildcard := msg.Wildcard
+	if wildcard {
+		accountCtrl.WildcardRegistrableBy(msg.Registerer)
+	}
 	if err := accountCtrl.
 		ValidName().
 		MustNotExist().
@@ -182,6 +199,20 @@ func registerAccount(ctx sdk.Context, k Keeper, msg *types.MsgRegisterAccountInt
 		return nil, err
 	}
 
+	// reserved names are protected only in open domains, closed domains are managed by their admin
+	if d.Type == types.OpenDomain && !wildcard {
+		if err := k.AssertNotReserved(ctx, msg.Name, msg.Registerer); err != nil {
+			return nil, err
+		}
+	}
+
+	// consume the registration commitment, only open domains are exposed to front-running
+	if conf.CommitRevealEnabled && d.Type == types.OpenDomain && !wildcard {
+		if err := k.RevealCommitment(ctx, conf, msg.Domain, msg.Name, msg.Registerer, msg.Salt); err != nil {
+			return nil, err
+		}
+	}
+
 	a := types.Account{
 		Domain:       msg.Domain,
 		Name:         utils.StrPtr(msg.Name),
@@ -189,6 +220,7 @@ func registerAccount(ctx sdk.Context, k Keeper, msg *types.MsgRegisterAccountInt
 		Resources:    msg.Resources,
 		Certificates: nil,
 		Broker:       msg.Broker,
+		Wildcard:     wildcard,
 	}
 	switch d.Type {
 	case types.ClosedDomain:
@@ -202,7 +234,7 @@ func registerAccount(ctx sdk.Context, k Keeper, msg *types.MsgRegisterAccountInt
 		return nil, errors.Wrapf(err, "unable to collect fees")
 	}
 
-	ex := NewAccountExecutor(ctx, a).WithAccounts(&accounts)
+	ex := NewAccountExecutor(ctx, a).WithAccounts(&accounts).WithKeeper(k)
 	ex.Create()
 
 	// success
@@ -224,7 +256,7 @@ func registerAccount(ctx sdk.Context, k Keeper, msg *types.MsgRegisterAccountInt
 func renewAccount(ctx sdk.Context, k Keeper, msg *types.MsgRenewAccountInternal) (*types.MsgRenewAccountResponse, error) {
 	// perform domain checks
 	domains := k.DomainStore(ctx)
-	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
 	domainCtrl := NewDomainController(ctx, msg.Domain).WithDomains(&domains).WithConfiguration(conf)
 	if err := domainCtrl.MustExist().Type(types.OpenDomain).Validate(); err != nil {
 		return nil, err
@@ -247,7 +279,7 @@ func renewAccount(ctx sdk.Context, k Keeper, msg *types.MsgRenewAccountInternal)
 
 	// renew account
 	// account valid until is extended here
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithConfiguration(conf)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k).WithConfiguration(conf)
 	ex.Renew()
 	// get grace period and expiration time
 	d := domainCtrl.Domain()
@@ -255,7 +287,7 @@ func renewAccount(ctx sdk.Context, k Keeper, msg *types.MsgRenewAccountInternal)
 	domainGracePeriodUntil := utils.SecondsToTime(d.ValidUntil).Add(dgp)
 	accNewValidUntil := utils.SecondsToTime(ex.State().ValidUntil)
 	if domainGracePeriodUntil.Before(accNewValidUntil) {
-		dex := NewDomainExecutor(ctx, domainCtrl.Domain()).WithDomains(&domains).WithAccounts(&accounts).WithConfiguration(conf)
+		dex := NewDomainExecutor(ctx, domainCtrl.Domain()).WithDomains(&domains).WithAccounts(&accounts).WithKeeper(k).WithConfiguration(conf)
 		dex.Renew(accNewValidUntil.Unix())
 	}
 
@@ -283,7 +315,7 @@ func replaceAccountResources(ctx sdk.Context, k Keeper, msg *types.MsgReplaceAcc
 
 	// perform account checks
 	accounts := k.AccountStore(ctx)
-	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
 	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
 	if err := accountCtrl.
 		MustExist().
@@ -301,7 +333,7 @@ func replaceAccountResources(ctx sdk.Context, k Keeper, msg *types.MsgReplaceAcc
 	}
 
 	// replace accounts resources
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
 	ex.ReplaceResources(msg.NewResources)
 
 	// success
@@ -318,6 +350,97 @@ func replaceAccountResources(ctx sdk.Context, k Keeper, msg *types.MsgReplaceAcc
 	return &types.MsgReplaceAccountResourcesResponse{}, nil
 }
 
+// upsertAccountResources adds or updates single account resources
+func upsertAccountResources(ctx sdk.Context, k Keeper, msg *types.MsgUpsertAccountResourcesInternal) (*types.MsgUpsertAccountResourcesResponse, error) {
+	// perform domain checks
+	domains := k.DomainStore(ctx)
+	domainCtrl := NewDomainController(ctx, msg.Domain).WithDomains(&domains)
+	if err := domainCtrl.MustExist().NotExpired().Validate(); err != nil {
+		return nil, err
+	}
+
+	// perform account checks
+	accounts := k.AccountStore(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
+	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
+	if err := accountCtrl.
+		MustExist().
+		NotExpired().
+		OwnedBy(msg.Owner).
+		ValidResources(msg.Resources).
+		UpsertResourceLimitNotExceeded(msg.Resources).
+		Validate(); err != nil {
+		return nil, err
+	}
+
+	// collect fees
+	if err := k.CollectProductFee(ctx, msg); err != nil {
+		return nil, errors.Wrapf(err, "unable to collect fees")
+	}
+
+	// upsert account resources
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
+	ex.UpsertResources(msg.Resources)
+
+	// success
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDomainName, msg.Domain),
+			sdk.NewAttribute(types.AttributeKeyAccountName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyNewResources, serializeResources(msg.Resources)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		),
+	)
+	return &types.MsgUpsertAccountResourcesResponse{}, nil
+}
+
+// removeAccountResources removes single account resources
+func removeAccountResources(ctx sdk.Context, k Keeper, msg *types.MsgRemoveAccountResourcesInternal) (*types.MsgRemoveAccountResourcesResponse, error) {
+	// perform domain checks
+	domains := k.DomainStore(ctx)
+	domainCtrl := NewDomainController(ctx, msg.Domain).WithDomains(&domains)
+	if err := domainCtrl.MustExist().NotExpired().Validate(); err != nil {
+		return nil, err
+	}
+
+	// perform account checks
+	accounts := k.AccountStore(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
+	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
+	if err := accountCtrl.
+		MustExist().
+		NotExpired().
+		OwnedBy(msg.Owner).
+		ResourcesExist(msg.URIs).
+		Validate(); err != nil {
+		return nil, err
+	}
+
+	// collect fees
+	if err := k.CollectProductFee(ctx, msg); err != nil {
+		return nil, errors.Wrapf(err, "unable to collect fees")
+	}
+
+	// remove account resources
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
+	ex.RemoveResources(msg.URIs)
+
+	// success
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDomainName, msg.Domain),
+			sdk.NewAttribute(types.AttributeKeyAccountName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyRemovedResources, strings.Join(msg.URIs, ",")),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		),
+	)
+	return &types.MsgRemoveAccountResourcesResponse{}, nil
+}
+
 // replaceAccountMetadata sets account metadata
 func replaceAccountMetadata(ctx sdk.Context, k Keeper, msg *types.MsgReplaceAccountMetadataInternal) (*types.MsgReplaceAccountMetadataResponse, error) {
 	// perform domain checks
@@ -329,7 +452,7 @@ func replaceAccountMetadata(ctx sdk.Context, k Keeper, msg *types.MsgReplaceAcco
 
 	// perform account checks
 	accounts := k.AccountStore(ctx)
-	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	conf := k.GetAccountConfiguration(ctx, msg.Domain)
 	accountCtrl := NewAccountController(ctx, msg.Domain, msg.Name).WithAccounts(&accounts).WithDomainController(domainCtrl).WithConfiguration(conf)
 	if err := accountCtrl.
 		MustExist().
@@ -346,7 +469,7 @@ func replaceAccountMetadata(ctx sdk.Context, k Keeper, msg *types.MsgReplaceAcco
 	}
 
 	// save to store
-	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts)
+	ex := NewAccountExecutor(ctx, accountCtrl.Account()).WithAccounts(&accounts).WithKeeper(k)
 	ex.UpdateMetadata(msg.NewMetadataURI)
 
 	// success
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"reflect"
 	"testing"
@@ -399,10 +403,128 @@ func Test_Common_addAccountCertificate(t *testing.T) {
 				}
 			},
 		},
+		"success typed certificate": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setConfig := GetConfigSetter(k.ConfigurationKeeper).SetConfig
+				setConfig(ctx, configuration.Config{
+					CertificateCountMax: 2,
+					CertificateSizeMax:  1000,
+					AccountGracePeriod:  1000 * time.Second,
+				})
+				domains := k.DomainStore(ctx)
+				accounts := k.AccountStore(ctx)
+				NewDomainExecutor(ctx, types.Domain{
+					Name:       "test",
+					ValidUntil: utils.TimeToSeconds(ctx.BlockTime().Add(1000 * time.Hour)),
+					Admin:      AliceKey,
+				}).WithDomains(&domains).WithAccounts(&accounts).Create()
+				// add mock account
+				NewAccountExecutor(ctx, types.Account{
+					Domain:       "test",
+					Name:         utils.StrPtr("test"),
+					ValidUntil:   utils.TimeToSeconds(ctx.BlockTime().Add(1000 * time.Hour)),
+					Owner:        AliceKey,
+					Certificates: [][]byte{[]byte("1")},
+				}).WithAccounts(&accounts).Create()
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				cert := newTestJWS(t, map[string]interface{}{"iss": "issuer", "sub": "test*test"})
+				_, err := addAccountCertificate(ctx, k, types.MsgAddAccountCertificate{
+					Domain:          "test",
+					Name:            "test",
+					Owner:           AliceKey.String(),
+					NewCertificate:  cert,
+					CertificateType: types.JWSCertificate,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("addAccountCertificate() got error: %s", err)
+				}
+				// the typed certificate counts toward the limit
+				_, err = addAccountCertificate(ctx, k, types.MsgAddAccountCertificate{
+					Domain:         "test",
+					Name:           "test",
+					Owner:          AliceKey.String(),
+					NewCertificate: []byte("2"),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCertificateLimitReached) {
+					t.Fatalf("addAccountCertificate() expected error: %s, got: %s", types.ErrCertificateLimitReached, err)
+				}
+				// the typed certificate can be deleted by its combined index
+				_, err = deleteAccountCertificate(ctx, k, types.MsgDeleteAccountCertificate{
+					Domain:            "test",
+					Name:              "test",
+					Owner:             AliceKey.String(),
+					DeleteCertificate: cert,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("deleteAccountCertificate() got error: %s", err)
+				}
+			},
+			AfterTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				account := new(types.Account)
+				if err := k.AccountStore(ctx).Read((&types.Account{Domain: "test", Name: utils.StrPtr("test")}).PrimaryKey(), account); err != nil {
+					t.Fatal("account not found")
+				}
+				if len(account.TypedCertificates) != 0 || len(account.Certificates) != 1 {
+					t.Fatalf("deleteAccountCertificate: unexpected certificates: %#v, %#v", account.Certificates, account.TypedCertificates)
+				}
+			},
+		},
+		"invalid typed certificate": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setConfig := GetConfigSetter(k.ConfigurationKeeper).SetConfig
+				setConfig(ctx, configuration.Config{
+					CertificateCountMax: 2,
+					CertificateSizeMax:  1000,
+					AccountGracePeriod:  1000 * time.Second,
+				})
+				domains := k.DomainStore(ctx)
+				accounts := k.AccountStore(ctx)
+				NewDomainExecutor(ctx, types.Domain{
+					Name:       "test",
+					ValidUntil: utils.TimeToSeconds(ctx.BlockTime().Add(1000 * time.Hour)),
+					Admin:      AliceKey,
+				}).WithDomains(&domains).WithAccounts(&accounts).Create()
+				// add mock account
+				NewAccountExecutor(ctx, types.Account{
+					Domain:     "test",
+					Name:       utils.StrPtr("test"),
+					ValidUntil: utils.TimeToSeconds(ctx.BlockTime().Add(1000 * time.Hour)),
+					Owner:      AliceKey,
+				}).WithAccounts(&accounts).Create()
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := addAccountCertificate(ctx, k, types.MsgAddAccountCertificate{
+					Domain:          "test",
+					Name:            "test",
+					Owner:           AliceKey.String(),
+					NewCertificate:  newTestJWS(t, map[string]interface{}{"iss": "issuer", "sub": "other*test"}),
+					CertificateType: types.JWSCertificate,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrInvalidCertificate) {
+					t.Fatalf("addAccountCertificate() expected error: %s, got: %s", types.ErrInvalidCertificate, err)
+				}
+			},
+		},
 	}
 	RunTests(t, cases)
 }
 
+// newTestJWS returns a compact JWS of the provided claims signed with a new Ed25519 key
+func newTestJWS(t *testing.T, claims map[string]interface{}) []byte {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, _ := json.Marshal(map[string]interface{}{
+		"alg": "EdDSA",
+		"jwk": map[string]string{"kty": "OKP", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(pub)},
+	})
+	payload, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return []byte(input + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(priv, []byte(input))))
+}
+
 func Test_Closed_deleteAccountCertificate(t *testing.T) {
 	cases := map[string]SubTest{
 		"does not respect account valid until": {
@@ -2972,3 +3094,214 @@ func Test_Common_handlerAccountTransfer(t *testing.T) {
 	}
 	RunTests(t, testCases)
 }
+
+func Test_upsertAndRemoveAccountResources(t *testing.T) {
+	before := func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+		setConfig := GetConfigSetter(k.ConfigurationKeeper).SetConfig
+		setConfig(ctx, configuration.Config{
+			ValidURI:      RegexMatchAll,
+			ValidResource: RegexMatchAll,
+			ResourcesMax:  2,
+		})
+		domains := k.DomainStore(ctx)
+		accounts := k.AccountStore(ctx)
+		NewDomainExecutor(ctx, types.Domain{
+			Name:       "test",
+			ValidUntil: utils.TimeToSeconds(time.Now().Add(1000 * time.Hour)),
+			Admin:      BobKey,
+			Type:       types.ClosedDomain,
+		}).WithDomains(&domains).WithAccounts(&accounts).Create()
+		NewAccountExecutor(ctx, types.Account{
+			Domain:    "test",
+			Name:      utils.StrPtr("test"),
+			Owner:     AliceKey,
+			Resources: []*types.Resource{{URI: "uri", Resource: "res"}},
+		}).WithAccounts(&accounts).Create()
+	}
+	readResources := func(t *testing.T, k Keeper, ctx sdk.Context) []*types.Resource {
+		account := new(types.Account)
+		if err := k.AccountStore(ctx).Read((&types.Account{Domain: "test", Name: utils.StrPtr("test")}).PrimaryKey(), account); err != nil {
+			t.Fatal(err)
+		}
+		return account.Resources
+	}
+	cases := map[string]SubTest{
+		"only owner can upsert resources": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := upsertAccountResources(ctx, k, types.MsgUpsertAccountResources{
+					Domain:    "test",
+					Name:      "test",
+					Resources: []*types.Resource{{URI: "uri", Resource: "new"}},
+					Owner:     BobKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrUnauthorized) {
+					t.Fatalf("upsertAccountResources() expected error: %s, got: %s", types.ErrUnauthorized, err)
+				}
+			},
+		},
+		"upsert exceeds resource limit": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := upsertAccountResources(ctx, k, types.MsgUpsertAccountResources{
+					Domain:    "test",
+					Name:      "test",
+					Resources: []*types.Resource{{URI: "uri1", Resource: "res"}, {URI: "uri2", Resource: "res"}},
+					Owner:     AliceKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrResourceLimitExceeded) {
+					t.Fatalf("upsertAccountResources() expected error: %s, got: %s", types.ErrResourceLimitExceeded, err)
+				}
+			},
+		},
+		"success upsert": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := upsertAccountResources(ctx, k, types.MsgUpsertAccountResources{
+					Domain:    "test",
+					Name:      "test",
+					Resources: []*types.Resource{{URI: "uri", Resource: "new"}, {URI: "uri1", Resource: "res"}},
+					Owner:     AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("upsertAccountResources() got error: %s", err)
+				}
+			},
+			AfterTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				expected := []*types.Resource{{URI: "uri", Resource: "new"}, {URI: "uri1", Resource: "res"}}
+				if got := readResources(t, k, ctx); !reflect.DeepEqual(got, expected) {
+					t.Fatalf("unexpected resources: %v", got)
+				}
+			},
+		},
+		"remove missing resource": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := removeAccountResources(ctx, k, types.MsgRemoveAccountResources{
+					Domain: "test",
+					Name:   "test",
+					URIs:   []string{"missing"},
+					Owner:  AliceKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrResourceDoesNotExist) {
+					t.Fatalf("removeAccountResources() expected error: %s, got: %s", types.ErrResourceDoesNotExist, err)
+				}
+			},
+		},
+		"success remove": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := removeAccountResources(ctx, k, types.MsgRemoveAccountResources{
+					Domain: "test",
+					Name:   "test",
+					URIs:   []string{"uri"},
+					Owner:  AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("removeAccountResources() got error: %s", err)
+				}
+			},
+			AfterTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				if got := readResources(t, k, ctx); len(got) != 0 {
+					t.Fatalf("unexpected resources: %v", got)
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
+
+func Test_registerWildcardAccount(t *testing.T) {
+	before := func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+		GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+			ValidAccountName:     "^[a-z]+$",
+			AccountRenewalPeriod: 1000 * time.Hour,
+		})
+		domains := k.DomainStore(ctx)
+		accounts := k.AccountStore(ctx)
+		NewDomainExecutor(ctx, types.Domain{
+			Name:       "acme",
+			ValidUntil: utils.TimeToSeconds(time.Now().Add(1000 * time.Hour)),
+			Admin:      BobKey,
+			Type:       types.OpenDomain,
+		}).WithDomains(&domains).WithAccounts(&accounts).Create()
+	}
+	cases := map[string]SubTest{
+		"only domain admin can register the wildcard account": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "acme",
+					Name:       "catchall",
+					Owner:      AliceKey.String(),
+					Registerer: AliceKey.String(),
+					Wildcard:   true,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrUnauthorized) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrUnauthorized, err)
+				}
+			},
+		},
+		"success": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "acme",
+					Name:       "catchall",
+					Owner:      BobKey.String(),
+					Registerer: BobKey.String(),
+					Wildcard:   true,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+			},
+			AfterTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				account, wildcard, err := k.ResolveStarname(ctx, "acme", "anything")
+				if err != nil || !wildcard {
+					t.Fatalf("ResolveStarname() wildcard %t, error %v", wildcard, err)
+				}
+				// the wildcard account is a regular account whose starname has a single separator
+				if account.GetStarname() != "catchall*acme" {
+					t.Fatalf("unexpected wildcard account %s", account.GetStarname())
+				}
+			},
+		},
+		"the wildcard account name must be valid": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "acme",
+					Name:       "*",
+					Owner:      BobKey.String(),
+					Registerer: BobKey.String(),
+					Wildcard:   true,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrInvalidAccountName) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrInvalidAccountName, err)
+				}
+			},
+		},
+		"a domain has a single wildcard account": {
+			BeforeTest: before,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				for i, name := range []string{"catchall", "other"} {
+					_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+						Domain:     "acme",
+						Name:       name,
+						Owner:      BobKey.String(),
+						Registerer: BobKey.String(),
+						Wildcard:   true,
+					}.ToInternal())
+					if i == 0 && err != nil {
+						t.Fatalf("registerAccount() got error: %s", err)
+					}
+					if i == 1 && !errors.Is(err, types.ErrWildcardAccountExists) {
+						t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrWildcardAccountExists, err)
+					}
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// commitRegistration saves a commitment to a future domain or account registration
+func commitRegistration(ctx sdk.Context, k Keeper, msg *types.MsgCommitRegistrationInternal) (*types.MsgCommitRegistrationResponse, error) {
+	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	if !conf.CommitRevealEnabled {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRequest, "commit-reveal registration is disabled")
+	}
+	if _, ok := k.GetCommitment(ctx, msg.Owner, msg.Commitment); ok {
+		return nil, sdkerrors.Wrapf(types.ErrCommitmentExists, "%x", msg.Commitment)
+	}
+
+	// collect fees
+	if err := k.CollectProductFee(ctx, msg); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to collect fees")
+	}
+
+	// save commitment
+	k.SetCommitment(ctx, types.Commitment{
+		Hash:      msg.Commitment,
+		Owner:     msg.Owner,
+		CreatedAt: ctx.BlockTime().Unix(),
+	})
+
+	// success
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCommitment, fmt.Sprintf("%x", msg.Commitment)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		),
+	)
+	return &types.MsgCommitRegistrationResponse{}, nil
+}
@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func Test_commitRegistration(t *testing.T) {
+	salt := []byte("salt")
+	setCommitRevealConfig := func(ctx sdk.Context, k Keeper) {
+		GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+			ValidDomainName:      "^(.*?)?",
+			ValidAccountName:     "^(.*?)?",
+			DomainRenewalPeriod:  1000 * time.Hour,
+			AccountRenewalPeriod: 1000 * time.Hour,
+			CommitRevealEnabled:  true,
+			CommitmentMinDelay:   10 * time.Second,
+			CommitmentMaxWindow:  100 * time.Second,
+		})
+	}
+	cases := map[string]SubTest{
+		"commit-reveal disabled": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{})
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+					Commitment: types.ComputeCommitment("test", "", AliceKey, salt),
+					Owner:      AliceKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrInvalidRequest) {
+					t.Fatalf("commitRegistration() expected error: %s, got: %s", types.ErrInvalidRequest, err)
+				}
+			},
+		},
+		"commitment exists": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				k.SetCommitment(ctx, types.Commitment{Hash: types.ComputeCommitment("test", "", AliceKey, salt), Owner: AliceKey})
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+					Commitment: types.ComputeCommitment("test", "", AliceKey, salt),
+					Owner:      AliceKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCommitmentExists) {
+					t.Fatalf("commitRegistration() expected error: %s, got: %s", types.ErrCommitmentExists, err)
+				}
+			},
+		},
+		"commitment copied by someone else": {
+			BeforeTestBlockTime: 1000,
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				// the copy made from the mempool is committed first, it does not block the original commitment
+				for _, owner := range []sdk.AccAddress{BobKey, AliceKey} {
+					_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+						Commitment: types.ComputeCommitment("test", "", AliceKey, salt),
+						Owner:      owner.String(),
+					}.ToInternal())
+					if err != nil {
+						t.Fatalf("commitRegistration() got error: %s", err)
+					}
+				}
+			},
+			TestBlockTime: 1050,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      BobKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCommitmentDoesNotExist) {
+					t.Fatalf("registerDomain() expected error: %s, got: %s", types.ErrCommitmentDoesNotExist, err)
+				}
+				_, err = registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      AliceKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("registerDomain() got error: %s", err)
+				}
+			},
+		},
+		"domain registration without commitment": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      AliceKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCommitmentDoesNotExist) {
+					t.Fatalf("registerDomain() expected error: %s, got: %s", types.ErrCommitmentDoesNotExist, err)
+				}
+			},
+		},
+		"domain registration revealed by someone else": {
+			BeforeTestBlockTime: 1000,
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+					Commitment: types.ComputeCommitment("test", "", AliceKey, salt),
+					Owner:      AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("commitRegistration() got error: %s", err)
+				}
+			},
+			TestBlockTime: 1050,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      BobKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCommitmentDoesNotExist) {
+					t.Fatalf("registerDomain() expected error: %s, got: %s", types.ErrCommitmentDoesNotExist, err)
+				}
+			},
+		},
+		"domain registration too early": {
+			BeforeTestBlockTime: 1000,
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+					Commitment: types.ComputeCommitment("test", "", AliceKey, salt),
+					Owner:      AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("commitRegistration() got error: %s", err)
+				}
+			},
+			TestBlockTime: 1005,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      AliceKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCommitmentTooRecent) {
+					t.Fatalf("registerDomain() expected error: %s, got: %s", types.ErrCommitmentTooRecent, err)
+				}
+			},
+		},
+		"domain registration too late": {
+			BeforeTestBlockTime: 1000,
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+					Commitment: types.ComputeCommitment("test", "", AliceKey, salt),
+					Owner:      AliceKey.String(),
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("commitRegistration() got error: %s", err)
+				}
+			},
+			TestBlockTime: 1100,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      AliceKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if !errors.Is(err, types.ErrCommitmentExpired) {
+					t.Fatalf("registerDomain() expected error: %s, got: %s", types.ErrCommitmentExpired, err)
+				}
+			},
+		},
+		"success domain and account registration": {
+			BeforeTestBlockTime: 1000,
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				for _, name := range []string{"", "account"} {
+					_, err := commitRegistration(ctx, k, types.MsgCommitRegistration{
+						Commitment: types.ComputeCommitment("test", name, AliceKey, salt),
+						Owner:      AliceKey.String(),
+					}.ToInternal())
+					if err != nil {
+						t.Fatalf("commitRegistration() got error: %s", err)
+					}
+				}
+			},
+			TestBlockTime: 1050,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "test",
+					Admin:      AliceKey.String(),
+					DomainType: types.OpenDomain,
+					Salt:       salt,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("registerDomain() got error: %s", err)
+				}
+				_, err = registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "test",
+					Name:       "account",
+					Owner:      BobKey.String(),
+					Registerer: AliceKey.String(),
+					Salt:       salt,
+				}.ToInternal())
+				if err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+			},
+			AfterTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				account := new(types.Account)
+				if err := k.AccountStore(ctx).Read((&types.Account{Domain: "test", Name: utils.StrPtr("account")}).PrimaryKey(), account); err != nil {
+					t.Fatal("account not found")
+				}
+				// commitments are consumed by the registration
+				k.IterateCommitments(ctx, func(commitment types.Commitment) bool {
+					t.Fatalf("unexpected commitment: %x", commitment.Hash)
+					return true
+				})
+			},
+		},
+		"expired commitments are removed": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				k.SetCommitment(ctx, types.Commitment{Hash: types.ComputeCommitment("old", "", AliceKey, salt), Owner: AliceKey, CreatedAt: 900})
+				k.SetCommitment(ctx, types.Commitment{Hash: types.ComputeCommitment("expired", "", AliceKey, salt), Owner: AliceKey, CreatedAt: 950})
+				k.SetCommitment(ctx, types.Commitment{Hash: types.ComputeCommitment("valid", "", AliceKey, salt), Owner: AliceKey, CreatedAt: 1000})
+			},
+			TestBlockTime: 1050,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				k.RemoveExpiredCommitments(ctx)
+				var remaining []types.Commitment
+				k.IterateCommitments(ctx, func(commitment types.Commitment) bool {
+					remaining = append(remaining, commitment)
+					return false
+				})
+				if len(remaining) != 1 || remaining[0].CreatedAt != 1000 {
+					t.Fatalf("RemoveExpiredCommitments() unexpected remaining commitments: %v", remaining)
+				}
+			},
+		},
+		"expired commitments removal is bounded": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setCommitRevealConfig(ctx, k)
+				for i := 0; i < types.MaxExpiredCommitmentsPerBlock+1; i++ {
+					k.SetCommitment(ctx, types.Commitment{Hash: types.ComputeCommitment(fmt.Sprintf("old%d", i), "", AliceKey, salt), Owner: AliceKey, CreatedAt: 900})
+				}
+			},
+			TestBlockTime: 1050,
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				if removed := k.RemoveExpiredCommitments(ctx); removed != types.MaxExpiredCommitmentsPerBlock {
+					t.Fatalf("RemoveExpiredCommitments() expected %d removed commitments, got %d", types.MaxExpiredCommitmentsPerBlock, removed)
+				}
+				if removed := k.RemoveExpiredCommitments(ctx); removed != 1 {
+					t.Fatalf("RemoveExpiredCommitments() expected the last commitment to be removed, got %d", removed)
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
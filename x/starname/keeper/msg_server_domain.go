@@ -29,7 +29,8 @@ func deleteDomain(ctx sdk.Context, k Keeper, msg *types.MsgDeleteDomainInternal)
 
 	// all checks passed delete domain
 	accounts := k.AccountStore(ctx)
-	NewDomainExecutor(ctx, ctrl.Domain()).WithDomains(&domains).WithAccounts(&accounts).Delete()
+	NewDomainExecutor(ctx, ctrl.Domain()).WithDomains(&domains).WithAccounts(&accounts).WithKeeper(k).Delete()
+	k.DeleteDomainPolicy(ctx, msg.Domain)
 
 	// success
 	ctx.EventManager().EmitEvent(
@@ -56,6 +57,16 @@ func registerDomain(ctx sdk.Context, k Keeper, msg *types.MsgRegisterDomainInter
 	if err != nil {
 		return nil, err
 	}
+	if err := k.AssertNotReserved(ctx, msg.Name, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	// consume the registration commitment
+	if conf.CommitRevealEnabled {
+		if err := k.RevealCommitment(ctx, conf, msg.Name, "", msg.Admin, msg.Salt); err != nil {
+			return nil, err
+		}
+	}
 
 	// create new domain
 	d := types.Domain{
@@ -73,8 +84,10 @@ func registerDomain(ctx sdk.Context, k Keeper, msg *types.MsgRegisterDomainInter
 
 	// save domain
 	accounts := k.AccountStore(ctx)
-	ex := NewDomainExecutor(ctx, d).WithDomains(&domains).WithAccounts(&accounts)
+	ex := NewDomainExecutor(ctx, d).WithDomains(&domains).WithAccounts(&accounts).WithKeeper(k)
 	ex.Create()
+	// drop the policy left by a previous registration of the domain
+	k.DeleteDomainPolicy(ctx, msg.Name)
 
 	// success
 	ctx.EventManager().EmitEvent(
@@ -115,7 +128,7 @@ func renewDomain(ctx sdk.Context, k Keeper, msg *types.MsgRenewDomainInternal) (
 
 	// update domain
 	accounts := k.AccountStore(ctx)
-	NewDomainExecutor(ctx, ctrl.Domain()).WithDomains(&domains).WithAccounts(&accounts).WithConfiguration(conf).Renew()
+	NewDomainExecutor(ctx, ctrl.Domain()).WithDomains(&domains).WithAccounts(&accounts).WithKeeper(k).WithConfiguration(conf).Renew()
 
 	// success
 	ctx.EventManager().EmitEvent(
@@ -129,6 +142,46 @@ func renewDomain(ctx sdk.Context, k Keeper, msg *types.MsgRenewDomainInternal) (
 	return &types.MsgRenewDomainResponse{}, nil
 }
 
+// setDomainPolicy sets the policy of a domain, only an open domain can charge prices and filter registrants
+func setDomainPolicy(ctx sdk.Context, k Keeper, msg *types.MsgSetDomainPolicyInternal) (*types.MsgSetDomainPolicyResponse, error) {
+	// do precondition and authorization checks
+	domains := k.DomainStore(ctx)
+	conf := k.ConfigurationKeeper.GetConfiguration(ctx)
+	ctrl := NewDomainController(ctx, msg.Domain).WithDomains(&domains).WithConfiguration(conf)
+	if err := ctrl.
+		MustExist().
+		NotExpired().
+		Admin(msg.Admin).
+		Validate(); err != nil {
+		return nil, err
+	}
+	if err := validatePolicyDomainType(ctrl.Domain(), msg.Policy); err != nil {
+		return nil, err
+	}
+	if err := validatePolicyOverrides(conf, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	// collect fees
+	if err := k.CollectProductFee(ctx, msg); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to collect fees")
+	}
+
+	// save policy
+	k.SetDomainPolicy(ctx, msg.Policy)
+
+	// success
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDomainName, msg.Domain),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Admin.String()),
+		),
+	)
+	return &types.MsgSetDomainPolicyResponse{}, nil
+}
+
 func transferDomain(ctx sdk.Context, k Keeper, msg *types.MsgTransferDomainInternal) (*types.MsgTransferDomainResponse, error) {
 	// do checks and domain transfer
 	if err := k.DoDomainTransfer(ctx, msg.Domain, msg.Owner, msg.NewAdmin, msg.TransferFlag); err != nil {
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	escrowtypes "github.com/iov-one/starnamed/x/escrow/types"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// NormalizeNames renames the domains and the accounts stored before names were normalized to their normalized form,
+// along with their search index entries, policy, history, provenance and the copies held by the escrows and offers.
+// A name whose normalized form is taken gets the first free "-N" suffix of that form, the names being processed in
+// store order, and an EventTypeNormalizedNameTaken event is emitted to its owner. Names that can not be normalized are
+// left untouched. Registration commitments are hashes of normalized names, they are not renamed.
+func (k Keeper) NormalizeNames(ctx sdk.Context) error {
+	domains := k.DomainStore(ctx)
+	accounts := k.AccountStore(ctx)
+	// collect the domains to rename first as the store can not be updated while it is iterated over
+	var domainsToRename []types.Domain
+	cursor, err := domains.Query().Do()
+	if err != nil {
+		return err
+	}
+	for ; cursor.Valid(); cursor.Next() {
+		domain := new(types.Domain)
+		if err := cursor.Read(domain); err != nil {
+			return err
+		}
+		if normalized := types.NormalizeNameOrKeep(domain.Name); normalized != domain.Name {
+			domainsToRename = append(domainsToRename, *domain)
+		}
+	}
+	renamedDomains := make(map[string]string, len(domainsToRename))
+	for _, domain := range domainsToRename {
+		normalized := types.NormalizeNameOrKeep(domain.Name)
+		name := freeName(normalized, func(name string) bool {
+			return domains.Read((&types.Domain{Name: name}).PrimaryKey(), new(types.Domain)) == nil
+		})
+		if err := k.renameDomain(ctx, domain, name); err != nil {
+			return err
+		}
+		if name != normalized {
+			emitNormalizedNameTaken(ctx, domain.Name, "", name, domain.Admin)
+		}
+		renamedDomains[domain.Name] = name
+	}
+	// then rename the accounts, including the ones of the renamed domains
+	var accountsToRename []types.Account
+	cursor, err = accounts.Query().Do()
+	if err != nil {
+		return err
+	}
+	for ; cursor.Valid(); cursor.Next() {
+		account := new(types.Account)
+		if err := cursor.Read(account); err != nil {
+			return err
+		}
+		if normalized := types.NormalizeNameOrKeep(*account.Name); normalized != *account.Name {
+			accountsToRename = append(accountsToRename, *account)
+		}
+	}
+	// the renamed accounts are keyed by their primary key in their renamed domain
+	renamedAccounts := make(map[string]string, len(accountsToRename))
+	for _, account := range accountsToRename {
+		normalized := types.NormalizeNameOrKeep(*account.Name)
+		name := freeName(normalized, func(name string) bool {
+			return accounts.Read((&types.Account{Domain: account.Domain, Name: &name}).PrimaryKey(), new(types.Account)) == nil
+		})
+		if err := k.renameAccount(ctx, account, account.Domain, name); err != nil {
+			return err
+		}
+		if name != normalized {
+			emitNormalizedNameTaken(ctx, account.Domain, *account.Name, name, account.Owner)
+		}
+		renamedAccounts[string(account.PrimaryKey())] = name
+	}
+	// finally rename the domains and accounts held by the escrows and offers, which are transferred by name
+	return k.EscrowKeeper.UpdateEscrowedObjects(ctx, func(obj escrowtypes.TransferableObject) bool {
+		switch o := obj.(type) {
+		case *types.Domain:
+			name, renamed := renamedDomains[o.Name]
+			if renamed {
+				o.Name = name
+			}
+			return renamed
+		case *types.Account:
+			domain, renamedDomain := renamedDomains[o.Domain]
+			if renamedDomain {
+				o.Domain = domain
+			}
+			name, renamed := renamedAccounts[string(o.PrimaryKey())]
+			if renamed {
+				o.Name = &name
+			}
+			return renamedDomain || renamed
+		default:
+			return false
+		}
+	})
+}
+
+// freeName returns the provided name if it is not taken, or the first name made of the provided name and a "-N"
+// suffix which is not taken
+func freeName(name string, taken func(name string) bool) string {
+	candidate := name
+	for i := 1; taken(candidate); i++ {
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+	return candidate
+}
+
+// emitNormalizedNameTaken emits the event telling the owner of a domain, or of an account if previousName is the
+// previous account name, that its normalized name was taken and that it was renamed to name instead
+func emitNormalizedNameTaken(ctx sdk.Context, domain, previousName, name string, owner sdk.AccAddress) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+	}
+	if previousName == "" {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyPreviousName, domain),
+			sdk.NewAttribute(types.AttributeKeyDomainName, name),
+		)
+	} else {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyDomainName, domain),
+			sdk.NewAttribute(types.AttributeKeyPreviousName, previousName),
+			sdk.NewAttribute(types.AttributeKeyAccountName, name),
+		)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeNormalizedNameTaken, attributes...))
+}
+
+// renameDomain renames a domain and moves its accounts, its policy and its provenance along
+func (k Keeper) renameDomain(ctx sdk.Context, domain types.Domain, name string) error {
+	domains := k.DomainStore(ctx)
+	accounts := k.AccountStore(ctx)
+	var domainAccounts []types.Account
+	cursor, err := accounts.Query().Where().Index(types.AccountDomainIndex).Equals([]byte(domain.Name)).Do()
+	if err != nil {
+		return err
+	}
+	for ; cursor.Valid(); cursor.Next() {
+		account := new(types.Account)
+		if err := cursor.Read(account); err != nil {
+			return err
+		}
+		domainAccounts = append(domainAccounts, *account)
+	}
+	for _, account := range domainAccounts {
+		if err := k.renameAccount(ctx, account, name, *account.Name); err != nil {
+			return err
+		}
+	}
+	if err := domains.Delete(domain.PrimaryKey()); err != nil {
+		return sdkerrors.Wrapf(err, "unable to delete domain %s", domain.Name)
+	}
+	k.UnindexDomain(ctx, domain.Name)
+	oldName := domain.Name
+	domain.Name = name
+	if err := domains.Create(&domain); err != nil {
+		return sdkerrors.Wrapf(err, "unable to create domain %s", domain.Name)
+	}
+	k.IndexDomain(ctx, domain.Name)
+	if policy, ok := k.GetDomainPolicy(ctx, oldName); ok {
+		k.DeleteDomainPolicy(ctx, oldName)
+		policy.Domain = name
+		k.SetDomainPolicy(ctx, policy)
+	}
+	k.RenameProvenance(ctx, oldName, name)
+	return nil
+}
+
+// renameAccount moves an account, its history and its provenance to the provided domain and name
+func (k Keeper) renameAccount(ctx sdk.Context, account types.Account, domain, name string) error {
+	accounts := k.AccountStore(ctx)
+	if err := accounts.Delete(account.PrimaryKey()); err != nil {
+		return sdkerrors.Wrapf(err, "unable to delete account %s", account.GetStarname())
+	}
+	k.UnindexAccount(ctx, account.Domain, *account.Name)
+	k.RenameAccountHistory(ctx, account.Domain, *account.Name, domain, name)
+	k.RenameProvenance(ctx,
+		strings.Join([]string{*account.Name, account.Domain}, types.StarnameSeparator),
+		strings.Join([]string{name, domain}, types.StarnameSeparator),
+	)
+	account.Domain, account.Name = domain, &name
+	if err := accounts.Create(&account); err != nil {
+		return sdkerrors.Wrapf(err, "unable to create account %s", account.GetStarname())
+	}
+	if name != types.EmptyAccountName {
+		k.IndexAccount(ctx, domain, name)
+	}
+	return nil
+}
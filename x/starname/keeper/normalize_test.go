@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+	"golang.org/x/net/idna"
+)
+
+func TestInternationalizedNames(t *testing.T) {
+	k, ctx, _ := NewTestKeeper(t, true)
+	config := configuration.Config{
+		ValidDomainName:      `^[\p{L}\p{M}\p{N}_-]{1,16}$`,
+		ValidAccountName:     `^[\p{L}\p{M}\p{N}_.-]{1,64}$`,
+		DomainRenewalPeriod:  1000 * time.Hour,
+		AccountRenewalPeriod: 1000 * time.Hour,
+		ResourcesMax:         5,
+	}
+	GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, config)
+	fees := configuration.NewFees()
+	fees.SetDefaults("testcoin")
+	GetConfigSetter(k.ConfigurationKeeper).SetFees(ctx, fees)
+	querier := NewQuerier(&k)
+	msgServer := NewMsgServerImpl(&k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	punycode, err := idna.ToASCII("école")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("normalized on registration", func(t *testing.T) {
+		if _, err := msgServer.RegisterDomain(goCtx, &types.MsgRegisterDomain{
+			Name:       "ÉCOLE",
+			Admin:      BobKey.String(),
+			DomainType: types.OpenDomain,
+		}); err != nil {
+			t.Fatalf("RegisterDomain() got error: %s", err)
+		}
+		if _, err := msgServer.RegisterAccount(goCtx, &types.MsgRegisterAccount{
+			Domain: punycode,
+			Name:   "Élève",
+			Owner:  AliceKey.String(),
+		}); err != nil {
+			t.Fatalf("RegisterAccount() got error: %s", err)
+		}
+		account := new(types.Account)
+		if err := k.AccountStore(ctx).Read((&types.Account{Domain: "école", Name: utils.StrPtr("élève")}).PrimaryKey(), account); err != nil {
+			t.Fatalf("expected the account to be stored normalized: %s", err)
+		}
+	})
+	t.Run("queries accept either form", func(t *testing.T) {
+		for _, domain := range []string{"école", "ÉCOLE", punycode} {
+			if _, err := querier.Domain(sdk.WrapSDKContext(ctx), &types.QueryDomainRequest{Name: domain}); err != nil {
+				t.Fatalf("Domain(%s) got error: %s", domain, err)
+			}
+			res, err := querier.Starname(sdk.WrapSDKContext(ctx), &types.QueryStarnameRequest{Starname: "ÉLÈVE*" + domain})
+			if err != nil {
+				t.Fatalf("Starname() in %s got error: %s", domain, err)
+			}
+			if *res.Account.Name != "élève" {
+				t.Fatalf("Starname() unexpected account: %+v", res.Account)
+			}
+		}
+	})
+	t.Run("same name in another form is taken", func(t *testing.T) {
+		_, err := msgServer.RegisterDomain(goCtx, &types.MsgRegisterDomain{
+			Name:       "École",
+			Admin:      AliceKey.String(),
+			DomainType: types.OpenDomain,
+		})
+		if !errors.Is(err, types.ErrDomainAlreadyExists) {
+			t.Fatalf("RegisterDomain() expected error %s, got: %v", types.ErrDomainAlreadyExists, err)
+		}
+	})
+	t.Run("mixed script confusable", func(t *testing.T) {
+		// the 'а' is cyrillic
+		_, err := msgServer.RegisterAccount(goCtx, &types.MsgRegisterAccount{
+			Domain: "école",
+			Name:   "pаypal",
+			Owner:  AliceKey.String(),
+		})
+		if !errors.Is(err, types.ErrInvalidAccountName) {
+			t.Fatalf("RegisterAccount() expected error %s, got: %v", types.ErrInvalidAccountName, err)
+		}
+	})
+	t.Run("allowed scripts", func(t *testing.T) {
+		restricted := config
+		restricted.AllowedNameScripts = []string{"Latin"}
+		GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, restricted)
+		defer GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, config)
+		_, err := msgServer.RegisterDomain(goCtx, &types.MsgRegisterDomain{
+			Name:       "βόλος",
+			Admin:      BobKey.String(),
+			DomainType: types.OpenDomain,
+		})
+		if !errors.Is(err, types.ErrInvalidDomainName) {
+			t.Fatalf("RegisterDomain() expected error %s, got: %v", types.ErrInvalidDomainName, err)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// provenancePrefix is the prefix of the provenance entries, keyed by starname and sequence
+var provenancePrefix = []byte{0x7}
+
+// provenanceStarnameKey returns the length prefixed starname the provenance entries of a domain or an account are keyed by,
+// domains are keyed by their name and accounts by name*domain so they never collide
+func provenanceStarnameKey(starname string) []byte {
+	key := make([]byte, 2, 2+len(starname))
+	binary.BigEndian.PutUint16(key, uint16(len(starname)))
+	return append(key, starname...)
+}
+
+// provenanceStore returns the store of the provenance entries of a domain or an account
+func (k Keeper) provenanceStore(ctx sdk.Context, starname string) prefix.Store {
+	return prefix.NewStore(prefix.NewStore(ctx.KVStore(k.StoreKey), provenancePrefix), provenanceStarnameKey(starname))
+}
+
+// AppendProvenanceEntry appends an entry to the provenance log of its starname, entries are never modified nor deleted
+func (k Keeper) AppendProvenanceEntry(ctx sdk.Context, entry types.ProvenanceEntry) {
+	store := k.provenanceStore(ctx, entry.Starname)
+	// the sequence of the entry follows the one of the last entry
+	var sequence uint64
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		sequence = binary.BigEndian.Uint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+	store.Set(key, k.Cdc.MustMarshal(&entry))
+}
+
+// RecordProvenance appends an entry of the provided event to the provenance log of a domain or an account at the current block
+func (k Keeper) RecordProvenance(ctx sdk.Context, starname string, event types.ProvenanceEvent, from, to sdk.AccAddress, price sdk.Coins) {
+	k.AppendProvenanceEntry(ctx, types.ProvenanceEntry{
+		Starname: starname,
+		Event:    event,
+		From:     from,
+		To:       to,
+		Price:    price,
+		Time:     ctx.BlockTime().Unix(),
+		Height:   ctx.BlockHeight(),
+	})
+}
+
+// RecordAccountProvenance appends an entry to the provenance log of an account
+func (k Keeper) RecordAccountProvenance(ctx sdk.Context, domain, name string, event types.ProvenanceEvent, from, to sdk.AccAddress, price sdk.Coins) {
+	k.RecordProvenance(ctx, strings.Join([]string{name, domain}, types.StarnameSeparator), event, from, to, price)
+}
+
+// GetProvenance returns the provenance entries of a domain or an account from the oldest,
+// skipping the first offset entries and returning at most limit entries, and the total number of entries
+func (k Keeper) GetProvenance(ctx sdk.Context, starname string, offset, limit uint64) ([]types.ProvenanceEntry, uint64) {
+	iterator := k.provenanceStore(ctx, starname).Iterator(nil, nil)
+	defer iterator.Close()
+	var entries []types.ProvenanceEntry
+	var total uint64
+	for ; iterator.Valid(); iterator.Next() {
+		if total >= offset && total < offset+limit {
+			var entry types.ProvenanceEntry
+			k.Cdc.MustUnmarshal(iterator.Value(), &entry)
+			entries = append(entries, entry)
+		}
+		total++
+	}
+	return entries, total
+}
+
+// RenameProvenance moves the provenance log of a domain or an account to the provided starname, keeping the sequence
+// of its entries
+func (k Keeper) RenameProvenance(ctx sdk.Context, starname, newStarname string) {
+	store := k.provenanceStore(ctx, starname)
+	var keys [][]byte
+	var entries []types.ProvenanceEntry
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ProvenanceEntry
+		k.Cdc.MustUnmarshal(iterator.Value(), &entry)
+		keys = append(keys, iterator.Key())
+		entries = append(entries, entry)
+	}
+	iterator.Close()
+	newStore := k.provenanceStore(ctx, newStarname)
+	for i, entry := range entries {
+		store.Delete(keys[i])
+		entry.Starname = newStarname
+		newStore.Set(keys[i], k.Cdc.MustMarshal(&entry))
+	}
+}
+
+// IterateProvenance iterates over the provenance entries of all the domains and accounts until the provided function returns true
+func (k Keeper) IterateProvenance(ctx sdk.Context, f func(entry types.ProvenanceEntry) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.StoreKey), provenancePrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ProvenanceEntry
+		k.Cdc.MustUnmarshal(iterator.Value(), &entry)
+		if f(entry) {
+			return
+		}
+	}
+}
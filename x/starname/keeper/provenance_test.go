@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func TestProvenance(t *testing.T) {
+	k, ctx, _ := NewTestKeeper(t, true)
+	GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+		ValidDomainName:      RegexMatchAll,
+		ValidAccountName:     RegexMatchAll,
+		DomainRenewalPeriod:  1000 * time.Hour,
+		AccountRenewalPeriod: 1000 * time.Hour,
+		ResourcesMax:         5,
+	})
+	fees := configuration.NewFees()
+	fees.SetDefaults("testcoin")
+	GetConfigSetter(k.ConfigurationKeeper).SetFees(ctx, fees)
+	at := func(seconds int64) sdk.Context {
+		return ctx.WithBlockTime(time.Unix(seconds, 0)).WithBlockHeight(seconds)
+	}
+	querier := NewQuerier(&k)
+	price := sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10))
+
+	// register, transfer, sell and delete an account
+	if _, err := registerDomain(at(100), k, types.MsgRegisterDomain{
+		Name:       "acme",
+		Admin:      BobKey.String(),
+		DomainType: types.OpenDomain,
+	}.ToInternal()); err != nil {
+		t.Fatalf("registerDomain() got error: %s", err)
+	}
+	if _, err := registerAccount(at(200), k, types.MsgRegisterAccount{
+		Domain: "acme",
+		Name:   "alice",
+		Owner:  AliceKey.String(),
+	}.ToInternal()); err != nil {
+		t.Fatalf("registerAccount() got error: %s", err)
+	}
+	if _, err := transferAccount(at(300), k, types.MsgTransferAccount{
+		Domain:   "acme",
+		Name:     "alice",
+		Owner:    AliceKey.String(),
+		NewOwner: CharlieKey.String(),
+	}.ToInternal()); err != nil {
+		t.Fatalf("transferAccount() got error: %s", err)
+	}
+	account := &types.Account{Domain: "acme", Name: &[]string{"alice"}[0]}
+	account.RecordSale(at(400), CharlieKey, BobKey, price, k)
+	if _, err := deleteAccount(at(500), k, types.MsgDeleteAccount{
+		Domain: "acme",
+		Name:   "alice",
+		Owner:  CharlieKey.String(),
+	}.ToInternal()); err != nil {
+		t.Fatalf("deleteAccount() got error: %s", err)
+	}
+	// transfer the domain
+	if _, err := transferDomain(at(600), k, types.MsgTransferDomain{
+		Domain:       "acme",
+		Owner:        BobKey.String(),
+		NewAdmin:     AliceKey.String(),
+		TransferFlag: types.TransferResetNone,
+	}.ToInternal()); err != nil {
+		t.Fatalf("transferDomain() got error: %s", err)
+	}
+
+	t.Run("account", func(t *testing.T) {
+		res, err := querier.Provenance(sdk.WrapSDKContext(ctx), &types.QueryProvenanceRequest{Starname: "alice*acme"})
+		if err != nil {
+			t.Fatalf("Provenance() got error: %s", err)
+		}
+		expected := []types.ProvenanceEntry{
+			{Starname: "alice*acme", Event: types.ProvenanceEvent_Register, To: AliceKey, Time: 200, Height: 200},
+			{Starname: "alice*acme", Event: types.ProvenanceEvent_Transfer, From: AliceKey, To: CharlieKey, Time: 300, Height: 300},
+			{Starname: "alice*acme", Event: types.ProvenanceEvent_Sale, From: CharlieKey, To: BobKey, Price: price, Time: 400, Height: 400},
+			{Starname: "alice*acme", Event: types.ProvenanceEvent_Delete, From: CharlieKey, Time: 500, Height: 500},
+		}
+		if len(res.Entries) != len(expected) {
+			t.Fatalf("Provenance() expected %d entries, got: %+v", len(expected), res.Entries)
+		}
+		for i := range expected {
+			if !expected[i].Equal(&res.Entries[i]) {
+				t.Fatalf("Provenance() entry %d expected %+v, got %+v", i, expected[i], res.Entries[i])
+			}
+		}
+	})
+	t.Run("domain", func(t *testing.T) {
+		res, err := querier.Provenance(sdk.WrapSDKContext(ctx), &types.QueryProvenanceRequest{Starname: "acme"})
+		if err != nil {
+			t.Fatalf("Provenance() got error: %s", err)
+		}
+		if len(res.Entries) != 2 || res.Entries[0].Event != types.ProvenanceEvent_Register || res.Entries[1].Event != types.ProvenanceEvent_Transfer {
+			t.Fatalf("Provenance() unexpected entries: %+v", res.Entries)
+		}
+		if !res.Entries[1].From.Equals(BobKey) || !res.Entries[1].To.Equals(AliceKey) {
+			t.Fatalf("Provenance() unexpected transfer: %+v", res.Entries[1])
+		}
+		// the empty account follows the domain
+		res, err = querier.Provenance(sdk.WrapSDKContext(ctx), &types.QueryProvenanceRequest{Starname: "*acme"})
+		if err != nil {
+			t.Fatalf("Provenance() got error: %s", err)
+		}
+		if len(res.Entries) != 2 || !res.Entries[1].To.Equals(AliceKey) {
+			t.Fatalf("Provenance() unexpected entries: %+v", res.Entries)
+		}
+	})
+	t.Run("pagination", func(t *testing.T) {
+		res, err := querier.Provenance(sdk.WrapSDKContext(ctx), &types.QueryProvenanceRequest{
+			Starname:   "alice*acme",
+			Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+		})
+		if err != nil {
+			t.Fatalf("Provenance() got error: %s", err)
+		}
+		if len(res.Entries) != 2 || res.Entries[0].Event != types.ProvenanceEvent_Transfer || res.Entries[1].Event != types.ProvenanceEvent_Sale {
+			t.Fatalf("Provenance() unexpected entries: %+v", res.Entries)
+		}
+		if res.Page == nil || res.Page.Total != 4 {
+			t.Fatalf("Provenance() expected a total of 4, got: %+v", res.Page)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strings"
 
@@ -9,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	crud "github.com/iov-one/cosmos-sdk-crud"
 
-	"github.com/iov-one/starnamed/pkg/utils"
 	"github.com/iov-one/starnamed/x/starname/types"
 )
 
@@ -23,6 +23,9 @@ const (
 	defaultStart     uint64 = 0
 	defaultLimit     uint64 = 100 // TODO: read this from config.toml
 	NumBlocksInAWeek uint64 = 100000
+	// maxSearchScan is the maximum number of index entries a search scans, it bounds the substring searches and the
+	// total counts
+	maxSearchScan uint64 = 10000
 )
 
 func getPagination(pageRequest *query.PageRequest) (uint64, uint64, bool, error) {
@@ -73,6 +76,7 @@ func (q grpcQuerier) Domain(c context.Context, req *types.QueryDomainRequest) (*
 }
 
 func queryDomain(ctx sdk.Context, name string, keeper *Keeper) (*types.QueryDomainResponse, error) {
+	name = types.NormalizeNameOrKeep(name)
 	domain := new(types.Domain)
 	filter := &types.Domain{Name: name}
 	if err := keeper.DomainStore(ctx).Read(filter.PrimaryKey(), domain); err != nil {
@@ -94,6 +98,7 @@ func (q grpcQuerier) DomainAccounts(c context.Context, req *types.QueryDomainAcc
 }
 
 func queryDomainAccounts(ctx sdk.Context, keeper *Keeper, domain string, start, end uint64, count bool) (*types.QueryDomainAccountsResponse, error) {
+	domain = types.NormalizeNameOrKeep(domain)
 	query := func() crud.FinalizedIndexStatement {
 		return keeper.AccountStore(ctx).Query().Where().Index(types.AccountDomainIndex).Equals([]byte(domain))
 	}
@@ -121,17 +126,164 @@ func (q grpcQuerier) Starname(c context.Context, req *types.QueryStarnameRequest
 	if req.Starname == "" || !strings.Contains(req.Starname, types.StarnameSeparator) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidAccountName, "'%s'", req.Starname)
 	}
-	return queryStarname(sdk.UnwrapSDKContext(c), q.keeper, req.Starname)
+	return queryStarname(sdk.UnwrapSDKContext(c), q.keeper, req.Starname, req.Resolve)
 }
 
-func queryStarname(ctx sdk.Context, keeper *Keeper, starname string) (*types.QueryStarnameResponse, error) {
-	parts := strings.Split(starname, types.StarnameSeparator)
-	filter := types.Account{Domain: parts[1], Name: utils.StrPtr(parts[0])}
-	account := new(types.Account)
-	if err := keeper.AccountStore(ctx).Read(filter.PrimaryKey(), account); err != nil {
+func queryStarname(ctx sdk.Context, keeper *Keeper, starname string, resolve bool) (*types.QueryStarnameResponse, error) {
+	domain, name, err := splitStarname(starname)
+	if err != nil {
+		return nil, err
+	}
+	account, wildcard, err := keeper.ResolveStarname(ctx, domain, name)
+	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "not found: %s", starname)
 	}
-	return &types.QueryStarnameResponse{Account: account}, nil
+	if resolve {
+		*account = keeper.ResolveAccount(ctx, *account)
+	}
+	return &types.QueryStarnameResponse{Account: account, Wildcard: wildcard}, nil
+}
+
+// splitStarname splits a starname of the form name*domain in its normalized domain and name
+func splitStarname(starname string) (domain, name string, err error) {
+	// domains can not contain the separator, so the last one splits the name from the domain
+	separator := strings.LastIndex(starname, types.StarnameSeparator)
+	if separator < 0 {
+		return "", "", sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid starname: %s", starname)
+	}
+	return types.NormalizeNameOrKeep(starname[separator+1:]), types.NormalizeNameOrKeep(starname[:separator]), nil
+}
+
+// normalizeStarname returns the normalized form of a domain name or of a starname of the form name*domain
+func normalizeStarname(starname string) string {
+	domain, name, err := splitStarname(starname)
+	if err != nil {
+		return types.NormalizeNameOrKeep(starname)
+	}
+	return strings.Join([]string{name, domain}, types.StarnameSeparator)
+}
+
+// StarnameHistory returns the states of a starname recorded in the requested time range
+func (q grpcQuerier) StarnameHistory(c context.Context, req *types.QueryStarnameHistoryRequest) (*types.QueryStarnameHistoryResponse, error) {
+	domain, name, err := splitStarname(req.Starname)
+	if err != nil {
+		return nil, err
+	}
+	if req.From < 0 || req.To < 0 || (req.To != 0 && req.To < req.From) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid time range [%d, %d]", req.From, req.To)
+	}
+	entries := q.keeper.GetAccountHistory(sdk.UnwrapSDKContext(c), domain, name, req.From, req.To)
+	return &types.QueryStarnameHistoryResponse{Entries: entries}, nil
+}
+
+// ResolveAt returns the state a starname resolved to at the requested time
+func (q grpcQuerier) ResolveAt(c context.Context, req *types.QueryResolveAtRequest) (*types.QueryResolveAtResponse, error) {
+	domain, name, err := splitStarname(req.Starname)
+	if err != nil {
+		return nil, err
+	}
+	if req.Time < 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid time %d", req.Time)
+	}
+	entry, ok := q.keeper.GetAccountHistoryAt(sdk.UnwrapSDKContext(c), domain, name, req.Time)
+	if !ok || entry.Deleted {
+		return nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "%s not found at %d", req.Starname, req.Time)
+	}
+	return &types.QueryResolveAtResponse{Entry: &entry}, nil
+}
+
+// Provenance returns the chain of custody of a domain or an account
+func (q grpcQuerier) Provenance(c context.Context, req *types.QueryProvenanceRequest) (*types.QueryProvenanceResponse, error) {
+	if req.Starname == "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "empty starname")
+	}
+	start, end, count, err := getPagination(req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed pagination")
+	}
+	entries, total := q.keeper.GetProvenance(sdk.UnwrapSDKContext(c), normalizeStarname(req.Starname), start, end-start)
+	var page *query.PageResponse
+	if count {
+		page = &query.PageResponse{Total: total}
+	}
+	return &types.QueryProvenanceResponse{Entries: entries, Page: page}, nil
+}
+
+// SearchStarnames returns the domains and the accounts whose name starts with the requested prefix
+func (q grpcQuerier) SearchStarnames(c context.Context, req *types.QuerySearchStarnamesRequest) (*types.QuerySearchStarnamesResponse, error) {
+	if _, ok := types.StarnameType_name[int32(req.Type)]; !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "invalid starname type %d", req.Type)
+	}
+	if req.Domain != "" && req.Type == types.StarnameType_Domain {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRequest, "domains can not be searched within a domain")
+	}
+	start, end, count, err := getPagination(req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed pagination")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prefix, domain := types.NormalizeNameOrKeep(req.Prefix), types.NormalizeNameOrKeep(req.Domain)
+	res := &types.QuerySearchStarnamesResponse{}
+	// domains are paginated before accounts, the search stops once the page is full unless the total is requested
+	var total uint64
+	visit := func() (take bool, stop bool) {
+		take = total >= start && total < end
+		total++
+		return take, !count && total >= end
+	}
+	var readErr error
+	stopped := false
+	// the domains and the accounts share the scan limit
+	limit, complete := maxSearchScan, true
+	if req.Domain == "" && req.Type != types.StarnameType_Account {
+		var scanned uint64
+		scanned, complete = q.keeper.SearchDomains(ctx, prefix, req.Substring, limit, func(name string) bool {
+			take, stop := visit()
+			if take {
+				domain := new(types.Domain)
+				if readErr = q.keeper.DomainStore(ctx).Read((&types.Domain{Name: name}).PrimaryKey(), domain); readErr != nil {
+					readErr = sdkerrors.Wrapf(readErr, "indexed domain %s not found", name)
+					return true
+				}
+				res.Domains = append(res.Domains, domain)
+			}
+			stopped = stop
+			return stop
+		})
+		limit -= scanned
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	if complete && !stopped && req.Type != types.StarnameType_Domain {
+		// the domains spent the scan limit, and a zero limit does not bound SearchAccounts
+		if limit == 0 {
+			complete = false
+		} else {
+			_, complete = q.keeper.SearchAccounts(ctx, prefix, domain, req.Substring, limit, func(domain, name string) bool {
+				take, stop := visit()
+				if take {
+					account := new(types.Account)
+					if readErr = q.keeper.AccountStore(ctx).Read((&types.Account{Domain: domain, Name: &name}).PrimaryKey(), account); readErr != nil {
+						readErr = sdkerrors.Wrapf(readErr, "indexed account %s%s%s not found", name, types.StarnameSeparator, domain)
+						return true
+					}
+					res.Accounts = append(res.Accounts, account)
+				}
+				return stop
+			})
+		}
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	if !complete {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "the search scanned more than %d starnames, use a longer prefix, a domain, a prefix search or no total count", maxSearchScan)
+	}
+	if count {
+		res.Page = &query.PageResponse{Total: total}
+	}
+	return res, nil
 }
 
 // OwnerAccounts returns types.Accounts associated with a given owner and nil on error
@@ -218,9 +370,78 @@ func (q grpcQuerier) ResourceAccounts(c context.Context, req *types.QueryResourc
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed pagination")
 	}
+	if req.IncludeInherited {
+		return queryResourceAccountsWithInherited(sdk.UnwrapSDKContext(c), q.keeper, req.Uri, req.Resource, start, end, count)
+	}
 	return queryResourceAccounts(sdk.UnwrapSDKContext(c), q.keeper, req.Uri, req.Resource, start, end, count)
 }
 
+// queryResourceAccountsWithInherited returns the accounts associated with a resource, followed by the accounts
+// that inherit it from the default resources of their domain. The accounts are read through the indexes one at a
+// time and the inherited accounts are not read once the page is full, unless the total count is requested.
+func queryResourceAccountsWithInherited(ctx sdk.Context, keeper *Keeper, uri string, resource string, start, end uint64, count bool) (*types.QueryResourceAccountsResponse, error) {
+	// the accounts holding the resource come first, their count gives the position of the inherited ones
+	res, err := queryResourceAccounts(ctx, keeper, uri, resource, start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	accounts := res.Accounts
+	position := res.Page.Total
+	if count || position < end {
+		err = iterateInheritedResourceAccounts(ctx, keeper, uri, resource, func(account *types.Account) bool {
+			if position >= start && position < end {
+				accounts = append(accounts, account)
+			}
+			position++
+			return count || position < end
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "'%s:%s' caused error", uri, resource)
+		}
+	}
+	var page *query.PageResponse
+	if count {
+		page = &query.PageResponse{Total: position}
+	}
+	return &types.QueryResourceAccountsResponse{Accounts: accounts, Page: page}, nil
+}
+
+// iterateInheritedResourceAccounts calls f on the accounts inheriting the resource from the default resources of
+// their domain, which are held by the empty account of the domain, until f returns false
+func iterateInheritedResourceAccounts(ctx sdk.Context, keeper *Keeper, uri string, resource string, f func(*types.Account) bool) error {
+	store := keeper.AccountStore(ctx)
+	holders, err := store.Query().Where().Index(types.AccountResourcesIndex).Equals(types.GetResourceKey(uri, resource)).Do()
+	if err != nil {
+		return err
+	}
+	for ; holders.Valid(); holders.Next() {
+		holder := new(types.Account)
+		if err := holders.Read(holder); err != nil {
+			return sdkerrors.Wrap(err, "failed to read")
+		}
+		if holder.Name == nil || *holder.Name != types.EmptyAccountName {
+			continue
+		}
+		cursor, err := store.Query().Where().Index(types.AccountDomainIndex).Equals([]byte(holder.Domain)).Do()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "'%s' caused error", holder.Domain)
+		}
+		for ; cursor.Valid(); cursor.Next() {
+			account := new(types.Account)
+			if err := cursor.Read(account); err != nil {
+				return sdkerrors.Wrap(err, "failed to read")
+			}
+			if *account.Name == types.EmptyAccountName || types.HasResourceURI(account.Resources, uri) {
+				continue
+			}
+			if !f(account) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
 func queryResourceAccounts(ctx sdk.Context, keeper *Keeper, uri string, resource string, start, end uint64, count bool) (*types.QueryResourceAccountsResponse, error) {
 	key := types.GetResourceKey(uri, resource)
 	query := func() crud.FinalizedIndexStatement {
@@ -317,6 +538,95 @@ func queryBrokerDomains(ctx sdk.Context, keeper *Keeper, broker sdk.AccAddress,
 	return &types.QueryBrokerDomainsResponse{Domains: domains, Page: page}, nil
 }
 
+// IssuerAccounts returns types.Accounts holding a certificate signed by a given issuer and nil on error
+func (q grpcQuerier) IssuerAccounts(c context.Context, req *types.QueryIssuerAccountsRequest) (*types.QueryIssuerAccountsResponse, error) {
+	if req.Issuer == "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRequest, "'%s' isn't a valid issuer", req.Issuer)
+	}
+	start, end, count, err := getPagination(req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed pagination")
+	}
+	return queryIssuerAccounts(sdk.UnwrapSDKContext(c), q.keeper, req.Issuer, start, end, count)
+}
+
+func queryIssuerAccounts(ctx sdk.Context, keeper *Keeper, issuer string, start, end uint64, count bool) (*types.QueryIssuerAccountsResponse, error) {
+	query := func() crud.FinalizedIndexStatement {
+		return keeper.AccountStore(ctx).Query().Where().Index(types.AccountCertificateIssuerIndex).Equals([]byte(issuer))
+	}
+	cursor, err := query().WithRange().Start(start).End(end).Do()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "'%s' caused error", issuer)
+	}
+	accounts := make([]*types.Account, 0, end-start)
+	for ; cursor.Valid(); cursor.Next() {
+		account := new(types.Account)
+		if err := cursor.Read(account); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to read")
+		}
+		accounts = append(accounts, account)
+	}
+	page, err := getPageResponse(count, query())
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "'%s' caused error", issuer)
+	}
+	return &types.QueryIssuerAccountsResponse{Accounts: accounts, Page: page}, nil
+}
+
+// VerifyCertificate verifies a certificate of the account associated with a given starname at the current block time
+func (q grpcQuerier) VerifyCertificate(c context.Context, req *types.QueryVerifyCertificateRequest) (*types.QueryVerifyCertificateResponse, error) {
+	if req.Starname == "" || !strings.Contains(req.Starname, types.StarnameSeparator) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAccountName, "'%s'", req.Starname)
+	}
+	if len(req.Certificate) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRequest, "certificate is empty")
+	}
+	return queryVerifyCertificate(sdk.UnwrapSDKContext(c), q.keeper, req.Starname, req.Certificate)
+}
+
+func queryVerifyCertificate(ctx sdk.Context, keeper *Keeper, starname string, certificate []byte) (*types.QueryVerifyCertificateResponse, error) {
+	domain, name, err := splitStarname(starname)
+	if err != nil {
+		return nil, err
+	}
+	// the certificates of the wildcard account of the domain are not those of an unregistered starname
+	account := new(types.Account)
+	if err := keeper.AccountStore(ctx).Read((&types.Account{Domain: domain, Name: &name}).PrimaryKey(), account); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "not found: %s", starname)
+	}
+	for _, cert := range account.TypedCertificates {
+		if !bytes.Equal(cert.Data, certificate) {
+			continue
+		}
+		if err := cert.Verify(account.GetStarname(), ctx.BlockTime()); err != nil {
+			return &types.QueryVerifyCertificateResponse{Valid: false, Certificate: cert, Reason: err.Error()}, nil
+		}
+		return &types.QueryVerifyCertificateResponse{Valid: true, Certificate: cert}, nil
+	}
+	for _, cert := range account.Certificates {
+		if bytes.Equal(cert, certificate) {
+			return &types.QueryVerifyCertificateResponse{Valid: false, Reason: "opaque certificates cannot be verified"}, nil
+		}
+	}
+	return &types.QueryVerifyCertificateResponse{Valid: false, Reason: types.ErrCertificateDoesNotExist.Error()}, nil
+}
+
+// DomainPolicy returns the policy of a domain, the policy is nil if the domain has none
+func (q grpcQuerier) DomainPolicy(c context.Context, req *types.QueryDomainPolicyRequest) (*types.QueryDomainPolicyResponse, error) {
+	if req.Domain == "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDomainName, "'%s'", req.Domain)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := queryDomain(ctx, req.Domain, q.keeper); err != nil {
+		return nil, err
+	}
+	policy, ok := q.keeper.GetDomainPolicy(ctx, types.NormalizeNameOrKeep(req.Domain))
+	if !ok {
+		return &types.QueryDomainPolicyResponse{}, nil
+	}
+	return &types.QueryDomainPolicyResponse{Policy: &policy}, nil
+}
+
 // Yield return an estimation of the delegators annualized yield based on the last 100k blocks
 func (q grpcQuerier) Yield(ctx context.Context, _ *types.QueryYieldRequest) (*types.QueryYieldResponse, error) {
 	var response types.QueryYieldResponse
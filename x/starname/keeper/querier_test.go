@@ -4,14 +4,17 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/iov-one/starnamed/pkg/utils"
 	"github.com/iov-one/starnamed/x/starname/types"
 )
 
@@ -883,3 +886,232 @@ func TestBrokerDomains(t *testing.T) {
 		}
 	}
 }
+
+func TestIssuerAccountsAndVerifyCertificate(t *testing.T) {
+	keeper, ctx, _ := NewTestKeeper(t, false)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	cert := newTestJWS(t, map[string]interface{}{"iss": "issuer", "exp": 2000})
+	typed, err := types.NewTypedCertificate(types.JWSCertificate, cert, "test*iov", ctx.BlockTime())
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts := keeper.AccountStore(ctx)
+	NewAccountExecutor(ctx, types.Account{
+		Domain:            "iov",
+		Name:              utils.StrPtr("test"),
+		Owner:             owners[0],
+		Certificates:      [][]byte{[]byte("opaque")},
+		TypedCertificates: []*types.TypedCertificate{typed, typed},
+	}).WithAccounts(&accounts).Create()
+	// a certificate claiming the same issuer but signed by another key is not indexed under the issuer
+	forged, err := types.NewTypedCertificate(types.JWSCertificate, newTestJWS(t, map[string]interface{}{"iss": "issuer"}), "forged*iov", ctx.BlockTime())
+	if err != nil {
+		t.Fatal(err)
+	}
+	NewAccountExecutor(ctx, types.Account{
+		Domain:            "iov",
+		Name:              utils.StrPtr("forged"),
+		Owner:             owners[0],
+		TypedCertificates: []*types.TypedCertificate{forged},
+	}).WithAccounts(&accounts).Create()
+
+	querier := NewQuerier(&keeper)
+	res, err := querier.IssuerAccounts(sdk.WrapSDKContext(ctx), &types.QueryIssuerAccountsRequest{
+		Issuer:     typed.Issuer,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an account signed twice by the same issuer is indexed once
+	if len(res.Accounts) != 1 || res.Page.Total != 1 {
+		t.Fatalf("wanted 1 account, got %d", len(res.Accounts))
+	}
+	if _, err := querier.IssuerAccounts(sdk.WrapSDKContext(ctx), &types.QueryIssuerAccountsRequest{}); err == nil {
+		t.Fatal("wanted error on empty issuer")
+	}
+
+	tests := map[string]struct {
+		ctx   sdk.Context
+		cert  []byte
+		valid bool
+	}{
+		"valid":      {ctx: ctx, cert: cert, valid: true},
+		"expired":    {ctx: ctx.WithBlockTime(time.Unix(2000, 0)), cert: cert, valid: false},
+		"opaque":     {ctx: ctx, cert: []byte("opaque"), valid: false},
+		"not exists": {ctx: ctx, cert: []byte("missing"), valid: false},
+	}
+	for name, test := range tests {
+		res, err := querier.VerifyCertificate(sdk.WrapSDKContext(test.ctx), &types.QueryVerifyCertificateRequest{
+			Starname:    "test*iov",
+			Certificate: test.cert,
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if res.Valid != test.valid {
+			t.Fatalf("%s: wanted valid %t, got %t: %s", name, test.valid, res.Valid, res.Reason)
+		}
+		if !res.Valid && res.Reason == "" {
+			t.Fatalf("%s: wanted a reason", name)
+		}
+	}
+}
+
+func TestVerifyCertificateWildcard(t *testing.T) {
+	keeper, ctx, _ := NewTestKeeper(t, false)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	cert := newTestJWS(t, map[string]interface{}{"iss": "issuer", "exp": 2000})
+	typed, err := types.NewTypedCertificate(types.JWSCertificate, cert, "catchall*acme", ctx.BlockTime())
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts := keeper.AccountStore(ctx)
+	NewAccountExecutor(ctx, types.Account{
+		Domain:            "acme",
+		Name:              utils.StrPtr("catchall"),
+		Owner:             owners[0],
+		TypedCertificates: []*types.TypedCertificate{typed},
+		Wildcard:          true,
+	}).WithAccounts(&accounts).Create()
+	querier := NewQuerier(&keeper)
+
+	res, err := querier.VerifyCertificate(sdk.WrapSDKContext(ctx), &types.QueryVerifyCertificateRequest{Starname: "catchall*acme", Certificate: cert})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Valid {
+		t.Fatalf("wanted a valid certificate on the wildcard account: %s", res.Reason)
+	}
+	// an unregistered starname resolved through the wildcard account does not own its certificates
+	_, err = querier.VerifyCertificate(sdk.WrapSDKContext(ctx), &types.QueryVerifyCertificateRequest{Starname: "anything*acme", Certificate: cert})
+	if !errors.Is(err, types.ErrAccountDoesNotExist) {
+		t.Fatalf("wanted %s, got %v", types.ErrAccountDoesNotExist, err)
+	}
+}
+
+func TestDomainDefaultResources(t *testing.T) {
+	keeper, ctx, _ := NewTestKeeper(t, false)
+	accounts := keeper.AccountStore(ctx)
+	for _, account := range []types.Account{
+		{Domain: "iov", Name: utils.StrPtr(types.EmptyAccountName), Owner: owners[0], Resources: []*types.Resource{{URI: "uri", Resource: "default"}, {URI: "other", Resource: "default"}}},
+		{Domain: "iov", Name: utils.StrPtr("raw"), Owner: owners[0]},
+		{Domain: "iov", Name: utils.StrPtr("plain"), Owner: owners[0]},
+		{Domain: "iov", Name: utils.StrPtr("override"), Owner: owners[0], Resources: []*types.Resource{{URI: "uri", Resource: "own"}}},
+		{Domain: "other", Name: utils.StrPtr("unrelated"), Owner: owners[0]},
+	} {
+		NewAccountExecutor(ctx, account).WithAccounts(&accounts).Create()
+	}
+	querier := NewQuerier(&keeper)
+
+	starnameTests := map[string]struct {
+		starname string
+		resolve  bool
+		expected []*types.Resource
+	}{
+		"raw":               {starname: "raw*iov", expected: nil},
+		"resolved":          {starname: "raw*iov", resolve: true, expected: []*types.Resource{{URI: "uri", Resource: "default"}, {URI: "other", Resource: "default"}}},
+		"resolved override": {starname: "override*iov", resolve: true, expected: []*types.Resource{{URI: "uri", Resource: "own"}, {URI: "other", Resource: "default"}}},
+		"resolved empty":    {starname: "*iov", resolve: true, expected: []*types.Resource{{URI: "uri", Resource: "default"}, {URI: "other", Resource: "default"}}},
+	}
+	for name, test := range starnameTests {
+		t.Run(name, func(t *testing.T) {
+			res, err := querier.Starname(sdk.WrapSDKContext(ctx), &types.QueryStarnameRequest{Starname: test.starname, Resolve: test.resolve})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(res.Account.Resources, test.expected) {
+				t.Fatalf("unexpected resources: %v", res.Account.Resources)
+			}
+		})
+	}
+
+	resourceTests := map[string]struct {
+		resource  string
+		inherited bool
+		offset    uint64
+		limit     uint64
+		expected  []string
+		total     uint64
+	}{
+		"default not inherited":    {resource: "default", expected: []string{"*iov"}, total: 1},
+		"default inherited":        {resource: "default", inherited: true, expected: []string{"*iov", "plain*iov", "raw*iov"}, total: 3},
+		"own inherited":            {resource: "own", inherited: true, expected: []string{"override*iov"}, total: 1},
+		"inherited first page":     {resource: "default", inherited: true, limit: 2, expected: []string{"*iov", "plain*iov"}, total: 3},
+		"inherited only page":      {resource: "default", inherited: true, offset: 1, limit: 1, expected: []string{"plain*iov"}, total: 3},
+		"inherited last page":      {resource: "default", inherited: true, offset: 2, limit: 2, expected: []string{"raw*iov"}, total: 3},
+		"inherited past last page": {resource: "default", inherited: true, offset: 3, limit: 2, expected: []string{}, total: 3},
+	}
+	for name, test := range resourceTests {
+		t.Run(name, func(t *testing.T) {
+			for _, count := range []bool{true, false} {
+				res, err := querier.ResourceAccounts(sdk.WrapSDKContext(ctx), &types.QueryResourceAccountsRequest{
+					Uri:              "uri",
+					Resource:         test.resource,
+					IncludeInherited: test.inherited,
+					Pagination:       &query.PageRequest{Offset: test.offset, Limit: test.limit, CountTotal: count},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				starnames := make([]string, len(res.Accounts))
+				for i, account := range res.Accounts {
+					starnames[i] = account.GetStarname()
+				}
+				if !reflect.DeepEqual(starnames, test.expected) {
+					t.Fatalf("unexpected accounts: %v", starnames)
+				}
+				if count && res.Page.Total != test.total {
+					t.Fatalf("unexpected total %d", res.Page.Total)
+				}
+				if !count && res.Page != nil {
+					t.Fatal("unexpected page response")
+				}
+			}
+		})
+	}
+}
+
+func TestWildcardStarname(t *testing.T) {
+	keeper, ctx, _ := NewTestKeeper(t, false)
+	accounts := keeper.AccountStore(ctx)
+	for _, account := range []types.Account{
+		{Domain: "acme", Name: utils.StrPtr(types.EmptyAccountName), Owner: owners[0]},
+		{Domain: "acme", Name: utils.StrPtr("catchall"), Owner: owners[0], Resources: []*types.Resource{{URI: "uri", Resource: "catch-all"}}, Wildcard: true},
+		{Domain: "acme", Name: utils.StrPtr("exact"), Owner: owners[1]},
+		{Domain: "other", Name: utils.StrPtr(types.EmptyAccountName), Owner: owners[0]},
+	} {
+		NewAccountExecutor(ctx, account).WithAccounts(&accounts).Create()
+	}
+	querier := NewQuerier(&keeper)
+	tests := map[string]struct {
+		starname string
+		name     string
+		wildcard bool
+		wantErr  bool
+	}{
+		"exact":              {starname: "exact*acme", name: "exact"},
+		"empty account":      {starname: "*acme", name: types.EmptyAccountName},
+		"wildcard":           {starname: "anything*acme", name: "catchall", wildcard: true},
+		"wildcard account":   {starname: "catchall*acme", name: "catchall"},
+		"no wildcard":        {starname: "anything*other", wantErr: true},
+		"no domain wildcard": {starname: "*missing", wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := querier.Starname(sdk.WrapSDKContext(ctx), &types.QueryStarnameRequest{Starname: test.starname})
+			if test.wantErr {
+				if !errors.Is(err, types.ErrAccountDoesNotExist) {
+					t.Fatalf("wanted error %s, got %v", types.ErrAccountDoesNotExist, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *res.Account.Name != test.name || res.Wildcard != test.wildcard {
+				t.Fatalf("unexpected response: %v wildcard %t", res.Account, res.Wildcard)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/iov-one/starnamed/pkg/queries"
-	"github.com/iov-one/starnamed/pkg/utils"
 	"github.com/iov-one/starnamed/x/starname/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -141,7 +140,7 @@ func queryAccountsInDomainHandler(ctx sdk.Context, _ []string, req abci.RequestQ
 	indexStart := query.ResultsPerPage*query.Offset - query.ResultsPerPage // this is the start
 	indexEnd := indexStart + query.ResultsPerPage - 1                      // this is the end
 	// iterate keys
-	cursor, err := k.AccountStore(ctx).Query().Where().Index(types.AccountDomainIndex).Equals([]byte(query.Domain)).Do()
+	cursor, err := k.AccountStore(ctx).Query().Where().Index(types.AccountDomainIndex).Equals([]byte(types.NormalizeNameOrKeep(query.Domain))).Do()
 	if err != nil {
 		panic(err)
 	}
@@ -435,6 +434,8 @@ func (q *QueryResolveAccount) QueryPath() string {
 type QueryResolveAccountResponse struct {
 	// Account contains the resolved account
 	Account types.Account `json:"account"`
+	// Wildcard is true if the account is not registered and the domain wildcard account was returned
+	Wildcard bool `json:"wildcard,omitempty"`
 }
 
 // queryResolveAccountHandler is the query handler that takes care of resolving accounts
@@ -449,13 +450,12 @@ func queryResolveAccountHandler(ctx sdk.Context, _ []string, req abci.RequestQue
 		return nil, err
 	}
 	// do query
-	account := new(types.Account)
-	pk := (&types.Account{Name: utils.StrPtr(q.Name), Domain: q.Domain}).PrimaryKey()
-	if err := k.AccountStore(ctx).Read(pk, account); err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "not found in domain %s: %s", q.Domain, q.Name)
+	account, wildcard, err := k.ResolveStarname(ctx, types.NormalizeNameOrKeep(q.Domain), types.NormalizeNameOrKeep(q.Name))
+	if err != nil {
+		return nil, err
 	}
 	// return response
-	respBytes, err := queries.DefaultQueryEncode(QueryResolveAccountResponse{Account: *account})
+	respBytes, err := queries.DefaultQueryEncode(QueryResolveAccountResponse{Account: *account, Wildcard: wildcard})
 	if err != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -514,7 +514,7 @@ func queryResolveDomainHandler(ctx sdk.Context, _ []string, req abci.RequestQuer
 	if err = q.Validate(); err != nil {
 		return nil, err
 	}
-	filter := &types.Domain{Name: q.Name}
+	filter := &types.Domain{Name: types.NormalizeNameOrKeep(q.Name)}
 	domain := new(types.Domain)
 	if err = k.DomainStore(ctx).Read(filter.PrimaryKey(), domain); err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrDomainDoesNotExist, "not found: %s", q.Name)
@@ -161,6 +161,27 @@ func Test_queryResolveAccountHandler(t *testing.T) {
 				Owner:  bobAddr,
 			}},
 		},
+		"success wildcard": {
+			BeforeTest: func(t *testing.T, ctx sdk.Context, k Keeper) {
+				k.AccountStore(ctx).Create(&types.Account{
+					Domain:   "test",
+					Name:     utils.StrPtr("catchall"),
+					Owner:    bobAddr,
+					Wildcard: true,
+				})
+			},
+			Request: &QueryResolveAccount{
+				Starname: "missing*test",
+			},
+			Handler: queryResolveAccountHandler,
+			WantErr: nil,
+			PtrExpectedResponse: &QueryResolveAccountResponse{Account: types.Account{
+				Domain:   "test",
+				Name:     utils.StrPtr("catchall"),
+				Owner:    bobAddr,
+				Wildcard: true,
+			}, Wildcard: true},
+		},
 		"failure provide only one param starname": {
 			Request: &QueryResolveAccount{
 				Domain:   "test",
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// AssertNotReserved checks that the provided name is not reserved, or that the registrant
+// is either its allowed claimant or the configurer
+func (k Keeper) AssertNotReserved(ctx sdk.Context, name string, registrant sdk.AccAddress) error {
+	reservation, ok := k.ConfigurationKeeper.GetReservation(ctx, name)
+	if !ok || reservation.IsClaimableBy(registrant) || k.ConfigurationKeeper.IsOwner(ctx, registrant) {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrNameReserved, "%s is reserved by %s", name, reservation.Name)
+}
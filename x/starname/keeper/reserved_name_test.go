@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func Test_reservedNames(t *testing.T) {
+	setReservedNames := func(ctx sdk.Context, k Keeper) {
+		setter := GetConfigSetter(k.ConfigurationKeeper)
+		setter.SetConfig(ctx, configuration.Config{
+			Configurer:           CharlieKey.String(),
+			ValidDomainName:      "^(.*?)?",
+			ValidAccountName:     "^(.*?)?",
+			DomainRenewalPeriod:  1000 * time.Hour,
+			AccountRenewalPeriod: 1000 * time.Hour,
+		})
+		setter.SetReservedName(ctx, configuration.ReservedName{Name: "iov", Claimant: AliceKey.String()})
+		setter.SetReservedName(ctx, configuration.ReservedName{Name: "^bank.*$", Pattern: true})
+	}
+	cases := map[string]SubTest{
+		"reserved domain": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setReservedNames(ctx, k)
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				for _, name := range []string{"iov", "bankofiov"} {
+					_, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+						Name:       name,
+						Admin:      BobKey.String(),
+						DomainType: types.OpenDomain,
+					}.ToInternal())
+					if !errors.Is(err, types.ErrNameReserved) {
+						t.Fatalf("registerDomain() %s expected error: %s, got: %s", name, types.ErrNameReserved, err)
+					}
+				}
+			},
+		},
+		"success reserved domain registered by claimant and configurer": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setReservedNames(ctx, k)
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				if _, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "iov",
+					Admin:      AliceKey.String(),
+					DomainType: types.OpenDomain,
+				}.ToInternal()); err != nil {
+					t.Fatalf("registerDomain() got error: %s", err)
+				}
+				if _, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+					Name:       "bankofiov",
+					Admin:      CharlieKey.String(),
+					DomainType: types.OpenDomain,
+				}.ToInternal()); err != nil {
+					t.Fatalf("registerDomain() got error: %s", err)
+				}
+			},
+		},
+		"reserved account in open domain": {
+			BeforeTest: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				setReservedNames(ctx, k)
+				for _, d := range []types.Domain{
+					{Name: "open", Admin: BobKey, Type: types.OpenDomain},
+					{Name: "closed", Admin: BobKey, Type: types.ClosedDomain},
+				} {
+					if _, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+						Name:       d.Name,
+						Admin:      d.Admin.String(),
+						DomainType: d.Type,
+					}.ToInternal()); err != nil {
+						t.Fatalf("registerDomain() got error: %s", err)
+					}
+				}
+			},
+			Test: func(t *testing.T, k Keeper, ctx sdk.Context, mocks *Mocks) {
+				_, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "open",
+					Name:       "bank",
+					Owner:      BobKey.String(),
+					Registerer: BobKey.String(),
+				}.ToInternal())
+				if !errors.Is(err, types.ErrNameReserved) {
+					t.Fatalf("registerAccount() expected error: %s, got: %s", types.ErrNameReserved, err)
+				}
+				if _, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "open",
+					Name:       "iov",
+					Owner:      BobKey.String(),
+					Registerer: AliceKey.String(),
+				}.ToInternal()); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+				// closed domains are managed by their admin
+				if _, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+					Domain:     "closed",
+					Name:       "bank",
+					Owner:      BobKey.String(),
+					Registerer: BobKey.String(),
+				}.ToInternal()); err != nil {
+					t.Fatalf("registerAccount() got error: %s", err)
+				}
+			},
+		},
+	}
+	RunTests(t, cases)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// searchIndexPrefix is the prefix of the name search index, it is kept apart from the crud stores so that
+// indexing a starname costs a single write instead of a secondary key update
+var searchIndexPrefix = []byte{0x8}
+
+var (
+	// searchDomainPrefix indexes the domains by name
+	searchDomainPrefix = []byte{0x1}
+	// searchAccountPrefix indexes the accounts by name then domain, the value is the domain
+	searchAccountPrefix = []byte{0x2}
+	// searchDomainAccountPrefix indexes the accounts by domain then name, the value is the name
+	searchDomainAccountPrefix = []byte{0x3}
+)
+
+// searchStore returns the store of the provided part of the search index
+func (k Keeper) searchStore(ctx sdk.Context, part []byte) prefix.Store {
+	return prefix.NewStore(prefix.NewStore(ctx.KVStore(k.StoreKey), searchIndexPrefix), part)
+}
+
+// searchAccountKey returns the key of an account in the index by name, the domain follows a zero byte
+func searchAccountKey(domain, name string) []byte {
+	key := make([]byte, 0, len(name)+1+len(domain))
+	key = append(key, name...)
+	key = append(key, 0)
+	return append(key, domain...)
+}
+
+// searchDomainKey returns the length prefixed domain the accounts of a domain are indexed by
+func searchDomainKey(domain string) []byte {
+	key := make([]byte, 2, 2+len(domain))
+	binary.BigEndian.PutUint16(key, uint16(len(domain)))
+	return append(key, domain...)
+}
+
+// IndexDomain adds a domain to the search index
+func (k Keeper) IndexDomain(ctx sdk.Context, domain string) {
+	k.searchStore(ctx, searchDomainPrefix).Set([]byte(domain), []byte{})
+}
+
+// UnindexDomain removes a domain from the search index
+func (k Keeper) UnindexDomain(ctx sdk.Context, domain string) {
+	k.searchStore(ctx, searchDomainPrefix).Delete([]byte(domain))
+}
+
+// IndexAccount adds an account to the search index
+func (k Keeper) IndexAccount(ctx sdk.Context, domain, name string) {
+	k.searchStore(ctx, searchAccountPrefix).Set(searchAccountKey(domain, name), []byte(domain))
+	k.searchStore(ctx, searchDomainAccountPrefix).Set(append(searchDomainKey(domain), name...), []byte(name))
+}
+
+// UnindexAccount removes an account from the search index
+func (k Keeper) UnindexAccount(ctx sdk.Context, domain, name string) {
+	k.searchStore(ctx, searchAccountPrefix).Delete(searchAccountKey(domain, name))
+	k.searchStore(ctx, searchDomainAccountPrefix).Delete(append(searchDomainKey(domain), name...))
+}
+
+// searchIterator returns an iterator over the keys of the store starting with the provided prefix,
+// or over the whole store if substring is true
+func searchIterator(store prefix.Store, keyPrefix []byte, substring bool) sdk.Iterator {
+	if substring {
+		return store.Iterator(nil, nil)
+	}
+	return sdk.KVStorePrefixIterator(store, keyPrefix)
+}
+
+// SearchDomains iterates over the names of the domains starting with the provided prefix, or containing it
+// if substring is true, until the provided function returns true. At most limit index entries are scanned if limit
+// is not zero, it returns the number of scanned entries and false if the limit stopped the search.
+func (k Keeper) SearchDomains(ctx sdk.Context, namePrefix string, substring bool, limit uint64, f func(domain string) (stop bool)) (uint64, bool) {
+	iterator := searchIterator(k.searchStore(ctx, searchDomainPrefix), []byte(namePrefix), substring)
+	defer iterator.Close()
+	var scanned uint64
+	for ; iterator.Valid(); iterator.Next() {
+		if limit != 0 && scanned == limit {
+			return scanned, false
+		}
+		scanned++
+		domain := string(iterator.Key())
+		if substring && !strings.Contains(domain, namePrefix) {
+			continue
+		}
+		if f(domain) {
+			break
+		}
+	}
+	return scanned, true
+}
+
+// SearchAccounts iterates over the accounts whose name starts with the provided prefix, or contains it
+// if substring is true, until the provided function returns true. If domain is not empty only the accounts
+// of the domain are iterated over, ordered by name, otherwise accounts are ordered by name then domain.
+// At most limit index entries are scanned if limit is not zero, it returns the number of scanned entries and false if
+// the limit stopped the search.
+func (k Keeper) SearchAccounts(ctx sdk.Context, namePrefix, domain string, substring bool, limit uint64, f func(domain, name string) (stop bool)) (uint64, bool) {
+	var iterator sdk.Iterator
+	if domain != "" {
+		store := prefix.NewStore(k.searchStore(ctx, searchDomainAccountPrefix), searchDomainKey(domain))
+		iterator = searchIterator(store, []byte(namePrefix), substring)
+	} else {
+		iterator = searchIterator(k.searchStore(ctx, searchAccountPrefix), []byte(namePrefix), substring)
+	}
+	defer iterator.Close()
+	var scanned uint64
+	for ; iterator.Valid(); iterator.Next() {
+		if limit != 0 && scanned == limit {
+			return scanned, false
+		}
+		scanned++
+		accountDomain, name := domain, string(iterator.Value())
+		if domain == "" {
+			accountDomain = name
+			name = string(iterator.Key()[:len(iterator.Key())-len(accountDomain)-1])
+		}
+		if substring && !strings.Contains(name, namePrefix) {
+			continue
+		}
+		if f(accountDomain, name) {
+			break
+		}
+	}
+	return scanned, true
+}
+
+// IndexStarnames adds all the stored domains and accounts to the search index, empty accounts are not searchable
+func (k Keeper) IndexStarnames(ctx sdk.Context) error {
+	cursor, err := k.DomainStore(ctx).Query().Do()
+	if err != nil {
+		return err
+	}
+	for ; cursor.Valid(); cursor.Next() {
+		domain := new(types.Domain)
+		if err := cursor.Read(domain); err != nil {
+			return err
+		}
+		k.IndexDomain(ctx, domain.Name)
+	}
+	cursor, err = k.AccountStore(ctx).Query().Do()
+	if err != nil {
+		return err
+	}
+	for ; cursor.Valid(); cursor.Next() {
+		account := new(types.Account)
+		if err := cursor.Read(account); err != nil {
+			return err
+		}
+		if *account.Name != types.EmptyAccountName {
+			k.IndexAccount(ctx, account.Domain, *account.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+func TestSearchStarnames(t *testing.T) {
+	k, ctx, _ := NewTestKeeper(t, true)
+	GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+		ValidDomainName:      RegexMatchAll,
+		ValidAccountName:     RegexMatchAll,
+		DomainRenewalPeriod:  1000 * time.Hour,
+		AccountRenewalPeriod: 1000 * time.Hour,
+		DomainGracePeriod:    1 * time.Second,
+		ResourcesMax:         5,
+	})
+	fees := configuration.NewFees()
+	fees.SetDefaults("testcoin")
+	GetConfigSetter(k.ConfigurationKeeper).SetFees(ctx, fees)
+	querier := NewQuerier(&k)
+
+	for _, domain := range []string{"alpha", "alphabet", "beta"} {
+		if _, err := registerDomain(ctx, k, types.MsgRegisterDomain{
+			Name:       domain,
+			Admin:      BobKey.String(),
+			DomainType: types.OpenDomain,
+		}.ToInternal()); err != nil {
+			t.Fatalf("registerDomain() got error: %s", err)
+		}
+	}
+	for _, starname := range [][2]string{{"alpha", "al"}, {"alpha", "alice"}, {"beta", "alice"}, {"beta", "bob"}, {"alphabet", "zalice"}} {
+		if _, err := registerAccount(ctx, k, types.MsgRegisterAccount{
+			Domain: starname[0],
+			Name:   starname[1],
+			Owner:  AliceKey.String(),
+		}.ToInternal()); err != nil {
+			t.Fatalf("registerAccount() got error: %s", err)
+		}
+	}
+	search := func(req types.QuerySearchStarnamesRequest) []string {
+		res, err := querier.SearchStarnames(sdk.WrapSDKContext(ctx), &req)
+		if err != nil {
+			t.Fatalf("SearchStarnames() got error: %s", err)
+		}
+		var names []string
+		for _, domain := range res.Domains {
+			names = append(names, domain.Name)
+		}
+		for _, account := range res.Accounts {
+			names = append(names, *account.Name+types.StarnameSeparator+account.Domain)
+		}
+		return names
+	}
+	expect := func(t *testing.T, got []string, expected ...string) {
+		if len(got) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Fatalf("expected %v, got %v", expected, got)
+			}
+		}
+	}
+
+	t.Run("prefix", func(t *testing.T) {
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "al"}), "alpha", "alphabet", "al*alpha", "alice*alpha", "alice*beta")
+	})
+	t.Run("type", func(t *testing.T) {
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "al", Type: types.StarnameType_Domain}), "alpha", "alphabet")
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "ali", Type: types.StarnameType_Account}), "alice*alpha", "alice*beta")
+	})
+	t.Run("domain", func(t *testing.T) {
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "al", Domain: "alpha"}), "al*alpha", "alice*alpha")
+		expect(t, search(types.QuerySearchStarnamesRequest{Domain: "beta"}), "alice*beta", "bob*beta")
+		// the domain length prefix keeps the accounts of alphabet out of alpha
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "z", Domain: "alpha"}))
+		_, err := querier.SearchStarnames(sdk.WrapSDKContext(ctx), &types.QuerySearchStarnamesRequest{Domain: "alpha", Type: types.StarnameType_Domain})
+		if !errors.Is(err, types.ErrInvalidRequest) {
+			t.Fatalf("expected error %s, got: %v", types.ErrInvalidRequest, err)
+		}
+	})
+	t.Run("substring", func(t *testing.T) {
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "lic", Substring: true}), "alice*alpha", "alice*beta", "zalice*alphabet")
+	})
+	t.Run("pagination", func(t *testing.T) {
+		res, err := querier.SearchStarnames(sdk.WrapSDKContext(ctx), &types.QuerySearchStarnamesRequest{
+			Prefix:     "al",
+			Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+		})
+		if err != nil {
+			t.Fatalf("SearchStarnames() got error: %s", err)
+		}
+		if len(res.Domains) != 1 || res.Domains[0].Name != "alphabet" || len(res.Accounts) != 1 || *res.Accounts[0].Name != "al" {
+			t.Fatalf("unexpected page: %+v", res)
+		}
+		if res.Page == nil || res.Page.Total != 5 {
+			t.Fatalf("expected a total of 5, got: %+v", res.Page)
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		if _, err := deleteAccount(ctx, k, types.MsgDeleteAccount{
+			Domain: "beta",
+			Name:   "bob",
+			Owner:  AliceKey.String(),
+		}.ToInternal()); err != nil {
+			t.Fatalf("deleteAccount() got error: %s", err)
+		}
+		expect(t, search(types.QuerySearchStarnamesRequest{Domain: "beta"}), "alice*beta")
+		if _, err := deleteDomain(ctx.WithBlockTime(time.Now().Add(2000*time.Hour)), k, types.MsgDeleteDomain{
+			Domain: "alpha",
+			Owner:  BobKey.String(),
+		}.ToInternal()); err != nil {
+			t.Fatalf("deleteDomain() got error: %s", err)
+		}
+		expect(t, search(types.QuerySearchStarnamesRequest{Prefix: "al"}), "alphabet", "alice*beta")
+	})
+}
+
+func TestSearchStarnamesScanLimit(t *testing.T) {
+	k, ctx, _ := NewTestKeeper(t, true)
+	querier := NewQuerier(&k)
+	accounts := k.AccountStore(ctx)
+	for i := uint64(0); i <= maxSearchScan; i++ {
+		name := fmt.Sprintf("name%05d", i)
+		if err := accounts.Create(&types.Account{Domain: "domain", Name: &name, Owner: BobKey}); err != nil {
+			t.Fatal(err)
+		}
+		k.IndexAccount(ctx, "domain", name)
+	}
+	for _, domain := range []string{"alpha", "beta"} {
+		k.IndexDomain(ctx, domain)
+		if err := k.DomainStore(ctx).Create(&types.Domain{Name: domain, Admin: BobKey}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]struct {
+		req     types.QuerySearchStarnamesRequest
+		wantErr bool
+	}{
+		"prefix page":           {req: types.QuerySearchStarnamesRequest{Prefix: "name", Type: types.StarnameType_Account}},
+		"domain prefix count":   {req: types.QuerySearchStarnamesRequest{Prefix: "al", Type: types.StarnameType_Domain, Pagination: &query.PageRequest{CountTotal: true}}},
+		"substring domains":     {req: types.QuerySearchStarnamesRequest{Prefix: "et", Type: types.StarnameType_Domain, Substring: true}},
+		"substring accounts":    {req: types.QuerySearchStarnamesRequest{Prefix: "none", Type: types.StarnameType_Account, Substring: true}, wantErr: true},
+		"prefix count":          {req: types.QuerySearchStarnamesRequest{Prefix: "name", Pagination: &query.PageRequest{CountTotal: true}}, wantErr: true},
+		"domain substring page": {req: types.QuerySearchStarnamesRequest{Prefix: "x", Domain: "domain", Type: types.StarnameType_Account, Substring: true}, wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := querier.SearchStarnames(sdk.WrapSDKContext(ctx), &test.req)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil && !errors.Is(err, types.ErrInvalidRequest) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -93,6 +93,7 @@ func RunTests(t *testing.T, tests map[string]SubTest) {
 type ConfigurationSetter interface {
 	SetConfig(ctx types.Context, config configuration.Config)
 	SetFees(ctx types.Context, fees *configuration.Fees)
+	SetReservedName(ctx types.Context, reserved configuration.ReservedName)
 }
 
 // getConfigSetter exposes the configurationSetter interface
@@ -67,12 +67,15 @@ func NewTestCodec() *codec.ProtoCodec {
 		&types.MsgDeleteDomain{},
 		&types.MsgRegisterAccount{},
 		&types.MsgRegisterDomain{},
+		&types.MsgRemoveAccountResources{},
 		&types.MsgRenewAccount{},
 		&types.MsgRenewDomain{},
 		&types.MsgReplaceAccountMetadata{},
 		&types.MsgReplaceAccountResources{},
+		&types.MsgSetDomainPolicy{},
 		&types.MsgTransferAccount{},
 		&types.MsgTransferDomain{},
+		&types.MsgUpsertAccountResources{},
 	)
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 	return cdc
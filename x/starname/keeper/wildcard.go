@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// ResolveStarname returns the account with the provided name in the domain, if it does not exist
+// the wildcard account of the domain is returned and wildcard is true
+func (k Keeper) ResolveStarname(ctx sdk.Context, domain, name string) (account *types.Account, wildcard bool, err error) {
+	store := k.AccountStore(ctx)
+	account = new(types.Account)
+	if err := store.Read((&types.Account{Domain: domain, Name: utils.StrPtr(name)}).PrimaryKey(), account); err == nil {
+		return account, false, nil
+	}
+	// the empty account is the domain itself and is never resolved through the wildcard
+	if name != types.EmptyAccountName {
+		if account, ok := k.GetWildcardAccount(ctx, domain); ok {
+			return account, true, nil
+		}
+	}
+	return nil, false, sdkerrors.Wrapf(types.ErrAccountDoesNotExist, "not found in domain %s: %s", domain, name)
+}
+
+// GetWildcardAccount returns the wildcard account of the domain, if it has one
+func (k Keeper) GetWildcardAccount(ctx sdk.Context, domain string) (*types.Account, bool) {
+	cursor, err := k.AccountStore(ctx).Query().Where().Index(types.AccountWildcardIndex).Equals([]byte(domain)).Do()
+	if err != nil || !cursor.Valid() {
+		return nil, false
+	}
+	account := new(types.Account)
+	if err := cursor.Read(account); err != nil {
+		return nil, false
+	}
+	return account, true
+}
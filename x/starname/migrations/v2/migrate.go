@@ -0,0 +1,16 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/x/starname/keeper"
+)
+
+// MigrateStore performs in-place store migrations from version 1 to version 2
+// This indexes the existing domains and accounts for search and renames them to their normalized form
+func MigrateStore(ctx sdk.Context, k keeper.Keeper) error {
+	if err := k.IndexStarnames(ctx); err != nil {
+		return err
+	}
+	return k.NormalizeNames(ctx)
+}
@@ -0,0 +1,175 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iov-one/starnamed/pkg/utils"
+	"github.com/iov-one/starnamed/x/configuration"
+	"github.com/iov-one/starnamed/x/starname/keeper"
+	v2 "github.com/iov-one/starnamed/x/starname/migrations/v2"
+	"github.com/iov-one/starnamed/x/starname/types"
+)
+
+// v1Domains and v1Accounts are stored the way they could be stored by version 1, without normalization nor search index
+var (
+	v1Domains = []types.Domain{
+		{Name: "OLD", Admin: keeper.BobKey, Type: types.ClosedDomain},
+		{Name: "Taken", Admin: keeper.BobKey, Type: types.ClosedDomain},
+		{Name: "taken", Admin: keeper.BobKey, Type: types.ClosedDomain},
+		{Name: "xn--cole-9oa", Admin: keeper.AliceKey, Type: types.OpenDomain},
+	}
+	v1Accounts = []types.Account{
+		{Domain: "OLD", Name: utils.StrPtr(""), Owner: keeper.BobKey},
+		{Domain: "OLD", Name: utils.StrPtr("Alice"), Owner: keeper.AliceKey},
+		{Domain: "OLD", Name: utils.StrPtr("bob"), Owner: keeper.AliceKey},
+		{Domain: "Taken", Name: utils.StrPtr(""), Owner: keeper.BobKey},
+		{Domain: "taken", Name: utils.StrPtr(""), Owner: keeper.BobKey},
+		{Domain: "taken", Name: utils.StrPtr("Charlie"), Owner: keeper.CharlieKey},
+		{Domain: "taken", Name: utils.StrPtr("charlie"), Owner: keeper.BobKey},
+		{Domain: "xn--cole-9oa", Name: utils.StrPtr(""), Owner: keeper.AliceKey},
+		{Domain: "xn--cole-9oa", Name: utils.StrPtr("ÉLÈVE"), Owner: keeper.CharlieKey},
+	}
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, mocks := keeper.NewTestKeeper(t, true)
+	keeper.GetConfigSetter(k.ConfigurationKeeper).SetConfig(ctx, configuration.Config{
+		ValidDomainName:      `^[\p{L}\p{M}\p{N}_-]{1,16}$`,
+		ValidAccountName:     `^[\p{L}\p{M}\p{N}_.-]{0,64}$`,
+		DomainRenewalPeriod:  1000 * time.Hour,
+		AccountRenewalPeriod: 1000 * time.Hour,
+	})
+	domains, accounts := k.DomainStore(ctx), k.AccountStore(ctx)
+	for i := range v1Domains {
+		if err := domains.Create(&v1Domains[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := range v1Accounts {
+		if err := accounts.Create(&v1Accounts[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	k.SetDomainPolicy(ctx, types.DomainPolicy{Domain: "OLD", ValidAccountName: "^[a-z]+$"})
+	k.RecordAccountHistory(ctx, v1Accounts[1], false)
+	k.RecordProvenance(ctx, "OLD", types.ProvenanceEvent_Register, nil, keeper.BobKey, nil)
+	k.RecordAccountProvenance(ctx, "OLD", "Alice", types.ProvenanceEvent_Register, nil, keeper.AliceKey, nil)
+	// the escrows hold copies of the domains and accounts they sell
+	escrowedDomain, escrowedAccount, escrowedTaken := v1Domains[0], v1Accounts[1], v1Domains[1]
+	mocks.Escrow.SetEscrowedObjects(&escrowedDomain, &escrowedAccount, &escrowedTaken)
+
+	if err := v2.MigrateStore(ctx, k); err != nil {
+		t.Fatalf("MigrateStore() got error: %s", err)
+	}
+
+	t.Run("names are normalized", func(t *testing.T) {
+		expected := map[string]sdk.AccAddress{
+			"*old":            keeper.BobKey,
+			"alice*old":       keeper.AliceKey,
+			"bob*old":         keeper.AliceKey,
+			"*taken":          keeper.BobKey,
+			"charlie*taken":   keeper.BobKey,
+			"*taken-1":        keeper.BobKey,
+			"charlie-1*taken": keeper.CharlieKey,
+			"*école":          keeper.AliceKey,
+			"élève*école":     keeper.CharlieKey,
+		}
+		querier := keeper.NewQuerier(&k)
+		for starname, owner := range expected {
+			res, err := querier.Starname(sdk.WrapSDKContext(ctx), &types.QueryStarnameRequest{Starname: starname})
+			if err != nil {
+				t.Fatalf("Starname(%s) got error: %s", starname, err)
+			}
+			if !res.Account.Owner.Equals(owner) {
+				t.Fatalf("Starname(%s) expected owner %s, got %s", starname, owner, res.Account.Owner)
+			}
+		}
+		for _, name := range []string{"OLD", "xn--cole-9oa"} {
+			if err := domains.Read((&types.Domain{Name: name}).PrimaryKey(), new(types.Domain)); err == nil {
+				t.Fatalf("expected domain %s to be renamed", name)
+			}
+		}
+		if _, ok := k.GetDomainPolicy(ctx, "old"); !ok {
+			t.Fatal("expected the domain policy to be moved")
+		}
+	})
+	t.Run("taken names are suffixed", func(t *testing.T) {
+		for _, name := range []string{"Taken", "taken-1"} {
+			found := domains.Read((&types.Domain{Name: name}).PrimaryKey(), new(types.Domain)) == nil
+			if found != (name == "taken-1") {
+				t.Fatalf("unexpected domain %s found: %t", name, found)
+			}
+		}
+		var renamed []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeNormalizedNameTaken {
+				continue
+			}
+			attributes := make(map[string]string)
+			for _, attribute := range event.Attributes {
+				attributes[string(attribute.Key)] = string(attribute.Value)
+			}
+			renamed = append(renamed, attributes[types.AttributeKeyPreviousName]+" "+attributes[types.AttributeKeyAccountName]+"*"+attributes[types.AttributeKeyDomainName]+" "+attributes[types.AttributeKeyOwner])
+		}
+		expected := []string{
+			"Taken *taken-1 " + keeper.BobKey.String(),
+			"Charlie charlie-1*taken " + keeper.CharlieKey.String(),
+		}
+		if len(renamed) != len(expected) || renamed[0] != expected[0] || renamed[1] != expected[1] {
+			t.Fatalf("unexpected renaming events: %v", renamed)
+		}
+	})
+	t.Run("escrowed objects are renamed", func(t *testing.T) {
+		if escrowedDomain.Name != "old" || escrowedTaken.Name != "taken-1" {
+			t.Fatalf("unexpected escrowed domains: %s, %s", escrowedDomain.Name, escrowedTaken.Name)
+		}
+		if escrowedAccount.Domain != "old" || *escrowedAccount.Name != "alice" {
+			t.Fatalf("unexpected escrowed account: %s", escrowedAccount.GetStarname())
+		}
+	})
+	t.Run("history and provenance are renamed", func(t *testing.T) {
+		if history := k.GetAccountHistory(ctx, "old", "alice", 0, 0); len(history) != 1 || history[0].Domain != "old" || history[0].Name != "alice" {
+			t.Fatalf("unexpected history of alice*old: %v", history)
+		}
+		if history := k.GetAccountHistory(ctx, "OLD", "Alice", 0, 0); len(history) != 0 {
+			t.Fatalf("expected the history of Alice*OLD to be moved: %v", history)
+		}
+		for _, starname := range []string{"old", "alice*old"} {
+			if entries, total := k.GetProvenance(ctx, starname, 0, 10); total != 1 || entries[0].Starname != starname {
+				t.Fatalf("unexpected provenance of %s: %v", starname, entries)
+			}
+		}
+		for _, starname := range []string{"OLD", "Alice*OLD"} {
+			if _, total := k.GetProvenance(ctx, starname, 0, 10); total != 0 {
+				t.Fatalf("expected the provenance of %s to be moved", starname)
+			}
+		}
+	})
+	t.Run("names are indexed", func(t *testing.T) {
+		var indexedDomains []string
+		k.SearchDomains(ctx, "", false, 0, func(domain string) bool {
+			indexedDomains = append(indexedDomains, domain)
+			return false
+		})
+		if len(indexedDomains) != 4 || indexedDomains[0] != "old" || indexedDomains[1] != "taken" || indexedDomains[2] != "taken-1" || indexedDomains[3] != "école" {
+			t.Fatalf("unexpected indexed domains: %v", indexedDomains)
+		}
+		var indexedAccounts []string
+		k.SearchAccounts(ctx, "", "", false, 0, func(domain, name string) bool {
+			indexedAccounts = append(indexedAccounts, name+"*"+domain)
+			return false
+		})
+		expected := []string{"alice*old", "bob*old", "charlie*taken", "charlie-1*taken", "élève*école"}
+		if len(indexedAccounts) != len(expected) {
+			t.Fatalf("unexpected indexed accounts: %v", indexedAccounts)
+		}
+		for i := range expected {
+			if indexedAccounts[i] != expected[i] {
+				t.Fatalf("unexpected indexed accounts: %v", indexedAccounts)
+			}
+		}
+	})
+}
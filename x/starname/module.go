@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -100,6 +101,11 @@ func (AppModule) Name() string { return types.ModuleName }
 func (am AppModule) RegisterServices(configurator module.Configurator) {
 	types.RegisterMsgServer(configurator.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
 	types.RegisterQueryServer(configurator.QueryServer(), keeper.NewQuerier(&am.keeper))
+
+	m := NewMigrator(am.keeper)
+	if err := configurator.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(sdkerrors.Wrapf(err, "Error while registering the starname module migration from version 1 to 2"))
+	}
 }
 
 // LegacyQuerierHandler provides an sdk.Querier object that uses the legacy amino codec.
@@ -143,4 +149,4 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
@@ -0,0 +1,259 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/iov-one/starnamed/pkg/utils"
+)
+
+// CertificateType defines the format of a certificate
+type CertificateType string
+
+const (
+	// OpaqueCertificate is the legacy certificate type, the certificate is stored as is without verification
+	OpaqueCertificate CertificateType = ""
+	// JWSCertificate is a compact JSON web signature whose header embeds the signer's public key as a jwk
+	JWSCertificate CertificateType = "jws"
+	// X509Certificate is a PEM or DER encoded X.509 chain, leaf first
+	X509Certificate CertificateType = "x509"
+	// VerifiableCredentialCertificate is a verifiable credential encoded as a JWS with a vc claim
+	VerifiableCredentialCertificate CertificateType = "vc"
+)
+
+// ValidateCertificateType asserts that the certificate type is known
+func ValidateCertificateType(typ CertificateType) error {
+	switch typ {
+	case OpaqueCertificate, JWSCertificate, X509Certificate, VerifiableCredentialCertificate:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "invalid certificate type: %s", typ)
+	}
+}
+
+const (
+	// JWKIssuerPrefix prefixes the issuer of a JWS certificate, followed by the RFC 7638 thumbprint of its signing key
+	JWKIssuerPrefix = "jwk:"
+	// X509IssuerPrefix prefixes the issuer of an X.509 certificate, followed by the base64url encoded SHA-256 of the
+	// subject public key info of the root of its chain
+	X509IssuerPrefix = "x509:"
+)
+
+// NewTypedCertificate parses the provided data according to the certificate type, verifies its signature
+// and its validity at the provided time, and returns the certificate with its issuer and expiration extracted.
+// The issuer is derived from the key which signed the certificate rather than from a name the certificate claims,
+// so that the certificates of an issuer can not be forged by anyone holding another key.
+// If the certificate contains a subject, it must match the starname the certificate is added to.
+func NewTypedCertificate(typ CertificateType, data []byte, starname string, now time.Time) (*TypedCertificate, error) {
+	cert := &TypedCertificate{Type: typ, Data: data}
+	var err error
+	switch typ {
+	case JWSCertificate, VerifiableCredentialCertificate:
+		err = cert.parseJWS(starname)
+	case X509Certificate:
+		err = cert.parseX509(now)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCertificate, "certificate type %q cannot be verified", typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cert.IsExpired(now) {
+		return nil, sdkerrors.Wrapf(ErrCertificateExpired, "certificate expired at %s", utils.SecondsToTime(cert.ExpiresAt))
+	}
+	return cert, nil
+}
+
+// Verify verifies again the signature of the certificate and checks it has not expired at the provided time
+func (m *TypedCertificate) Verify(starname string, now time.Time) error {
+	verified, err := NewTypedCertificate(m.Type, m.Data, starname, now)
+	if err != nil {
+		return err
+	}
+	if verified.Issuer != m.Issuer || verified.ExpiresAt != m.ExpiresAt {
+		return sdkerrors.Wrap(ErrInvalidCertificate, "certificate metadata does not match its content")
+	}
+	return nil
+}
+
+// IsExpired returns true if the certificate has an expiration and it is not after the provided time
+func (m *TypedCertificate) IsExpired(now time.Time) bool {
+	return m.ExpiresAt != 0 && !utils.SecondsToTime(m.ExpiresAt).After(now)
+}
+
+// jwk is the subset of a JSON web key used to verify a certificate
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of the key: the base64url encoded SHA-256 of its required members
+// serialized in lexicographic order
+func (k jwk) Thumbprint() string {
+	var members string
+	switch k.Kty {
+	case "EC":
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, k.Crv, k.Kty, k.X, k.Y)
+	default:
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, k.Crv, k.Kty, k.X)
+	}
+	digest := sha256.Sum256([]byte(members))
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+// jwsHeader is the subset of the JOSE header used to verify a certificate
+type jwsHeader struct {
+	Alg string `json:"alg"`
+	JWK *jwk   `json:"jwk"`
+}
+
+// jwsClaims is the subset of the JWT claims used to describe a certificate
+type jwsClaims struct {
+	Iss string          `json:"iss"`
+	Sub string          `json:"sub"`
+	Exp int64           `json:"exp"`
+	VC  json.RawMessage `json:"vc"`
+}
+
+// parseJWS verifies a compact JWS certificate signed with either ES256 or EdDSA.
+// The iss claim is self-asserted, the issuer is the thumbprint of the jwk which signed the certificate.
+func (m *TypedCertificate) parseJWS(starname string) error {
+	parts := strings.Split(string(m.Data), ".")
+	if len(parts) != 3 {
+		return sdkerrors.Wrap(ErrInvalidCertificate, "jws must be in compact serialization")
+	}
+	var header jwsHeader
+	if err := decodeJWSPart(parts[0], &header); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "invalid jws header: %s", err)
+	}
+	var claims jwsClaims
+	if err := decodeJWSPart(parts[1], &claims); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "invalid jws payload: %s", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "invalid jws signature encoding: %s", err)
+	}
+	if header.JWK == nil {
+		return sdkerrors.Wrap(ErrInvalidCertificate, "jws header does not contain the signer jwk")
+	}
+	signingInput := []byte(parts[0] + "." + parts[1])
+	switch header.Alg {
+	case "ES256":
+		if header.JWK.Kty != "EC" || header.JWK.Crv != "P-256" {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "ES256 requires a P-256 EC key")
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(header.JWK.X)
+		y, errY := base64.RawURLEncoding.DecodeString(header.JWK.Y)
+		if errX != nil || errY != nil {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "invalid EC key coordinates")
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "EC key is not on curve P-256")
+		}
+		if len(signature) != 64 {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "invalid ES256 signature length")
+		}
+		digest := sha256.Sum256(signingInput)
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(key, digest[:], r, s) {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "invalid jws signature")
+		}
+	case "EdDSA":
+		if header.JWK.Kty != "OKP" || header.JWK.Crv != "Ed25519" {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "EdDSA requires an Ed25519 OKP key")
+		}
+		key, err := base64.RawURLEncoding.DecodeString(header.JWK.X)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "invalid Ed25519 key")
+		}
+		if !ed25519.Verify(key, signingInput, signature) {
+			return sdkerrors.Wrap(ErrInvalidCertificate, "invalid jws signature")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "unsupported jws algorithm: %s", header.Alg)
+	}
+	if claims.Sub != "" && claims.Sub != starname {
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "certificate subject %s does not match %s", claims.Sub, starname)
+	}
+	if m.Type == VerifiableCredentialCertificate && (len(claims.VC) == 0 || claims.VC[0] != '{') {
+		return sdkerrors.Wrap(ErrInvalidCertificate, "verifiable credential requires a vc claim")
+	}
+	m.Issuer = JWKIssuerPrefix + header.JWK.Thumbprint()
+	m.ExpiresAt = claims.Exp
+	return nil
+}
+
+// decodeJWSPart decodes a base64url encoded JSON part of a JWS
+func decodeJWSPart(part string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// parseX509 verifies that each certificate of the chain is signed by the next one, that the chain
+// ends with a self-signed certificate and that every certificate is valid at the provided time.
+// Anyone can self-sign a root with any subject, the issuer is the hash of the public key of the root.
+func (m *TypedCertificate) parseX509(now time.Time) error {
+	der := m.Data
+	if bytes.HasPrefix(bytes.TrimSpace(der), []byte("-----BEGIN")) {
+		der = nil
+		for rest := m.Data; ; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				return sdkerrors.Wrapf(ErrInvalidCertificate, "unexpected pem block %s", block.Type)
+			}
+			der = append(der, block.Bytes...)
+		}
+	}
+	chain, err := x509.ParseCertificates(der)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCertificate, "invalid x509 chain: %s", err)
+	}
+	if len(chain) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCertificate, "empty x509 chain")
+	}
+	var expiresAt int64
+	for i, cert := range chain {
+		if now.Before(cert.NotBefore) {
+			return sdkerrors.Wrapf(ErrInvalidCertificate, "certificate %s is not valid before %s", cert.Subject, cert.NotBefore)
+		}
+		if expiresAt == 0 || cert.NotAfter.Unix() < expiresAt {
+			expiresAt = cert.NotAfter.Unix()
+		}
+		parent := cert
+		if i+1 < len(chain) {
+			parent = chain[i+1]
+		} else if !bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+			return sdkerrors.Wrapf(ErrInvalidCertificate, "chain must end with a self-signed certificate, got %s", cert.Subject)
+		}
+		if err := cert.CheckSignatureFrom(parent); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCertificate, "certificate %s is not signed by %s: %s", cert.Subject, parent.Subject, err)
+		}
+	}
+	spki := sha256.Sum256(chain[len(chain)-1].RawSubjectPublicKeyInfo)
+	m.Issuer = X509IssuerPrefix + base64.RawURLEncoding.EncodeToString(spki[:])
+	m.ExpiresAt = expiresAt
+	return nil
+}
@@ -0,0 +1,250 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// signJWS builds a compact JWS of the provided claims, signed with a new key of the provided algorithm
+func signJWS(t *testing.T, alg string, claims map[string]interface{}) []byte {
+	t.Helper()
+	encode := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	var header map[string]interface{}
+	var sign func(input []byte) []byte
+	switch alg {
+	case "ES256":
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header = map[string]interface{}{"alg": alg, "jwk": map[string]string{
+			"kty": "EC",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}}
+		sign = func(input []byte) []byte {
+			digest := sha256.Sum256(input)
+			r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	case "EdDSA":
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header = map[string]interface{}{"alg": alg, "jwk": map[string]string{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"x":   base64.RawURLEncoding.EncodeToString(pub),
+		}}
+		sign = func(input []byte) []byte {
+			return ed25519.Sign(priv, input)
+		}
+	default:
+		t.Fatalf("unsupported algorithm %s", alg)
+	}
+	input := encode(header) + "." + encode(claims)
+	return []byte(input + "." + base64.RawURLEncoding.EncodeToString(sign([]byte(input))))
+}
+
+// newX509Chain returns a PEM encoded chain made of a leaf signed by a self-signed root
+func newX509Chain(t *testing.T, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, root, root, &rootKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test*iov"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, root, &leafKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})
+	return append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER})...)
+}
+
+func TestNewTypedCertificate(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	tampered := signJWS(t, "EdDSA", map[string]interface{}{"iss": "issuer"})
+	tampered[len(tampered)-2] ^= 0x1
+	cases := map[string]struct {
+		typ        CertificateType
+		data       []byte
+		wantErr    *errors.Error
+		wantIssuer string // prefix of the issuer, followed by a hash of the signing key
+		wantExpiry int64
+	}{
+		"success jws ES256": {
+			typ:        JWSCertificate,
+			data:       signJWS(t, "ES256", map[string]interface{}{"iss": "issuer", "sub": "test*iov", "exp": now.Unix() + 10}),
+			wantIssuer: JWKIssuerPrefix,
+			wantExpiry: now.Unix() + 10,
+		},
+		"success jws EdDSA": {
+			typ:        JWSCertificate,
+			data:       signJWS(t, "EdDSA", map[string]interface{}{"iss": "issuer"}),
+			wantIssuer: JWKIssuerPrefix,
+		},
+		"success vc": {
+			typ:        VerifiableCredentialCertificate,
+			data:       signJWS(t, "EdDSA", map[string]interface{}{"iss": "did:example:1", "vc": map[string]interface{}{"type": []string{"VerifiableCredential"}}}),
+			wantIssuer: JWKIssuerPrefix,
+		},
+		"success x509": {
+			typ:        X509Certificate,
+			data:       newX509Chain(t, now.Add(-time.Hour), now.Add(time.Hour)),
+			wantIssuer: X509IssuerPrefix,
+			wantExpiry: now.Add(time.Hour).Unix(),
+		},
+		"fail opaque": {
+			typ:     OpaqueCertificate,
+			data:    []byte("opaque"),
+			wantErr: ErrInvalidCertificate,
+		},
+		"fail unknown type": {
+			typ:     "pgp",
+			data:    []byte("pgp"),
+			wantErr: ErrInvalidCertificate,
+		},
+		"fail jws tampered": {
+			typ:     JWSCertificate,
+			data:    tampered,
+			wantErr: ErrInvalidCertificate,
+		},
+		"success jws without issuer": {
+			typ:        JWSCertificate,
+			data:       signJWS(t, "ES256", map[string]interface{}{"sub": "test*iov"}),
+			wantIssuer: JWKIssuerPrefix,
+		},
+		"fail jws subject mismatch": {
+			typ:     JWSCertificate,
+			data:    signJWS(t, "ES256", map[string]interface{}{"iss": "issuer", "sub": "other*iov"}),
+			wantErr: ErrInvalidCertificate,
+		},
+		"fail jws expired": {
+			typ:     JWSCertificate,
+			data:    signJWS(t, "EdDSA", map[string]interface{}{"iss": "issuer", "exp": now.Unix()}),
+			wantErr: ErrCertificateExpired,
+		},
+		"fail vc without vc claim": {
+			typ:     VerifiableCredentialCertificate,
+			data:    signJWS(t, "EdDSA", map[string]interface{}{"iss": "issuer"}),
+			wantErr: ErrInvalidCertificate,
+		},
+		"fail x509 garbage": {
+			typ:     X509Certificate,
+			data:    []byte("not a certificate"),
+			wantErr: ErrInvalidCertificate,
+		},
+		"fail x509 not yet valid": {
+			typ:     X509Certificate,
+			data:    newX509Chain(t, now.Add(time.Minute), now.Add(time.Hour)),
+			wantErr: ErrInvalidCertificate,
+		},
+		"fail x509 expired": {
+			typ:     X509Certificate,
+			data:    newX509Chain(t, now.Add(-time.Hour), now.Add(-time.Minute)),
+			wantErr: ErrCertificateExpired,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cert, err := NewTypedCertificate(tc.typ, tc.data, "test*iov", now)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if !strings.HasPrefix(cert.Issuer, tc.wantIssuer) || len(cert.Issuer) != len(tc.wantIssuer)+43 {
+				t.Fatalf("unexpected issuer: want %s followed by a hash, got %s", tc.wantIssuer, cert.Issuer)
+			}
+			if cert.ExpiresAt != tc.wantExpiry {
+				t.Fatalf("unexpected expiration: want %d, got %d", tc.wantExpiry, cert.ExpiresAt)
+			}
+			if err := cert.Verify("test*iov", now); err != nil {
+				t.Fatalf("unexpected verification error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestCertificateIssuerIsBoundToKey(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	// RFC 8037 appendix A.3
+	key := jwk{Kty: "OKP", Crv: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}
+	if got := key.Thumbprint(); got != "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k" {
+		t.Fatalf("unexpected thumbprint: %s", got)
+	}
+	// two certificates claiming the same issuer but signed by different keys have different issuers
+	claims := map[string]interface{}{"iss": "issuer"}
+	first, err := NewTypedCertificate(JWSCertificate, signJWS(t, "EdDSA", claims), "test*iov", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewTypedCertificate(JWSCertificate, signJWS(t, "EdDSA", claims), "test*iov", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Issuer == second.Issuer {
+		t.Fatalf("certificates signed by different keys share the issuer %s", first.Issuer)
+	}
+	// so do two chains whose roots have the same subject
+	first, err = NewTypedCertificate(X509Certificate, newX509Chain(t, now.Add(-time.Hour), now.Add(time.Hour)), "test*iov", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err = NewTypedCertificate(X509Certificate, newX509Chain(t, now.Add(-time.Hour), now.Add(time.Hour)), "test*iov", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Issuer == second.Issuer {
+		t.Fatalf("chains of different roots share the issuer %s", first.Issuer)
+	}
+}
@@ -26,6 +26,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRenewDomain{}, fmt.Sprintf("%s/RenewDomain", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgReplaceAccountResources{}, fmt.Sprintf("%s/ReplaceAccountResources", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgReplaceAccountMetadata{}, fmt.Sprintf("%s/SetAccountMetadata", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCommitRegistration{}, fmt.Sprintf("%s/CommitRegistration", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpsertAccountResources{}, fmt.Sprintf("%s/UpsertAccountResources", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRemoveAccountResources{}, fmt.Sprintf("%s/RemoveAccountResources", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgSetDomainPolicy{}, fmt.Sprintf("%s/SetDomainPolicy", ModuleName), nil)
 
 	cdc.RegisterConcrete(&Domain{}, fmt.Sprintf("%s/Domain", ModuleName), nil)
 }
@@ -35,17 +39,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgAddAccountCertificate{},
+		&MsgCommitRegistration{},
 		&MsgDeleteAccount{},
 		&MsgDeleteAccountCertificate{},
 		&MsgDeleteDomain{},
 		&MsgRegisterAccount{},
 		&MsgRegisterDomain{},
+		&MsgRemoveAccountResources{},
 		&MsgRenewAccount{},
 		&MsgRenewDomain{},
 		&MsgReplaceAccountMetadata{},
 		&MsgReplaceAccountResources{},
+		&MsgSetDomainPolicy{},
 		&MsgTransferAccount{},
 		&MsgTransferDomain{},
+		&MsgUpsertAccountResources{},
 	)
 	registry.RegisterImplementations(
 		(*escrowtypes.TransferableObject)(nil),
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommitmentSize is the size of a registration commitment
+const CommitmentSize = sha256.Size
+
+// ComputeCommitment returns the registration commitment of a domain, if name is empty, or of an account.
+// The commitment binds the starname to the address that is going to reveal it and to a secret salt.
+// Names are normalized first, so any form of a name can be committed.
+func ComputeCommitment(domain, name string, owner sdk.AccAddress, salt []byte) []byte {
+	domain, name = NormalizeNameOrKeep(domain), NormalizeNameOrKeep(name)
+	h := sha256.New()
+	// every part is length prefixed so that different parts cannot produce the same preimage
+	for _, part := range [][]byte{[]byte(strings.Join([]string{name, domain}, StarnameSeparator)), owner, salt} {
+		var length [4]byte
+		binary.BigEndian.PutUint32(length[:], uint32(len(part)))
+		h.Write(length[:])
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
@@ -7,3 +7,11 @@ const MaxValidUntil int64 = 4746278435
 
 // EmptyAccountName defines the empty account identifier in an IOV domain
 const EmptyAccountName = ""
+
+// AccountHistoryMax defines the maximum number of history entries kept for an account,
+// older entries are pruned when new ones are recorded
+const AccountHistoryMax = 50
+
+// MaxExpiredCommitmentsPerBlock defines the maximum number of expired registration commitments removed at the end of
+// a block, the ones left behind are removed in the next blocks
+const MaxExpiredCommitmentsPerBlock = 100
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the domain policy is well formed
+func (p DomainPolicy) Validate() error {
+	if p.Domain == "" {
+		return errors.Wrap(ErrInvalidDomainName, "empty")
+	}
+	if err := p.RegistrationPrice.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidDomainPolicy, "invalid registration price: %s", err)
+	}
+	if err := p.RenewalPrice.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidDomainPolicy, "invalid renewal price: %s", err)
+	}
+	if p.AccountRenewalPeriod != nil && *p.AccountRenewalPeriod <= 0 {
+		return errors.Wrap(ErrInvalidDomainPolicy, "account renewal period must be positive")
+	}
+	if p.AccountGracePeriod != nil && *p.AccountGracePeriod < 0 {
+		return errors.Wrap(ErrInvalidDomainPolicy, "account grace period must not be negative")
+	}
+	if _, err := regexp.Compile(p.ValidAccountName); err != nil {
+		return errors.Wrapf(ErrInvalidDomainPolicy, "invalid account name regexp: %s", err)
+	}
+	if rate := p.GetRoyaltyRate(); rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalidDomainPolicy, "royalty rate must be in interval [0;1]")
+	}
+	if p.RoyaltyRecipient != nil {
+		if err := sdk.VerifyAddressFormat(p.RoyaltyRecipient); err != nil {
+			return errors.Wrapf(ErrInvalidDomainPolicy, "invalid royalty recipient: %s", err)
+		}
+	} else if p.GetRoyaltyRate().IsPositive() {
+		return errors.Wrap(ErrInvalidDomainPolicy, "a royalty rate requires a royalty recipient")
+	}
+	allowed := make(map[string]struct{}, len(p.Allowlist))
+	for _, addr := range p.Allowlist {
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			return errors.Wrapf(ErrInvalidDomainPolicy, "invalid allowlist address: %s", err)
+		}
+		if _, ok := allowed[addr.String()]; ok {
+			return errors.Wrapf(ErrInvalidDomainPolicy, "duplicate allowlist address %s", addr)
+		}
+		allowed[addr.String()] = struct{}{}
+	}
+	denied := make(map[string]struct{}, len(p.Denylist))
+	for _, addr := range p.Denylist {
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			return errors.Wrapf(ErrInvalidDomainPolicy, "invalid denylist address: %s", err)
+		}
+		if _, ok := denied[addr.String()]; ok {
+			return errors.Wrapf(ErrInvalidDomainPolicy, "duplicate denylist address %s", addr)
+		}
+		if _, ok := allowed[addr.String()]; ok {
+			return errors.Wrapf(ErrInvalidDomainPolicy, "address %s is both allowed and denied", addr)
+		}
+		denied[addr.String()] = struct{}{}
+	}
+	return nil
+}
+
+// AllowsRegistrant returns true if the domain policy allows the provided address to register accounts
+func (p DomainPolicy) AllowsRegistrant(registrant sdk.AccAddress) bool {
+	for _, addr := range p.Denylist {
+		if addr.Equals(registrant) {
+			return false
+		}
+	}
+	if len(p.Allowlist) == 0 {
+		return true
+	}
+	for _, addr := range p.Allowlist {
+		if addr.Equals(registrant) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoyaltyRate returns the royalty rate of the domain policy, zero if it is not set
+func (p DomainPolicy) GetRoyaltyRate() sdk.Dec {
+	if p.RoyaltyRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.RoyaltyRate
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestDomainPolicy_Validate(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	cases := map[string]struct {
+		Policy DomainPolicy
+		Err    *errors.Error
+	}{
+		"valid": {
+			Policy: DomainPolicy{
+				Domain:            "test",
+				RegistrationPrice: sdk.NewCoins(sdk.NewInt64Coin("tiov", 10)),
+				Allowlist:         []sdk.AccAddress{alice},
+				Denylist:          []sdk.AccAddress{bob},
+			},
+		},
+		"missing domain": {
+			Policy: DomainPolicy{},
+			Err:    ErrInvalidDomainName,
+		},
+		"invalid price": {
+			Policy: DomainPolicy{Domain: "test", RenewalPrice: sdk.Coins{sdk.Coin{Denom: "tiov", Amount: sdk.NewInt(-1)}}},
+			Err:    ErrInvalidDomainPolicy,
+		},
+		"duplicate address": {
+			Policy: DomainPolicy{Domain: "test", Denylist: []sdk.AccAddress{bob, bob}},
+			Err:    ErrInvalidDomainPolicy,
+		},
+		"invalid account name regexp": {
+			Policy: DomainPolicy{Domain: "test", ValidAccountName: "("},
+			Err:    ErrInvalidDomainPolicy,
+		},
+		"allowed and denied": {
+			Policy: DomainPolicy{Domain: "test", Allowlist: []sdk.AccAddress{alice}, Denylist: []sdk.AccAddress{alice}},
+			Err:    ErrInvalidDomainPolicy,
+		},
+		"valid royalty": {
+			Policy: DomainPolicy{Domain: "test", RoyaltyRecipient: alice, RoyaltyRate: sdk.NewDecWithPrec(5, 2)},
+		},
+		"royalty without recipient": {
+			Policy: DomainPolicy{Domain: "test", RoyaltyRate: sdk.NewDecWithPrec(5, 2)},
+			Err:    ErrInvalidDomainPolicy,
+		},
+		"royalty rate above one": {
+			Policy: DomainPolicy{Domain: "test", RoyaltyRecipient: alice, RoyaltyRate: sdk.NewDec(2)},
+			Err:    ErrInvalidDomainPolicy,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := c.Policy.Validate()
+			if c.Err == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if c.Err != nil && !c.Err.Is(err) {
+				t.Fatalf("expected error %s, got %v", c.Err, err)
+			}
+		})
+	}
+}
+
+func TestDomainPolicy_AllowsRegistrant(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	if !(DomainPolicy{}).AllowsRegistrant(alice) {
+		t.Fatal("empty policy must allow everyone")
+	}
+	if (DomainPolicy{Denylist: []sdk.AccAddress{alice}}).AllowsRegistrant(alice) {
+		t.Fatal("denied address must not be allowed")
+	}
+	if (DomainPolicy{Allowlist: []sdk.AccAddress{alice}}).AllowsRegistrant(bob) {
+		t.Fatal("address missing from the allowlist must not be allowed")
+	}
+}
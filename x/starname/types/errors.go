@@ -101,3 +101,39 @@ var ErrStarnameNotContainSep = sdkerrors.Register(ModuleName, 29, "starname does
 
 // ErrStarnameMultipleSeparator returned when provided starname contains more than one separator
 var ErrStarnameMultipleSeparator = sdkerrors.Register(ModuleName, 30, "starname should contain single separator")
+
+// ErrInvalidCertificate is returned when a typed certificate cannot be parsed or its signature is not valid
+var ErrInvalidCertificate = sdkerrors.Register(ModuleName, 32, "invalid certificate")
+
+// ErrCertificateExpired is returned when a typed certificate has expired
+var ErrCertificateExpired = sdkerrors.Register(ModuleName, 33, "certificate has expired")
+
+// ErrInvalidCommitment is returned when a registration commitment is malformed
+var ErrInvalidCommitment = sdkerrors.Register(ModuleName, 34, "invalid registration commitment")
+
+// ErrCommitmentExists is returned when a registration commitment already exists
+var ErrCommitmentExists = sdkerrors.Register(ModuleName, 35, "registration commitment already exists")
+
+// ErrCommitmentDoesNotExist is returned when a registration is revealed without a matching commitment
+var ErrCommitmentDoesNotExist = sdkerrors.Register(ModuleName, 36, "registration commitment does not exist")
+
+// ErrCommitmentTooRecent is returned when a registration is revealed before the commitment minimum delay
+var ErrCommitmentTooRecent = sdkerrors.Register(ModuleName, 37, "registration commitment is too recent")
+
+// ErrCommitmentExpired is returned when a registration is revealed after the commitment maximum window
+var ErrCommitmentExpired = sdkerrors.Register(ModuleName, 38, "registration commitment has expired")
+
+// ErrNameReserved is returned when a reserved name is registered by someone who is not its claimant
+var ErrNameReserved = sdkerrors.Register(ModuleName, 39, "name is reserved")
+
+// ErrResourceDoesNotExist is returned when the account has no resource with the provided URI
+var ErrResourceDoesNotExist = sdkerrors.Register(ModuleName, 40, "resource does not exist")
+
+// ErrInvalidDomainPolicy is returned when the registration policy of a domain is not valid
+var ErrInvalidDomainPolicy = sdkerrors.Register(ModuleName, 41, "invalid domain policy")
+
+// ErrRegistrantNotAllowed is returned when the domain policy does not allow the registrant to register accounts
+var ErrRegistrantNotAllowed = sdkerrors.Register(ModuleName, 42, "registrant not allowed by the domain policy")
+
+// ErrWildcardAccountExists is returned when a wildcard account is registered in a domain which already has one
+var ErrWildcardAccountExists = sdkerrors.Register(ModuleName, 43, "wildcard account already exists")
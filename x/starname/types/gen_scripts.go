@@ -0,0 +1,96 @@
+//go:build ignore
+// +build ignore
+
+// This program generates scripts.go, the unicode script table the names are validated with, from the script tables
+// of the go toolchain running it. The table is pinned to one unicode version, so that the validation of the names does
+// not depend on the go toolchain the nodes are built with, and the program refuses to run with another version.
+// Run it with go generate, from a go toolchain whose unicode version is the requested one.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"unicode"
+)
+
+var (
+	version = flag.String("version", "", "unicode version of the generated table")
+	output  = flag.String("output", "scripts.go", "file the table is written to")
+)
+
+type scriptRange struct {
+	lo, hi rune
+	script int
+}
+
+func main() {
+	flag.Parse()
+	if *version != unicode.Version {
+		log.Fatalf("the go toolchain provides the unicode %s scripts, not the requested unicode %s ones", unicode.Version, *version)
+	}
+
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	scripts := make([]int, unicode.MaxRune+1)
+	for i, name := range names {
+		table := unicode.Scripts[name]
+		for _, r16 := range table.R16 {
+			for r := rune(r16.Lo); r <= rune(r16.Hi); r += rune(r16.Stride) {
+				scripts[r] = i + 1
+			}
+		}
+		for _, r32 := range table.R32 {
+			for r := rune(r32.Lo); r <= rune(r32.Hi); r += rune(r32.Stride) {
+				scripts[r] = i + 1
+			}
+		}
+	}
+	var ranges []scriptRange
+	for r, script := range scripts {
+		if script == 0 {
+			continue
+		}
+		if last := len(ranges) - 1; last >= 0 && ranges[last].script == script && ranges[last].hi == rune(r)-1 {
+			ranges[last].hi = rune(r)
+			continue
+		}
+		ranges = append(ranges, scriptRange{lo: rune(r), hi: rune(r), script: script})
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by running \"go generate\" in x/starname/types. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package types\n\n")
+	fmt.Fprintf(&buf, "// ScriptsUnicodeVersion is the unicode version of the scripts the letters of the names are validated with\n")
+	fmt.Fprintf(&buf, "const ScriptsUnicodeVersion = %q\n\n", *version)
+	fmt.Fprintf(&buf, "// scriptNames are the unicode scripts other than the Common and Inherited ones, sorted by name\n")
+	fmt.Fprintf(&buf, "var scriptNames = [...]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%q,\n", name)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+	fmt.Fprintf(&buf, "// scriptRanges are the sorted rune ranges of the scripts, with the index of their script in scriptNames\n")
+	fmt.Fprintf(&buf, "var scriptRanges = [...]scriptRange{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(&buf, "{0x%04X, 0x%04X, %d},\n", r.lo, r.hi, r.script-1)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -25,8 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/starname
 type GenesisState struct {
-	Domains  []Domain  `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
-	Accounts []Account `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Domains        []Domain              `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	Accounts       []Account             `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Commitments    []Commitment          `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	DomainPolicies []DomainPolicy        `protobuf:"bytes,4,rep,name=domain_policies,json=domainPolicies,proto3" json:"domain_policies,omitempty"`
+	AccountHistory []AccountHistoryEntry `protobuf:"bytes,5,rep,name=account_history,json=accountHistory,proto3" json:"account_history,omitempty"`
+	Provenance     []ProvenanceEntry     `protobuf:"bytes,6,rep,name=provenance,proto3" json:"provenance,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +80,34 @@ func (m *GenesisState) GetAccounts() []Account {
 	return nil
 }
 
+func (m *GenesisState) GetCommitments() []Commitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDomainPolicies() []DomainPolicy {
+	if m != nil {
+		return m.DomainPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountHistory() []AccountHistoryEntry {
+	if m != nil {
+		return m.AccountHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProvenance() []ProvenanceEntry {
+	if m != nil {
+		return m.Provenance
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "starnamed.x.starname.v1beta1.GenesisState")
 }
@@ -85,24 +117,32 @@ func init() {
 }
 
 var fileDescriptor_7a91046f9c008639 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xcc, 0x2f, 0xd3,
-	0x2f, 0x2e, 0x49, 0x2c, 0xca, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
-	0x81, 0xc9, 0xa7, 0xe8, 0x55, 0xe8, 0xc1, 0xd8, 0x7a, 0x50, 0xb5, 0x52, 0x22, 0xe9, 0xf9, 0xe9,
-	0xf9, 0x60, 0x85, 0xfa, 0x20, 0x16, 0x44, 0x8f, 0x94, 0x02, 0x56, 0x73, 0x4b, 0x2a, 0x0b, 0x52,
-	0xa1, 0xa6, 0x2a, 0x9d, 0x60, 0xe4, 0xe2, 0x71, 0x87, 0xd8, 0x13, 0x5c, 0x92, 0x58, 0x92, 0x2a,
-	0x14, 0xc9, 0xc5, 0x9e, 0x92, 0x9f, 0x9b, 0x98, 0x99, 0x57, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1,
-	0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0x62, 0x3d, 0x17, 0xb0, 0x62, 0x27, 0xc9, 0x13, 0xf7, 0xe4, 0x19,
-	0x5e, 0xdd, 0x93, 0x17, 0x84, 0x6a, 0xd6, 0xc9, 0xcf, 0xcd, 0x2c, 0x49, 0xcd, 0x2d, 0x28, 0xa9,
-	0x0c, 0x82, 0x99, 0x27, 0x14, 0xcb, 0xc5, 0x91, 0x98, 0x9c, 0x9c, 0x5f, 0x9a, 0x57, 0x52, 0x2c,
-	0xc1, 0x04, 0x36, 0x5b, 0x15, 0xbf, 0xd9, 0x8e, 0x10, 0xd5, 0x4e, 0x52, 0x50, 0xc3, 0x85, 0x60,
-	0xda, 0x91, 0x4c, 0x87, 0x1b, 0xe9, 0xe4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x99, 0xf9,
-	0x65, 0xba, 0xf9, 0x79, 0xa9, 0xf0, 0x50, 0x49, 0xd1, 0xaf, 0x40, 0x84, 0x10, 0x38, 0x64, 0x92,
-	0xd8, 0xc0, 0x41, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xca, 0x52, 0xe0, 0x19, 0x96, 0x01,
-	0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0xcb, 0xd3, 0x30,
+	0x18, 0xc7, 0x5b, 0x5f, 0x37, 0x25, 0x13, 0x87, 0x61, 0x42, 0x37, 0x66, 0x37, 0x87, 0xc2, 0x10,
+	0xd7, 0x32, 0xfd, 0x04, 0x56, 0x65, 0x1e, 0x87, 0x9e, 0x14, 0x64, 0x64, 0x6d, 0xe8, 0x82, 0x26,
+	0x29, 0x4d, 0x56, 0xd6, 0x83, 0xdf, 0xc1, 0x9b, 0x5f, 0x69, 0xc7, 0x1d, 0x3d, 0x0d, 0xd9, 0x6e,
+	0x7e, 0x0a, 0x59, 0x9a, 0x76, 0x71, 0xbc, 0x94, 0xdd, 0x1e, 0xc8, 0xff, 0xff, 0xfb, 0x91, 0x87,
+	0x07, 0x8c, 0x08, 0xcf, 0x7c, 0x21, 0x51, 0xca, 0x10, 0xc5, 0x7e, 0x36, 0x5d, 0x62, 0x89, 0xa6,
+	0x7e, 0x8c, 0x19, 0x16, 0x44, 0x78, 0x49, 0xca, 0x25, 0x87, 0xfd, 0xf2, 0x3d, 0xf2, 0x36, 0x5e,
+	0x39, 0x7b, 0x3a, 0xdb, 0xeb, 0xc4, 0x3c, 0xe6, 0x2a, 0xe8, 0x9f, 0xa6, 0xa2, 0xd3, 0x1b, 0xde,
+	0xca, 0x95, 0x79, 0x82, 0x35, 0x75, 0xf4, 0xab, 0x01, 0x1e, 0xcc, 0x0a, 0xcf, 0x27, 0x89, 0x24,
+	0x86, 0x9f, 0xc1, 0xbd, 0x88, 0x53, 0x44, 0x98, 0x70, 0xec, 0xe1, 0xcd, 0xb8, 0xf5, 0xea, 0x99,
+	0x57, 0x27, 0xf6, 0xde, 0xa9, 0x70, 0xd0, 0xdd, 0xee, 0x07, 0xd6, 0xdf, 0xfd, 0xe0, 0x91, 0x2e,
+	0xbf, 0xe4, 0x94, 0x48, 0x4c, 0x13, 0x99, 0x7f, 0x2c, 0x79, 0xf0, 0x2b, 0xb8, 0x8f, 0xc2, 0x90,
+	0xaf, 0x99, 0x14, 0xce, 0x1d, 0xc5, 0x7e, 0x5e, 0xcf, 0x7e, 0x53, 0xa4, 0x83, 0x9e, 0x86, 0xc3,
+	0xb2, 0x6e, 0xd0, 0x2b, 0x24, 0x24, 0xa0, 0x15, 0x72, 0x4a, 0x89, 0xa4, 0xf8, 0x64, 0xb8, 0x51,
+	0x86, 0x71, 0xbd, 0xe1, 0x6d, 0x55, 0x08, 0x9e, 0x68, 0xc9, 0x63, 0x03, 0x62, 0x78, 0x4c, 0x36,
+	0xcc, 0x40, 0xbb, 0xf8, 0xd4, 0x22, 0xe1, 0xdf, 0x49, 0x48, 0xb0, 0x70, 0xee, 0x2a, 0xdd, 0x8b,
+	0x6b, 0x96, 0x35, 0x3f, 0x75, 0xf2, 0xe0, 0xa9, 0x16, 0x76, 0x2f, 0x50, 0x86, 0xf4, 0x61, 0x74,
+	0x2e, 0x10, 0x2c, 0xe0, 0x0f, 0xd0, 0xd6, 0xdf, 0x5d, 0xac, 0x88, 0x90, 0x3c, 0xcd, 0x9d, 0x86,
+	0xf2, 0x4e, 0xaf, 0x5a, 0xe4, 0x87, 0xa2, 0xf3, 0x9e, 0xc9, 0xd4, 0xd0, 0x5f, 0x10, 0x4d, 0x3d,
+	0xfa, 0xaf, 0x07, 0xbf, 0x01, 0x90, 0xa4, 0x3c, 0xc3, 0x0c, 0xb1, 0x10, 0x3b, 0x4d, 0x65, 0x9e,
+	0xd4, 0x9b, 0xe7, 0x55, 0xbe, 0xb0, 0xf6, 0xb5, 0xb5, 0x73, 0x06, 0x19, 0x42, 0x03, 0x1f, 0xcc,
+	0xb6, 0x07, 0xd7, 0xde, 0x1d, 0x5c, 0xfb, 0xcf, 0xc1, 0xb5, 0x7f, 0x1e, 0x5d, 0x6b, 0x77, 0x74,
+	0xad, 0xdf, 0x47, 0xd7, 0xfa, 0x32, 0x89, 0x89, 0x5c, 0xad, 0x97, 0x5e, 0xc8, 0xa9, 0x4f, 0x78,
+	0x36, 0xe1, 0x0c, 0x57, 0x47, 0x1e, 0xf9, 0x9b, 0x6a, 0x2e, 0x0e, 0x7d, 0xd9, 0x54, 0x97, 0xfe,
+	0xfa, 0xdf, 0x00, 0x4d, 0x0b, 0xd4, 0xc0, 0x65, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +165,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccountHistory) > 0 {
+		for iNdEx := len(m.AccountHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DomainPolicies) > 0 {
+		for iNdEx := len(m.DomainPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -185,6 +281,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DomainPolicies) > 0 {
+		for _, e := range m.DomainPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountHistory) > 0 {
+		for _, e := range m.AccountHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -291,6 +411,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, Commitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainPolicies = append(m.DomainPolicies, DomainPolicy{})
+			if err := m.DomainPolicies[len(m.DomainPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountHistory = append(m.AccountHistory, AccountHistoryEntry{})
+			if err := m.AccountHistory[len(m.AccountHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, ProvenanceEntry{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
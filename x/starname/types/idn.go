@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// nameProfile maps names as UTS-46 lookups do, case folding and NFC normalizing them and decoding punycode labels,
+// without the hostname restrictions on the characters and hyphens the name regexps already take care of
+var nameProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+	idna.CheckHyphens(false),
+)
+
+// NormalizeName returns the normalized form of a domain or an account name, the form names are stored in,
+// names given in punycode are returned in their unicode form
+func NormalizeName(name string) (string, error) {
+	if name == "" {
+		return name, nil
+	}
+	normalized, err := nameProfile.ToUnicode(name)
+	if err != nil {
+		return "", fmt.Errorf("%s can not be normalized: %s", name, err)
+	}
+	return normalized, nil
+}
+
+// NormalizeNameOrKeep returns the normalized form of a name or the name itself if it can not be normalized,
+// names that can not be normalized are never valid so they are left to be rejected by the name validation
+func NormalizeNameOrKeep(name string) string {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return name
+	}
+	return normalized
+}
+
+// NormalizeNames replaces the provided domain and account names by their normalized form, the form names are looked up
+// and stored in, keeping the names which can not be normalized. The msg server normalizes the names of the messages
+// with it before handling them, so that the handlers and the controllers only deal with normalized names.
+func NormalizeNames(names ...*string) {
+	for _, name := range names {
+		*name = NormalizeNameOrKeep(*name)
+	}
+}
+
+// ValidateNormalizedName checks that a name is in its normalized form and that its letters are written in the allowed scripts
+func ValidateNormalizedName(name string, allowedScripts []string) error {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return err
+	}
+	if normalized != name {
+		return fmt.Errorf("%s is not normalized, expected %s", name, normalized)
+	}
+	return ValidateNameScripts(name, allowedScripts)
+}
+
+// allowedScriptMixes are the scripts that can be mixed in a name, as in the highly restrictive level of UTS-39,
+// because they are commonly written together and are not confusable with each other
+var allowedScriptMixes = [][]string{
+	{"Han", "Hiragana", "Katakana", "Latin"},
+	{"Bopomofo", "Han", "Latin"},
+	{"Han", "Hangul", "Latin"},
+}
+
+//go:generate go run gen_scripts.go -version 17.0.0
+
+// scriptRange is a range of runes belonging to the script at index script of scriptNames. The scripts are pinned
+// to the unicode version ScriptsUnicodeVersion, instead of the unicode version of the go toolchain the nodes are built
+// with, so that all the nodes validate the names the same way.
+type scriptRange struct {
+	lo, hi rune
+	script uint8
+}
+
+// runeScript returns the script of a rune, or an empty string if it is shared between scripts or belongs to none
+func runeScript(r rune) string {
+	i := sort.Search(len(scriptRanges), func(i int) bool { return scriptRanges[i].hi >= r })
+	if i < len(scriptRanges) && scriptRanges[i].lo <= r {
+		return scriptNames[scriptRanges[i].script]
+	}
+	return ""
+}
+
+// IsNameScript tells whether a script is one of the scripts the letters of the names are written in
+func IsNameScript(script string) bool {
+	i := sort.SearchStrings(scriptNames[:], script)
+	return i < len(scriptNames) && scriptNames[i] == script
+}
+
+// NameScripts returns the sorted scripts the letters of a name are written in,
+// characters shared between scripts such as digits and punctuation are not considered
+func NameScripts(name string) []string {
+	set := make(map[string]struct{})
+	for _, r := range name {
+		if script := runeScript(r); script != "" {
+			set[script] = struct{}{}
+		}
+	}
+	scripts := make([]string, 0, len(set))
+	for script := range set {
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// ValidateNameScripts checks that the letters of a name are written in the allowed scripts, any script is allowed
+// if none is provided, and that the name does not mix scripts, which would allow confusables such as a latin
+// name containing a cyrillic 'а', unless the scripts are commonly written together
+func ValidateNameScripts(name string, allowed []string) error {
+	scripts := NameScripts(name)
+	if len(allowed) != 0 {
+		for _, script := range scripts {
+			if !containsString(allowed, script) {
+				return fmt.Errorf("%s contains %s characters which are not allowed", name, script)
+			}
+		}
+	}
+	if len(scripts) <= 1 {
+		return nil
+	}
+	for _, mix := range allowedScriptMixes {
+		mixed := true
+		for _, script := range scripts {
+			if !containsString(mix, script) {
+				mixed = false
+				break
+			}
+		}
+		if mixed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s mixes the %s scripts", name, strings.Join(scripts, ", "))
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
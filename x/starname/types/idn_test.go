@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestNormalizeName(t *testing.T) {
+	cases := map[string]string{
+		"alice":         "alice",
+		"ALICE":         "alice",
+		"Straße":        "straße",
+		"ｆｕｌｌ":          "full",
+		"e\u0301toile":  "étoile",
+		"xn--toile-9ra": "étoile",
+		"*":             "*",
+		"-_.":           "-_.",
+		"":              "",
+		"日本":            "日本",
+		"under_score-1": "under_score-1",
+	}
+	for name, expected := range cases {
+		got, err := NormalizeName(name)
+		if err != nil {
+			t.Fatalf("NormalizeName(%q) got error: %s", name, err)
+		}
+		if got != expected {
+			t.Fatalf("NormalizeName(%q) expected %q, got %q", name, expected, got)
+		}
+	}
+	if _, err := NormalizeName("xn--a"); err == nil {
+		t.Fatal("NormalizeName() expected an error on invalid punycode")
+	}
+	if got := NormalizeNameOrKeep("xn--a"); got != "xn--a" {
+		t.Fatalf("NormalizeNameOrKeep() expected the name to be kept, got %q", got)
+	}
+}
+
+func TestValidateNameScripts(t *testing.T) {
+	cases := map[string]struct {
+		Name    string
+		Allowed []string
+		Valid   bool
+	}{
+		"ascii":                  {Name: "alice-1", Valid: true},
+		"greek":                  {Name: "βόλος", Valid: true},
+		"allowed script":         {Name: "βόλος", Allowed: []string{"Greek"}, Valid: true},
+		"disallowed script":      {Name: "βόλος", Allowed: []string{"Latin"}},
+		"latin cyrillic a":       {Name: "pаypal"},
+		"latin greek omicron":    {Name: "gοogle"},
+		"japanese with latin":    {Name: "東京tokyoとうトウ", Valid: true},
+		"korean with latin":      {Name: "서울seoul漢", Valid: true},
+		"hiragana with hangul":   {Name: "とう서울"},
+		"digits with any script": {Name: "βόλος2000", Allowed: []string{"Greek"}, Valid: true},
+		"no letters":             {Name: "1234", Allowed: []string{"Latin"}, Valid: true},
+		"combining mark":         {Name: "e\u0301", Allowed: []string{"Latin"}, Valid: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateNameScripts(c.Name, c.Allowed)
+			if c.Valid && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !c.Valid && err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestRuneScript(t *testing.T) {
+	cases := map[rune]string{
+		'a': "Latin", 'é': "Latin", 'а': "Cyrillic", 'ο': "Greek", '東': "Han", 'と': "Hiragana", '서': "Hangul",
+		'ㄅ': "Bopomofo", '1': "", '-': "", '\u0301': "", 0x20000: "Han", 0x1F600: "", 0x10FFFF: "",
+	}
+	for r, expected := range cases {
+		if got := runeScript(r); got != expected {
+			t.Fatalf("runeScript(%U) expected %q, got %q", r, expected, got)
+		}
+	}
+}
+
+func TestScriptRanges(t *testing.T) {
+	for i, sr := range scriptRanges {
+		if sr.lo > sr.hi || (i > 0 && scriptRanges[i-1].hi >= sr.lo) {
+			t.Fatalf("script range %d [%U, %U] is not sorted", i, sr.lo, sr.hi)
+		}
+	}
+	if unicode.Version != ScriptsUnicodeVersion {
+		t.Skipf("the go toolchain provides the unicode %s scripts, the table is pinned to unicode %s", unicode.Version, ScriptsUnicodeVersion)
+	}
+	// the pinned table agrees with the unicode scripts of its version
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		expected := ""
+		if !unicode.In(r, unicode.Common, unicode.Inherited) {
+			for script, table := range unicode.Scripts {
+				if unicode.Is(table, r) {
+					expected = script
+					break
+				}
+			}
+		}
+		if got := runeScript(r); got != expected {
+			t.Fatalf("runeScript(%U) expected %q, got %q", r, expected, got)
+		}
+	}
+}
+
+func TestIsNameScript(t *testing.T) {
+	for script, expected := range map[string]bool{"Latin": true, "Han": true, "Adlam": true, "Yi": true, "Common": false, "Inherited": false, "Klingon": false, "": false} {
+		if got := IsNameScript(script); got != expected {
+			t.Fatalf("IsNameScript(%q) expected %t, got %t", script, expected, got)
+		}
+	}
+}
+
+func TestValidateNormalizedName(t *testing.T) {
+	if err := ValidateNormalizedName("étoile", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, name := range []string{"Alice", "e\u0301toile", "xn--toile-9ra", "pаypal"} {
+		if err := ValidateNormalizedName(name, nil); err == nil {
+			t.Fatalf("expected %q to be rejected", name)
+		}
+	}
+}
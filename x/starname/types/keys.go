@@ -15,17 +15,29 @@ const (
 	DefaultParamSpace = ModuleName
 )
 
+// Event types
+const (
+	// EventTypeNormalizedNameTaken is emitted by the name normalization migration for each domain or account whose
+	// normalized name was taken, renamed to the normalized name with a suffix
+	EventTypeNormalizedNameTaken = "normalized_name_taken"
+)
+
 // Event attribute keys
 const (
 	AttributeKeyAccountName             = "account_name"
 	AttributeKeyBroker                  = "broker"
+	AttributeKeyCertificateIssuer       = "certificate_issuer"
+	AttributeKeyCertificateType         = "certificate_type"
+	AttributeKeyCommitment              = "commitment"
 	AttributeKeyDeletedCertificate      = "deleted_certificate"
 	AttributeKeyDomainName              = "domain_name"
 	AttributeKeyDomainType              = "domain_type"
 	AttributeKeyNewCertificate          = "new_certificate"
 	AttributeKeyNewMetadata             = "new_metadata"
 	AttributeKeyNewResources            = "new_resources"
+	AttributeKeyRemovedResources        = "removed_resources"
 	AttributeKeyOwner                   = "owner"
+	AttributeKeyPreviousName            = "previous_name"
 	AttributeKeyRegisterer              = "registerer"
 	AttributeKeyResources               = "resources"
 	AttributeKeyTransferAccountNewOwner = "new_account_owner"
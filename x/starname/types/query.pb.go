@@ -31,6 +31,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StarnameType defines the kind of starnames a search returns
+type StarnameType int32
+
+const (
+	// STARNAME_TYPE_UNSPECIFIED returns both domains and accounts.
+	StarnameType_Unspecified StarnameType = 0
+	// STARNAME_TYPE_DOMAIN returns domains only.
+	StarnameType_Domain StarnameType = 1
+	// STARNAME_TYPE_ACCOUNT returns accounts only.
+	StarnameType_Account StarnameType = 2
+)
+
+var StarnameType_name = map[int32]string{
+	0: "STARNAME_TYPE_UNSPECIFIED",
+	1: "STARNAME_TYPE_DOMAIN",
+	2: "STARNAME_TYPE_ACCOUNT",
+}
+
+var StarnameType_value = map[string]int32{
+	"STARNAME_TYPE_UNSPECIFIED": 0,
+	"STARNAME_TYPE_DOMAIN":      1,
+	"STARNAME_TYPE_ACCOUNT":     2,
+}
+
+func (x StarnameType) String() string {
+	return proto.EnumName(StarnameType_name, int32(x))
+}
+
+func (StarnameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{0}
+}
+
 // QueryDomainRequest is the request type for the Query/Domain RPC method.
 type QueryDomainRequest struct {
 	// Name is the name of the domain.
@@ -195,6 +227,9 @@ var xxx_messageInfo_QueryDomainAccountsResponse proto.InternalMessageInfo
 type QueryStarnameRequest struct {
 	// Starname is the of the form account*domain.
 	Starname string `protobuf:"bytes,1,opt,name=starname,proto3" json:"starname,omitempty" yaml:"starname"`
+	// Resolve defines if the account resources are merged with the default
+	// resources of its domain, otherwise the raw account is returned
+	Resolve bool `protobuf:"varint,2,opt,name=resolve,proto3" json:"resolve,omitempty" yaml:"resolve"`
 }
 
 func (m *QueryStarnameRequest) Reset()         { *m = QueryStarnameRequest{} }
@@ -234,6 +269,9 @@ var xxx_messageInfo_QueryStarnameRequest proto.InternalMessageInfo
 type QueryStarnameResponse struct {
 	// Account is the information associated with the starname.
 	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty" yaml:"account"`
+	// Wildcard defines if the starname is not registered and the account is the
+	// wildcard account of the domain
+	Wildcard bool `protobuf:"varint,2,opt,name=wildcard,proto3" json:"wildcard,omitempty" yaml:"wildcard"`
 }
 
 func (m *QueryStarnameResponse) Reset()         { *m = QueryStarnameResponse{} }
@@ -442,6 +480,9 @@ type QueryResourceAccountsRequest struct {
 	// Resource is the resource of interest.
 	Resource   string             `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty" yaml:"resource"`
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// IncludeInherited defines if the accounts that inherit the resource from
+	// the default resources of their domain are returned too
+	IncludeInherited bool `protobuf:"varint,4,opt,name=include_inherited,json=includeInherited,proto3" json:"include_inherited,omitempty" yaml:"include_inherited"`
 }
 
 func (m *QueryResourceAccountsRequest) Reset()         { *m = QueryResourceAccountsRequest{} }
@@ -682,22 +723,26 @@ func (m *QueryBrokerDomainsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBrokerDomainsResponse proto.InternalMessageInfo
 
-// QueryYieldRequest is the request type for the Query/Yield RPC method.
-type QueryYieldRequest struct {
+// QueryIssuerAccountsRequest is the request type for the Query/IssuerAccounts
+// RPC method.
+type QueryIssuerAccountsRequest struct {
+	// Issuer is the issuer of the certificates.
+	Issuer     string             `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty" yaml:"issuer"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryYieldRequest) Reset()         { *m = QueryYieldRequest{} }
-func (m *QueryYieldRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryYieldRequest) ProtoMessage()    {}
-func (*QueryYieldRequest) Descriptor() ([]byte, []int) {
+func (m *QueryIssuerAccountsRequest) Reset()         { *m = QueryIssuerAccountsRequest{} }
+func (m *QueryIssuerAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAccountsRequest) ProtoMessage()    {}
+func (*QueryIssuerAccountsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6b9113be3f37c9ff, []int{16}
 }
-func (m *QueryYieldRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryIssuerAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIssuerAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryYieldRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIssuerAccountsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -707,35 +752,38 @@ func (m *QueryYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryYieldRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryYieldRequest.Merge(m, src)
+func (m *QueryIssuerAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAccountsRequest.Merge(m, src)
 }
-func (m *QueryYieldRequest) XXX_Size() int {
+func (m *QueryIssuerAccountsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryYieldRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryYieldRequest.DiscardUnknown(m)
+func (m *QueryIssuerAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAccountsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryYieldRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryIssuerAccountsRequest proto.InternalMessageInfo
 
-// QueryYieldResponse is the response type for the Query/Yield RPC method.
-type QueryYieldResponse struct {
-	Yield github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yield"`
+// QueryIssuerAccountsResponse is the response type for the
+// Query/IssuerAccounts RPC method.
+type QueryIssuerAccountsResponse struct {
+	// Accounts is the accounts holding a certificate signed by the issuer.
+	Accounts []*Account          `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty" yaml:"accounts"`
+	Page     *query.PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (m *QueryYieldResponse) Reset()         { *m = QueryYieldResponse{} }
-func (m *QueryYieldResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryYieldResponse) ProtoMessage()    {}
-func (*QueryYieldResponse) Descriptor() ([]byte, []int) {
+func (m *QueryIssuerAccountsResponse) Reset()         { *m = QueryIssuerAccountsResponse{} }
+func (m *QueryIssuerAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAccountsResponse) ProtoMessage()    {}
+func (*QueryIssuerAccountsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6b9113be3f37c9ff, []int{17}
 }
-func (m *QueryYieldResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryIssuerAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIssuerAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryYieldResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIssuerAccountsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -745,1180 +793,1419 @@ func (m *QueryYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryYieldResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryYieldResponse.Merge(m, src)
+func (m *QueryIssuerAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAccountsResponse.Merge(m, src)
 }
-func (m *QueryYieldResponse) XXX_Size() int {
+func (m *QueryIssuerAccountsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryYieldResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryYieldResponse.DiscardUnknown(m)
+func (m *QueryIssuerAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAccountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryYieldResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIssuerAccountsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryDomainRequest)(nil), "starnamed.x.starname.v1beta1.QueryDomainRequest")
-	proto.RegisterType((*QueryDomainResponse)(nil), "starnamed.x.starname.v1beta1.QueryDomainResponse")
-	proto.RegisterType((*QueryDomainAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryDomainAccountsRequest")
-	proto.RegisterType((*QueryDomainAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryDomainAccountsResponse")
-	proto.RegisterType((*QueryStarnameRequest)(nil), "starnamed.x.starname.v1beta1.QueryStarnameRequest")
-	proto.RegisterType((*QueryStarnameResponse)(nil), "starnamed.x.starname.v1beta1.QueryStarnameResponse")
-	proto.RegisterType((*QueryOwnerAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryOwnerAccountsRequest")
-	proto.RegisterType((*QueryOwnerAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryOwnerAccountsResponse")
-	proto.RegisterType((*QueryOwnerDomainsRequest)(nil), "starnamed.x.starname.v1beta1.QueryOwnerDomainsRequest")
-	proto.RegisterType((*QueryOwnerDomainsResponse)(nil), "starnamed.x.starname.v1beta1.QueryOwnerDomainsResponse")
-	proto.RegisterType((*QueryResourceAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryResourceAccountsRequest")
-	proto.RegisterType((*QueryResourceAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryResourceAccountsResponse")
-	proto.RegisterType((*QueryBrokerAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryBrokerAccountsRequest")
-	proto.RegisterType((*QueryBrokerAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryBrokerAccountsResponse")
-	proto.RegisterType((*QueryBrokerDomainsRequest)(nil), "starnamed.x.starname.v1beta1.QueryBrokerDomainsRequest")
-	proto.RegisterType((*QueryBrokerDomainsResponse)(nil), "starnamed.x.starname.v1beta1.QueryBrokerDomainsResponse")
-	proto.RegisterType((*QueryYieldRequest)(nil), "starnamed.x.starname.v1beta1.QueryYieldRequest")
-	proto.RegisterType((*QueryYieldResponse)(nil), "starnamed.x.starname.v1beta1.QueryYieldResponse")
+// QueryVerifyCertificateRequest is the request type for the
+// Query/VerifyCertificate RPC method.
+type QueryVerifyCertificateRequest struct {
+	// Starname is the of the form account*domain.
+	Starname string `protobuf:"bytes,1,opt,name=starname,proto3" json:"starname,omitempty" yaml:"starname"`
+	// Certificate is the raw certificate to verify.
+	Certificate []byte `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty" yaml:"certificate"`
 }
 
-func init() { proto.RegisterFile("iov/starname/v1beta1/query.proto", fileDescriptor_6b9113be3f37c9ff) }
-
-var fileDescriptor_6b9113be3f37c9ff = []byte{
-	// 1031 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x4d, 0x93, 0xa6, 0x2f, 0x3f, 0xda, 0x4e, 0x8a, 0x48, 0x97, 0xe0, 0x0d, 0x43,
-	0xc9, 0x2f, 0x9a, 0x9d, 0xc4, 0x45, 0x6d, 0xda, 0x72, 0xc1, 0x8a, 0xe0, 0x18, 0xd8, 0x4a, 0x48,
-	0xf4, 0xb6, 0xb6, 0x07, 0xb3, 0x6a, 0xbc, 0xe3, 0xee, 0xae, 0x43, 0x23, 0xcb, 0x17, 0x8e, 0x54,
-	0x02, 0x24, 0x24, 0xee, 0xdc, 0x38, 0x80, 0x04, 0xa8, 0x48, 0xf4, 0x3f, 0x08, 0xb7, 0x4a, 0x5c,
-	0x10, 0x07, 0x0b, 0x12, 0xfe, 0x02, 0xff, 0x05, 0x68, 0xe7, 0xc7, 0xc6, 0xbb, 0xfe, 0xc1, 0x3a,
-	0x54, 0x4a, 0x4e, 0xeb, 0xcc, 0xcc, 0x7b, 0xef, 0x33, 0xdf, 0x99, 0x37, 0xef, 0x29, 0xb0, 0xe8,
-	0xf2, 0x3d, 0x1a, 0x84, 0x8e, 0xef, 0x39, 0x35, 0x46, 0xf7, 0x36, 0x4b, 0x2c, 0x74, 0x36, 0xe9,
-	0xa3, 0x06, 0xf3, 0xf7, 0xad, 0xba, 0xcf, 0x43, 0x8e, 0x17, 0xf4, 0x6c, 0xc5, 0x7a, 0x6c, 0xe9,
-	0xdf, 0x96, 0x5a, 0x69, 0x5c, 0xad, 0xf2, 0x2a, 0x17, 0x0b, 0x69, 0xf4, 0x4b, 0xda, 0x18, 0x0b,
-	0x55, 0xce, 0xab, 0xbb, 0x8c, 0x3a, 0x75, 0x97, 0x3a, 0x9e, 0xc7, 0x43, 0x27, 0x74, 0xb9, 0x17,
-	0xa8, 0xd9, 0xfe, 0x31, 0xc3, 0xfd, 0x3a, 0xd3, 0x2b, 0xd6, 0xca, 0x3c, 0xa8, 0xf1, 0x80, 0x96,
-	0x9c, 0x80, 0x49, 0x98, 0x78, 0x59, 0xdd, 0xa9, 0xba, 0x9e, 0x70, 0x27, 0xd7, 0x92, 0x3b, 0x80,
-	0x3f, 0x88, 0x56, 0x6c, 0xf3, 0x9a, 0xe3, 0x7a, 0x36, 0x7b, 0xd4, 0x60, 0x41, 0x88, 0x5f, 0x87,
-	0xf3, 0x91, 0xf7, 0x79, 0xb4, 0x88, 0x56, 0x2e, 0x16, 0x2f, 0x75, 0xda, 0xe6, 0xd4, 0xbe, 0x53,
-	0xdb, 0xbd, 0x4b, 0xa2, 0x51, 0x62, 0x8b, 0x49, 0xf2, 0x31, 0xcc, 0x25, 0x4c, 0x83, 0x3a, 0xf7,
-	0x02, 0x86, 0x77, 0x60, 0xa2, 0x22, 0x46, 0x84, 0xf5, 0x54, 0xe1, 0xba, 0x35, 0x4c, 0x02, 0x4b,
-	0x5a, 0x17, 0xaf, 0x74, 0xda, 0xe6, 0x8c, 0x8c, 0x21, 0xad, 0x89, 0xad, 0xdc, 0x90, 0x2f, 0x11,
-	0x18, 0x5d, 0x81, 0xde, 0x29, 0x97, 0x79, 0xc3, 0x0b, 0x03, 0xcd, 0xba, 0x9a, 0x88, 0x77, 0x71,
-	0x88, 0x27, 0xfc, 0x2e, 0xc0, 0xb1, 0x00, 0xf3, 0xe7, 0x04, 0xde, 0x92, 0x25, 0xd5, 0xb2, 0x22,
-	0xb5, 0x2c, 0x79, 0x74, 0x9a, 0xed, 0x7d, 0xa7, 0xca, 0x54, 0x18, 0xbb, 0xcb, 0x92, 0xfc, 0x8c,
-	0xe0, 0x95, 0xbe, 0x44, 0x4a, 0x82, 0x0f, 0x61, 0xd2, 0x51, 0x63, 0xf3, 0x68, 0x71, 0x6c, 0x65,
-	0xaa, 0xf0, 0xc6, 0x70, 0x11, 0x94, 0x87, 0xe2, 0x5c, 0xa7, 0x6d, 0x5e, 0x92, 0xec, 0xda, 0x01,
-	0xb1, 0x63, 0x5f, 0xf8, 0x1e, 0x9c, 0xaf, 0x3b, 0x55, 0xa6, 0xc8, 0x97, 0xff, 0x93, 0x5c, 0xe2,
-	0xd8, 0xc2, 0x88, 0xbc, 0x07, 0x57, 0x05, 0xf3, 0x7d, 0x15, 0x5c, 0xeb, 0x47, 0x61, 0x52, 0xf3,
-	0x28, 0x05, 0xbb, 0x28, 0xf4, 0x0c, 0xb1, 0xe3, 0x45, 0x64, 0x17, 0x5e, 0x4a, 0x39, 0x52, 0xdb,
-	0xbe, 0x0f, 0x17, 0x14, 0xaa, 0x3a, 0xfa, 0x8c, 0xbb, 0xc6, 0x9d, 0xb6, 0x39, 0x9b, 0xd8, 0x35,
-	0xb1, 0xb5, 0x27, 0xf2, 0x04, 0xc1, 0x35, 0x11, 0x6e, 0xe7, 0x53, 0x8f, 0xf9, 0xe9, 0xc3, 0x5f,
-	0x82, 0x71, 0x1e, 0x8d, 0x2b, 0xf2, 0xcb, 0x9d, 0xb6, 0x39, 0x2d, 0x3d, 0x89, 0x61, 0x62, 0xcb,
-	0xe9, 0x17, 0x76, 0xf2, 0x3f, 0xe9, 0xbb, 0x98, 0xa2, 0x39, 0xcb, 0x07, 0xff, 0x39, 0x82, 0xf9,
-	0x63, 0x66, 0x79, 0x65, 0x4f, 0x4d, 0xc0, 0xef, 0x13, 0xc7, 0x19, 0xc3, 0x28, 0xfd, 0x6c, 0xb8,
-	0x20, 0x53, 0x55, 0xcb, 0x97, 0xed, 0xf1, 0xe8, 0xba, 0x40, 0xca, 0x9c, 0xd8, 0xda, 0xd1, 0xff,
-	0xd3, 0xee, 0x19, 0x82, 0x05, 0x81, 0x6b, 0xb3, 0x80, 0x37, 0xfc, 0x32, 0x4b, 0x5f, 0xc0, 0x45,
-	0x18, 0x6b, 0xf8, 0xae, 0x52, 0x6f, 0xb6, 0xd3, 0x36, 0x41, 0x72, 0x34, 0x7c, 0x97, 0xd8, 0xd1,
-	0x54, 0x94, 0x5f, 0xbe, 0x32, 0x16, 0x0c, 0x89, 0xfc, 0xd2, 0x33, 0xc4, 0x8e, 0x17, 0xa5, 0xa4,
-	0x1e, 0x3b, 0xb1, 0xd4, 0x4f, 0x11, 0xbc, 0x3a, 0x80, 0xfd, 0x2c, 0x5f, 0xd7, 0xf8, 0xb9, 0x2f,
-	0xfa, 0xfc, 0x61, 0x6f, 0xc6, 0xaf, 0xc2, 0x44, 0x49, 0x4c, 0xf4, 0x3e, 0xf7, 0x72, 0x9c, 0xd8,
-	0x6a, 0xc1, 0x8b, 0x7f, 0xee, 0xd3, 0x44, 0x67, 0x59, 0xc6, 0x2f, 0x74, 0xa2, 0x49, 0xe8, 0x54,
-	0xda, 0x9f, 0x82, 0x8a, 0x3f, 0x24, 0xcf, 0xf5, 0xcc, 0xa7, 0xfe, 0x1c, 0x5c, 0x11, 0xb8, 0x1f,
-	0xb9, 0x6c, 0xb7, 0xa2, 0x36, 0x44, 0x1e, 0xa8, 0x76, 0x49, 0x0d, 0x2a, 0xf6, 0x6d, 0x18, 0xdf,
-	0x8f, 0x06, 0x94, 0x98, 0xd6, 0x41, 0xdb, 0xcc, 0xfd, 0xd9, 0x36, 0x97, 0xaa, 0x6e, 0xf8, 0x49,
-	0xa3, 0x64, 0x95, 0x79, 0x8d, 0xaa, 0x96, 0x4c, 0x7e, 0xd6, 0x83, 0xca, 0x43, 0xd5, 0xb1, 0x6d,
-	0xb3, 0xb2, 0x2d, 0x8d, 0x0b, 0xbf, 0x4e, 0xc3, 0xb8, 0x70, 0x8e, 0xbf, 0x41, 0x30, 0x21, 0xf7,
-	0x87, 0x37, 0x86, 0xab, 0xd0, 0xdb, 0xbb, 0x19, 0x9b, 0x23, 0x58, 0x48, 0x7e, 0xb2, 0xfc, 0xd9,
-	0xef, 0xff, 0x7c, 0x7d, 0xee, 0x35, 0x6c, 0xf6, 0xf6, 0x95, 0x52, 0x4a, 0xda, 0x8c, 0x06, 0x5b,
-	0xf8, 0x19, 0x82, 0xd9, 0x64, 0xcf, 0x83, 0xb7, 0x32, 0x87, 0x4b, 0x65, 0xb2, 0x71, 0xe7, 0x04,
-	0x96, 0x0a, 0xb8, 0x20, 0x80, 0x6f, 0xe0, 0xb5, 0x5e, 0x60, 0x9d, 0x3d, 0x31, 0xb9, 0xfc, 0xb6,
-	0xf0, 0xb7, 0x08, 0x26, 0x75, 0xcb, 0x82, 0x0b, 0x19, 0x62, 0xa7, 0x1a, 0x25, 0xe3, 0xe6, 0x48,
-	0x36, 0x8a, 0xf4, 0x86, 0x20, 0x5d, 0xc2, 0xd7, 0x07, 0x92, 0xd2, 0xa6, 0x9e, 0x69, 0xe1, 0xa7,
-	0x08, 0x66, 0x12, 0x9d, 0x05, 0xbe, 0x9d, 0x21, 0x68, 0xbf, 0xce, 0xc8, 0xd8, 0x1a, 0xdd, 0x50,
-	0x21, 0x6f, 0x08, 0xe4, 0x35, 0xbc, 0x32, 0x44, 0x5c, 0xd1, 0x14, 0xd0, 0xa6, 0xf8, 0xb4, 0xf0,
-	0x8f, 0x08, 0xa6, 0xbb, 0xeb, 0x39, 0xbe, 0x95, 0x35, 0x78, 0xf2, 0x59, 0x32, 0x6e, 0x8f, 0x6c,
-	0xa7, 0x98, 0xa9, 0x60, 0x5e, 0xc5, 0xcb, 0x83, 0x6e, 0x70, 0x1a, 0xf9, 0x37, 0x04, 0x97, 0xd3,
-	0x75, 0x11, 0xdf, 0xcd, 0x10, 0x7e, 0x40, 0x23, 0x60, 0xdc, 0x3b, 0x91, 0xad, 0xc2, 0x7f, 0x5b,
-	0xe0, 0xdf, 0xc2, 0x6f, 0x0d, 0x91, 0x5c, 0xf7, 0x07, 0xb4, 0xd9, 0xf0, 0xdd, 0x16, 0x6d, 0xea,
-	0xbf, 0x65, 0x56, 0x26, 0x4b, 0x53, 0xa6, 0xac, 0xec, 0x5b, 0x5f, 0x33, 0x65, 0x65, 0xff, 0x3a,
-	0x98, 0x29, 0x2b, 0x65, 0x51, 0xa1, 0x4d, 0xf9, 0x6d, 0xe1, 0x5f, 0x10, 0xcc, 0x24, 0x0a, 0x42,
-	0xa6, 0x1b, 0xdf, 0xaf, 0xa6, 0x19, 0x5b, 0xa3, 0x1b, 0x2a, 0xf0, 0x4d, 0x01, 0xfe, 0x26, 0x5e,
-	0x1d, 0x7c, 0x7b, 0xd2, 0xdc, 0x4f, 0x10, 0x8c, 0x8b, 0x22, 0x80, 0x69, 0x86, 0xb0, 0xdd, 0x35,
-	0xc4, 0xd8, 0xc8, 0x6e, 0xa0, 0xf8, 0x4c, 0xc1, 0x77, 0x0d, 0xbf, 0xdc, 0xcb, 0x27, 0x4a, 0x47,
-	0x71, 0xe7, 0xe0, 0xef, 0x7c, 0xee, 0xbb, 0xc3, 0x7c, 0xee, 0xe0, 0x30, 0x8f, 0x9e, 0x1f, 0xe6,
-	0xd1, 0x5f, 0x87, 0x79, 0xf4, 0xd5, 0x51, 0x3e, 0xf7, 0xfc, 0x28, 0x9f, 0xfb, 0xe3, 0x28, 0x9f,
-	0x7b, 0xb0, 0xde, 0x55, 0x8b, 0x5c, 0xbe, 0xb7, 0xce, 0x3d, 0x16, 0x3b, 0xab, 0xd0, 0xc7, 0xc7,
-	0x8e, 0x45, 0x59, 0x2a, 0x4d, 0x88, 0xff, 0x0e, 0xdc, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3d,
-	0x61, 0xdb, 0xeb, 0xe1, 0x10, 0x00, 0x00,
+func (m *QueryVerifyCertificateRequest) Reset()         { *m = QueryVerifyCertificateRequest{} }
+func (m *QueryVerifyCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCertificateRequest) ProtoMessage()    {}
+func (*QueryVerifyCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Domain gets a starname's domain info.
-	Domain(ctx context.Context, in *QueryDomainRequest, opts ...grpc.CallOption) (*QueryDomainResponse, error)
-	// DomainAccounts gets accounts associated with a given domain.
-	DomainAccounts(ctx context.Context, in *QueryDomainAccountsRequest, opts ...grpc.CallOption) (*QueryDomainAccountsResponse, error)
-	// Starname gets all the information associated with a starname.
-	Starname(ctx context.Context, in *QueryStarnameRequest, opts ...grpc.CallOption) (*QueryStarnameResponse, error)
-	// OwnerAccounts gets accounts associated with a given owner.
-	OwnerAccounts(ctx context.Context, in *QueryOwnerAccountsRequest, opts ...grpc.CallOption) (*QueryOwnerAccountsResponse, error)
-	// OwnerDomains gets domains associated with a given owner.
-	OwnerDomains(ctx context.Context, in *QueryOwnerDomainsRequest, opts ...grpc.CallOption) (*QueryOwnerDomainsResponse, error)
-	// ResourceAccounts gets accounts associated with a given resource.
-	ResourceAccounts(ctx context.Context, in *QueryResourceAccountsRequest, opts ...grpc.CallOption) (*QueryResourceAccountsResponse, error)
-	// BrokerAccounts gets accounts associated with a given broker.
-	BrokerAccounts(ctx context.Context, in *QueryBrokerAccountsRequest, opts ...grpc.CallOption) (*QueryBrokerAccountsResponse, error)
-	// BrokerDomains gets domains associated with a given broker.
-	BrokerDomains(ctx context.Context, in *QueryBrokerDomainsRequest, opts ...grpc.CallOption) (*QueryBrokerDomainsResponse, error)
-	// Yield estimates and retrieves the annualized yield for delegators
-	Yield(ctx context.Context, in *QueryYieldRequest, opts ...grpc.CallOption) (*QueryYieldResponse, error)
+func (m *QueryVerifyCertificateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVerifyCertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCertificateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryVerifyCertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCertificateRequest.Merge(m, src)
 }
-
-func (c *queryClient) Domain(ctx context.Context, in *QueryDomainRequest, opts ...grpc.CallOption) (*QueryDomainResponse, error) {
-	out := new(QueryDomainResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Domain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVerifyCertificateRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) DomainAccounts(ctx context.Context, in *QueryDomainAccountsRequest, opts ...grpc.CallOption) (*QueryDomainAccountsResponse, error) {
-	out := new(QueryDomainAccountsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/DomainAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVerifyCertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCertificateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Starname(ctx context.Context, in *QueryStarnameRequest, opts ...grpc.CallOption) (*QueryStarnameResponse, error) {
-	out := new(QueryStarnameResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Starname", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryVerifyCertificateRequest proto.InternalMessageInfo
 
-func (c *queryClient) OwnerAccounts(ctx context.Context, in *QueryOwnerAccountsRequest, opts ...grpc.CallOption) (*QueryOwnerAccountsResponse, error) {
-	out := new(QueryOwnerAccountsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/OwnerAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVerifyCertificateResponse is the response type for the
+// Query/VerifyCertificate RPC method.
+type QueryVerifyCertificateResponse struct {
+	// Valid is true if the certificate belongs to the account, its signature is
+	// correct and it has not expired.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty" yaml:"valid"`
+	// Certificate is the certificate stored in the account.
+	Certificate *TypedCertificate `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty" yaml:"certificate"`
+	// Reason explains why the certificate is not valid.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
 }
 
-func (c *queryClient) OwnerDomains(ctx context.Context, in *QueryOwnerDomainsRequest, opts ...grpc.CallOption) (*QueryOwnerDomainsResponse, error) {
-	out := new(QueryOwnerDomainsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/OwnerDomains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVerifyCertificateResponse) Reset()         { *m = QueryVerifyCertificateResponse{} }
+func (m *QueryVerifyCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCertificateResponse) ProtoMessage()    {}
+func (*QueryVerifyCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{19}
 }
-
-func (c *queryClient) ResourceAccounts(ctx context.Context, in *QueryResourceAccountsRequest, opts ...grpc.CallOption) (*QueryResourceAccountsResponse, error) {
-	out := new(QueryResourceAccountsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/ResourceAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVerifyCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) BrokerAccounts(ctx context.Context, in *QueryBrokerAccountsRequest, opts ...grpc.CallOption) (*QueryBrokerAccountsResponse, error) {
-	out := new(QueryBrokerAccountsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/BrokerAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCertificateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) BrokerDomains(ctx context.Context, in *QueryBrokerDomainsRequest, opts ...grpc.CallOption) (*QueryBrokerDomainsResponse, error) {
-	out := new(QueryBrokerDomainsResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/BrokerDomains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVerifyCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCertificateResponse.Merge(m, src)
 }
-
-func (c *queryClient) Yield(ctx context.Context, in *QueryYieldRequest, opts ...grpc.CallOption) (*QueryYieldResponse, error) {
-	out := new(QueryYieldResponse)
-	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Yield", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVerifyCertificateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Domain gets a starname's domain info.
-	Domain(context.Context, *QueryDomainRequest) (*QueryDomainResponse, error)
-	// DomainAccounts gets accounts associated with a given domain.
-	DomainAccounts(context.Context, *QueryDomainAccountsRequest) (*QueryDomainAccountsResponse, error)
-	// Starname gets all the information associated with a starname.
-	Starname(context.Context, *QueryStarnameRequest) (*QueryStarnameResponse, error)
-	// OwnerAccounts gets accounts associated with a given owner.
-	OwnerAccounts(context.Context, *QueryOwnerAccountsRequest) (*QueryOwnerAccountsResponse, error)
-	// OwnerDomains gets domains associated with a given owner.
-	OwnerDomains(context.Context, *QueryOwnerDomainsRequest) (*QueryOwnerDomainsResponse, error)
-	// ResourceAccounts gets accounts associated with a given resource.
-	ResourceAccounts(context.Context, *QueryResourceAccountsRequest) (*QueryResourceAccountsResponse, error)
-	// BrokerAccounts gets accounts associated with a given broker.
-	BrokerAccounts(context.Context, *QueryBrokerAccountsRequest) (*QueryBrokerAccountsResponse, error)
-	// BrokerDomains gets domains associated with a given broker.
-	BrokerDomains(context.Context, *QueryBrokerDomainsRequest) (*QueryBrokerDomainsResponse, error)
-	// Yield estimates and retrieves the annualized yield for delegators
-	Yield(context.Context, *QueryYieldRequest) (*QueryYieldResponse, error)
+func (m *QueryVerifyCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCertificateResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryVerifyCertificateResponse proto.InternalMessageInfo
+
+// QueryDomainPolicyRequest is the request type for the Query/DomainPolicy RPC
+// method.
+type QueryDomainPolicyRequest struct {
+	// Domain is the name of the domain.
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
 }
 
-func (*UnimplementedQueryServer) Domain(ctx context.Context, req *QueryDomainRequest) (*QueryDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Domain not implemented")
+func (m *QueryDomainPolicyRequest) Reset()         { *m = QueryDomainPolicyRequest{} }
+func (m *QueryDomainPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainPolicyRequest) ProtoMessage()    {}
+func (*QueryDomainPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{20}
 }
-func (*UnimplementedQueryServer) DomainAccounts(ctx context.Context, req *QueryDomainAccountsRequest) (*QueryDomainAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DomainAccounts not implemented")
+func (m *QueryDomainPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Starname(ctx context.Context, req *QueryStarnameRequest) (*QueryStarnameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Starname not implemented")
+func (m *QueryDomainPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OwnerAccounts(ctx context.Context, req *QueryOwnerAccountsRequest) (*QueryOwnerAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OwnerAccounts not implemented")
+func (m *QueryDomainPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainPolicyRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OwnerDomains(ctx context.Context, req *QueryOwnerDomainsRequest) (*QueryOwnerDomainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OwnerDomains not implemented")
+func (m *QueryDomainPolicyRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ResourceAccounts(ctx context.Context, req *QueryResourceAccountsRequest) (*QueryResourceAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResourceAccounts not implemented")
+func (m *QueryDomainPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainPolicyRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BrokerAccounts(ctx context.Context, req *QueryBrokerAccountsRequest) (*QueryBrokerAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BrokerAccounts not implemented")
+
+var xxx_messageInfo_QueryDomainPolicyRequest proto.InternalMessageInfo
+
+// QueryDomainPolicyResponse is the response type for the Query/DomainPolicy
+// RPC method.
+type QueryDomainPolicyResponse struct {
+	// Policy is the registration policy of the domain.
+	Policy *DomainPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty" yaml:"policy"`
 }
-func (*UnimplementedQueryServer) BrokerDomains(ctx context.Context, req *QueryBrokerDomainsRequest) (*QueryBrokerDomainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BrokerDomains not implemented")
+
+func (m *QueryDomainPolicyResponse) Reset()         { *m = QueryDomainPolicyResponse{} }
+func (m *QueryDomainPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainPolicyResponse) ProtoMessage()    {}
+func (*QueryDomainPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{21}
 }
-func (*UnimplementedQueryServer) Yield(ctx context.Context, req *QueryYieldRequest) (*QueryYieldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Yield not implemented")
+func (m *QueryDomainPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDomainPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainPolicyResponse.Merge(m, src)
+}
+func (m *QueryDomainPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainPolicyResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryDomainPolicyResponse proto.InternalMessageInfo
+
+// QueryStarnameHistoryRequest is the request type for the
+// Query/StarnameHistory RPC method.
+type QueryStarnameHistoryRequest struct {
+	// Starname is the of the form account*domain.
+	Starname string `protobuf:"bytes,1,opt,name=starname,proto3" json:"starname,omitempty" yaml:"starname"`
+	// From is the unix timestamp the range starts at, inclusive.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty" yaml:"from"`
+	// To is the unix timestamp the range ends at, inclusive, zero for no limit.
+	To int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty" yaml:"to"`
 }
 
-func _Query_Domain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Domain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/Domain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Domain(ctx, req.(*QueryDomainRequest))
+func (m *QueryStarnameHistoryRequest) Reset()         { *m = QueryStarnameHistoryRequest{} }
+func (m *QueryStarnameHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStarnameHistoryRequest) ProtoMessage()    {}
+func (*QueryStarnameHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{22}
+}
+func (m *QueryStarnameHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStarnameHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStarnameHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryStarnameHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStarnameHistoryRequest.Merge(m, src)
+}
+func (m *QueryStarnameHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStarnameHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStarnameHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_DomainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDomainAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DomainAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/DomainAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DomainAccounts(ctx, req.(*QueryDomainAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryStarnameHistoryRequest proto.InternalMessageInfo
+
+// QueryStarnameHistoryResponse is the response type for the
+// Query/StarnameHistory RPC method.
+type QueryStarnameHistoryResponse struct {
+	// Entries are the recorded states of the starname, from the oldest.
+	Entries []AccountHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
 }
 
-func _Query_Starname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStarnameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Starname(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/Starname",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Starname(ctx, req.(*QueryStarnameRequest))
+func (m *QueryStarnameHistoryResponse) Reset()         { *m = QueryStarnameHistoryResponse{} }
+func (m *QueryStarnameHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStarnameHistoryResponse) ProtoMessage()    {}
+func (*QueryStarnameHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{23}
+}
+func (m *QueryStarnameHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStarnameHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStarnameHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryStarnameHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStarnameHistoryResponse.Merge(m, src)
+}
+func (m *QueryStarnameHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStarnameHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStarnameHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_OwnerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOwnerAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OwnerAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/OwnerAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OwnerAccounts(ctx, req.(*QueryOwnerAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryStarnameHistoryResponse proto.InternalMessageInfo
+
+// QueryResolveAtRequest is the request type for the Query/ResolveAt RPC
+// method.
+type QueryResolveAtRequest struct {
+	// Starname is the of the form account*domain.
+	Starname string `protobuf:"bytes,1,opt,name=starname,proto3" json:"starname,omitempty" yaml:"starname"`
+	// Time is the unix timestamp to resolve the starname at.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty" yaml:"time"`
 }
 
-func _Query_OwnerDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOwnerDomainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OwnerDomains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/OwnerDomains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OwnerDomains(ctx, req.(*QueryOwnerDomainsRequest))
+func (m *QueryResolveAtRequest) Reset()         { *m = QueryResolveAtRequest{} }
+func (m *QueryResolveAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveAtRequest) ProtoMessage()    {}
+func (*QueryResolveAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{24}
+}
+func (m *QueryResolveAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryResolveAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveAtRequest.Merge(m, src)
+}
+func (m *QueryResolveAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveAtRequest.DiscardUnknown(m)
 }
 
-func _Query_ResourceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryResourceAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ResourceAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/ResourceAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ResourceAccounts(ctx, req.(*QueryResourceAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryResolveAtRequest proto.InternalMessageInfo
+
+// QueryResolveAtResponse is the response type for the Query/ResolveAt RPC
+// method.
+type QueryResolveAtResponse struct {
+	// Entry is the state the starname resolved to at the requested time.
+	Entry *AccountHistoryEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty" yaml:"entry"`
 }
 
-func _Query_BrokerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBrokerAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BrokerAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/BrokerAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BrokerAccounts(ctx, req.(*QueryBrokerAccountsRequest))
+func (m *QueryResolveAtResponse) Reset()         { *m = QueryResolveAtResponse{} }
+func (m *QueryResolveAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveAtResponse) ProtoMessage()    {}
+func (*QueryResolveAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{25}
+}
+func (m *QueryResolveAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryResolveAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveAtResponse.Merge(m, src)
+}
+func (m *QueryResolveAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveAtResponse.DiscardUnknown(m)
 }
 
-func _Query_BrokerDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBrokerDomainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BrokerDomains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/BrokerDomains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BrokerDomains(ctx, req.(*QueryBrokerDomainsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryResolveAtResponse proto.InternalMessageInfo
+
+// QueryProvenanceRequest is the request type for the Query/Provenance RPC
+// method.
+type QueryProvenanceRequest struct {
+	// Starname is either a domain name or an account of the form account*domain.
+	Starname   string             `protobuf:"bytes,1,opt,name=starname,proto3" json:"starname,omitempty" yaml:"starname"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Yield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryYieldRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Yield(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Query/Yield",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Yield(ctx, req.(*QueryYieldRequest))
+func (m *QueryProvenanceRequest) Reset()         { *m = QueryProvenanceRequest{} }
+func (m *QueryProvenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvenanceRequest) ProtoMessage()    {}
+func (*QueryProvenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{26}
+}
+func (m *QueryProvenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProvenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvenanceRequest.Merge(m, src)
+}
+func (m *QueryProvenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvenanceRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "starnamed.x.starname.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Domain",
-			Handler:    _Query_Domain_Handler,
-		},
-		{
-			MethodName: "DomainAccounts",
-			Handler:    _Query_DomainAccounts_Handler,
-		},
-		{
-			MethodName: "Starname",
-			Handler:    _Query_Starname_Handler,
-		},
-		{
-			MethodName: "OwnerAccounts",
-			Handler:    _Query_OwnerAccounts_Handler,
-		},
-		{
-			MethodName: "OwnerDomains",
-			Handler:    _Query_OwnerDomains_Handler,
-		},
-		{
-			MethodName: "ResourceAccounts",
-			Handler:    _Query_ResourceAccounts_Handler,
-		},
-		{
-			MethodName: "BrokerAccounts",
-			Handler:    _Query_BrokerAccounts_Handler,
-		},
-		{
-			MethodName: "BrokerDomains",
-			Handler:    _Query_BrokerDomains_Handler,
-		},
-		{
-			MethodName: "Yield",
-			Handler:    _Query_Yield_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "iov/starname/v1beta1/query.proto",
-}
+var xxx_messageInfo_QueryProvenanceRequest proto.InternalMessageInfo
 
-func (m *QueryDomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryProvenanceResponse is the response type for the Query/Provenance RPC
+// method.
+type QueryProvenanceResponse struct {
+	// Entries are the provenance entries of the starname, from the oldest.
+	Entries []ProvenanceEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	Page    *query.PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (m *QueryDomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProvenanceResponse) Reset()         { *m = QueryProvenanceResponse{} }
+func (m *QueryProvenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvenanceResponse) ProtoMessage()    {}
+func (*QueryProvenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{27}
 }
-
-func (m *QueryDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryProvenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProvenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProvenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvenanceResponse.Merge(m, src)
+}
+func (m *QueryProvenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvenanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProvenanceResponse proto.InternalMessageInfo
+
+// QuerySearchStarnamesRequest is the request type for the
+// Query/SearchStarnames RPC method.
+type QuerySearchStarnamesRequest struct {
+	// Prefix is the prefix of the names to search for.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty" yaml:"prefix"`
+	// Domain, if not empty, restricts the search to the accounts of the domain.
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
+	// Type restricts the search to domains or accounts.
+	Type StarnameType `protobuf:"varint,3,opt,name=type,proto3,enum=starnamed.x.starname.v1beta1.StarnameType" json:"type,omitempty" yaml:"type"`
+	// Substring defines if the names containing the prefix anywhere match too,
+	// which requires a scan of the whole index. A search scanning more than
+	// 10000 names fails, as does a total count of more than 10000 names.
+	Substring  bool               `protobuf:"varint,4,opt,name=substring,proto3" json:"substring,omitempty" yaml:"substring"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Domain != nil {
-		{
-			size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySearchStarnamesRequest) Reset()         { *m = QuerySearchStarnamesRequest{} }
+func (m *QuerySearchStarnamesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchStarnamesRequest) ProtoMessage()    {}
+func (*QuerySearchStarnamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{28}
+}
+func (m *QuerySearchStarnamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchStarnamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchStarnamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDomainAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySearchStarnamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchStarnamesRequest.Merge(m, src)
+}
+func (m *QuerySearchStarnamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchStarnamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchStarnamesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDomainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySearchStarnamesRequest proto.InternalMessageInfo
+
+// QuerySearchStarnamesResponse is the response type for the
+// Query/SearchStarnames RPC method, domains are paginated before accounts.
+type QuerySearchStarnamesResponse struct {
+	// Domains are the matching domains, ordered by name.
+	Domains []*Domain `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty" yaml:"domains"`
+	// Accounts are the matching accounts, ordered by name then domain.
+	Accounts []*Account          `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty" yaml:"accounts"`
+	Page     *query.PageResponse `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (m *QueryDomainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySearchStarnamesResponse) Reset()         { *m = QuerySearchStarnamesResponse{} }
+func (m *QuerySearchStarnamesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchStarnamesResponse) ProtoMessage()    {}
+func (*QuerySearchStarnamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{29}
+}
+func (m *QuerySearchStarnamesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchStarnamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchStarnamesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDomainAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySearchStarnamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchStarnamesResponse.Merge(m, src)
+}
+func (m *QuerySearchStarnamesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchStarnamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchStarnamesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDomainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySearchStarnamesResponse proto.InternalMessageInfo
+
+// QueryYieldRequest is the request type for the Query/Yield RPC method.
+type QueryYieldRequest struct {
 }
 
-func (m *QueryDomainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != nil {
-		{
-			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryYieldRequest) Reset()         { *m = QueryYieldRequest{} }
+func (m *QueryYieldRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldRequest) ProtoMessage()    {}
+func (*QueryYieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{30}
+}
+func (m *QueryYieldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStarnameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryYieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldRequest.Merge(m, src)
+}
+func (m *QueryYieldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStarnameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryYieldRequest proto.InternalMessageInfo
+
+// QueryYieldResponse is the response type for the Query/Yield RPC method.
+type QueryYieldResponse struct {
+	Yield github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yield"`
 }
 
-func (m *QueryStarnameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Starname) > 0 {
-		i -= len(m.Starname)
-		copy(dAtA[i:], m.Starname)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Starname)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryYieldResponse) Reset()         { *m = QueryYieldResponse{} }
+func (m *QueryYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldResponse) ProtoMessage()    {}
+func (*QueryYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9113be3f37c9ff, []int{31}
+}
+func (m *QueryYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldResponse.Merge(m, src)
+}
+func (m *QueryYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStarnameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryYieldResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("starnamed.x.starname.v1beta1.StarnameType", StarnameType_name, StarnameType_value)
+	proto.RegisterType((*QueryDomainRequest)(nil), "starnamed.x.starname.v1beta1.QueryDomainRequest")
+	proto.RegisterType((*QueryDomainResponse)(nil), "starnamed.x.starname.v1beta1.QueryDomainResponse")
+	proto.RegisterType((*QueryDomainAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryDomainAccountsRequest")
+	proto.RegisterType((*QueryDomainAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryDomainAccountsResponse")
+	proto.RegisterType((*QueryStarnameRequest)(nil), "starnamed.x.starname.v1beta1.QueryStarnameRequest")
+	proto.RegisterType((*QueryStarnameResponse)(nil), "starnamed.x.starname.v1beta1.QueryStarnameResponse")
+	proto.RegisterType((*QueryOwnerAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryOwnerAccountsRequest")
+	proto.RegisterType((*QueryOwnerAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryOwnerAccountsResponse")
+	proto.RegisterType((*QueryOwnerDomainsRequest)(nil), "starnamed.x.starname.v1beta1.QueryOwnerDomainsRequest")
+	proto.RegisterType((*QueryOwnerDomainsResponse)(nil), "starnamed.x.starname.v1beta1.QueryOwnerDomainsResponse")
+	proto.RegisterType((*QueryResourceAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryResourceAccountsRequest")
+	proto.RegisterType((*QueryResourceAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryResourceAccountsResponse")
+	proto.RegisterType((*QueryBrokerAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryBrokerAccountsRequest")
+	proto.RegisterType((*QueryBrokerAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryBrokerAccountsResponse")
+	proto.RegisterType((*QueryBrokerDomainsRequest)(nil), "starnamed.x.starname.v1beta1.QueryBrokerDomainsRequest")
+	proto.RegisterType((*QueryBrokerDomainsResponse)(nil), "starnamed.x.starname.v1beta1.QueryBrokerDomainsResponse")
+	proto.RegisterType((*QueryIssuerAccountsRequest)(nil), "starnamed.x.starname.v1beta1.QueryIssuerAccountsRequest")
+	proto.RegisterType((*QueryIssuerAccountsResponse)(nil), "starnamed.x.starname.v1beta1.QueryIssuerAccountsResponse")
+	proto.RegisterType((*QueryVerifyCertificateRequest)(nil), "starnamed.x.starname.v1beta1.QueryVerifyCertificateRequest")
+	proto.RegisterType((*QueryVerifyCertificateResponse)(nil), "starnamed.x.starname.v1beta1.QueryVerifyCertificateResponse")
+	proto.RegisterType((*QueryDomainPolicyRequest)(nil), "starnamed.x.starname.v1beta1.QueryDomainPolicyRequest")
+	proto.RegisterType((*QueryDomainPolicyResponse)(nil), "starnamed.x.starname.v1beta1.QueryDomainPolicyResponse")
+	proto.RegisterType((*QueryStarnameHistoryRequest)(nil), "starnamed.x.starname.v1beta1.QueryStarnameHistoryRequest")
+	proto.RegisterType((*QueryStarnameHistoryResponse)(nil), "starnamed.x.starname.v1beta1.QueryStarnameHistoryResponse")
+	proto.RegisterType((*QueryResolveAtRequest)(nil), "starnamed.x.starname.v1beta1.QueryResolveAtRequest")
+	proto.RegisterType((*QueryResolveAtResponse)(nil), "starnamed.x.starname.v1beta1.QueryResolveAtResponse")
+	proto.RegisterType((*QueryProvenanceRequest)(nil), "starnamed.x.starname.v1beta1.QueryProvenanceRequest")
+	proto.RegisterType((*QueryProvenanceResponse)(nil), "starnamed.x.starname.v1beta1.QueryProvenanceResponse")
+	proto.RegisterType((*QuerySearchStarnamesRequest)(nil), "starnamed.x.starname.v1beta1.QuerySearchStarnamesRequest")
+	proto.RegisterType((*QuerySearchStarnamesResponse)(nil), "starnamed.x.starname.v1beta1.QuerySearchStarnamesResponse")
+	proto.RegisterType((*QueryYieldRequest)(nil), "starnamed.x.starname.v1beta1.QueryYieldRequest")
+	proto.RegisterType((*QueryYieldResponse)(nil), "starnamed.x.starname.v1beta1.QueryYieldResponse")
+}
+
+func init() { proto.RegisterFile("iov/starname/v1beta1/query.proto", fileDescriptor_6b9113be3f37c9ff) }
+
+var fileDescriptor_6b9113be3f37c9ff = []byte{
+	// 1880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xd7, 0x50, 0xef, 0x4f, 0xb2, 0x2d, 0x8f, 0x1f, 0xa1, 0xb7, 0x0a, 0xc9, 0x4e, 0x5c, 0xd9,
+	0x56, 0x6d, 0xae, 0x25, 0xbb, 0xb6, 0xe5, 0xe4, 0x22, 0x5a, 0x0a, 0xaa, 0x43, 0x2c, 0x75, 0x6d,
+	0x07, 0x70, 0x2e, 0xc6, 0x8a, 0x1c, 0x51, 0x8b, 0x90, 0xbb, 0xcc, 0xee, 0x92, 0x31, 0x41, 0xf0,
+	0xd2, 0x16, 0x68, 0xa1, 0x00, 0x7d, 0xa4, 0x40, 0x6f, 0x3a, 0x14, 0xbd, 0x14, 0x41, 0x5b, 0x34,
+	0x45, 0x8a, 0xa2, 0x97, 0xa0, 0xb7, 0xba, 0xb7, 0x00, 0xbd, 0x14, 0x05, 0x4a, 0xb4, 0x72, 0xff,
+	0x02, 0xfe, 0x05, 0xc5, 0xce, 0x83, 0xdc, 0x07, 0x45, 0x2d, 0x69, 0x05, 0xd6, 0x69, 0xa9, 0x99,
+	0xef, 0xf1, 0x9b, 0xdf, 0x7c, 0xf3, 0xcd, 0x7c, 0x9f, 0x20, 0x63, 0x58, 0x35, 0xd5, 0x71, 0x75,
+	0xdb, 0xd4, 0xcb, 0x54, 0xad, 0x2d, 0x6d, 0x53, 0x57, 0x5f, 0x52, 0x3f, 0xaa, 0x52, 0xbb, 0x9e,
+	0xad, 0xd8, 0x96, 0x6b, 0xe1, 0x79, 0x39, 0x5b, 0xc8, 0x3e, 0xcf, 0xca, 0xdf, 0x59, 0x21, 0xa9,
+	0x9c, 0x2f, 0x5a, 0x45, 0x8b, 0x09, 0xaa, 0xde, 0x2f, 0xae, 0xa3, 0xcc, 0x17, 0x2d, 0xab, 0x58,
+	0xa2, 0xaa, 0x5e, 0x31, 0x54, 0xdd, 0x34, 0x2d, 0x57, 0x77, 0x0d, 0xcb, 0x74, 0xc4, 0x6c, 0x6f,
+	0x9f, 0x6e, 0xbd, 0x42, 0xa5, 0xc4, 0x62, 0xde, 0x72, 0xca, 0x96, 0xa3, 0x6e, 0xeb, 0x0e, 0xe5,
+	0x60, 0x3a, 0x62, 0x15, 0xbd, 0x68, 0x98, 0xcc, 0x1c, 0x97, 0x25, 0x2b, 0x80, 0xbf, 0xe7, 0x49,
+	0xac, 0x59, 0x65, 0xdd, 0x30, 0x35, 0xfa, 0x51, 0x95, 0x3a, 0x2e, 0x7e, 0x0b, 0xc6, 0x3c, 0xeb,
+	0x49, 0x94, 0x41, 0x57, 0xa7, 0x73, 0x67, 0xda, 0xad, 0xf4, 0x4c, 0x5d, 0x2f, 0x97, 0xee, 0x13,
+	0x6f, 0x94, 0x68, 0x6c, 0x92, 0xec, 0xc0, 0xb9, 0x80, 0xaa, 0x53, 0xb1, 0x4c, 0x87, 0xe2, 0x4d,
+	0x98, 0x28, 0xb0, 0x11, 0xa6, 0x3d, 0xb3, 0x7c, 0x39, 0xdb, 0x8f, 0x82, 0x2c, 0xd7, 0xce, 0x9d,
+	0x6d, 0xb7, 0xd2, 0xa7, 0xb8, 0x0f, 0xae, 0x4d, 0x34, 0x61, 0x86, 0xfc, 0x14, 0x81, 0xe2, 0x73,
+	0xb4, 0x9a, 0xcf, 0x5b, 0x55, 0xd3, 0x75, 0x24, 0xd6, 0x6b, 0x01, 0x7f, 0xd3, 0x7d, 0x2c, 0xe1,
+	0x77, 0x01, 0xba, 0x04, 0x24, 0x13, 0x0c, 0xde, 0x42, 0x96, 0xb3, 0x95, 0xf5, 0xd8, 0xca, 0xf2,
+	0xad, 0x93, 0xd8, 0xb6, 0xf4, 0x22, 0x15, 0x6e, 0x34, 0x9f, 0x26, 0xf9, 0x23, 0x82, 0x6f, 0xf4,
+	0x44, 0x24, 0x28, 0x78, 0x1f, 0xa6, 0x74, 0x31, 0x96, 0x44, 0x99, 0xd1, 0xab, 0x33, 0xcb, 0xdf,
+	0xea, 0x4f, 0x82, 0xb0, 0x90, 0x3b, 0xd7, 0x6e, 0xa5, 0xcf, 0x70, 0xec, 0xd2, 0x00, 0xd1, 0x3a,
+	0xb6, 0xf0, 0xdb, 0x30, 0x56, 0xd1, 0x8b, 0x54, 0x20, 0xbf, 0x72, 0x24, 0x72, 0x0e, 0x47, 0x63,
+	0x4a, 0xa4, 0x0a, 0xe7, 0x19, 0xe6, 0x47, 0xc2, 0xb9, 0xe4, 0x4f, 0x85, 0x29, 0x89, 0x47, 0x30,
+	0xe8, 0x43, 0x21, 0x67, 0x88, 0xd6, 0x11, 0xc2, 0xd7, 0x61, 0xd2, 0xa6, 0x8e, 0x55, 0xaa, 0x71,
+	0x20, 0x53, 0x39, 0xdc, 0x6e, 0xa5, 0x4f, 0x73, 0x79, 0x31, 0x41, 0x34, 0x29, 0x42, 0xf6, 0x11,
+	0x5c, 0x08, 0xf9, 0x15, 0x2c, 0x3d, 0x82, 0x49, 0xb1, 0x32, 0x11, 0x29, 0x31, 0x49, 0xf2, 0xb9,
+	0x13, 0xfa, 0x44, 0x93, 0x96, 0xbc, 0xd5, 0x7c, 0x6c, 0x94, 0x0a, 0x79, 0xdd, 0x2e, 0x08, 0x74,
+	0xbe, 0xd5, 0xc8, 0x19, 0xa2, 0x75, 0x84, 0xc8, 0x27, 0x08, 0x2e, 0x31, 0x7c, 0x9b, 0x1f, 0x9b,
+	0xd4, 0x0e, 0x07, 0xd7, 0x02, 0x8c, 0x5b, 0xde, 0xb8, 0x60, 0x66, 0xae, 0xdd, 0x4a, 0xcf, 0x72,
+	0x5b, 0x6c, 0x98, 0x68, 0x7c, 0xfa, 0xd8, 0x22, 0xeb, 0x73, 0x19, 0xeb, 0x21, 0x34, 0x27, 0x39,
+	0xb0, 0xf6, 0x10, 0x24, 0xbb, 0x98, 0xf9, 0x91, 0x78, 0x6d, 0x04, 0xfe, 0x36, 0xb0, 0x9d, 0x1d,
+	0x30, 0x82, 0x3f, 0x0d, 0x26, 0x79, 0x2a, 0x90, 0xf4, 0xc5, 0x4b, 0x4e, 0xbe, 0x88, 0x13, 0xea,
+	0x44, 0x93, 0x86, 0x5e, 0x8d, 0xbb, 0x1f, 0x26, 0x60, 0x9e, 0xc1, 0xd5, 0xa8, 0x63, 0x55, 0xed,
+	0x3c, 0x0d, 0x07, 0x60, 0x06, 0x46, 0xab, 0xb6, 0x21, 0xd8, 0x3b, 0xdd, 0x6e, 0xa5, 0x81, 0xe3,
+	0xa8, 0xda, 0x06, 0xd1, 0xbc, 0x29, 0x2f, 0xe2, 0x6d, 0xa1, 0x9c, 0x4c, 0x84, 0xcf, 0xaf, 0x9c,
+	0x21, 0x5a, 0x47, 0x28, 0x44, 0xf5, 0xe8, 0xb0, 0x54, 0xe3, 0x0d, 0x38, 0x6b, 0x98, 0xf9, 0x52,
+	0xb5, 0x40, 0x9f, 0x19, 0xe6, 0x2e, 0xb5, 0x0d, 0x97, 0x16, 0x92, 0x63, 0xec, 0xcc, 0xcd, 0xb7,
+	0x5b, 0xe9, 0x24, 0x47, 0x10, 0x11, 0x21, 0xda, 0x9c, 0x18, 0xdb, 0xe8, 0x0c, 0x7d, 0x81, 0xe0,
+	0xcd, 0x43, 0x68, 0x38, 0xc9, 0x91, 0xdf, 0xb9, 0x99, 0x72, 0xb6, 0xf5, 0x61, 0x34, 0x79, 0x5c,
+	0x83, 0x89, 0x6d, 0x36, 0x11, 0xbd, 0x99, 0xf8, 0x38, 0xd1, 0x84, 0xc0, 0xf1, 0xdf, 0x4c, 0x61,
+	0x44, 0x27, 0x99, 0xc6, 0x9f, 0xc8, 0x33, 0xcb, 0x41, 0x87, 0x32, 0xc8, 0x6b, 0x60, 0xf1, 0x77,
+	0xc1, 0x7d, 0x3d, 0xf1, 0x59, 0xa4, 0x13, 0x87, 0x1b, 0x8e, 0x53, 0xed, 0x19, 0x87, 0x06, 0x9b,
+	0x88, 0x32, 0xc8, 0xc7, 0x89, 0x26, 0x04, 0x8e, 0x3f, 0x0e, 0xc3, 0x88, 0x4e, 0xf8, 0x45, 0xc6,
+	0xb3, 0xd0, 0xfb, 0xd4, 0x36, 0x76, 0xea, 0x0f, 0xa8, 0xed, 0x1a, 0x3b, 0x46, 0x5e, 0x77, 0x87,
+	0x7f, 0x2b, 0xdd, 0x83, 0x99, 0x7c, 0xd7, 0x0c, 0x83, 0x35, 0x9b, 0xbb, 0xd8, 0x6e, 0xa5, 0x31,
+	0xd7, 0xf1, 0x4d, 0x12, 0xcd, 0x2f, 0x4a, 0xfe, 0x8d, 0x20, 0x75, 0x18, 0x18, 0x41, 0xe2, 0x02,
+	0x8c, 0xd7, 0xf4, 0x92, 0x51, 0x60, 0x50, 0xa6, 0xfc, 0x77, 0x2b, 0x1b, 0x26, 0x1a, 0x9f, 0xc6,
+	0xbb, 0x51, 0x10, 0x33, 0xcb, 0xd9, 0xfe, 0x7c, 0x3f, 0xae, 0x57, 0x68, 0xc1, 0xe7, 0x34, 0x16,
+	0x68, 0x2f, 0xd2, 0x6c, 0xaa, 0x3b, 0xe2, 0x5a, 0x09, 0x44, 0x1a, 0x1f, 0x27, 0x9a, 0x10, 0x20,
+	0xeb, 0xe2, 0xd1, 0xc0, 0x0f, 0xc7, 0x96, 0x55, 0x32, 0xf2, 0xf5, 0xc1, 0x9f, 0xf4, 0xc4, 0x86,
+	0x4b, 0x3d, 0xcc, 0x08, 0x82, 0x9e, 0xc0, 0x44, 0x85, 0x8d, 0x88, 0x07, 0xe6, 0x62, 0x9c, 0x73,
+	0xca, 0x6d, 0xf8, 0x7d, 0x72, 0x1b, 0x44, 0x13, 0xc6, 0xc8, 0xa7, 0x32, 0xb8, 0xe5, 0x93, 0xf6,
+	0xbb, 0x86, 0xe3, 0x5a, 0x76, 0x7d, 0xe8, 0x28, 0x79, 0x0b, 0xc6, 0x76, 0x6c, 0xab, 0xcc, 0x76,
+	0x66, 0xd4, 0x5f, 0x6e, 0x79, 0xa3, 0x44, 0x63, 0x93, 0xf8, 0x4d, 0x48, 0xb8, 0x16, 0xe3, 0x75,
+	0x34, 0x77, 0xaa, 0xdd, 0x4a, 0x4f, 0x73, 0x11, 0xd7, 0x22, 0x5a, 0xc2, 0xb5, 0xc8, 0x0f, 0x10,
+	0xcc, 0xf7, 0x06, 0x25, 0xc8, 0xc8, 0xc3, 0x24, 0x35, 0x5d, 0xdb, 0xa0, 0xf2, 0xc4, 0x2d, 0xc5,
+	0x3a, 0x71, 0xc2, 0xcc, 0xba, 0xe9, 0xda, 0xf5, 0xdc, 0xc5, 0x17, 0xad, 0xf4, 0x48, 0x37, 0x8d,
+	0x09, 0x7b, 0x44, 0x93, 0x96, 0x49, 0x19, 0x2e, 0x74, 0xee, 0xf1, 0x52, 0x8d, 0xae, 0xba, 0xaf,
+	0xc2, 0x89, 0x6b, 0x94, 0x69, 0x94, 0x13, 0x6f, 0x94, 0x68, 0x6c, 0x92, 0x38, 0x70, 0x31, 0xec,
+	0x4e, 0xac, 0xf6, 0x29, 0x8c, 0x7b, 0x98, 0xe4, 0xce, 0x0f, 0xb1, 0x56, 0xdf, 0x71, 0x62, 0x96,
+	0x88, 0xc6, 0x2d, 0x92, 0x9f, 0x23, 0xe1, 0x75, 0xcb, 0xb6, 0x6a, 0xd4, 0xd4, 0xcd, 0xfc, 0xf0,
+	0xf9, 0xe1, 0xb8, 0xf2, 0xed, 0x9f, 0x11, 0xbc, 0x11, 0xc1, 0x24, 0xa8, 0x78, 0x16, 0xde, 0xf8,
+	0x1b, 0xfd, 0xc9, 0xe8, 0x9a, 0x88, 0xb7, 0xe9, 0xaf, 0x96, 0x74, 0xff, 0x9a, 0x90, 0x87, 0x89,
+	0xea, 0x76, 0x7e, 0x57, 0x46, 0xaf, 0xff, 0xf2, 0xaa, 0xd8, 0x74, 0xc7, 0x78, 0x1e, 0xcd, 0x05,
+	0x7c, 0xdc, 0x3b, 0x97, 0xec, 0x87, 0x2f, 0x6d, 0x24, 0x8e, 0xea, 0x04, 0x6c, 0xc2, 0x98, 0xd7,
+	0x31, 0x61, 0xc7, 0xe9, 0xf4, 0x51, 0x79, 0x41, 0x62, 0xf2, 0x72, 0x62, 0x20, 0x12, 0xeb, 0x15,
+	0x16, 0x89, 0xf5, 0x0a, 0xc5, 0xcb, 0x30, 0xed, 0x54, 0xb7, 0x1d, 0xd7, 0x36, 0xcc, 0xa2, 0x78,
+	0x04, 0x9f, 0x6f, 0xb7, 0xd2, 0x73, 0x62, 0xeb, 0xe5, 0x14, 0xd1, 0xba, 0x62, 0xa1, 0xcd, 0x1f,
+	0x1f, 0x7a, 0xf3, 0x7f, 0x24, 0x8b, 0x88, 0x08, 0x85, 0x5f, 0xe3, 0x83, 0xc5, 0x7f, 0x83, 0x27,
+	0xbe, 0x86, 0x1b, 0x7c, 0x74, 0x98, 0x60, 0x3a, 0x07, 0x67, 0x19, 0x11, 0x4f, 0x0d, 0x5a, 0x2a,
+	0x08, 0xaa, 0xc8, 0x07, 0x80, 0xfd, 0x83, 0x82, 0x93, 0x35, 0x18, 0xaf, 0x7b, 0x03, 0x22, 0xac,
+	0xb2, 0x5e, 0x90, 0xff, 0xab, 0x95, 0x5e, 0x28, 0x1a, 0xee, 0x6e, 0x75, 0x3b, 0x9b, 0xb7, 0xca,
+	0x2a, 0x77, 0x2d, 0x3e, 0x37, 0x9c, 0xc2, 0x87, 0xa2, 0xcb, 0xb6, 0x46, 0xf3, 0x1a, 0x57, 0x5e,
+	0xfc, 0x14, 0xc1, 0xac, 0x3f, 0x3c, 0x70, 0x16, 0x2e, 0x3d, 0x7a, 0xbc, 0xaa, 0x3d, 0x5c, 0x7d,
+	0x6f, 0xfd, 0xd9, 0xe3, 0xa7, 0x5b, 0xeb, 0xcf, 0x9e, 0x3c, 0x7c, 0xb4, 0xb5, 0xfe, 0x60, 0xe3,
+	0xdd, 0x8d, 0xf5, 0xb5, 0xb9, 0x11, 0xe5, 0xcc, 0xde, 0x7e, 0x66, 0xe6, 0x89, 0xe9, 0x54, 0x68,
+	0xde, 0xd8, 0x31, 0x68, 0x01, 0x5f, 0x86, 0xf3, 0x41, 0xf9, 0xb5, 0xcd, 0xf7, 0x56, 0x37, 0x1e,
+	0xce, 0x21, 0x05, 0xf6, 0xf6, 0x33, 0x13, 0x7c, 0x27, 0xf0, 0x02, 0x5c, 0x08, 0x4a, 0xad, 0x3e,
+	0x78, 0xb0, 0xf9, 0xe4, 0xe1, 0xe3, 0xb9, 0x84, 0x32, 0xb3, 0xb7, 0x9f, 0x99, 0x14, 0xdc, 0x2a,
+	0x63, 0x3f, 0xfe, 0x75, 0x0a, 0x2d, 0x7f, 0x79, 0x11, 0xc6, 0xd9, 0x8a, 0xf1, 0x2f, 0x11, 0x48,
+	0x13, 0x37, 0xfb, 0xef, 0x4e, 0xb4, 0x09, 0xa8, 0x2c, 0x0d, 0xa0, 0xc1, 0x49, 0x25, 0x57, 0xbe,
+	0xff, 0x8f, 0xff, 0xfd, 0x22, 0xf1, 0x4d, 0x9c, 0x8e, 0x36, 0x28, 0x79, 0xdc, 0xa8, 0x0d, 0x6f,
+	0xb0, 0x89, 0xff, 0x82, 0xe0, 0x74, 0xb0, 0x79, 0x86, 0xef, 0xc5, 0x76, 0x17, 0x7a, 0xdf, 0x2a,
+	0x2b, 0x43, 0x68, 0x0a, 0xc0, 0xcb, 0x0c, 0xf0, 0x75, 0xbc, 0x18, 0x05, 0x2c, 0x23, 0xb2, 0x83,
+	0x9c, 0x7f, 0x9b, 0xf8, 0x57, 0x08, 0xa6, 0xe4, 0x9e, 0xe3, 0xe5, 0x18, 0xbe, 0x43, 0x1d, 0x37,
+	0xe5, 0xd6, 0x40, 0x3a, 0x02, 0xe9, 0x75, 0x86, 0x74, 0x01, 0x5f, 0x3e, 0x14, 0xa9, 0xda, 0x90,
+	0x33, 0x4d, 0xfc, 0x05, 0x82, 0x53, 0x81, 0x16, 0x12, 0xbe, 0x1b, 0xc3, 0x69, 0xaf, 0x16, 0x98,
+	0x72, 0x6f, 0x70, 0x45, 0x01, 0xf9, 0x26, 0x83, 0xbc, 0x88, 0xaf, 0xf6, 0x21, 0x97, 0x75, 0x7f,
+	0xd4, 0x06, 0xfb, 0x34, 0xf1, 0x1f, 0x10, 0xcc, 0xfa, 0x1b, 0x37, 0xf8, 0x4e, 0x5c, 0xe7, 0xc1,
+	0xa2, 0x51, 0xb9, 0x3b, 0xb0, 0x9e, 0xc0, 0xac, 0x32, 0xcc, 0xd7, 0xf0, 0x95, 0xc3, 0x22, 0x38,
+	0x0c, 0xf9, 0xef, 0x08, 0xe6, 0xc2, 0x5d, 0x0b, 0x7c, 0x3f, 0x86, 0xfb, 0x43, 0x3a, 0x3e, 0xca,
+	0xdb, 0x43, 0xe9, 0x0a, 0xf8, 0xef, 0x30, 0xf8, 0x77, 0xf0, 0xed, 0x3e, 0x94, 0xcb, 0x46, 0x90,
+	0xda, 0xa8, 0xda, 0x46, 0x53, 0x6d, 0xc8, 0xbf, 0xf9, 0xa9, 0x0c, 0x36, 0x0e, 0x62, 0x9d, 0xca,
+	0x9e, 0xdd, 0x0f, 0x65, 0x65, 0x08, 0xcd, 0x01, 0x4e, 0x25, 0x2f, 0xf9, 0xd5, 0x06, 0xff, 0x36,
+	0xf1, 0x9f, 0x10, 0x9c, 0x0a, 0x94, 0xeb, 0xb1, 0x22, 0xbe, 0x57, 0xc7, 0x41, 0xb9, 0x37, 0xb8,
+	0xa2, 0x00, 0xbe, 0xc4, 0x80, 0x7f, 0x1b, 0x5f, 0x3b, 0x3c, 0x7a, 0xc2, 0xb8, 0x3d, 0xce, 0x83,
+	0x45, 0x72, 0x2c, 0xce, 0x7b, 0x56, 0xfa, 0xca, 0xca, 0x10, 0x9a, 0x03, 0x70, 0xce, 0x9b, 0x04,
+	0x6a, 0x83, 0x7f, 0x9b, 0xf8, 0x6f, 0x08, 0xce, 0x46, 0xca, 0x53, 0x1c, 0x27, 0x80, 0x0f, 0xab,
+	0xb0, 0x95, 0x77, 0x86, 0x53, 0x16, 0x8b, 0xb8, 0xcb, 0x16, 0xb1, 0x84, 0xd5, 0xe8, 0x22, 0x7c,
+	0x65, 0xaa, 0x5a, 0x63, 0x06, 0xfc, 0xf9, 0xf2, 0x73, 0x04, 0xb3, 0xfe, 0xf2, 0x2f, 0x56, 0xe2,
+	0xe9, 0x51, 0xba, 0x2a, 0x77, 0x07, 0xd6, 0x3b, 0x3a, 0x59, 0x86, 0x2e, 0x20, 0x95, 0x97, 0xa1,
+	0xf8, 0x4b, 0x04, 0x67, 0x42, 0xc5, 0x1e, 0x5e, 0x19, 0xe0, 0x6a, 0x09, 0x56, 0xad, 0xca, 0xfd,
+	0x61, 0x54, 0x05, 0xf8, 0xdb, 0x0c, 0x7c, 0x16, 0x5f, 0x8f, 0x73, 0x39, 0xa9, 0xbb, 0x02, 0xec,
+	0xef, 0x11, 0x4c, 0x77, 0x2a, 0x37, 0x7c, 0x2b, 0x66, 0xde, 0xf3, 0x97, 0x95, 0xca, 0xed, 0xc1,
+	0x94, 0x04, 0xdc, 0x3b, 0x0c, 0xee, 0x4d, 0x9c, 0x8d, 0x05, 0x57, 0x77, 0xd5, 0x86, 0x57, 0x6d,
+	0x36, 0xf1, 0x67, 0x08, 0xa0, 0x5b, 0x1d, 0xe1, 0x38, 0xce, 0x23, 0x35, 0xa2, 0xf2, 0x9d, 0x01,
+	0xb5, 0x8e, 0xbe, 0x98, 0x2a, 0x1d, 0x69, 0x7f, 0x48, 0x7f, 0xe6, 0x85, 0x47, 0xb0, 0x20, 0x88,
+	0x17, 0x1e, 0x3d, 0xeb, 0x30, 0xe5, 0xfe, 0x30, 0xaa, 0x02, 0x7b, 0x86, 0x61, 0x57, 0x70, 0x32,
+	0x8a, 0xdd, 0x61, 0x2a, 0xf8, 0x13, 0x04, 0xe3, 0xec, 0x7d, 0x8e, 0xd5, 0x18, 0x7e, 0xfc, 0xcf,
+	0x7b, 0xe5, 0x66, 0x7c, 0x05, 0x01, 0x27, 0xcd, 0xe0, 0x5c, 0xc2, 0x6f, 0x44, 0xe1, 0xb0, 0x57,
+	0x7d, 0x6e, 0xf3, 0xc5, 0x7f, 0x53, 0x23, 0xbf, 0x39, 0x48, 0x8d, 0xbc, 0x38, 0x48, 0xa1, 0xaf,
+	0x0e, 0x52, 0xe8, 0x3f, 0x07, 0x29, 0xf4, 0xb3, 0x97, 0xa9, 0x91, 0xaf, 0x5e, 0xa6, 0x46, 0xfe,
+	0xf9, 0x32, 0x35, 0xf2, 0xc1, 0x0d, 0x5f, 0x99, 0x60, 0x58, 0xb5, 0x1b, 0x96, 0x49, 0x3b, 0xc6,
+	0x0a, 0xea, 0xf3, 0xae, 0x61, 0x56, 0x31, 0x6c, 0x4f, 0xb0, 0x7f, 0xb6, 0xdf, 0xfa, 0xff, 0x00,
+	0x90, 0xf0, 0x71, 0x8f, 0x30, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Domain gets a starname's domain info.
+	Domain(ctx context.Context, in *QueryDomainRequest, opts ...grpc.CallOption) (*QueryDomainResponse, error)
+	// DomainAccounts gets accounts associated with a given domain.
+	DomainAccounts(ctx context.Context, in *QueryDomainAccountsRequest, opts ...grpc.CallOption) (*QueryDomainAccountsResponse, error)
+	// Starname gets all the information associated with a starname.
+	Starname(ctx context.Context, in *QueryStarnameRequest, opts ...grpc.CallOption) (*QueryStarnameResponse, error)
+	// OwnerAccounts gets accounts associated with a given owner.
+	OwnerAccounts(ctx context.Context, in *QueryOwnerAccountsRequest, opts ...grpc.CallOption) (*QueryOwnerAccountsResponse, error)
+	// OwnerDomains gets domains associated with a given owner.
+	OwnerDomains(ctx context.Context, in *QueryOwnerDomainsRequest, opts ...grpc.CallOption) (*QueryOwnerDomainsResponse, error)
+	// ResourceAccounts gets accounts associated with a given resource.
+	ResourceAccounts(ctx context.Context, in *QueryResourceAccountsRequest, opts ...grpc.CallOption) (*QueryResourceAccountsResponse, error)
+	// BrokerAccounts gets accounts associated with a given broker.
+	BrokerAccounts(ctx context.Context, in *QueryBrokerAccountsRequest, opts ...grpc.CallOption) (*QueryBrokerAccountsResponse, error)
+	// BrokerDomains gets domains associated with a given broker.
+	BrokerDomains(ctx context.Context, in *QueryBrokerDomainsRequest, opts ...grpc.CallOption) (*QueryBrokerDomainsResponse, error)
+	// IssuerAccounts gets accounts holding a certificate signed by a given
+	// issuer.
+	IssuerAccounts(ctx context.Context, in *QueryIssuerAccountsRequest, opts ...grpc.CallOption) (*QueryIssuerAccountsResponse, error)
+	// VerifyCertificate verifies a certificate held by a starname against the
+	// current block time.
+	VerifyCertificate(ctx context.Context, in *QueryVerifyCertificateRequest, opts ...grpc.CallOption) (*QueryVerifyCertificateResponse, error)
+	// DomainPolicy gets the policy of a domain.
+	DomainPolicy(ctx context.Context, in *QueryDomainPolicyRequest, opts ...grpc.CallOption) (*QueryDomainPolicyResponse, error)
+	// StarnameHistory gets the recorded states of a starname in a time range.
+	StarnameHistory(ctx context.Context, in *QueryStarnameHistoryRequest, opts ...grpc.CallOption) (*QueryStarnameHistoryResponse, error)
+	// ResolveAt gets the state a starname resolved to at a point in time.
+	ResolveAt(ctx context.Context, in *QueryResolveAtRequest, opts ...grpc.CallOption) (*QueryResolveAtResponse, error)
+	// Provenance gets the chain of custody of a domain or an account.
+	Provenance(ctx context.Context, in *QueryProvenanceRequest, opts ...grpc.CallOption) (*QueryProvenanceResponse, error)
+	// SearchStarnames gets the domains and accounts whose name starts with a
+	// prefix.
+	SearchStarnames(ctx context.Context, in *QuerySearchStarnamesRequest, opts ...grpc.CallOption) (*QuerySearchStarnamesResponse, error)
+	// Yield estimates and retrieves the annualized yield for delegators
+	Yield(ctx context.Context, in *QueryYieldRequest, opts ...grpc.CallOption) (*QueryYieldResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Domain(ctx context.Context, in *QueryDomainRequest, opts ...grpc.CallOption) (*QueryDomainResponse, error) {
+	out := new(QueryDomainResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Domain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStarnameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DomainAccounts(ctx context.Context, in *QueryDomainAccountsRequest, opts ...grpc.CallOption) (*QueryDomainAccountsResponse, error) {
+	out := new(QueryDomainAccountsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/DomainAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStarnameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Starname(ctx context.Context, in *QueryStarnameRequest, opts ...grpc.CallOption) (*QueryStarnameResponse, error) {
+	out := new(QueryStarnameResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Starname", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOwnerAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OwnerAccounts(ctx context.Context, in *QueryOwnerAccountsRequest, opts ...grpc.CallOption) (*QueryOwnerAccountsResponse, error) {
+	out := new(QueryOwnerAccountsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/OwnerAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOwnerAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OwnerDomains(ctx context.Context, in *QueryOwnerDomainsRequest, opts ...grpc.CallOption) (*QueryOwnerDomainsResponse, error) {
+	out := new(QueryOwnerDomainsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/OwnerDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOwnerAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ResourceAccounts(ctx context.Context, in *QueryResourceAccountsRequest, opts ...grpc.CallOption) (*QueryResourceAccountsResponse, error) {
+	out := new(QueryResourceAccountsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/ResourceAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOwnerAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BrokerAccounts(ctx context.Context, in *QueryBrokerAccountsRequest, opts ...grpc.CallOption) (*QueryBrokerAccountsResponse, error) {
+	out := new(QueryBrokerAccountsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/BrokerAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOwnerAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BrokerDomains(ctx context.Context, in *QueryBrokerDomainsRequest, opts ...grpc.CallOption) (*QueryBrokerDomainsResponse, error) {
+	out := new(QueryBrokerDomainsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/BrokerDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOwnerAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != nil {
-		{
-			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) IssuerAccounts(ctx context.Context, in *QueryIssuerAccountsRequest, opts ...grpc.CallOption) (*QueryIssuerAccountsResponse, error) {
+	out := new(QueryIssuerAccountsResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/IssuerAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) VerifyCertificate(ctx context.Context, in *QueryVerifyCertificateRequest, opts ...grpc.CallOption) (*QueryVerifyCertificateResponse, error) {
+	out := new(QueryVerifyCertificateResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/VerifyCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOwnerDomainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DomainPolicy(ctx context.Context, in *QueryDomainPolicyRequest, opts ...grpc.CallOption) (*QueryDomainPolicyResponse, error) {
+	out := new(QueryDomainPolicyResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/DomainPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOwnerDomainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOwnerDomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) StarnameHistory(ctx context.Context, in *QueryStarnameHistoryRequest, opts ...grpc.CallOption) (*QueryStarnameHistoryResponse, error) {
+	out := new(QueryStarnameHistoryResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/StarnameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOwnerDomainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ResolveAt(ctx context.Context, in *QueryResolveAtRequest, opts ...grpc.CallOption) (*QueryResolveAtResponse, error) {
+	out := new(QueryResolveAtResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/ResolveAt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOwnerDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Provenance(ctx context.Context, in *QueryProvenanceRequest, opts ...grpc.CallOption) (*QueryProvenanceResponse, error) {
+	out := new(QueryProvenanceResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Provenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOwnerDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != nil {
-		{
-			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domains) > 0 {
-		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) SearchStarnames(ctx context.Context, in *QuerySearchStarnamesRequest, opts ...grpc.CallOption) (*QuerySearchStarnamesResponse, error) {
+	out := new(QuerySearchStarnamesResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/SearchStarnames", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryResourceAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Yield(ctx context.Context, in *QueryYieldRequest, opts ...grpc.CallOption) (*QueryYieldResponse, error) {
+	out := new(QueryYieldResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Query/Yield", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryResourceAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Domain gets a starname's domain info.
+	Domain(context.Context, *QueryDomainRequest) (*QueryDomainResponse, error)
+	// DomainAccounts gets accounts associated with a given domain.
+	DomainAccounts(context.Context, *QueryDomainAccountsRequest) (*QueryDomainAccountsResponse, error)
+	// Starname gets all the information associated with a starname.
+	Starname(context.Context, *QueryStarnameRequest) (*QueryStarnameResponse, error)
+	// OwnerAccounts gets accounts associated with a given owner.
+	OwnerAccounts(context.Context, *QueryOwnerAccountsRequest) (*QueryOwnerAccountsResponse, error)
+	// OwnerDomains gets domains associated with a given owner.
+	OwnerDomains(context.Context, *QueryOwnerDomainsRequest) (*QueryOwnerDomainsResponse, error)
+	// ResourceAccounts gets accounts associated with a given resource.
+	ResourceAccounts(context.Context, *QueryResourceAccountsRequest) (*QueryResourceAccountsResponse, error)
+	// BrokerAccounts gets accounts associated with a given broker.
+	BrokerAccounts(context.Context, *QueryBrokerAccountsRequest) (*QueryBrokerAccountsResponse, error)
+	// BrokerDomains gets domains associated with a given broker.
+	BrokerDomains(context.Context, *QueryBrokerDomainsRequest) (*QueryBrokerDomainsResponse, error)
+	// IssuerAccounts gets accounts holding a certificate signed by a given
+	// issuer.
+	IssuerAccounts(context.Context, *QueryIssuerAccountsRequest) (*QueryIssuerAccountsResponse, error)
+	// VerifyCertificate verifies a certificate held by a starname against the
+	// current block time.
+	VerifyCertificate(context.Context, *QueryVerifyCertificateRequest) (*QueryVerifyCertificateResponse, error)
+	// DomainPolicy gets the policy of a domain.
+	DomainPolicy(context.Context, *QueryDomainPolicyRequest) (*QueryDomainPolicyResponse, error)
+	// StarnameHistory gets the recorded states of a starname in a time range.
+	StarnameHistory(context.Context, *QueryStarnameHistoryRequest) (*QueryStarnameHistoryResponse, error)
+	// ResolveAt gets the state a starname resolved to at a point in time.
+	ResolveAt(context.Context, *QueryResolveAtRequest) (*QueryResolveAtResponse, error)
+	// Provenance gets the chain of custody of a domain or an account.
+	Provenance(context.Context, *QueryProvenanceRequest) (*QueryProvenanceResponse, error)
+	// SearchStarnames gets the domains and accounts whose name starts with a
+	// prefix.
+	SearchStarnames(context.Context, *QuerySearchStarnamesRequest) (*QuerySearchStarnamesResponse, error)
+	// Yield estimates and retrieves the annualized yield for delegators
+	Yield(context.Context, *QueryYieldRequest) (*QueryYieldResponse, error)
 }
 
-func (m *QueryResourceAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryResourceAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Domain(ctx context.Context, req *QueryDomainRequest) (*QueryDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domain not implemented")
+}
+func (*UnimplementedQueryServer) DomainAccounts(ctx context.Context, req *QueryDomainAccountsRequest) (*QueryDomainAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainAccounts not implemented")
+}
+func (*UnimplementedQueryServer) Starname(ctx context.Context, req *QueryStarnameRequest) (*QueryStarnameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Starname not implemented")
+}
+func (*UnimplementedQueryServer) OwnerAccounts(ctx context.Context, req *QueryOwnerAccountsRequest) (*QueryOwnerAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerAccounts not implemented")
+}
+func (*UnimplementedQueryServer) OwnerDomains(ctx context.Context, req *QueryOwnerDomainsRequest) (*QueryOwnerDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerDomains not implemented")
+}
+func (*UnimplementedQueryServer) ResourceAccounts(ctx context.Context, req *QueryResourceAccountsRequest) (*QueryResourceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceAccounts not implemented")
+}
+func (*UnimplementedQueryServer) BrokerAccounts(ctx context.Context, req *QueryBrokerAccountsRequest) (*QueryBrokerAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrokerAccounts not implemented")
+}
+func (*UnimplementedQueryServer) BrokerDomains(ctx context.Context, req *QueryBrokerDomainsRequest) (*QueryBrokerDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrokerDomains not implemented")
+}
+func (*UnimplementedQueryServer) IssuerAccounts(ctx context.Context, req *QueryIssuerAccountsRequest) (*QueryIssuerAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerAccounts not implemented")
+}
+func (*UnimplementedQueryServer) VerifyCertificate(ctx context.Context, req *QueryVerifyCertificateRequest) (*QueryVerifyCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCertificate not implemented")
+}
+func (*UnimplementedQueryServer) DomainPolicy(ctx context.Context, req *QueryDomainPolicyRequest) (*QueryDomainPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainPolicy not implemented")
+}
+func (*UnimplementedQueryServer) StarnameHistory(ctx context.Context, req *QueryStarnameHistoryRequest) (*QueryStarnameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StarnameHistory not implemented")
+}
+func (*UnimplementedQueryServer) ResolveAt(ctx context.Context, req *QueryResolveAtRequest) (*QueryResolveAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAt not implemented")
+}
+func (*UnimplementedQueryServer) Provenance(ctx context.Context, req *QueryProvenanceRequest) (*QueryProvenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provenance not implemented")
+}
+func (*UnimplementedQueryServer) SearchStarnames(ctx context.Context, req *QuerySearchStarnamesRequest) (*QuerySearchStarnamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStarnames not implemented")
+}
+func (*UnimplementedQueryServer) Yield(ctx context.Context, req *QueryYieldRequest) (*QueryYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Yield not implemented")
 }
 
-func (m *QueryResourceAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryResourceAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != nil {
-		{
-			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Domain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Domain(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/Domain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Domain(ctx, req.(*QueryDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DomainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainAccountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DomainAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/DomainAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainAccounts(ctx, req.(*QueryDomainAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Starname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStarnameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Starname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/Starname",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Starname(ctx, req.(*QueryStarnameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OwnerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Broker) > 0 {
-		i -= len(m.Broker)
-		copy(dAtA[i:], m.Broker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Broker)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerAccounts(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/OwnerAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerAccounts(ctx, req.(*QueryOwnerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OwnerDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerDomainsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/OwnerDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerDomains(ctx, req.(*QueryOwnerDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ResourceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/ResourceAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceAccounts(ctx, req.(*QueryResourceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != nil {
-		{
-			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_BrokerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBrokerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BrokerAccounts(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/BrokerAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BrokerAccounts(ctx, req.(*QueryBrokerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerDomainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BrokerDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBrokerDomainsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BrokerDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/BrokerDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BrokerDomains(ctx, req.(*QueryBrokerDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerDomainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IssuerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/IssuerAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerAccounts(ctx, req.(*QueryIssuerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerDomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_VerifyCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Broker) > 0 {
-		i -= len(m.Broker)
-		copy(dAtA[i:], m.Broker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Broker)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCertificate(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/VerifyCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCertificate(ctx, req.(*QueryVerifyCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerDomainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DomainPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DomainPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/DomainPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainPolicy(ctx, req.(*QueryDomainPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StarnameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStarnameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StarnameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/StarnameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StarnameHistory(ctx, req.(*QueryStarnameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBrokerDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != nil {
-		{
-			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ResolveAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Domains) > 0 {
-		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveAt(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/ResolveAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveAt(ctx, req.(*QueryResolveAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryYieldRequest) Marshal() (dAtA []byte, err error) {
+func _Query_Provenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Provenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/Provenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Provenance(ctx, req.(*QueryProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchStarnames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchStarnamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchStarnames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/SearchStarnames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchStarnames(ctx, req.(*QuerySearchStarnamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Yield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Yield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Query/Yield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Yield(ctx, req.(*QueryYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "starnamed.x.starname.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Domain",
+			Handler:    _Query_Domain_Handler,
+		},
+		{
+			MethodName: "DomainAccounts",
+			Handler:    _Query_DomainAccounts_Handler,
+		},
+		{
+			MethodName: "Starname",
+			Handler:    _Query_Starname_Handler,
+		},
+		{
+			MethodName: "OwnerAccounts",
+			Handler:    _Query_OwnerAccounts_Handler,
+		},
+		{
+			MethodName: "OwnerDomains",
+			Handler:    _Query_OwnerDomains_Handler,
+		},
+		{
+			MethodName: "ResourceAccounts",
+			Handler:    _Query_ResourceAccounts_Handler,
+		},
+		{
+			MethodName: "BrokerAccounts",
+			Handler:    _Query_BrokerAccounts_Handler,
+		},
+		{
+			MethodName: "BrokerDomains",
+			Handler:    _Query_BrokerDomains_Handler,
+		},
+		{
+			MethodName: "IssuerAccounts",
+			Handler:    _Query_IssuerAccounts_Handler,
+		},
+		{
+			MethodName: "VerifyCertificate",
+			Handler:    _Query_VerifyCertificate_Handler,
+		},
+		{
+			MethodName: "DomainPolicy",
+			Handler:    _Query_DomainPolicy_Handler,
+		},
+		{
+			MethodName: "StarnameHistory",
+			Handler:    _Query_StarnameHistory_Handler,
+		},
+		{
+			MethodName: "ResolveAt",
+			Handler:    _Query_ResolveAt_Handler,
+		},
+		{
+			MethodName: "Provenance",
+			Handler:    _Query_Provenance_Handler,
+		},
+		{
+			MethodName: "SearchStarnames",
+			Handler:    _Query_SearchStarnames_Handler,
+		},
+		{
+			MethodName: "Yield",
+			Handler:    _Query_Yield_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iov/starname/v1beta1/query.proto",
+}
+
+func (m *QueryDomainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1928,20 +2215,27 @@ func (m *QueryYieldRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryYieldRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDomainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryYieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryYieldResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDomainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1951,339 +2245,3294 @@ func (m *QueryYieldResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDomainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Yield.Size()
-		i -= size
-		if _, err := m.Yield.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Domain != nil {
+		{
+			size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDomainAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Domain != nil {
-		l = m.Domain.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDomainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDomainAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDomainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDomainAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDomainAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Page != nil {
-		l = m.Page.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryStarnameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.Starname)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStarnameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStarnameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOwnerAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStarnameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOwnerAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStarnameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Resolve {
+		i--
+		if m.Resolve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Page != nil {
-		l = m.Page.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Starname) > 0 {
+		i -= len(m.Starname)
+		copy(dAtA[i:], m.Starname)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Starname)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOwnerDomainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStarnameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOwnerDomainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStarnameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStarnameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Domains) > 0 {
-		for _, e := range m.Domains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Wildcard {
+		i--
+		if m.Wildcard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Page != nil {
-		l = m.Page.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResourceAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOwnerAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryResourceAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOwnerAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Page != nil {
-		l = m.Page.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBrokerAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Broker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOwnerAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBrokerAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOwnerAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Page != nil {
-		l = m.Page.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBrokerDomainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOwnerDomainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerDomainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerDomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Broker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBrokerDomainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOwnerDomainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Domains) > 0 {
-		for _, e := range m.Domains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeInherited {
+		i--
+		if m.IncludeInherited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBrokerAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBrokerAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBrokerAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBrokerAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBrokerAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBrokerAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBrokerDomainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBrokerDomainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBrokerDomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBrokerDomainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBrokerDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBrokerDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCertificateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCertificateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCertificateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Starname) > 0 {
+		i -= len(m.Starname)
+		copy(dAtA[i:], m.Starname)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Starname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCertificateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCertificateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCertificateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Certificate != nil {
+		{
+			size, err := m.Certificate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStarnameHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStarnameHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStarnameHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Starname) > 0 {
+		i -= len(m.Starname)
+		copy(dAtA[i:], m.Starname)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Starname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStarnameHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStarnameHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStarnameHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Starname) > 0 {
+		i -= len(m.Starname)
+		copy(dAtA[i:], m.Starname)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Starname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		{
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Starname) > 0 {
+		i -= len(m.Starname)
+		copy(dAtA[i:], m.Starname)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Starname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchStarnamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchStarnamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchStarnamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Substring {
+		i--
+		if m.Substring {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchStarnamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchStarnamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchStarnamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Yield.Size()
+		i -= size
+		if _, err := m.Yield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Domain != nil {
+		l = m.Domain.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStarnameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Starname)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Resolve {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStarnameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Wildcard {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOwnerAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerDomainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerDomainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeInherited {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryResourceAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBrokerAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBrokerAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBrokerDomainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBrokerDomainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCertificateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Starname)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCertificateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.Certificate != nil {
+		l = m.Certificate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStarnameHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Starname)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
+	return n
+}
+
+func (m *QueryStarnameHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Starname)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovQuery(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *QueryResolveAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Starname)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchStarnamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Substring {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchStarnamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Yield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Domain == nil {
+				m.Domain = &Domain{}
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Page == nil {
+				m.Page = &query.PageResponse{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStarnameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStarnameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStarnameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Starname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolve = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStarnameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStarnameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStarnameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wildcard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wildcard = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Page == nil {
+				m.Page = &query.PageResponse{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerDomainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerDomainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerDomainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerDomainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, &Domain{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Page == nil {
+				m.Page = &query.PageResponse{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeInherited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeInherited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Page == nil {
+				m.Page = &query.PageResponse{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Page != nil {
-		l = m.Page.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryYieldRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryYieldResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Yield.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBrokerAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,15 +5555,15 @@ func (m *QueryDomainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBrokerAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBrokerAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2342,7 +5591,43 @@ func (m *QueryDomainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2365,7 +5650,7 @@ func (m *QueryDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBrokerAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2388,15 +5673,15 @@ func (m *QueryDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBrokerAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBrokerAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2423,10 +5708,44 @@ func (m *QueryDomainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Domain == nil {
-				m.Domain = &Domain{}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Page == nil {
+				m.Page = &query.PageResponse{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2451,7 +5770,7 @@ func (m *QueryDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDomainAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBrokerDomainsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2474,15 +5793,15 @@ func (m *QueryDomainAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDomainAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBrokerDomainsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDomainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBrokerDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2510,7 +5829,7 @@ func (m *QueryDomainAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Broker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2569,7 +5888,7 @@ func (m *QueryDomainAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDomainAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBrokerDomainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2592,15 +5911,15 @@ func (m *QueryDomainAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDomainAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBrokerDomainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDomainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBrokerDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2627,8 +5946,8 @@ func (m *QueryDomainAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Domains = append(m.Domains, &Domain{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2689,7 +6008,7 @@ func (m *QueryDomainAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStarnameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuerAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,17 +6031,49 @@ func (m *QueryStarnameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStarnameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuerAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStarnameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuerAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2732,23 +6083,27 @@ func (m *QueryStarnameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Starname = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2771,7 +6126,7 @@ func (m *QueryStarnameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStarnameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuerAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2794,15 +6149,15 @@ func (m *QueryStarnameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStarnameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuerAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStarnameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuerAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2829,10 +6184,44 @@ func (m *QueryStarnameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &Account{}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Page == nil {
+				m.Page = &query.PageResponse{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2857,7 +6246,7 @@ func (m *QueryStarnameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCertificateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2880,15 +6269,15 @@ func (m *QueryOwnerAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCertificateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCertificateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2916,13 +6305,13 @@ func (m *QueryOwnerAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Starname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2932,26 +6321,24 @@ func (m *QueryOwnerAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2975,7 +6362,7 @@ func (m *QueryOwnerAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCertificateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,15 +6385,35 @@ func (m *QueryOwnerAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCertificateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCertificateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3033,16 +6440,18 @@ func (m *QueryOwnerAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Certificate == nil {
+				m.Certificate = &TypedCertificate{}
+			}
+			if err := m.Certificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3052,27 +6461,23 @@ func (m *QueryOwnerAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Page == nil {
-				m.Page = &query.PageResponse{}
-			}
-			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3095,7 +6500,7 @@ func (m *QueryOwnerAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerDomainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,15 +6523,15 @@ func (m *QueryOwnerDomainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerDomainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3154,11 +6559,61 @@ func (m *QueryOwnerDomainsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3185,10 +6640,10 @@ func (m *QueryOwnerDomainsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Policy == nil {
+				m.Policy = &DomainPolicy{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3213,7 +6668,7 @@ func (m *QueryOwnerDomainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerDomainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStarnameHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3236,17 +6691,17 @@ func (m *QueryOwnerDomainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerDomainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStarnameHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStarnameHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3256,29 +6711,115 @@ func (m *QueryOwnerDomainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Starname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStarnameHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domains = append(m.Domains, &Domain{})
-			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStarnameHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStarnameHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3305,10 +6846,8 @@ func (m *QueryOwnerDomainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Page == nil {
-				m.Page = &query.PageResponse{}
-			}
-			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, AccountHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3333,7 +6872,7 @@ func (m *QueryOwnerDomainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResourceAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3356,15 +6895,15 @@ func (m *QueryResourceAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResourceAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResourceAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3392,45 +6931,13 @@ func (m *QueryResourceAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Starname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var msglen int
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3440,28 +6947,11 @@ func (m *QueryResourceAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3483,7 +6973,7 @@ func (m *QueryResourceAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResourceAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3506,49 +6996,15 @@ func (m *QueryResourceAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResourceAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResourceAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3575,10 +7031,10 @@ func (m *QueryResourceAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Page == nil {
-				m.Page = &query.PageResponse{}
+			if m.Entry == nil {
+				m.Entry = &AccountHistoryEntry{}
 			}
-			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3603,7 +7059,7 @@ func (m *QueryResourceAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBrokerAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProvenanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3626,15 +7082,15 @@ func (m *QueryBrokerAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBrokerAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvenanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBrokerAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3662,7 +7118,7 @@ func (m *QueryBrokerAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Broker = string(dAtA[iNdEx:postIndex])
+			m.Starname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3721,7 +7177,7 @@ func (m *QueryBrokerAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBrokerAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProvenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3744,15 +7200,15 @@ func (m *QueryBrokerAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBrokerAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBrokerAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3779,8 +7235,8 @@ func (m *QueryBrokerAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, ProvenanceEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3841,7 +7297,7 @@ func (m *QueryBrokerAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBrokerDomainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchStarnamesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3864,15 +7320,15 @@ func (m *QueryBrokerDomainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBrokerDomainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchStarnamesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBrokerDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchStarnamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3900,9 +7356,80 @@ func (m *QueryBrokerDomainsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Broker = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= StarnameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Substring", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Substring = bool(v != 0)
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3959,7 +7486,7 @@ func (m *QueryBrokerDomainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBrokerDomainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchStarnamesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3982,10 +7509,10 @@ func (m *QueryBrokerDomainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBrokerDomainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchStarnamesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBrokerDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchStarnamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4023,6 +7550,40 @@ func (m *QueryBrokerDomainsResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
@@ -0,0 +1,60 @@
+package types
+
+// UpsertResources returns the resources obtained by replacing the resources with the same URI
+// of the provided ones and by appending the provided resources with new URIs
+func UpsertResources(resources []*Resource, upsert []*Resource) []*Resource {
+	result := make([]*Resource, 0, len(resources)+len(upsert))
+	result = append(result, resources...)
+	for _, resource := range upsert {
+		replaced := false
+		for i, current := range result {
+			if current.URI == resource.URI {
+				result[i] = resource
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+// RemoveResources returns the resources whose URI is not among the provided ones
+func RemoveResources(resources []*Resource, uris []string) []*Resource {
+	remove := make(map[string]struct{}, len(uris))
+	for _, uri := range uris {
+		remove[uri] = struct{}{}
+	}
+	result := make([]*Resource, 0, len(resources))
+	for _, resource := range resources {
+		if _, ok := remove[resource.URI]; !ok {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+// InheritResources returns the resources obtained by appending to the provided resources the
+// default ones whose URI is not already among them
+func InheritResources(resources []*Resource, defaults []*Resource) []*Resource {
+	result := make([]*Resource, 0, len(resources)+len(defaults))
+	result = append(result, resources...)
+	for _, resource := range defaults {
+		if !HasResourceURI(resources, resource.URI) {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+// HasResourceURI checks if the provided resources contain a resource with the given URI
+func HasResourceURI(resources []*Resource, uri string) bool {
+	for _, resource := range resources {
+		if resource.URI == uri {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,964 @@
+// Code generated by running "go generate" in x/starname/types. DO NOT EDIT.
+
+package types
+
+// ScriptsUnicodeVersion is the unicode version of the scripts the letters of the names are validated with
+const ScriptsUnicodeVersion = "17.0.0"
+
+// scriptNames are the unicode scripts other than the Common and Inherited ones, sorted by name
+var scriptNames = [...]string{
+	"Adlam",
+	"Ahom",
+	"Anatolian_Hieroglyphs",
+	"Arabic",
+	"Armenian",
+	"Avestan",
+	"Balinese",
+	"Bamum",
+	"Bassa_Vah",
+	"Batak",
+	"Bengali",
+	"Beria_Erfe",
+	"Bhaiksuki",
+	"Bopomofo",
+	"Brahmi",
+	"Braille",
+	"Buginese",
+	"Buhid",
+	"Canadian_Aboriginal",
+	"Carian",
+	"Caucasian_Albanian",
+	"Chakma",
+	"Cham",
+	"Cherokee",
+	"Chorasmian",
+	"Coptic",
+	"Cuneiform",
+	"Cypriot",
+	"Cypro_Minoan",
+	"Cyrillic",
+	"Deseret",
+	"Devanagari",
+	"Dives_Akuru",
+	"Dogra",
+	"Duployan",
+	"Egyptian_Hieroglyphs",
+	"Elbasan",
+	"Elymaic",
+	"Ethiopic",
+	"Garay",
+	"Georgian",
+	"Glagolitic",
+	"Gothic",
+	"Grantha",
+	"Greek",
+	"Gujarati",
+	"Gunjala_Gondi",
+	"Gurmukhi",
+	"Gurung_Khema",
+	"Han",
+	"Hangul",
+	"Hanifi_Rohingya",
+	"Hanunoo",
+	"Hatran",
+	"Hebrew",
+	"Hiragana",
+	"Imperial_Aramaic",
+	"Inscriptional_Pahlavi",
+	"Inscriptional_Parthian",
+	"Javanese",
+	"Kaithi",
+	"Kannada",
+	"Katakana",
+	"Kawi",
+	"Kayah_Li",
+	"Kharoshthi",
+	"Khitan_Small_Script",
+	"Khmer",
+	"Khojki",
+	"Khudawadi",
+	"Kirat_Rai",
+	"Lao",
+	"Latin",
+	"Lepcha",
+	"Limbu",
+	"Linear_A",
+	"Linear_B",
+	"Lisu",
+	"Lycian",
+	"Lydian",
+	"Mahajani",
+	"Makasar",
+	"Malayalam",
+	"Mandaic",
+	"Manichaean",
+	"Marchen",
+	"Masaram_Gondi",
+	"Medefaidrin",
+	"Meetei_Mayek",
+	"Mende_Kikakui",
+	"Meroitic_Cursive",
+	"Meroitic_Hieroglyphs",
+	"Miao",
+	"Modi",
+	"Mongolian",
+	"Mro",
+	"Multani",
+	"Myanmar",
+	"Nabataean",
+	"Nag_Mundari",
+	"Nandinagari",
+	"New_Tai_Lue",
+	"Newa",
+	"Nko",
+	"Nushu",
+	"Nyiakeng_Puachue_Hmong",
+	"Ogham",
+	"Ol_Chiki",
+	"Ol_Onal",
+	"Old_Hungarian",
+	"Old_Italic",
+	"Old_North_Arabian",
+	"Old_Permic",
+	"Old_Persian",
+	"Old_Sogdian",
+	"Old_South_Arabian",
+	"Old_Turkic",
+	"Old_Uyghur",
+	"Oriya",
+	"Osage",
+	"Osmanya",
+	"Pahawh_Hmong",
+	"Palmyrene",
+	"Pau_Cin_Hau",
+	"Phags_Pa",
+	"Phoenician",
+	"Psalter_Pahlavi",
+	"Rejang",
+	"Runic",
+	"Samaritan",
+	"Saurashtra",
+	"Sharada",
+	"Shavian",
+	"Siddham",
+	"Sidetic",
+	"SignWriting",
+	"Sinhala",
+	"Sogdian",
+	"Sora_Sompeng",
+	"Soyombo",
+	"Sundanese",
+	"Sunuwar",
+	"Syloti_Nagri",
+	"Syriac",
+	"Tagalog",
+	"Tagbanwa",
+	"Tai_Le",
+	"Tai_Tham",
+	"Tai_Viet",
+	"Tai_Yo",
+	"Takri",
+	"Tamil",
+	"Tangsa",
+	"Tangut",
+	"Telugu",
+	"Thaana",
+	"Thai",
+	"Tibetan",
+	"Tifinagh",
+	"Tirhuta",
+	"Todhri",
+	"Tolong_Siki",
+	"Toto",
+	"Tulu_Tigalari",
+	"Ugaritic",
+	"Vai",
+	"Vithkuqi",
+	"Wancho",
+	"Warang_Citi",
+	"Yezidi",
+	"Yi",
+	"Zanabazar_Square",
+}
+
+// scriptRanges are the sorted rune ranges of the scripts, with the index of their script in scriptNames
+var scriptRanges = [...]scriptRange{
+	{0x0041, 0x005A, 72},
+	{0x0061, 0x007A, 72},
+	{0x00AA, 0x00AA, 72},
+	{0x00BA, 0x00BA, 72},
+	{0x00C0, 0x00D6, 72},
+	{0x00D8, 0x00F6, 72},
+	{0x00F8, 0x02B8, 72},
+	{0x02E0, 0x02E4, 72},
+	{0x02EA, 0x02EB, 13},
+	{0x0370, 0x0373, 44},
+	{0x0375, 0x0377, 44},
+	{0x037A, 0x037D, 44},
+	{0x037F, 0x037F, 44},
+	{0x0384, 0x0384, 44},
+	{0x0386, 0x0386, 44},
+	{0x0388, 0x038A, 44},
+	{0x038C, 0x038C, 44},
+	{0x038E, 0x03A1, 44},
+	{0x03A3, 0x03E1, 44},
+	{0x03E2, 0x03EF, 25},
+	{0x03F0, 0x03FF, 44},
+	{0x0400, 0x0484, 29},
+	{0x0487, 0x052F, 29},
+	{0x0531, 0x0556, 4},
+	{0x0559, 0x058A, 4},
+	{0x058D, 0x058F, 4},
+	{0x0591, 0x05C7, 54},
+	{0x05D0, 0x05EA, 54},
+	{0x05EF, 0x05F4, 54},
+	{0x0600, 0x0604, 3},
+	{0x0606, 0x060B, 3},
+	{0x060D, 0x061A, 3},
+	{0x061C, 0x061E, 3},
+	{0x0620, 0x063F, 3},
+	{0x0641, 0x064A, 3},
+	{0x0656, 0x066F, 3},
+	{0x0671, 0x06DC, 3},
+	{0x06DE, 0x06FF, 3},
+	{0x0700, 0x070D, 143},
+	{0x070F, 0x074A, 143},
+	{0x074D, 0x074F, 143},
+	{0x0750, 0x077F, 3},
+	{0x0780, 0x07B1, 155},
+	{0x07C0, 0x07FA, 103},
+	{0x07FD, 0x07FF, 103},
+	{0x0800, 0x082D, 129},
+	{0x0830, 0x083E, 129},
+	{0x0840, 0x085B, 83},
+	{0x085E, 0x085E, 83},
+	{0x0860, 0x086A, 143},
+	{0x0870, 0x0891, 3},
+	{0x0897, 0x08E1, 3},
+	{0x08E3, 0x08FF, 3},
+	{0x0900, 0x0950, 31},
+	{0x0955, 0x0963, 31},
+	{0x0966, 0x097F, 31},
+	{0x0980, 0x0983, 10},
+	{0x0985, 0x098C, 10},
+	{0x098F, 0x0990, 10},
+	{0x0993, 0x09A8, 10},
+	{0x09AA, 0x09B0, 10},
+	{0x09B2, 0x09B2, 10},
+	{0x09B6, 0x09B9, 10},
+	{0x09BC, 0x09C4, 10},
+	{0x09C7, 0x09C8, 10},
+	{0x09CB, 0x09CE, 10},
+	{0x09D7, 0x09D7, 10},
+	{0x09DC, 0x09DD, 10},
+	{0x09DF, 0x09E3, 10},
+	{0x09E6, 0x09FE, 10},
+	{0x0A01, 0x0A03, 47},
+	{0x0A05, 0x0A0A, 47},
+	{0x0A0F, 0x0A10, 47},
+	{0x0A13, 0x0A28, 47},
+	{0x0A2A, 0x0A30, 47},
+	{0x0A32, 0x0A33, 47},
+	{0x0A35, 0x0A36, 47},
+	{0x0A38, 0x0A39, 47},
+	{0x0A3C, 0x0A3C, 47},
+	{0x0A3E, 0x0A42, 47},
+	{0x0A47, 0x0A48, 47},
+	{0x0A4B, 0x0A4D, 47},
+	{0x0A51, 0x0A51, 47},
+	{0x0A59, 0x0A5C, 47},
+	{0x0A5E, 0x0A5E, 47},
+	{0x0A66, 0x0A76, 47},
+	{0x0A81, 0x0A83, 45},
+	{0x0A85, 0x0A8D, 45},
+	{0x0A8F, 0x0A91, 45},
+	{0x0A93, 0x0AA8, 45},
+	{0x0AAA, 0x0AB0, 45},
+	{0x0AB2, 0x0AB3, 45},
+	{0x0AB5, 0x0AB9, 45},
+	{0x0ABC, 0x0AC5, 45},
+	{0x0AC7, 0x0AC9, 45},
+	{0x0ACB, 0x0ACD, 45},
+	{0x0AD0, 0x0AD0, 45},
+	{0x0AE0, 0x0AE3, 45},
+	{0x0AE6, 0x0AF1, 45},
+	{0x0AF9, 0x0AFF, 45},
+	{0x0B01, 0x0B03, 118},
+	{0x0B05, 0x0B0C, 118},
+	{0x0B0F, 0x0B10, 118},
+	{0x0B13, 0x0B28, 118},
+	{0x0B2A, 0x0B30, 118},
+	{0x0B32, 0x0B33, 118},
+	{0x0B35, 0x0B39, 118},
+	{0x0B3C, 0x0B44, 118},
+	{0x0B47, 0x0B48, 118},
+	{0x0B4B, 0x0B4D, 118},
+	{0x0B55, 0x0B57, 118},
+	{0x0B5C, 0x0B5D, 118},
+	{0x0B5F, 0x0B63, 118},
+	{0x0B66, 0x0B77, 118},
+	{0x0B82, 0x0B83, 151},
+	{0x0B85, 0x0B8A, 151},
+	{0x0B8E, 0x0B90, 151},
+	{0x0B92, 0x0B95, 151},
+	{0x0B99, 0x0B9A, 151},
+	{0x0B9C, 0x0B9C, 151},
+	{0x0B9E, 0x0B9F, 151},
+	{0x0BA3, 0x0BA4, 151},
+	{0x0BA8, 0x0BAA, 151},
+	{0x0BAE, 0x0BB9, 151},
+	{0x0BBE, 0x0BC2, 151},
+	{0x0BC6, 0x0BC8, 151},
+	{0x0BCA, 0x0BCD, 151},
+	{0x0BD0, 0x0BD0, 151},
+	{0x0BD7, 0x0BD7, 151},
+	{0x0BE6, 0x0BFA, 151},
+	{0x0C00, 0x0C0C, 154},
+	{0x0C0E, 0x0C10, 154},
+	{0x0C12, 0x0C28, 154},
+	{0x0C2A, 0x0C39, 154},
+	{0x0C3C, 0x0C44, 154},
+	{0x0C46, 0x0C48, 154},
+	{0x0C4A, 0x0C4D, 154},
+	{0x0C55, 0x0C56, 154},
+	{0x0C58, 0x0C5A, 154},
+	{0x0C5C, 0x0C5D, 154},
+	{0x0C60, 0x0C63, 154},
+	{0x0C66, 0x0C6F, 154},
+	{0x0C77, 0x0C7F, 154},
+	{0x0C80, 0x0C8C, 61},
+	{0x0C8E, 0x0C90, 61},
+	{0x0C92, 0x0CA8, 61},
+	{0x0CAA, 0x0CB3, 61},
+	{0x0CB5, 0x0CB9, 61},
+	{0x0CBC, 0x0CC4, 61},
+	{0x0CC6, 0x0CC8, 61},
+	{0x0CCA, 0x0CCD, 61},
+	{0x0CD5, 0x0CD6, 61},
+	{0x0CDC, 0x0CDE, 61},
+	{0x0CE0, 0x0CE3, 61},
+	{0x0CE6, 0x0CEF, 61},
+	{0x0CF1, 0x0CF3, 61},
+	{0x0D00, 0x0D0C, 82},
+	{0x0D0E, 0x0D10, 82},
+	{0x0D12, 0x0D44, 82},
+	{0x0D46, 0x0D48, 82},
+	{0x0D4A, 0x0D4F, 82},
+	{0x0D54, 0x0D63, 82},
+	{0x0D66, 0x0D7F, 82},
+	{0x0D81, 0x0D83, 136},
+	{0x0D85, 0x0D96, 136},
+	{0x0D9A, 0x0DB1, 136},
+	{0x0DB3, 0x0DBB, 136},
+	{0x0DBD, 0x0DBD, 136},
+	{0x0DC0, 0x0DC6, 136},
+	{0x0DCA, 0x0DCA, 136},
+	{0x0DCF, 0x0DD4, 136},
+	{0x0DD6, 0x0DD6, 136},
+	{0x0DD8, 0x0DDF, 136},
+	{0x0DE6, 0x0DEF, 136},
+	{0x0DF2, 0x0DF4, 136},
+	{0x0E01, 0x0E3A, 156},
+	{0x0E40, 0x0E5B, 156},
+	{0x0E81, 0x0E82, 71},
+	{0x0E84, 0x0E84, 71},
+	{0x0E86, 0x0E8A, 71},
+	{0x0E8C, 0x0EA3, 71},
+	{0x0EA5, 0x0EA5, 71},
+	{0x0EA7, 0x0EBD, 71},
+	{0x0EC0, 0x0EC4, 71},
+	{0x0EC6, 0x0EC6, 71},
+	{0x0EC8, 0x0ECE, 71},
+	{0x0ED0, 0x0ED9, 71},
+	{0x0EDC, 0x0EDF, 71},
+	{0x0F00, 0x0F47, 157},
+	{0x0F49, 0x0F6C, 157},
+	{0x0F71, 0x0F97, 157},
+	{0x0F99, 0x0FBC, 157},
+	{0x0FBE, 0x0FCC, 157},
+	{0x0FCE, 0x0FD4, 157},
+	{0x0FD9, 0x0FDA, 157},
+	{0x1000, 0x109F, 97},
+	{0x10A0, 0x10C5, 40},
+	{0x10C7, 0x10C7, 40},
+	{0x10CD, 0x10CD, 40},
+	{0x10D0, 0x10FA, 40},
+	{0x10FC, 0x10FF, 40},
+	{0x1100, 0x11FF, 50},
+	{0x1200, 0x1248, 38},
+	{0x124A, 0x124D, 38},
+	{0x1250, 0x1256, 38},
+	{0x1258, 0x1258, 38},
+	{0x125A, 0x125D, 38},
+	{0x1260, 0x1288, 38},
+	{0x128A, 0x128D, 38},
+	{0x1290, 0x12B0, 38},
+	{0x12B2, 0x12B5, 38},
+	{0x12B8, 0x12BE, 38},
+	{0x12C0, 0x12C0, 38},
+	{0x12C2, 0x12C5, 38},
+	{0x12C8, 0x12D6, 38},
+	{0x12D8, 0x1310, 38},
+	{0x1312, 0x1315, 38},
+	{0x1318, 0x135A, 38},
+	{0x135D, 0x137C, 38},
+	{0x1380, 0x1399, 38},
+	{0x13A0, 0x13F5, 23},
+	{0x13F8, 0x13FD, 23},
+	{0x1400, 0x167F, 18},
+	{0x1680, 0x169C, 106},
+	{0x16A0, 0x16EA, 128},
+	{0x16EE, 0x16F8, 128},
+	{0x1700, 0x1715, 144},
+	{0x171F, 0x171F, 144},
+	{0x1720, 0x1734, 52},
+	{0x1740, 0x1753, 17},
+	{0x1760, 0x176C, 145},
+	{0x176E, 0x1770, 145},
+	{0x1772, 0x1773, 145},
+	{0x1780, 0x17DD, 67},
+	{0x17E0, 0x17E9, 67},
+	{0x17F0, 0x17F9, 67},
+	{0x1800, 0x1801, 94},
+	{0x1804, 0x1804, 94},
+	{0x1806, 0x1819, 94},
+	{0x1820, 0x1878, 94},
+	{0x1880, 0x18AA, 94},
+	{0x18B0, 0x18F5, 18},
+	{0x1900, 0x191E, 74},
+	{0x1920, 0x192B, 74},
+	{0x1930, 0x193B, 74},
+	{0x1940, 0x1940, 74},
+	{0x1944, 0x194F, 74},
+	{0x1950, 0x196D, 146},
+	{0x1970, 0x1974, 146},
+	{0x1980, 0x19AB, 101},
+	{0x19B0, 0x19C9, 101},
+	{0x19D0, 0x19DA, 101},
+	{0x19DE, 0x19DF, 101},
+	{0x19E0, 0x19FF, 67},
+	{0x1A00, 0x1A1B, 16},
+	{0x1A1E, 0x1A1F, 16},
+	{0x1A20, 0x1A5E, 147},
+	{0x1A60, 0x1A7C, 147},
+	{0x1A7F, 0x1A89, 147},
+	{0x1A90, 0x1A99, 147},
+	{0x1AA0, 0x1AAD, 147},
+	{0x1B00, 0x1B4C, 6},
+	{0x1B4E, 0x1B7F, 6},
+	{0x1B80, 0x1BBF, 140},
+	{0x1BC0, 0x1BF3, 9},
+	{0x1BFC, 0x1BFF, 9},
+	{0x1C00, 0x1C37, 73},
+	{0x1C3B, 0x1C49, 73},
+	{0x1C4D, 0x1C4F, 73},
+	{0x1C50, 0x1C7F, 107},
+	{0x1C80, 0x1C8A, 29},
+	{0x1C90, 0x1CBA, 40},
+	{0x1CBD, 0x1CBF, 40},
+	{0x1CC0, 0x1CC7, 140},
+	{0x1D00, 0x1D25, 72},
+	{0x1D26, 0x1D2A, 44},
+	{0x1D2B, 0x1D2B, 29},
+	{0x1D2C, 0x1D5C, 72},
+	{0x1D5D, 0x1D61, 44},
+	{0x1D62, 0x1D65, 72},
+	{0x1D66, 0x1D6A, 44},
+	{0x1D6B, 0x1D77, 72},
+	{0x1D78, 0x1D78, 29},
+	{0x1D79, 0x1DBE, 72},
+	{0x1DBF, 0x1DBF, 44},
+	{0x1E00, 0x1EFF, 72},
+	{0x1F00, 0x1F15, 44},
+	{0x1F18, 0x1F1D, 44},
+	{0x1F20, 0x1F45, 44},
+	{0x1F48, 0x1F4D, 44},
+	{0x1F50, 0x1F57, 44},
+	{0x1F59, 0x1F59, 44},
+	{0x1F5B, 0x1F5B, 44},
+	{0x1F5D, 0x1F5D, 44},
+	{0x1F5F, 0x1F7D, 44},
+	{0x1F80, 0x1FB4, 44},
+	{0x1FB6, 0x1FC4, 44},
+	{0x1FC6, 0x1FD3, 44},
+	{0x1FD6, 0x1FDB, 44},
+	{0x1FDD, 0x1FEF, 44},
+	{0x1FF2, 0x1FF4, 44},
+	{0x1FF6, 0x1FFE, 44},
+	{0x2071, 0x2071, 72},
+	{0x207F, 0x207F, 72},
+	{0x2090, 0x209C, 72},
+	{0x2126, 0x2126, 44},
+	{0x212A, 0x212B, 72},
+	{0x2132, 0x2132, 72},
+	{0x214E, 0x214E, 72},
+	{0x2160, 0x2188, 72},
+	{0x2800, 0x28FF, 15},
+	{0x2C00, 0x2C5F, 41},
+	{0x2C60, 0x2C7F, 72},
+	{0x2C80, 0x2CF3, 25},
+	{0x2CF9, 0x2CFF, 25},
+	{0x2D00, 0x2D25, 40},
+	{0x2D27, 0x2D27, 40},
+	{0x2D2D, 0x2D2D, 40},
+	{0x2D30, 0x2D67, 158},
+	{0x2D6F, 0x2D70, 158},
+	{0x2D7F, 0x2D7F, 158},
+	{0x2D80, 0x2D96, 38},
+	{0x2DA0, 0x2DA6, 38},
+	{0x2DA8, 0x2DAE, 38},
+	{0x2DB0, 0x2DB6, 38},
+	{0x2DB8, 0x2DBE, 38},
+	{0x2DC0, 0x2DC6, 38},
+	{0x2DC8, 0x2DCE, 38},
+	{0x2DD0, 0x2DD6, 38},
+	{0x2DD8, 0x2DDE, 38},
+	{0x2DE0, 0x2DFF, 29},
+	{0x2E80, 0x2E99, 49},
+	{0x2E9B, 0x2EF3, 49},
+	{0x2F00, 0x2FD5, 49},
+	{0x3005, 0x3005, 49},
+	{0x3007, 0x3007, 49},
+	{0x3021, 0x3029, 49},
+	{0x302E, 0x302F, 50},
+	{0x3038, 0x303B, 49},
+	{0x3041, 0x3096, 55},
+	{0x309D, 0x309F, 55},
+	{0x30A1, 0x30FA, 62},
+	{0x30FD, 0x30FF, 62},
+	{0x3105, 0x312F, 13},
+	{0x3131, 0x318E, 50},
+	{0x31A0, 0x31BF, 13},
+	{0x31F0, 0x31FF, 62},
+	{0x3200, 0x321E, 50},
+	{0x3260, 0x327E, 50},
+	{0x32D0, 0x32FE, 62},
+	{0x3300, 0x3357, 62},
+	{0x3400, 0x4DBF, 49},
+	{0x4E00, 0x9FFF, 49},
+	{0xA000, 0xA48C, 170},
+	{0xA490, 0xA4C6, 170},
+	{0xA4D0, 0xA4FF, 77},
+	{0xA500, 0xA62B, 165},
+	{0xA640, 0xA69F, 29},
+	{0xA6A0, 0xA6F7, 7},
+	{0xA722, 0xA787, 72},
+	{0xA78B, 0xA7DC, 72},
+	{0xA7F1, 0xA7FF, 72},
+	{0xA800, 0xA82C, 142},
+	{0xA840, 0xA877, 124},
+	{0xA880, 0xA8C5, 130},
+	{0xA8CE, 0xA8D9, 130},
+	{0xA8E0, 0xA8FF, 31},
+	{0xA900, 0xA92D, 64},
+	{0xA92F, 0xA92F, 64},
+	{0xA930, 0xA953, 127},
+	{0xA95F, 0xA95F, 127},
+	{0xA960, 0xA97C, 50},
+	{0xA980, 0xA9CD, 59},
+	{0xA9D0, 0xA9D9, 59},
+	{0xA9DE, 0xA9DF, 59},
+	{0xA9E0, 0xA9FE, 97},
+	{0xAA00, 0xAA36, 22},
+	{0xAA40, 0xAA4D, 22},
+	{0xAA50, 0xAA59, 22},
+	{0xAA5C, 0xAA5F, 22},
+	{0xAA60, 0xAA7F, 97},
+	{0xAA80, 0xAAC2, 148},
+	{0xAADB, 0xAADF, 148},
+	{0xAAE0, 0xAAF6, 88},
+	{0xAB01, 0xAB06, 38},
+	{0xAB09, 0xAB0E, 38},
+	{0xAB11, 0xAB16, 38},
+	{0xAB20, 0xAB26, 38},
+	{0xAB28, 0xAB2E, 38},
+	{0xAB30, 0xAB5A, 72},
+	{0xAB5C, 0xAB64, 72},
+	{0xAB65, 0xAB65, 44},
+	{0xAB66, 0xAB69, 72},
+	{0xAB70, 0xABBF, 23},
+	{0xABC0, 0xABED, 88},
+	{0xABF0, 0xABF9, 88},
+	{0xAC00, 0xD7A3, 50},
+	{0xD7B0, 0xD7C6, 50},
+	{0xD7CB, 0xD7FB, 50},
+	{0xF900, 0xFA6D, 49},
+	{0xFA70, 0xFAD9, 49},
+	{0xFB00, 0xFB06, 72},
+	{0xFB13, 0xFB17, 4},
+	{0xFB1D, 0xFB36, 54},
+	{0xFB38, 0xFB3C, 54},
+	{0xFB3E, 0xFB3E, 54},
+	{0xFB40, 0xFB41, 54},
+	{0xFB43, 0xFB44, 54},
+	{0xFB46, 0xFB4F, 54},
+	{0xFB50, 0xFD3D, 3},
+	{0xFD40, 0xFDCF, 3},
+	{0xFDF0, 0xFDFF, 3},
+	{0xFE2E, 0xFE2F, 29},
+	{0xFE70, 0xFE74, 3},
+	{0xFE76, 0xFEFC, 3},
+	{0xFF21, 0xFF3A, 72},
+	{0xFF41, 0xFF5A, 72},
+	{0xFF66, 0xFF6F, 62},
+	{0xFF71, 0xFF9D, 62},
+	{0xFFA0, 0xFFBE, 50},
+	{0xFFC2, 0xFFC7, 50},
+	{0xFFCA, 0xFFCF, 50},
+	{0xFFD2, 0xFFD7, 50},
+	{0xFFDA, 0xFFDC, 50},
+	{0x10000, 0x1000B, 76},
+	{0x1000D, 0x10026, 76},
+	{0x10028, 0x1003A, 76},
+	{0x1003C, 0x1003D, 76},
+	{0x1003F, 0x1004D, 76},
+	{0x10050, 0x1005D, 76},
+	{0x10080, 0x100FA, 76},
+	{0x10140, 0x1018E, 44},
+	{0x101A0, 0x101A0, 44},
+	{0x10280, 0x1029C, 78},
+	{0x102A0, 0x102D0, 19},
+	{0x10300, 0x10323, 110},
+	{0x1032D, 0x1032F, 110},
+	{0x10330, 0x1034A, 42},
+	{0x10350, 0x1037A, 112},
+	{0x10380, 0x1039D, 164},
+	{0x1039F, 0x1039F, 164},
+	{0x103A0, 0x103C3, 113},
+	{0x103C8, 0x103D5, 113},
+	{0x10400, 0x1044F, 30},
+	{0x10450, 0x1047F, 132},
+	{0x10480, 0x1049D, 120},
+	{0x104A0, 0x104A9, 120},
+	{0x104B0, 0x104D3, 119},
+	{0x104D8, 0x104FB, 119},
+	{0x10500, 0x10527, 36},
+	{0x10530, 0x10563, 20},
+	{0x1056F, 0x1056F, 20},
+	{0x10570, 0x1057A, 166},
+	{0x1057C, 0x1058A, 166},
+	{0x1058C, 0x10592, 166},
+	{0x10594, 0x10595, 166},
+	{0x10597, 0x105A1, 166},
+	{0x105A3, 0x105B1, 166},
+	{0x105B3, 0x105B9, 166},
+	{0x105BB, 0x105BC, 166},
+	{0x105C0, 0x105F3, 160},
+	{0x10600, 0x10736, 75},
+	{0x10740, 0x10755, 75},
+	{0x10760, 0x10767, 75},
+	{0x10780, 0x10785, 72},
+	{0x10787, 0x107B0, 72},
+	{0x107B2, 0x107BA, 72},
+	{0x10800, 0x10805, 27},
+	{0x10808, 0x10808, 27},
+	{0x1080A, 0x10835, 27},
+	{0x10837, 0x10838, 27},
+	{0x1083C, 0x1083C, 27},
+	{0x1083F, 0x1083F, 27},
+	{0x10840, 0x10855, 56},
+	{0x10857, 0x1085F, 56},
+	{0x10860, 0x1087F, 122},
+	{0x10880, 0x1089E, 98},
+	{0x108A7, 0x108AF, 98},
+	{0x108E0, 0x108F2, 53},
+	{0x108F4, 0x108F5, 53},
+	{0x108FB, 0x108FF, 53},
+	{0x10900, 0x1091B, 125},
+	{0x1091F, 0x1091F, 125},
+	{0x10920, 0x10939, 79},
+	{0x1093F, 0x1093F, 79},
+	{0x10940, 0x10959, 134},
+	{0x10980, 0x1099F, 91},
+	{0x109A0, 0x109B7, 90},
+	{0x109BC, 0x109CF, 90},
+	{0x109D2, 0x109FF, 90},
+	{0x10A00, 0x10A03, 65},
+	{0x10A05, 0x10A06, 65},
+	{0x10A0C, 0x10A13, 65},
+	{0x10A15, 0x10A17, 65},
+	{0x10A19, 0x10A35, 65},
+	{0x10A38, 0x10A3A, 65},
+	{0x10A3F, 0x10A48, 65},
+	{0x10A50, 0x10A58, 65},
+	{0x10A60, 0x10A7F, 115},
+	{0x10A80, 0x10A9F, 111},
+	{0x10AC0, 0x10AE6, 84},
+	{0x10AEB, 0x10AF6, 84},
+	{0x10B00, 0x10B35, 5},
+	{0x10B39, 0x10B3F, 5},
+	{0x10B40, 0x10B55, 58},
+	{0x10B58, 0x10B5F, 58},
+	{0x10B60, 0x10B72, 57},
+	{0x10B78, 0x10B7F, 57},
+	{0x10B80, 0x10B91, 126},
+	{0x10B99, 0x10B9C, 126},
+	{0x10BA9, 0x10BAF, 126},
+	{0x10C00, 0x10C48, 116},
+	{0x10C80, 0x10CB2, 109},
+	{0x10CC0, 0x10CF2, 109},
+	{0x10CFA, 0x10CFF, 109},
+	{0x10D00, 0x10D27, 51},
+	{0x10D30, 0x10D39, 51},
+	{0x10D40, 0x10D65, 39},
+	{0x10D69, 0x10D85, 39},
+	{0x10D8E, 0x10D8F, 39},
+	{0x10E60, 0x10E7E, 3},
+	{0x10E80, 0x10EA9, 169},
+	{0x10EAB, 0x10EAD, 169},
+	{0x10EB0, 0x10EB1, 169},
+	{0x10EC2, 0x10EC7, 3},
+	{0x10ED0, 0x10ED8, 3},
+	{0x10EFA, 0x10EFF, 3},
+	{0x10F00, 0x10F27, 114},
+	{0x10F30, 0x10F59, 137},
+	{0x10F70, 0x10F89, 117},
+	{0x10FB0, 0x10FCB, 24},
+	{0x10FE0, 0x10FF6, 37},
+	{0x11000, 0x1104D, 14},
+	{0x11052, 0x11075, 14},
+	{0x1107F, 0x1107F, 14},
+	{0x11080, 0x110C2, 60},
+	{0x110CD, 0x110CD, 60},
+	{0x110D0, 0x110E8, 138},
+	{0x110F0, 0x110F9, 138},
+	{0x11100, 0x11134, 21},
+	{0x11136, 0x11147, 21},
+	{0x11150, 0x11176, 80},
+	{0x11180, 0x111DF, 131},
+	{0x111E1, 0x111F4, 136},
+	{0x11200, 0x11211, 68},
+	{0x11213, 0x11241, 68},
+	{0x11280, 0x11286, 96},
+	{0x11288, 0x11288, 96},
+	{0x1128A, 0x1128D, 96},
+	{0x1128F, 0x1129D, 96},
+	{0x1129F, 0x112A9, 96},
+	{0x112B0, 0x112EA, 69},
+	{0x112F0, 0x112F9, 69},
+	{0x11300, 0x11303, 43},
+	{0x11305, 0x1130C, 43},
+	{0x1130F, 0x11310, 43},
+	{0x11313, 0x11328, 43},
+	{0x1132A, 0x11330, 43},
+	{0x11332, 0x11333, 43},
+	{0x11335, 0x11339, 43},
+	{0x1133C, 0x11344, 43},
+	{0x11347, 0x11348, 43},
+	{0x1134B, 0x1134D, 43},
+	{0x11350, 0x11350, 43},
+	{0x11357, 0x11357, 43},
+	{0x1135D, 0x11363, 43},
+	{0x11366, 0x1136C, 43},
+	{0x11370, 0x11374, 43},
+	{0x11380, 0x11389, 163},
+	{0x1138B, 0x1138B, 163},
+	{0x1138E, 0x1138E, 163},
+	{0x11390, 0x113B5, 163},
+	{0x113B7, 0x113C0, 163},
+	{0x113C2, 0x113C2, 163},
+	{0x113C5, 0x113C5, 163},
+	{0x113C7, 0x113CA, 163},
+	{0x113CC, 0x113D5, 163},
+	{0x113D7, 0x113D8, 163},
+	{0x113E1, 0x113E2, 163},
+	{0x11400, 0x1145B, 102},
+	{0x1145D, 0x11461, 102},
+	{0x11480, 0x114C7, 159},
+	{0x114D0, 0x114D9, 159},
+	{0x11580, 0x115B5, 133},
+	{0x115B8, 0x115DD, 133},
+	{0x11600, 0x11644, 93},
+	{0x11650, 0x11659, 93},
+	{0x11660, 0x1166C, 94},
+	{0x11680, 0x116B9, 150},
+	{0x116C0, 0x116C9, 150},
+	{0x116D0, 0x116E3, 97},
+	{0x11700, 0x1171A, 1},
+	{0x1171D, 0x1172B, 1},
+	{0x11730, 0x11746, 1},
+	{0x11800, 0x1183B, 33},
+	{0x118A0, 0x118F2, 168},
+	{0x118FF, 0x118FF, 168},
+	{0x11900, 0x11906, 32},
+	{0x11909, 0x11909, 32},
+	{0x1190C, 0x11913, 32},
+	{0x11915, 0x11916, 32},
+	{0x11918, 0x11935, 32},
+	{0x11937, 0x11938, 32},
+	{0x1193B, 0x11946, 32},
+	{0x11950, 0x11959, 32},
+	{0x119A0, 0x119A7, 100},
+	{0x119AA, 0x119D7, 100},
+	{0x119DA, 0x119E4, 100},
+	{0x11A00, 0x11A47, 171},
+	{0x11A50, 0x11AA2, 139},
+	{0x11AB0, 0x11ABF, 18},
+	{0x11AC0, 0x11AF8, 123},
+	{0x11B00, 0x11B09, 31},
+	{0x11B60, 0x11B67, 131},
+	{0x11BC0, 0x11BE1, 141},
+	{0x11BF0, 0x11BF9, 141},
+	{0x11C00, 0x11C08, 12},
+	{0x11C0A, 0x11C36, 12},
+	{0x11C38, 0x11C45, 12},
+	{0x11C50, 0x11C6C, 12},
+	{0x11C70, 0x11C8F, 85},
+	{0x11C92, 0x11CA7, 85},
+	{0x11CA9, 0x11CB6, 85},
+	{0x11D00, 0x11D06, 86},
+	{0x11D08, 0x11D09, 86},
+	{0x11D0B, 0x11D36, 86},
+	{0x11D3A, 0x11D3A, 86},
+	{0x11D3C, 0x11D3D, 86},
+	{0x11D3F, 0x11D47, 86},
+	{0x11D50, 0x11D59, 86},
+	{0x11D60, 0x11D65, 46},
+	{0x11D67, 0x11D68, 46},
+	{0x11D6A, 0x11D8E, 46},
+	{0x11D90, 0x11D91, 46},
+	{0x11D93, 0x11D98, 46},
+	{0x11DA0, 0x11DA9, 46},
+	{0x11DB0, 0x11DDB, 161},
+	{0x11DE0, 0x11DE9, 161},
+	{0x11EE0, 0x11EF8, 81},
+	{0x11F00, 0x11F10, 63},
+	{0x11F12, 0x11F3A, 63},
+	{0x11F3E, 0x11F5A, 63},
+	{0x11FB0, 0x11FB0, 77},
+	{0x11FC0, 0x11FF1, 151},
+	{0x11FFF, 0x11FFF, 151},
+	{0x12000, 0x12399, 26},
+	{0x12400, 0x1246E, 26},
+	{0x12470, 0x12474, 26},
+	{0x12480, 0x12543, 26},
+	{0x12F90, 0x12FF2, 28},
+	{0x13000, 0x13455, 35},
+	{0x13460, 0x143FA, 35},
+	{0x14400, 0x14646, 2},
+	{0x16100, 0x16139, 48},
+	{0x16800, 0x16A38, 7},
+	{0x16A40, 0x16A5E, 95},
+	{0x16A60, 0x16A69, 95},
+	{0x16A6E, 0x16A6F, 95},
+	{0x16A70, 0x16ABE, 152},
+	{0x16AC0, 0x16AC9, 152},
+	{0x16AD0, 0x16AED, 8},
+	{0x16AF0, 0x16AF5, 8},
+	{0x16B00, 0x16B45, 121},
+	{0x16B50, 0x16B59, 121},
+	{0x16B5B, 0x16B61, 121},
+	{0x16B63, 0x16B77, 121},
+	{0x16B7D, 0x16B8F, 121},
+	{0x16D40, 0x16D79, 70},
+	{0x16E40, 0x16E9A, 87},
+	{0x16EA0, 0x16EB8, 11},
+	{0x16EBB, 0x16ED3, 11},
+	{0x16F00, 0x16F4A, 92},
+	{0x16F4F, 0x16F87, 92},
+	{0x16F8F, 0x16F9F, 92},
+	{0x16FE0, 0x16FE0, 153},
+	{0x16FE1, 0x16FE1, 104},
+	{0x16FE2, 0x16FE3, 49},
+	{0x16FE4, 0x16FE4, 66},
+	{0x16FF0, 0x16FF6, 49},
+	{0x17000, 0x18AFF, 153},
+	{0x18B00, 0x18CD5, 66},
+	{0x18CFF, 0x18CFF, 66},
+	{0x18D00, 0x18D1E, 153},
+	{0x18D80, 0x18DF2, 153},
+	{0x1AFF0, 0x1AFF3, 62},
+	{0x1AFF5, 0x1AFFB, 62},
+	{0x1AFFD, 0x1AFFE, 62},
+	{0x1B000, 0x1B000, 62},
+	{0x1B001, 0x1B11F, 55},
+	{0x1B120, 0x1B122, 62},
+	{0x1B132, 0x1B132, 55},
+	{0x1B150, 0x1B152, 55},
+	{0x1B155, 0x1B155, 62},
+	{0x1B164, 0x1B167, 62},
+	{0x1B170, 0x1B2FB, 104},
+	{0x1BC00, 0x1BC6A, 34},
+	{0x1BC70, 0x1BC7C, 34},
+	{0x1BC80, 0x1BC88, 34},
+	{0x1BC90, 0x1BC99, 34},
+	{0x1BC9C, 0x1BC9F, 34},
+	{0x1D200, 0x1D245, 44},
+	{0x1D800, 0x1DA8B, 135},
+	{0x1DA9B, 0x1DA9F, 135},
+	{0x1DAA1, 0x1DAAF, 135},
+	{0x1DF00, 0x1DF1E, 72},
+	{0x1DF25, 0x1DF2A, 72},
+	{0x1E000, 0x1E006, 41},
+	{0x1E008, 0x1E018, 41},
+	{0x1E01B, 0x1E021, 41},
+	{0x1E023, 0x1E024, 41},
+	{0x1E026, 0x1E02A, 41},
+	{0x1E030, 0x1E06D, 29},
+	{0x1E08F, 0x1E08F, 29},
+	{0x1E100, 0x1E12C, 105},
+	{0x1E130, 0x1E13D, 105},
+	{0x1E140, 0x1E149, 105},
+	{0x1E14E, 0x1E14F, 105},
+	{0x1E290, 0x1E2AE, 162},
+	{0x1E2C0, 0x1E2F9, 167},
+	{0x1E2FF, 0x1E2FF, 167},
+	{0x1E4D0, 0x1E4F9, 99},
+	{0x1E5D0, 0x1E5FA, 108},
+	{0x1E5FF, 0x1E5FF, 108},
+	{0x1E6C0, 0x1E6DE, 149},
+	{0x1E6E0, 0x1E6F5, 149},
+	{0x1E6FE, 0x1E6FF, 149},
+	{0x1E7E0, 0x1E7E6, 38},
+	{0x1E7E8, 0x1E7EB, 38},
+	{0x1E7ED, 0x1E7EE, 38},
+	{0x1E7F0, 0x1E7FE, 38},
+	{0x1E800, 0x1E8C4, 89},
+	{0x1E8C7, 0x1E8D6, 89},
+	{0x1E900, 0x1E94B, 0},
+	{0x1E950, 0x1E959, 0},
+	{0x1E95E, 0x1E95F, 0},
+	{0x1EE00, 0x1EE03, 3},
+	{0x1EE05, 0x1EE1F, 3},
+	{0x1EE21, 0x1EE22, 3},
+	{0x1EE24, 0x1EE24, 3},
+	{0x1EE27, 0x1EE27, 3},
+	{0x1EE29, 0x1EE32, 3},
+	{0x1EE34, 0x1EE37, 3},
+	{0x1EE39, 0x1EE39, 3},
+	{0x1EE3B, 0x1EE3B, 3},
+	{0x1EE42, 0x1EE42, 3},
+	{0x1EE47, 0x1EE47, 3},
+	{0x1EE49, 0x1EE49, 3},
+	{0x1EE4B, 0x1EE4B, 3},
+	{0x1EE4D, 0x1EE4F, 3},
+	{0x1EE51, 0x1EE52, 3},
+	{0x1EE54, 0x1EE54, 3},
+	{0x1EE57, 0x1EE57, 3},
+	{0x1EE59, 0x1EE59, 3},
+	{0x1EE5B, 0x1EE5B, 3},
+	{0x1EE5D, 0x1EE5D, 3},
+	{0x1EE5F, 0x1EE5F, 3},
+	{0x1EE61, 0x1EE62, 3},
+	{0x1EE64, 0x1EE64, 3},
+	{0x1EE67, 0x1EE6A, 3},
+	{0x1EE6C, 0x1EE72, 3},
+	{0x1EE74, 0x1EE77, 3},
+	{0x1EE79, 0x1EE7C, 3},
+	{0x1EE7E, 0x1EE7E, 3},
+	{0x1EE80, 0x1EE89, 3},
+	{0x1EE8B, 0x1EE9B, 3},
+	{0x1EEA1, 0x1EEA3, 3},
+	{0x1EEA5, 0x1EEA9, 3},
+	{0x1EEAB, 0x1EEBB, 3},
+	{0x1EEF0, 0x1EEF1, 3},
+	{0x1F200, 0x1F200, 55},
+	{0x20000, 0x2A6DF, 49},
+	{0x2A700, 0x2B81D, 49},
+	{0x2B820, 0x2CEAD, 49},
+	{0x2CEB0, 0x2EBE0, 49},
+	{0x2EBF0, 0x2EE5D, 49},
+	{0x2F800, 0x2FA1D, 49},
+	{0x30000, 0x3134A, 49},
+	{0x31350, 0x33479, 49},
+}
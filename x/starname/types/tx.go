@@ -79,6 +79,9 @@ func (m *MsgAddAccountCertificate) ValidateBasic() error {
 	if m.NewCertificate == nil {
 		return errors.Wrap(ErrInvalidRequest, "certificate is empty")
 	}
+	if err := ValidateCertificateType(m.CertificateType); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -200,6 +203,191 @@ func (m *MsgDeleteAccountCertificate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{payer, owner}
 }
 
+// MsgCommitRegistrationInternal embeds MsgCommitRegistration and adds sdk.Address properties for Owner and Payer
+type MsgCommitRegistrationInternal struct {
+	MsgCommitRegistration
+	Owner sdk.AccAddress
+	Payer sdk.AccAddress
+}
+
+// ToInternal returns a pointer to the MsgCommitRegistrationInternal struct corresponding to the method receiver
+func (m MsgCommitRegistration) ToInternal() *MsgCommitRegistrationInternal {
+	var err error
+	var owner sdk.AccAddress = nil
+	var payer sdk.AccAddress = nil
+
+	if m.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(m.Owner)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if m.Payer != "" {
+		payer, err = sdk.AccAddressFromBech32(m.Payer)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	msgi := MsgCommitRegistrationInternal{
+		MsgCommitRegistration: m,
+		Owner:                 owner,
+		Payer:                 payer,
+	}
+
+	return &msgi
+}
+
+var _ MsgWithFeePayer = (*MsgCommitRegistrationInternal)(nil)
+
+// FeePayer implements FeePayer interface
+func (m *MsgCommitRegistrationInternal) FeePayer() sdk.AccAddress {
+	if !m.Payer.Empty() {
+		return m.Payer
+	}
+	return m.Owner
+}
+
+// Route implements sdk.Msg
+func (m *MsgCommitRegistration) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgCommitRegistration) Type() string {
+	return "commit_registration"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCommitRegistration) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.Wrap(ErrInvalidOwner, "empty")
+	}
+	if len(m.Commitment) != CommitmentSize {
+		return errors.Wrapf(ErrInvalidCommitment, "commitment must be %d bytes long", CommitmentSize)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgCommitRegistration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCommitRegistration) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	if m.Payer == "" {
+		return []sdk.AccAddress{owner}
+	}
+
+	payer, err := sdk.AccAddressFromBech32(m.Payer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{payer, owner}
+}
+
+// MsgSetDomainPolicyInternal embeds MsgSetDomainPolicy and adds sdk.Address properties for Admin and Payer
+type MsgSetDomainPolicyInternal struct {
+	MsgSetDomainPolicy
+	Admin sdk.AccAddress
+	Payer sdk.AccAddress
+}
+
+// ToInternal returns a pointer to the MsgSetDomainPolicyInternal struct corresponding to the method receiver
+func (m MsgSetDomainPolicy) ToInternal() *MsgSetDomainPolicyInternal {
+	var err error
+	var admin sdk.AccAddress = nil
+	var payer sdk.AccAddress = nil
+
+	if m.Admin != "" {
+		admin, err = sdk.AccAddressFromBech32(m.Admin)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if m.Payer != "" {
+		payer, err = sdk.AccAddressFromBech32(m.Payer)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	msgi := MsgSetDomainPolicyInternal{
+		MsgSetDomainPolicy: m,
+		Admin:              admin,
+		Payer:              payer,
+	}
+
+	return &msgi
+}
+
+var _ MsgWithFeePayer = (*MsgSetDomainPolicyInternal)(nil)
+
+// FeePayer implements FeePayer interface
+func (m *MsgSetDomainPolicyInternal) FeePayer() sdk.AccAddress {
+	if !m.Payer.Empty() {
+		return m.Payer
+	}
+	return m.Admin
+}
+
+// Route implements sdk.Msg
+func (m *MsgSetDomainPolicy) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgSetDomainPolicy) Type() string {
+	return "set_domain_policy"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetDomainPolicy) ValidateBasic() error {
+	if m.Domain == "" {
+		return errors.Wrap(ErrInvalidDomainName, "empty")
+	}
+	if m.Admin == "" {
+		return errors.Wrap(ErrInvalidOwner, "empty")
+	}
+	if m.Policy.Domain != m.Domain {
+		return errors.Wrapf(ErrInvalidDomainPolicy, "policy domain %s does not match %s", m.Policy.Domain, m.Domain)
+	}
+	return m.Policy.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetDomainPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetDomainPolicy) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+
+	if m.Payer == "" {
+		return []sdk.AccAddress{admin}
+	}
+
+	payer, err := sdk.AccAddressFromBech32(m.Payer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{payer, admin}
+}
+
 // MsgDeleteAccountInternal embeds MsgDeleteDomain and adds sdk.Address properties for Owner and Payer
 type MsgDeleteAccountInternal struct {
 	MsgDeleteAccount
@@ -870,6 +1058,194 @@ func (m *MsgReplaceAccountResources) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{payer, owner}
 }
 
+// MsgUpsertAccountResourcesInternal embeds MsgUpsertAccountResources and adds sdk.Address properties for Owner and Payer
+type MsgUpsertAccountResourcesInternal struct {
+	MsgUpsertAccountResources
+	Owner sdk.AccAddress
+	Payer sdk.AccAddress
+}
+
+// ToInternal returns a pointer to the MsgUpsertAccountResourcesInternal struct corresponding to the method receiver
+func (m MsgUpsertAccountResources) ToInternal() *MsgUpsertAccountResourcesInternal {
+	var err error
+	var owner sdk.AccAddress = nil
+	var payer sdk.AccAddress = nil
+
+	if m.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(m.Owner)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if m.Payer != "" {
+		payer, err = sdk.AccAddressFromBech32(m.Payer)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	msgi := MsgUpsertAccountResourcesInternal{
+		MsgUpsertAccountResources: m,
+		Owner:                     owner,
+		Payer:                     payer,
+	}
+
+	return &msgi
+}
+
+var _ MsgWithFeePayer = (*MsgUpsertAccountResourcesInternal)(nil)
+
+// FeePayer implements FeePayer interface
+func (m *MsgUpsertAccountResourcesInternal) FeePayer() sdk.AccAddress {
+	if !m.Payer.Empty() {
+		return m.Payer
+	}
+	return m.Owner
+}
+
+// Route implements sdk.Msg
+func (m *MsgUpsertAccountResources) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgUpsertAccountResources) Type() string {
+	return "upsert_account_resources"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUpsertAccountResources) ValidateBasic() error {
+	if m.Domain == "" {
+		return errors.Wrap(ErrInvalidDomainName, "empty")
+	}
+	if m.Owner == "" {
+		return errors.Wrap(ErrInvalidOwner, "empty")
+	}
+	if len(m.Resources) == 0 {
+		return errors.Wrap(ErrInvalidResource, "no resources provided")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgUpsertAccountResources) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUpsertAccountResources) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	if m.Payer == "" {
+		return []sdk.AccAddress{owner}
+	}
+
+	payer, err := sdk.AccAddressFromBech32(m.Payer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{payer, owner}
+}
+
+// MsgRemoveAccountResourcesInternal embeds MsgRemoveAccountResources and adds sdk.Address properties for Owner and Payer
+type MsgRemoveAccountResourcesInternal struct {
+	MsgRemoveAccountResources
+	Owner sdk.AccAddress
+	Payer sdk.AccAddress
+}
+
+// ToInternal returns a pointer to the MsgRemoveAccountResourcesInternal struct corresponding to the method receiver
+func (m MsgRemoveAccountResources) ToInternal() *MsgRemoveAccountResourcesInternal {
+	var err error
+	var owner sdk.AccAddress = nil
+	var payer sdk.AccAddress = nil
+
+	if m.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(m.Owner)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if m.Payer != "" {
+		payer, err = sdk.AccAddressFromBech32(m.Payer)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	msgi := MsgRemoveAccountResourcesInternal{
+		MsgRemoveAccountResources: m,
+		Owner:                     owner,
+		Payer:                     payer,
+	}
+
+	return &msgi
+}
+
+var _ MsgWithFeePayer = (*MsgRemoveAccountResourcesInternal)(nil)
+
+// FeePayer implements FeePayer interface
+func (m *MsgRemoveAccountResourcesInternal) FeePayer() sdk.AccAddress {
+	if !m.Payer.Empty() {
+		return m.Payer
+	}
+	return m.Owner
+}
+
+// Route implements sdk.Msg
+func (m *MsgRemoveAccountResources) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgRemoveAccountResources) Type() string {
+	return "remove_account_resources"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRemoveAccountResources) ValidateBasic() error {
+	if m.Domain == "" {
+		return errors.Wrap(ErrInvalidDomainName, "empty")
+	}
+	if m.Owner == "" {
+		return errors.Wrap(ErrInvalidOwner, "empty")
+	}
+	if len(m.URIs) == 0 {
+		return errors.Wrap(ErrInvalidResource, "no resource URIs provided")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRemoveAccountResources) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRemoveAccountResources) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	if m.Payer == "" {
+		return []sdk.AccAddress{owner}
+	}
+
+	payer, err := sdk.AccAddressFromBech32(m.Payer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{payer, owner}
+}
+
 // MsgReplaceAccountMetadataInternal embeds MsgReplaceAccountMetadata and adds sdk.Address properties for Owner and Payer
 type MsgReplaceAccountMetadataInternal struct {
 	MsgReplaceAccountMetadata
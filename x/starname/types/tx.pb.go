@@ -42,6 +42,9 @@ type MsgAddAccountCertificate struct {
 	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
 	// NewCertificate is the new certificate to add
 	NewCertificate []byte `protobuf:"bytes,5,opt,name=new_certificate,json=newCertificate,proto3" json:"new_certificate,omitempty" yaml:"new_certificate"`
+	// CertificateType is the format of the certificate, if empty the certificate
+	// is stored as opaque bytes without any verification
+	CertificateType CertificateType `protobuf:"bytes,6,opt,name=certificate_type,json=certificateType,proto3,casttype=CertificateType" json:"certificate_type,omitempty" yaml:"certificate_type"`
 }
 
 func (m *MsgAddAccountCertificate) Reset()         { *m = MsgAddAccountCertificate{} }
@@ -112,6 +115,13 @@ func (m *MsgAddAccountCertificate) GetNewCertificate() []byte {
 	return nil
 }
 
+func (m *MsgAddAccountCertificate) GetCertificateType() CertificateType {
+	if m != nil {
+		return m.CertificateType
+	}
+	return ""
+}
+
 // MsgAddAccountCertificateResponse returns an empty response.
 type MsgAddAccountCertificateResponse struct {
 }
@@ -270,6 +280,222 @@ func (m *MsgDeleteAccountCertificateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteAccountCertificateResponse proto.InternalMessageInfo
 
+// MsgCommitRegistration is the request used to commit to a future domain or
+// account registration without revealing the name
+type MsgCommitRegistration struct {
+	// Commitment is the hash of the starname, the owner and a salt
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty" yaml:"commitment"`
+	// Owner is the address that is going to reveal the registration
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Payer is the address of the entity that pays the product and transaction
+	// fees
+	Payer string `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
+}
+
+func (m *MsgCommitRegistration) Reset()         { *m = MsgCommitRegistration{} }
+func (m *MsgCommitRegistration) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitRegistration) ProtoMessage()    {}
+func (*MsgCommitRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{4}
+}
+func (m *MsgCommitRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitRegistration.Merge(m, src)
+}
+func (m *MsgCommitRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitRegistration proto.InternalMessageInfo
+
+func (m *MsgCommitRegistration) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *MsgCommitRegistration) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCommitRegistration) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+// MsgCommitRegistrationResponse returns an empty response.
+type MsgCommitRegistrationResponse struct {
+}
+
+func (m *MsgCommitRegistrationResponse) Reset()         { *m = MsgCommitRegistrationResponse{} }
+func (m *MsgCommitRegistrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitRegistrationResponse) ProtoMessage()    {}
+func (*MsgCommitRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{5}
+}
+func (m *MsgCommitRegistrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitRegistrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitRegistrationResponse.Merge(m, src)
+}
+func (m *MsgCommitRegistrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitRegistrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitRegistrationResponse proto.InternalMessageInfo
+
+// MsgSetDomainPolicy is the request used by the admin of a domain to set the
+// policy of the domain, only open domains can set registration prices and
+// registrant lists
+type MsgSetDomainPolicy struct {
+	// Domain is the name of the domain
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
+	// Admin is the admin of the domain
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Payer is the address of the entity that pays the product and transaction
+	// fees
+	Payer string `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
+	// Policy is the new policy of the domain
+	Policy DomainPolicy `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+}
+
+func (m *MsgSetDomainPolicy) Reset()         { *m = MsgSetDomainPolicy{} }
+func (m *MsgSetDomainPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainPolicy) ProtoMessage()    {}
+func (*MsgSetDomainPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{6}
+}
+func (m *MsgSetDomainPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDomainPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDomainPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainPolicy.Merge(m, src)
+}
+func (m *MsgSetDomainPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDomainPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDomainPolicy proto.InternalMessageInfo
+
+func (m *MsgSetDomainPolicy) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MsgSetDomainPolicy) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetDomainPolicy) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgSetDomainPolicy) GetPolicy() DomainPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DomainPolicy{}
+}
+
+// MsgSetDomainPolicyResponse returns an empty response.
+type MsgSetDomainPolicyResponse struct {
+}
+
+func (m *MsgSetDomainPolicyResponse) Reset()         { *m = MsgSetDomainPolicyResponse{} }
+func (m *MsgSetDomainPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainPolicyResponse) ProtoMessage()    {}
+func (*MsgSetDomainPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{7}
+}
+func (m *MsgSetDomainPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDomainPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDomainPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetDomainPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDomainPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDomainPolicyResponse proto.InternalMessageInfo
+
 // MsgDeleteAccount is the request model used to delete an account
 type MsgDeleteAccount struct {
 	// Domain is the domain of the account
@@ -287,7 +513,7 @@ func (m *MsgDeleteAccount) Reset()         { *m = MsgDeleteAccount{} }
 func (m *MsgDeleteAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteAccount) ProtoMessage()    {}
 func (*MsgDeleteAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{4}
+	return fileDescriptor_6831bd3a11b01297, []int{8}
 }
 func (m *MsgDeleteAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +578,7 @@ func (m *MsgDeleteAccountResponse) Reset()         { *m = MsgDeleteAccountRespon
 func (m *MsgDeleteAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteAccountResponse) ProtoMessage()    {}
 func (*MsgDeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{5}
+	return fileDescriptor_6831bd3a11b01297, []int{9}
 }
 func (m *MsgDeleteAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +622,7 @@ func (m *MsgDeleteDomain) Reset()         { *m = MsgDeleteDomain{} }
 func (m *MsgDeleteDomain) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteDomain) ProtoMessage()    {}
 func (*MsgDeleteDomain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{6}
+	return fileDescriptor_6831bd3a11b01297, []int{10}
 }
 func (m *MsgDeleteDomain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +680,7 @@ func (m *MsgDeleteDomainResponse) Reset()         { *m = MsgDeleteDomainResponse
 func (m *MsgDeleteDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteDomainResponse) ProtoMessage()    {}
 func (*MsgDeleteDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{7}
+	return fileDescriptor_6831bd3a11b01297, []int{11}
 }
 func (m *MsgDeleteDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,13 +726,19 @@ type MsgRegisterAccount struct {
 	Registerer string `protobuf:"bytes,6,opt,name=registerer,proto3" json:"registerer,omitempty" yaml:"registerer"`
 	// Resources are the blockchain addresses of the account
 	Resources []*Resource `protobuf:"bytes,7,rep,name=resources,proto3" json:"resources,omitempty" yaml:"resources"`
+	// Salt is the salt of the registration commitment, required only if
+	// commit-reveal registration is enabled
+	Salt []byte `protobuf:"bytes,8,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	// Wildcard registers the account as the catch-all account of the domain,
+	// only the domain admin can register it
+	Wildcard bool `protobuf:"varint,9,opt,name=wildcard,proto3" json:"wildcard,omitempty" yaml:"wildcard"`
 }
 
 func (m *MsgRegisterAccount) Reset()         { *m = MsgRegisterAccount{} }
 func (m *MsgRegisterAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterAccount) ProtoMessage()    {}
 func (*MsgRegisterAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{8}
+	return fileDescriptor_6831bd3a11b01297, []int{12}
 }
 func (m *MsgRegisterAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,6 +816,20 @@ func (m *MsgRegisterAccount) GetResources() []*Resource {
 	return nil
 }
 
+func (m *MsgRegisterAccount) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *MsgRegisterAccount) GetWildcard() bool {
+	if m != nil {
+		return m.Wildcard
+	}
+	return false
+}
+
 // MsgRegisterAccountResponse returns an empty response.
 type MsgRegisterAccountResponse struct {
 }
@@ -592,7 +838,7 @@ func (m *MsgRegisterAccountResponse) Reset()         { *m = MsgRegisterAccountRe
 func (m *MsgRegisterAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterAccountResponse) ProtoMessage()    {}
 func (*MsgRegisterAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{9}
+	return fileDescriptor_6831bd3a11b01297, []int{13}
 }
 func (m *MsgRegisterAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,13 +878,16 @@ type MsgRegisterDomain struct {
 	Broker string `protobuf:"bytes,4,opt,name=broker,proto3" json:"broker,omitempty" yaml:"broker"`
 	// DomainType defines the type of the domain
 	DomainType DomainType `protobuf:"bytes,5,opt,name=domain_type,json=domainType,proto3,casttype=DomainType" json:"domain_type,omitempty" yaml:"domain_type`
+	// Salt is the salt of the registration commitment, required only if
+	// commit-reveal registration is enabled
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
 }
 
 func (m *MsgRegisterDomain) Reset()         { *m = MsgRegisterDomain{} }
 func (m *MsgRegisterDomain) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterDomain) ProtoMessage()    {}
 func (*MsgRegisterDomain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{10}
+	return fileDescriptor_6831bd3a11b01297, []int{14}
 }
 func (m *MsgRegisterDomain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,6 +951,13 @@ func (m *MsgRegisterDomain) GetDomainType() DomainType {
 	return ""
 }
 
+func (m *MsgRegisterDomain) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
 // MsgRegisterDomainResponse returns an empty response.
 type MsgRegisterDomainResponse struct {
 }
@@ -710,7 +966,7 @@ func (m *MsgRegisterDomainResponse) Reset()         { *m = MsgRegisterDomainResp
 func (m *MsgRegisterDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterDomainResponse) ProtoMessage()    {}
 func (*MsgRegisterDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{11}
+	return fileDescriptor_6831bd3a11b01297, []int{15}
 }
 func (m *MsgRegisterDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +1012,7 @@ func (m *MsgRenewAccount) Reset()         { *m = MsgRenewAccount{} }
 func (m *MsgRenewAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgRenewAccount) ProtoMessage()    {}
 func (*MsgRenewAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{12}
+	return fileDescriptor_6831bd3a11b01297, []int{16}
 }
 func (m *MsgRenewAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +1077,7 @@ func (m *MsgRenewAccountResponse) Reset()         { *m = MsgRenewAccountResponse
 func (m *MsgRenewAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRenewAccountResponse) ProtoMessage()    {}
 func (*MsgRenewAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{13}
+	return fileDescriptor_6831bd3a11b01297, []int{17}
 }
 func (m *MsgRenewAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +1121,7 @@ func (m *MsgRenewDomain) Reset()         { *m = MsgRenewDomain{} }
 func (m *MsgRenewDomain) String() string { return proto.CompactTextString(m) }
 func (*MsgRenewDomain) ProtoMessage()    {}
 func (*MsgRenewDomain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{14}
+	return fileDescriptor_6831bd3a11b01297, []int{18}
 }
 func (m *MsgRenewDomain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1179,7 @@ func (m *MsgRenewDomainResponse) Reset()         { *m = MsgRenewDomainResponse{}
 func (m *MsgRenewDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRenewDomainResponse) ProtoMessage()    {}
 func (*MsgRenewDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{15}
+	return fileDescriptor_6831bd3a11b01297, []int{19}
 }
 func (m *MsgRenewDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1228,7 @@ func (m *MsgReplaceAccountResources) Reset()         { *m = MsgReplaceAccountRes
 func (m *MsgReplaceAccountResources) String() string { return proto.CompactTextString(m) }
 func (*MsgReplaceAccountResources) ProtoMessage()    {}
 func (*MsgReplaceAccountResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{16}
+	return fileDescriptor_6831bd3a11b01297, []int{20}
 }
 func (m *MsgReplaceAccountResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,7 +1300,7 @@ func (m *MsgReplaceAccountResourcesResponse) Reset()         { *m = MsgReplaceAc
 func (m *MsgReplaceAccountResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgReplaceAccountResourcesResponse) ProtoMessage()    {}
 func (*MsgReplaceAccountResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{17}
+	return fileDescriptor_6831bd3a11b01297, []int{21}
 }
 func (m *MsgReplaceAccountResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,34 +1329,34 @@ func (m *MsgReplaceAccountResourcesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgReplaceAccountResourcesResponse proto.InternalMessageInfo
 
-// MsgReplaceAccountMetadata is the function used to set accounts metadata
-type MsgReplaceAccountMetadata struct {
+// MsgUpsertAccountResources is the request model used to add resources to an
+// account or to update the resources of an account with the same URI
+type MsgUpsertAccountResources struct {
 	// Domain is the domain of the account
 	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
 	// Name is the name of the account
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	// Owner is the owner of the account
-	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner`
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	// Payer is the address of the entity that pays the product and transaction
 	// fees
 	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
-	// NewMetadataURI is the metadata URI of the account
-	// we want to update or insert
-	NewMetadataURI string `protobuf:"bytes,5,opt,name=new_metadata_uri,json=newMetadataUri,proto3" json:"new_metadata_uri,omitempty" yaml:"new_metadata_uri"`
+	// Resources are the resources to add or update
+	Resources []*Resource `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty" yaml:"resources"`
 }
 
-func (m *MsgReplaceAccountMetadata) Reset()         { *m = MsgReplaceAccountMetadata{} }
-func (m *MsgReplaceAccountMetadata) String() string { return proto.CompactTextString(m) }
-func (*MsgReplaceAccountMetadata) ProtoMessage()    {}
-func (*MsgReplaceAccountMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{18}
+func (m *MsgUpsertAccountResources) Reset()         { *m = MsgUpsertAccountResources{} }
+func (m *MsgUpsertAccountResources) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertAccountResources) ProtoMessage()    {}
+func (*MsgUpsertAccountResources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{22}
 }
-func (m *MsgReplaceAccountMetadata) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpsertAccountResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReplaceAccountMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpsertAccountResources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReplaceAccountMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpsertAccountResources.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1110,69 +1366,69 @@ func (m *MsgReplaceAccountMetadata) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgReplaceAccountMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReplaceAccountMetadata.Merge(m, src)
+func (m *MsgUpsertAccountResources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertAccountResources.Merge(m, src)
 }
-func (m *MsgReplaceAccountMetadata) XXX_Size() int {
+func (m *MsgUpsertAccountResources) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReplaceAccountMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReplaceAccountMetadata.DiscardUnknown(m)
+func (m *MsgUpsertAccountResources) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertAccountResources.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReplaceAccountMetadata proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpsertAccountResources proto.InternalMessageInfo
 
-func (m *MsgReplaceAccountMetadata) GetDomain() string {
+func (m *MsgUpsertAccountResources) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-func (m *MsgReplaceAccountMetadata) GetName() string {
+func (m *MsgUpsertAccountResources) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgReplaceAccountMetadata) GetOwner() string {
+func (m *MsgUpsertAccountResources) GetOwner() string {
 	if m != nil {
 		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgReplaceAccountMetadata) GetPayer() string {
+func (m *MsgUpsertAccountResources) GetPayer() string {
 	if m != nil {
 		return m.Payer
 	}
 	return ""
 }
 
-func (m *MsgReplaceAccountMetadata) GetNewMetadataURI() string {
+func (m *MsgUpsertAccountResources) GetResources() []*Resource {
 	if m != nil {
-		return m.NewMetadataURI
+		return m.Resources
 	}
-	return ""
+	return nil
 }
 
-// MsgReplaceAccountMetadataResponse returns an empty response.
-type MsgReplaceAccountMetadataResponse struct {
+// MsgUpsertAccountResourcesResponse returns an empty response.
+type MsgUpsertAccountResourcesResponse struct {
 }
 
-func (m *MsgReplaceAccountMetadataResponse) Reset()         { *m = MsgReplaceAccountMetadataResponse{} }
-func (m *MsgReplaceAccountMetadataResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgReplaceAccountMetadataResponse) ProtoMessage()    {}
-func (*MsgReplaceAccountMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{19}
+func (m *MsgUpsertAccountResourcesResponse) Reset()         { *m = MsgUpsertAccountResourcesResponse{} }
+func (m *MsgUpsertAccountResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertAccountResourcesResponse) ProtoMessage()    {}
+func (*MsgUpsertAccountResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{23}
 }
-func (m *MsgReplaceAccountMetadataResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpsertAccountResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReplaceAccountMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpsertAccountResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReplaceAccountMetadataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpsertAccountResourcesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1182,47 +1438,46 @@ func (m *MsgReplaceAccountMetadataResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgReplaceAccountMetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReplaceAccountMetadataResponse.Merge(m, src)
+func (m *MsgUpsertAccountResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertAccountResourcesResponse.Merge(m, src)
 }
-func (m *MsgReplaceAccountMetadataResponse) XXX_Size() int {
+func (m *MsgUpsertAccountResourcesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReplaceAccountMetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReplaceAccountMetadataResponse.DiscardUnknown(m)
+func (m *MsgUpsertAccountResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertAccountResourcesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReplaceAccountMetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpsertAccountResourcesResponse proto.InternalMessageInfo
 
-// MsgTransferAccount is the request model used to transfer accounts
-type MsgTransferAccount struct {
+// MsgRemoveAccountResources is the request model used to remove the resources
+// of an account with the given URIs
+type MsgRemoveAccountResources struct {
 	// Domain is the domain of the account
 	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
 	// Name is the name of the account
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	// Owner is the owner of the account
-	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner`
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	// Payer is the address of the entity that pays the product and transaction
 	// fees
 	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
-	// NewOwner is the new owner of the account
-	NewOwner string `protobuf:"bytes,5,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" yaml:"new_owner`
-	// ToReset if true, removes all old data from account
-	ToReset bool `protobuf:"varint,6,opt,name=reset,proto3" json:"reset,omitempty" yaml:"reset`
+	// URIs are the URIs of the resources to remove
+	URIs []string `protobuf:"bytes,5,rep,name=uris,proto3" json:"uris,omitempty" yaml:"uris"`
 }
 
-func (m *MsgTransferAccount) Reset()         { *m = MsgTransferAccount{} }
-func (m *MsgTransferAccount) String() string { return proto.CompactTextString(m) }
-func (*MsgTransferAccount) ProtoMessage()    {}
-func (*MsgTransferAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{20}
+func (m *MsgRemoveAccountResources) Reset()         { *m = MsgRemoveAccountResources{} }
+func (m *MsgRemoveAccountResources) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAccountResources) ProtoMessage()    {}
+func (*MsgRemoveAccountResources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{24}
 }
-func (m *MsgTransferAccount) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveAccountResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTransferAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveAccountResources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTransferAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveAccountResources.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1232,19 +1487,262 @@ func (m *MsgTransferAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgTransferAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTransferAccount.Merge(m, src)
+func (m *MsgRemoveAccountResources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAccountResources.Merge(m, src)
 }
-func (m *MsgTransferAccount) XXX_Size() int {
+func (m *MsgRemoveAccountResources) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTransferAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTransferAccount.DiscardUnknown(m)
+func (m *MsgRemoveAccountResources) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAccountResources.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTransferAccount proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveAccountResources proto.InternalMessageInfo
 
-func (m *MsgTransferAccount) GetDomain() string {
+func (m *MsgRemoveAccountResources) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MsgRemoveAccountResources) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRemoveAccountResources) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRemoveAccountResources) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgRemoveAccountResources) GetURIs() []string {
+	if m != nil {
+		return m.URIs
+	}
+	return nil
+}
+
+// MsgRemoveAccountResourcesResponse returns an empty response.
+type MsgRemoveAccountResourcesResponse struct {
+}
+
+func (m *MsgRemoveAccountResourcesResponse) Reset()         { *m = MsgRemoveAccountResourcesResponse{} }
+func (m *MsgRemoveAccountResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAccountResourcesResponse) ProtoMessage()    {}
+func (*MsgRemoveAccountResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{25}
+}
+func (m *MsgRemoveAccountResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAccountResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAccountResourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAccountResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAccountResourcesResponse.Merge(m, src)
+}
+func (m *MsgRemoveAccountResourcesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAccountResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAccountResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAccountResourcesResponse proto.InternalMessageInfo
+
+// MsgReplaceAccountMetadata is the function used to set accounts metadata
+type MsgReplaceAccountMetadata struct {
+	// Domain is the domain of the account
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
+	// Name is the name of the account
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Owner is the owner of the account
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner`
+	// Payer is the address of the entity that pays the product and transaction
+	// fees
+	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
+	// NewMetadataURI is the metadata URI of the account
+	// we want to update or insert
+	NewMetadataURI string `protobuf:"bytes,5,opt,name=new_metadata_uri,json=newMetadataUri,proto3" json:"new_metadata_uri,omitempty" yaml:"new_metadata_uri"`
+}
+
+func (m *MsgReplaceAccountMetadata) Reset()         { *m = MsgReplaceAccountMetadata{} }
+func (m *MsgReplaceAccountMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceAccountMetadata) ProtoMessage()    {}
+func (*MsgReplaceAccountMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{26}
+}
+func (m *MsgReplaceAccountMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceAccountMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceAccountMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceAccountMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceAccountMetadata.Merge(m, src)
+}
+func (m *MsgReplaceAccountMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceAccountMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceAccountMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceAccountMetadata proto.InternalMessageInfo
+
+func (m *MsgReplaceAccountMetadata) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MsgReplaceAccountMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgReplaceAccountMetadata) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgReplaceAccountMetadata) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgReplaceAccountMetadata) GetNewMetadataURI() string {
+	if m != nil {
+		return m.NewMetadataURI
+	}
+	return ""
+}
+
+// MsgReplaceAccountMetadataResponse returns an empty response.
+type MsgReplaceAccountMetadataResponse struct {
+}
+
+func (m *MsgReplaceAccountMetadataResponse) Reset()         { *m = MsgReplaceAccountMetadataResponse{} }
+func (m *MsgReplaceAccountMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceAccountMetadataResponse) ProtoMessage()    {}
+func (*MsgReplaceAccountMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{27}
+}
+func (m *MsgReplaceAccountMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceAccountMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceAccountMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceAccountMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceAccountMetadataResponse.Merge(m, src)
+}
+func (m *MsgReplaceAccountMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceAccountMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceAccountMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceAccountMetadataResponse proto.InternalMessageInfo
+
+// MsgTransferAccount is the request model used to transfer accounts
+type MsgTransferAccount struct {
+	// Domain is the domain of the account
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
+	// Name is the name of the account
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Owner is the owner of the account
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner`
+	// Payer is the address of the entity that pays the product and transaction
+	// fees
+	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
+	// NewOwner is the new owner of the account
+	NewOwner string `protobuf:"bytes,5,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" yaml:"new_owner`
+	// ToReset if true, removes all old data from account
+	ToReset bool `protobuf:"varint,6,opt,name=reset,proto3" json:"reset,omitempty" yaml:"reset`
+}
+
+func (m *MsgTransferAccount) Reset()         { *m = MsgTransferAccount{} }
+func (m *MsgTransferAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAccount) ProtoMessage()    {}
+func (*MsgTransferAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6831bd3a11b01297, []int{28}
+}
+func (m *MsgTransferAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAccount.Merge(m, src)
+}
+func (m *MsgTransferAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAccount proto.InternalMessageInfo
+
+func (m *MsgTransferAccount) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
@@ -1294,7 +1792,7 @@ func (m *MsgTransferAccountResponse) Reset()         { *m = MsgTransferAccountRe
 func (m *MsgTransferAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferAccountResponse) ProtoMessage()    {}
 func (*MsgTransferAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{21}
+	return fileDescriptor_6831bd3a11b01297, []int{29}
 }
 func (m *MsgTransferAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,7 +1840,7 @@ func (m *MsgTransferDomain) Reset()         { *m = MsgTransferDomain{} }
 func (m *MsgTransferDomain) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferDomain) ProtoMessage()    {}
 func (*MsgTransferDomain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{22}
+	return fileDescriptor_6831bd3a11b01297, []int{30}
 }
 func (m *MsgTransferDomain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1414,7 +1912,7 @@ func (m *MsgTransferDomainResponse) Reset()         { *m = MsgTransferDomainResp
 func (m *MsgTransferDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferDomainResponse) ProtoMessage()    {}
 func (*MsgTransferDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6831bd3a11b01297, []int{23}
+	return fileDescriptor_6831bd3a11b01297, []int{31}
 }
 func (m *MsgTransferDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1448,6 +1946,10 @@ func init() {
 	proto.RegisterType((*MsgAddAccountCertificateResponse)(nil), "starnamed.x.starname.v1beta1.MsgAddAccountCertificateResponse")
 	proto.RegisterType((*MsgDeleteAccountCertificate)(nil), "starnamed.x.starname.v1beta1.MsgDeleteAccountCertificate")
 	proto.RegisterType((*MsgDeleteAccountCertificateResponse)(nil), "starnamed.x.starname.v1beta1.MsgDeleteAccountCertificateResponse")
+	proto.RegisterType((*MsgCommitRegistration)(nil), "starnamed.x.starname.v1beta1.MsgCommitRegistration")
+	proto.RegisterType((*MsgCommitRegistrationResponse)(nil), "starnamed.x.starname.v1beta1.MsgCommitRegistrationResponse")
+	proto.RegisterType((*MsgSetDomainPolicy)(nil), "starnamed.x.starname.v1beta1.MsgSetDomainPolicy")
+	proto.RegisterType((*MsgSetDomainPolicyResponse)(nil), "starnamed.x.starname.v1beta1.MsgSetDomainPolicyResponse")
 	proto.RegisterType((*MsgDeleteAccount)(nil), "starnamed.x.starname.v1beta1.MsgDeleteAccount")
 	proto.RegisterType((*MsgDeleteAccountResponse)(nil), "starnamed.x.starname.v1beta1.MsgDeleteAccountResponse")
 	proto.RegisterType((*MsgDeleteDomain)(nil), "starnamed.x.starname.v1beta1.MsgDeleteDomain")
@@ -1462,6 +1964,10 @@ func init() {
 	proto.RegisterType((*MsgRenewDomainResponse)(nil), "starnamed.x.starname.v1beta1.MsgRenewDomainResponse")
 	proto.RegisterType((*MsgReplaceAccountResources)(nil), "starnamed.x.starname.v1beta1.MsgReplaceAccountResources")
 	proto.RegisterType((*MsgReplaceAccountResourcesResponse)(nil), "starnamed.x.starname.v1beta1.MsgReplaceAccountResourcesResponse")
+	proto.RegisterType((*MsgUpsertAccountResources)(nil), "starnamed.x.starname.v1beta1.MsgUpsertAccountResources")
+	proto.RegisterType((*MsgUpsertAccountResourcesResponse)(nil), "starnamed.x.starname.v1beta1.MsgUpsertAccountResourcesResponse")
+	proto.RegisterType((*MsgRemoveAccountResources)(nil), "starnamed.x.starname.v1beta1.MsgRemoveAccountResources")
+	proto.RegisterType((*MsgRemoveAccountResourcesResponse)(nil), "starnamed.x.starname.v1beta1.MsgRemoveAccountResourcesResponse")
 	proto.RegisterType((*MsgReplaceAccountMetadata)(nil), "starnamed.x.starname.v1beta1.MsgReplaceAccountMetadata")
 	proto.RegisterType((*MsgReplaceAccountMetadataResponse)(nil), "starnamed.x.starname.v1beta1.MsgReplaceAccountMetadataResponse")
 	proto.RegisterType((*MsgTransferAccount)(nil), "starnamed.x.starname.v1beta1.MsgTransferAccount")
@@ -1473,79 +1979,97 @@ func init() {
 func init() { proto.RegisterFile("iov/starname/v1beta1/tx.proto", fileDescriptor_6831bd3a11b01297) }
 
 var fileDescriptor_6831bd3a11b01297 = []byte{
-	// 1138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x25, 0xdb, 0x89, 0xc7, 0xb2, 0x65, 0x33, 0x89, 0x4d, 0x33, 0x89, 0xa8, 0xdf, 0xfa,
-	0xe7, 0xd4, 0x01, 0x2a, 0xc9, 0x49, 0x9b, 0x34, 0xed, 0xa1, 0xad, 0x15, 0xa3, 0x45, 0x81, 0xa8,
-	0x05, 0x16, 0x2e, 0x0a, 0xe4, 0x62, 0xd0, 0xe2, 0x9a, 0x25, 0x2a, 0x91, 0x2a, 0x49, 0x5b, 0x76,
-	0x81, 0x3e, 0x41, 0xff, 0xa2, 0x45, 0xd1, 0x5e, 0xfb, 0x00, 0xbd, 0x14, 0x7d, 0x88, 0x1e, 0x73,
-	0xec, 0x89, 0x28, 0xe4, 0x37, 0xe0, 0xad, 0xb9, 0xb4, 0xe0, 0x2e, 0xb5, 0xfc, 0x63, 0xc9, 0x22,
-	0x8d, 0x04, 0x48, 0x6e, 0xd2, 0xce, 0x37, 0xb3, 0xdf, 0x7c, 0xdc, 0xd9, 0x19, 0x12, 0x6e, 0x1a,
-	0xd6, 0x51, 0xc3, 0x71, 0x55, 0xdb, 0x54, 0xbb, 0xa4, 0x71, 0x74, 0x67, 0x9f, 0xb8, 0xea, 0x9d,
-	0x86, 0x7b, 0x5c, 0xef, 0xd9, 0x96, 0x6b, 0x89, 0x37, 0x86, 0x26, 0xad, 0x7e, 0x5c, 0x1f, 0xfe,
-	0xae, 0x87, 0x30, 0xf9, 0xaa, 0x6e, 0xe9, 0x16, 0x05, 0x36, 0x82, 0x5f, 0xcc, 0x47, 0xae, 0x8e,
-	0x0e, 0x79, 0xd2, 0x23, 0x0e, 0x43, 0xa0, 0x7f, 0x05, 0x90, 0x5a, 0x8e, 0xbe, 0xad, 0x69, 0xdb,
-	0xed, 0xb6, 0x75, 0x68, 0xba, 0x0f, 0x89, 0xed, 0x1a, 0x07, 0x46, 0x5b, 0x75, 0x89, 0x78, 0x1b,
-	0x66, 0x35, 0xab, 0xab, 0x1a, 0xa6, 0x24, 0x54, 0x85, 0xcd, 0xb9, 0xe6, 0xb2, 0xef, 0x29, 0x0b,
-	0x27, 0x6a, 0xb7, 0xf3, 0x16, 0x62, 0xeb, 0x08, 0x87, 0x00, 0x71, 0x1d, 0xa6, 0x83, 0x3d, 0xa4,
-	0x02, 0x05, 0x96, 0x7d, 0x4f, 0x99, 0x67, 0xc0, 0x60, 0x15, 0x61, 0x6a, 0x14, 0x6f, 0xc1, 0x8c,
-	0xd5, 0x37, 0x89, 0x2d, 0x15, 0x29, 0x6a, 0xc9, 0xf7, 0x94, 0x12, 0x43, 0xd1, 0x65, 0x84, 0x99,
-	0x39, 0xc0, 0xf5, 0xd4, 0x13, 0x62, 0x4b, 0xd3, 0x69, 0x1c, 0x5d, 0x46, 0x98, 0x99, 0xc5, 0x87,
-	0x50, 0x36, 0x49, 0x7f, 0xaf, 0x1d, 0x51, 0x96, 0x66, 0xaa, 0xc2, 0x66, 0xa9, 0x29, 0xfb, 0x9e,
-	0xb2, 0x12, 0xee, 0x9f, 0x04, 0x20, 0xbc, 0x68, 0x92, 0x7e, 0x2c, 0x49, 0x84, 0xa0, 0x3a, 0x4e,
-	0x00, 0x4c, 0x9c, 0x9e, 0x65, 0x3a, 0x04, 0x7d, 0x55, 0x80, 0xeb, 0x2d, 0x47, 0xdf, 0x21, 0x1d,
-	0xe2, 0x92, 0x97, 0x50, 0xa8, 0x47, 0x20, 0x6a, 0x94, 0xfb, 0x08, 0xad, 0x6e, 0xfa, 0x9e, 0xb2,
-	0x16, 0x72, 0x3d, 0x83, 0x41, 0x78, 0x99, 0x2d, 0xc6, 0x15, 0xdb, 0x80, 0xf5, 0x73, 0xc4, 0xe0,
-	0xa2, 0xfd, 0x2e, 0xc0, 0x52, 0x1a, 0xf7, 0xa2, 0x2b, 0x85, 0x64, 0x5a, 0x0e, 0x09, 0xce, 0x3c,
-	0xa1, 0xaf, 0x05, 0x28, 0x73, 0xe3, 0x0e, 0x23, 0x99, 0x23, 0x1f, 0x4e, 0xb5, 0x90, 0x91, 0x6a,
-	0xf1, 0x7c, 0xaa, 0x6b, 0xb0, 0x9a, 0x62, 0xc3, 0x99, 0xfa, 0x05, 0x10, 0x5b, 0x8e, 0x8e, 0x89,
-	0x6e, 0x38, 0x2e, 0xb1, 0x5f, 0x12, 0xf1, 0x03, 0x7e, 0xfb, 0xb6, 0xf5, 0x19, 0xb1, 0xe9, 0xd1,
-	0x4c, 0xf0, 0x63, 0xeb, 0x08, 0x87, 0x00, 0xf1, 0x1e, 0x80, 0x1d, 0x66, 0x47, 0x6c, 0x69, 0x96,
-	0xc2, 0xaf, 0xf9, 0x9e, 0xb2, 0xcc, 0xe0, 0x91, 0x0d, 0xe1, 0x18, 0x50, 0x7c, 0x0c, 0x73, 0x36,
-	0x71, 0xac, 0x43, 0xbb, 0x4d, 0x1c, 0xe9, 0x52, 0xb5, 0xb8, 0x39, 0x7f, 0xf7, 0x56, 0xfd, 0xbc,
-	0x8b, 0xb5, 0x8e, 0x43, 0x78, 0xf3, 0xaa, 0xef, 0x29, 0x4b, 0xc3, 0xe8, 0x61, 0x08, 0x84, 0xa3,
-	0x70, 0xe8, 0x06, 0xc8, 0x67, 0x35, 0xe7, 0x8f, 0xe4, 0x1f, 0x01, 0x96, 0x63, 0xe6, 0x9d, 0xa4,
-	0xcc, 0xc2, 0x04, 0x99, 0x55, 0xad, 0x6b, 0x98, 0x67, 0x0f, 0x0e, 0x5d, 0x46, 0x98, 0x99, 0xb3,
-	0x1e, 0x9c, 0x98, 0xcc, 0xd3, 0x93, 0x64, 0xde, 0x81, 0x79, 0x76, 0x20, 0xf6, 0x82, 0xa6, 0x11,
-	0x3e, 0x96, 0xf5, 0x48, 0xe7, 0x98, 0xf1, 0xa9, 0xa7, 0x00, 0xcb, 0x6a, 0xf7, 0xa4, 0x47, 0x30,
-	0x68, 0xfc, 0x37, 0xba, 0x0e, 0x6b, 0x67, 0x52, 0xe7, 0xc2, 0xfc, 0xc1, 0xaa, 0x0a, 0x13, 0x93,
-	0xf4, 0x9f, 0xd7, 0x41, 0xbd, 0x0d, 0xb3, 0x8e, 0xa1, 0x47, 0x27, 0x35, 0x16, 0x8f, 0xad, 0x23,
-	0x1c, 0x02, 0x32, 0x5f, 0x14, 0xac, 0xfa, 0xe2, 0xac, 0x79, 0x46, 0xdf, 0x0a, 0xb0, 0x38, 0xb4,
-	0xe5, 0xbf, 0x26, 0x22, 0xae, 0x85, 0xcc, 0x5c, 0x27, 0xdc, 0x14, 0x12, 0xac, 0x24, 0xf9, 0x70,
-	0xaa, 0xbf, 0x16, 0xc2, 0x43, 0xdb, 0xeb, 0xa8, 0xed, 0xd8, 0x85, 0xc7, 0x8e, 0xf4, 0x33, 0x7f,
-	0x0e, 0x1b, 0xc9, 0x0b, 0x23, 0x86, 0xa2, 0xcb, 0x79, 0xef, 0x0b, 0x0d, 0x16, 0x82, 0xf6, 0x1e,
-	0x55, 0xf4, 0x4c, 0xae, 0x8a, 0x5e, 0xf5, 0x3d, 0xe5, 0x4a, 0x34, 0x25, 0xf0, 0x30, 0xb8, 0x64,
-	0x92, 0x3e, 0x17, 0x01, 0xfd, 0x1f, 0xd0, 0x78, 0x89, 0xb8, 0x92, 0x3f, 0x16, 0xc2, 0x43, 0x1e,
-	0x87, 0xb5, 0x88, 0xab, 0x6a, 0xaa, 0xab, 0xbe, 0xe8, 0x42, 0x7e, 0x02, 0x4b, 0x81, 0x02, 0xdd,
-	0x90, 0xee, 0xde, 0xa1, 0x6d, 0x84, 0xb5, 0x5e, 0x1b, 0x78, 0xca, 0xe2, 0x87, 0xa4, 0x3f, 0xcc,
-	0xe4, 0x63, 0xfc, 0x81, 0xef, 0x29, 0xab, 0x91, 0x6a, 0x71, 0x1f, 0x36, 0x5c, 0x71, 0xa8, 0x6d,
-	0xa0, 0x75, 0xf8, 0xdf, 0x58, 0x51, 0xb8, 0x74, 0x3f, 0xb3, 0x6e, 0xb5, 0x6b, 0xab, 0xa6, 0x73,
-	0xf0, 0xfc, 0xba, 0xd5, 0x33, 0xd6, 0x6c, 0x0b, 0xe6, 0x82, 0xfc, 0x59, 0x48, 0x26, 0xd6, 0x15,
-	0xdf, 0x53, 0xca, 0x91, 0x34, 0x2c, 0xec, 0x65, 0x93, 0xf4, 0x3f, 0xa2, 0x91, 0xb7, 0x60, 0xc6,
-	0x26, 0x0e, 0x71, 0x69, 0xbb, 0xba, 0xdc, 0x94, 0x07, 0x9e, 0x72, 0x69, 0xd7, 0xc2, 0xc1, 0x52,
-	0xc4, 0x85, 0x22, 0x30, 0x03, 0x86, 0x2d, 0x25, 0x25, 0x0c, 0xd7, 0xed, 0x9b, 0x02, 0x6d, 0x29,
-	0x43, 0x73, 0xfe, 0xab, 0x66, 0x23, 0x39, 0x91, 0x4c, 0x54, 0xa4, 0x98, 0x49, 0x11, 0xd6, 0xab,
-	0xa6, 0x47, 0x29, 0x42, 0x4d, 0x54, 0x91, 0x6d, 0xda, 0xb1, 0x1e, 0xc1, 0x82, 0x1b, 0xb2, 0xdf,
-	0x3b, 0xe8, 0xa8, 0x3a, 0xd5, 0xb1, 0xd8, 0x7c, 0x25, 0x2a, 0xcc, 0x84, 0xf9, 0xa9, 0xa7, 0x94,
-	0x86, 0xd9, 0xbe, 0xd7, 0x51, 0x75, 0x5c, 0x72, 0x63, 0xff, 0xc2, 0x36, 0x93, 0x94, 0x63, 0x28,
-	0xd6, 0xdd, 0xdf, 0x4a, 0x50, 0x6c, 0x39, 0xba, 0xf8, 0x9d, 0x00, 0xd7, 0x46, 0xbf, 0xed, 0xdc,
-	0x3f, 0xff, 0xda, 0x18, 0xf7, 0x92, 0x20, 0xbf, 0x7d, 0x31, 0xbf, 0x21, 0x33, 0xb1, 0x0f, 0x0b,
-	0xc9, 0x19, 0xb9, 0x3e, 0x31, 0x60, 0x02, 0x2f, 0xdf, 0xcf, 0x87, 0xe7, 0x1b, 0xff, 0x22, 0x80,
-	0x34, 0xf6, 0x95, 0xe6, 0xcd, 0x7c, 0x41, 0xe3, 0x82, 0x6c, 0x5f, 0xd8, 0x95, 0x53, 0x73, 0xa1,
-	0x94, 0x18, 0xb3, 0x6b, 0x19, 0x43, 0x32, 0xb8, 0x7c, 0x2f, 0x17, 0x9c, 0xef, 0xfa, 0x25, 0x94,
-	0xd3, 0x23, 0xf3, 0xd6, 0xc4, 0x48, 0x29, 0x0f, 0xf9, 0x41, 0x5e, 0x0f, 0xbe, 0xfd, 0x17, 0xb0,
-	0x98, 0x1a, 0x0f, 0x1b, 0x99, 0x63, 0x85, 0x89, 0xbf, 0x91, 0xd3, 0x21, 0x2e, 0x78, 0x62, 0x02,
-	0xab, 0x65, 0x08, 0x14, 0xc1, 0x33, 0x08, 0x3e, 0x6a, 0x52, 0x12, 0x3f, 0x87, 0xf9, 0xf8, 0x94,
-	0xf4, 0x6a, 0xb6, 0x28, 0x61, 0xae, 0xaf, 0xe7, 0x41, 0xf3, 0x2d, 0x7f, 0x10, 0x60, 0x65, 0x4c,
-	0x93, 0xce, 0x22, 0xde, 0x28, 0x47, 0xf9, 0x9d, 0x0b, 0x3a, 0x72, 0x52, 0x3f, 0x09, 0xb0, 0x3a,
-	0x6e, 0x06, 0x7b, 0x90, 0x33, 0x38, 0xf7, 0x94, 0xdf, 0xbd, 0xa8, 0x67, 0xbc, 0x20, 0xd2, 0x5d,
-	0x79, 0x72, 0x41, 0xa4, 0x3c, 0x32, 0x14, 0xc4, 0x98, 0x06, 0x17, 0x14, 0x44, 0xaa, 0xb9, 0x35,
-	0x32, 0xc7, 0xca, 0x5c, 0x10, 0xa3, 0xfb, 0x45, 0xf3, 0xfd, 0x3f, 0x07, 0x15, 0xe1, 0xc9, 0xa0,
-	0x22, 0xfc, 0x3d, 0xa8, 0x08, 0xdf, 0x9f, 0x56, 0xa6, 0x9e, 0x9c, 0x56, 0xa6, 0xfe, 0x3a, 0xad,
-	0x4c, 0x3d, 0xae, 0xe9, 0x86, 0xfb, 0xe9, 0xe1, 0x7e, 0xbd, 0x6d, 0x75, 0x1b, 0x86, 0x75, 0x54,
-	0xb3, 0x4c, 0xc2, 0xbf, 0xb1, 0x69, 0x8d, 0xe3, 0xe8, 0x7b, 0x1b, 0xfd, 0xce, 0xb6, 0x3f, 0x4b,
-	0x3f, 0xb4, 0xbd, 0xf6, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x42, 0x00, 0x1f, 0xdf, 0x13,
-	0x00, 0x00,
+	// 1440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x77, 0xb3, 0x69, 0xf2, 0xb2, 0xc9, 0x26, 0x6e, 0xd3, 0x38, 0x6e, 0x1b, 0x87, 0x09,
+	0x2d, 0x29, 0x90, 0xdd, 0xfe, 0xa1, 0x25, 0x80, 0x44, 0xc9, 0x36, 0x02, 0x55, 0x6a, 0x00, 0x0d,
+	0xad, 0x50, 0x7b, 0x89, 0x9c, 0xdd, 0xe9, 0x62, 0xb1, 0x6b, 0x2f, 0xb6, 0x93, 0x6d, 0x90, 0xb8,
+	0x72, 0xe1, 0xaf, 0x40, 0x08, 0x4e, 0x48, 0x7c, 0x05, 0xc4, 0x87, 0xe8, 0xb1, 0x47, 0xc4, 0xc1,
+	0xa0, 0xf4, 0x82, 0x38, 0xee, 0xb1, 0x27, 0xe4, 0x19, 0xef, 0x78, 0xec, 0x78, 0xb3, 0xf6, 0x2a,
+	0x95, 0x9a, 0x9b, 0xf7, 0xbd, 0xdf, 0x7b, 0xf3, 0xde, 0xef, 0xcd, 0x3c, 0xbf, 0xf1, 0xc2, 0x39,
+	0xc3, 0xda, 0xad, 0x38, 0xae, 0x6e, 0x9b, 0x7a, 0x8b, 0x54, 0x76, 0x2f, 0x6f, 0x13, 0x57, 0xbf,
+	0x5c, 0x71, 0x1f, 0x96, 0xdb, 0xb6, 0xe5, 0x5a, 0xf2, 0xd9, 0x9e, 0xaa, 0x5e, 0x7e, 0x58, 0xee,
+	0x3d, 0x97, 0x03, 0x98, 0x7a, 0xaa, 0x61, 0x35, 0x2c, 0x0a, 0xac, 0xf8, 0x4f, 0xcc, 0x46, 0x5d,
+	0x4a, 0x76, 0xb9, 0xd7, 0x26, 0x0e, 0x43, 0xa0, 0xbf, 0x72, 0xa0, 0x6c, 0x3a, 0x8d, 0xf5, 0x7a,
+	0x7d, 0xbd, 0x56, 0xb3, 0x76, 0x4c, 0xf7, 0x26, 0xb1, 0x5d, 0xe3, 0x81, 0x51, 0xd3, 0x5d, 0x22,
+	0x5f, 0x84, 0xb1, 0xba, 0xd5, 0xd2, 0x0d, 0x53, 0x91, 0x96, 0xa4, 0x95, 0x89, 0xea, 0x6c, 0xd7,
+	0xd3, 0xa6, 0xf6, 0xf4, 0x56, 0xf3, 0x4d, 0xc4, 0xe4, 0x08, 0x07, 0x00, 0x79, 0x19, 0x46, 0xfd,
+	0x35, 0x94, 0x1c, 0x05, 0x96, 0xba, 0x9e, 0x36, 0xc9, 0x80, 0xbe, 0x14, 0x61, 0xaa, 0x94, 0x2f,
+	0x40, 0xc1, 0xea, 0x98, 0xc4, 0x56, 0xf2, 0x14, 0x35, 0xd3, 0xf5, 0xb4, 0x22, 0x43, 0x51, 0x31,
+	0xc2, 0x4c, 0xed, 0xe3, 0xda, 0xfa, 0x1e, 0xb1, 0x95, 0xd1, 0x38, 0x8e, 0x8a, 0x11, 0x66, 0x6a,
+	0xf9, 0x26, 0x94, 0x4c, 0xd2, 0xd9, 0xaa, 0x85, 0x21, 0x2b, 0x85, 0x25, 0x69, 0xa5, 0x58, 0x55,
+	0xbb, 0x9e, 0x76, 0x3a, 0x58, 0x3f, 0x0a, 0x40, 0x78, 0xda, 0x24, 0x1d, 0x31, 0xc9, 0x7b, 0x30,
+	0x23, 0xe8, 0xb7, 0x7c, 0x72, 0x94, 0x31, 0xba, 0x6e, 0xb9, 0xeb, 0x69, 0xf3, 0xcc, 0x4b, 0x1c,
+	0x81, 0x9e, 0x7a, 0x5a, 0x49, 0xf0, 0x72, 0x67, 0xaf, 0x4d, 0x70, 0xa9, 0x16, 0x15, 0x20, 0x04,
+	0x4b, 0xfd, 0xb8, 0xc5, 0xc4, 0x69, 0x5b, 0xa6, 0x43, 0xd0, 0x57, 0x39, 0x38, 0xb3, 0xe9, 0x34,
+	0x36, 0x48, 0x93, 0xb8, 0xe4, 0x18, 0xd6, 0xe0, 0x36, 0xc8, 0x75, 0x1a, 0x7b, 0x42, 0x19, 0xce,
+	0x75, 0x3d, 0x6d, 0x21, 0x88, 0xf5, 0x00, 0x06, 0xe1, 0x59, 0x26, 0x14, 0xb2, 0x45, 0xe7, 0x61,
+	0xf9, 0x10, 0x32, 0x38, 0x69, 0xbf, 0x4a, 0x30, 0xb7, 0xe9, 0x34, 0x6e, 0x5a, 0xad, 0x96, 0xe1,
+	0x62, 0xd2, 0x30, 0x1c, 0xd7, 0xd6, 0x5d, 0xc3, 0x32, 0xe5, 0x6b, 0x00, 0x35, 0x2a, 0x6d, 0x11,
+	0xd3, 0xa5, 0x94, 0x15, 0xab, 0x73, 0x5d, 0x4f, 0x9b, 0x0d, 0xea, 0xc8, 0x75, 0x08, 0x0b, 0xc0,
+	0x90, 0x95, 0x5c, 0x4a, 0x56, 0xf2, 0x87, 0xb2, 0x82, 0x34, 0x38, 0x97, 0x18, 0x1f, 0xcf, 0xe0,
+	0x3f, 0x09, 0xe4, 0x4d, 0xa7, 0xf1, 0x11, 0x71, 0x37, 0x68, 0xf1, 0x3e, 0xb4, 0x9a, 0x46, 0x6d,
+	0x2f, 0x4b, 0xb5, 0x2f, 0x40, 0x41, 0xaf, 0xb7, 0x0c, 0xf3, 0x60, 0xc8, 0x54, 0x8c, 0x30, 0x53,
+	0xa7, 0x0d, 0x59, 0xbe, 0x07, 0x63, 0x6d, 0x1a, 0x04, 0xad, 0xf8, 0xe4, 0x95, 0x97, 0xcb, 0x87,
+	0x35, 0x9c, 0xb2, 0x18, 0x76, 0x75, 0xee, 0x91, 0xa7, 0x8d, 0x84, 0xa1, 0x32, 0x3f, 0x08, 0x07,
+	0x0e, 0xd1, 0x59, 0x50, 0x0f, 0xe6, 0xca, 0xa9, 0xf8, 0x5d, 0x82, 0x99, 0x78, 0xd1, 0x9f, 0xf7,
+	0x6d, 0x8f, 0x54, 0x50, 0xe2, 0x31, 0xf3, 0x84, 0xbe, 0x96, 0xa0, 0xc4, 0x95, 0x2c, 0xe5, 0x8c,
+	0x85, 0x3d, 0xd2, 0xbd, 0xb8, 0x00, 0xf3, 0xb1, 0x68, 0x78, 0xa4, 0x8f, 0xf2, 0x74, 0x17, 0xb2,
+	0x1d, 0x4a, 0xec, 0x63, 0x42, 0xbe, 0x1f, 0xdf, 0xb6, 0x6d, 0x7d, 0x4a, 0x6c, 0xa5, 0x10, 0x8f,
+	0x8f, 0xc9, 0x11, 0x0e, 0x00, 0x7e, 0x3f, 0xb0, 0x83, 0xec, 0x88, 0x1d, 0xf4, 0x75, 0xa1, 0x1f,
+	0x84, 0x3a, 0x84, 0x05, 0xa0, 0x7c, 0x1f, 0x26, 0x6c, 0xe2, 0x58, 0x3b, 0x76, 0x8d, 0x38, 0xca,
+	0x89, 0xa5, 0xfc, 0xca, 0xe4, 0x95, 0x0b, 0x87, 0x9f, 0x07, 0x1c, 0xc0, 0xab, 0xa7, 0xba, 0x9e,
+	0x36, 0xd3, 0xf3, 0x1e, 0xb8, 0x40, 0x38, 0x74, 0xe7, 0x53, 0xe6, 0xe8, 0x4d, 0x57, 0x19, 0xa7,
+	0xcd, 0x49, 0xa0, 0xcc, 0x97, 0x22, 0x4c, 0x95, 0x72, 0x05, 0xc6, 0x3b, 0x46, 0xb3, 0x5e, 0xd3,
+	0xed, 0xba, 0x32, 0xb1, 0x24, 0xad, 0x8c, 0x57, 0x4f, 0x76, 0x3d, 0xad, 0xc4, 0x80, 0x3d, 0x0d,
+	0xc2, 0x1c, 0x14, 0x9c, 0xb1, 0x58, 0x25, 0xc3, 0x86, 0x99, 0x83, 0x59, 0x41, 0xbd, 0x11, 0x2d,
+	0x9e, 0x34, 0xa0, 0x78, 0x47, 0xda, 0x67, 0xc2, 0xe2, 0x8d, 0x0e, 0x2a, 0xde, 0x06, 0x4c, 0xb2,
+	0x6d, 0xc6, 0xde, 0xca, 0xac, 0xd8, 0xcb, 0x61, 0xf5, 0x04, 0xe5, 0x53, 0x4f, 0x03, 0x96, 0x15,
+	0x7d, 0x15, 0x43, 0x9d, 0x3f, 0x73, 0xbe, 0xc7, 0x0e, 0xe1, 0x1b, 0x9d, 0x81, 0x85, 0x03, 0xfc,
+	0x70, 0xf6, 0xfe, 0x60, 0x07, 0x1a, 0x13, 0x93, 0x74, 0x9e, 0xd5, 0x19, 0xb9, 0x08, 0x63, 0x8e,
+	0xd1, 0x08, 0x0f, 0x89, 0xe0, 0x8f, 0xc9, 0x11, 0x0e, 0x00, 0xa9, 0x7b, 0x14, 0x3b, 0xf8, 0x62,
+	0xd4, 0x3c, 0xa3, 0x6f, 0x25, 0x98, 0xee, 0xe9, 0xb2, 0x77, 0xa8, 0x30, 0xd6, 0x5c, 0xea, 0x58,
+	0x07, 0x34, 0x29, 0x05, 0x4e, 0x47, 0xe3, 0xe1, 0xa1, 0xfe, 0x96, 0x0b, 0x76, 0x76, 0xbb, 0xa9,
+	0xd7, 0x84, 0x5e, 0x1b, 0x9c, 0xa6, 0xa3, 0xae, 0xc3, 0xf9, 0x68, 0xaf, 0x12, 0x50, 0x54, 0x9c,
+	0xb5, 0x55, 0xd5, 0x61, 0xca, 0x9f, 0x40, 0xc3, 0x66, 0x52, 0xc8, 0xd4, 0x4c, 0xe6, 0xbb, 0x9e,
+	0x76, 0x32, 0x1c, 0x64, 0xb9, 0x1b, 0x5c, 0x34, 0x49, 0x87, 0x93, 0x80, 0x5e, 0x04, 0xd4, 0x9f,
+	0x22, 0xce, 0xe4, 0xcf, 0x39, 0xba, 0xc9, 0xef, 0xb6, 0x1d, 0x62, 0xbb, 0xcf, 0x9c, 0xc8, 0xa3,
+	0x6e, 0xfa, 0x91, 0x96, 0x5c, 0x38, 0xd2, 0x96, 0x8c, 0x96, 0xe1, 0x85, 0xbe, 0xc4, 0x70, 0xfa,
+	0xfe, 0x95, 0x82, 0x1e, 0xd1, 0xb2, 0x76, 0xc9, 0xb1, 0xa3, 0xef, 0x15, 0x18, 0xdd, 0xb1, 0x0d,
+	0xc6, 0xdc, 0x44, 0x75, 0x7e, 0xdf, 0xd3, 0x46, 0xef, 0xe2, 0x5b, 0x4e, 0xb8, 0xb8, 0xaf, 0x45,
+	0x98, 0x82, 0x02, 0x3e, 0x92, 0x33, 0xe5, 0x7c, 0xfc, 0x98, 0x83, 0x85, 0x03, 0xbb, 0x6e, 0x93,
+	0xb8, 0x7a, 0x5d, 0x77, 0xf5, 0xe7, 0xfd, 0x5c, 0x7e, 0x0c, 0x33, 0xfe, 0x81, 0x6a, 0x05, 0xe1,
+	0x6e, 0xed, 0xd8, 0x46, 0xf0, 0x7e, 0x59, 0xdd, 0xf7, 0xb4, 0xe9, 0xf7, 0x49, 0xa7, 0x97, 0xc9,
+	0x5d, 0x7c, 0x2b, 0xbc, 0x07, 0xc6, 0x6d, 0xd8, 0x75, 0x92, 0x43, 0x6d, 0x83, 0x53, 0x97, 0x44,
+	0x8a, 0x78, 0x12, 0xfd, 0xb9, 0xeb, 0x8e, 0xad, 0x9b, 0xce, 0x83, 0x67, 0x37, 0x77, 0x1d, 0x31,
+	0x67, 0x97, 0x60, 0xc2, 0xcf, 0x9f, 0xb9, 0x64, 0x64, 0x09, 0x43, 0x09, 0x57, 0xe1, 0x71, 0x93,
+	0x74, 0x3e, 0xa0, 0x9e, 0x2f, 0x41, 0xc1, 0x26, 0x0e, 0x61, 0xef, 0xde, 0xf1, 0xaa, 0xba, 0xef,
+	0x69, 0x27, 0xee, 0x58, 0xd8, 0x17, 0x85, 0xb1, 0x50, 0x04, 0x66, 0xc0, 0x60, 0x8c, 0x89, 0x11,
+	0xc3, 0x79, 0xfb, 0x86, 0x8d, 0x31, 0x3d, 0x75, 0xf6, 0x37, 0xd7, 0xf9, 0xe8, 0x6c, 0x3d, 0x90,
+	0x91, 0x7c, 0x2a, 0x46, 0xd8, 0x7c, 0x34, 0x9a, 0xc4, 0x08, 0x55, 0x51, 0x46, 0xd6, 0xfd, 0x27,
+	0xf9, 0x36, 0x4c, 0xb9, 0x41, 0xf4, 0x5b, 0x0f, 0x9a, 0x7a, 0x83, 0xf2, 0x98, 0xaf, 0xbe, 0x14,
+	0xf6, 0xf9, 0x88, 0xfa, 0xa9, 0xa7, 0x15, 0x7b, 0xd9, 0xbe, 0xdb, 0xd4, 0x1b, 0xb8, 0xe8, 0x0a,
+	0xbf, 0x82, 0xa9, 0x25, 0x4a, 0x47, 0x8f, 0xac, 0x2b, 0x7f, 0xcf, 0x40, 0x7e, 0xd3, 0x69, 0xc8,
+	0xdf, 0x49, 0x30, 0x97, 0xfc, 0x7d, 0xe7, 0xfa, 0xe1, 0xfd, 0xb3, 0xdf, 0xb7, 0x0b, 0xf5, 0xed,
+	0xe1, 0xec, 0x7a, 0x91, 0xc9, 0x5f, 0x4a, 0x20, 0x27, 0xdc, 0xdd, 0xaf, 0x0e, 0x74, 0x7b, 0xd0,
+	0x48, 0x7d, 0x6b, 0x08, 0x23, 0x1e, 0x48, 0x07, 0xa6, 0xa2, 0xd7, 0xce, 0xf2, 0x40, 0x6f, 0x11,
+	0xbc, 0x7a, 0x3d, 0x1b, 0x9e, 0x2f, 0xfc, 0x8b, 0x04, 0x4a, 0xdf, 0x4f, 0x3e, 0x6f, 0x64, 0x73,
+	0x2a, 0x56, 0x66, 0x7d, 0x68, 0x53, 0x1e, 0x9a, 0x0b, 0xc5, 0xc8, 0xcd, 0x75, 0x35, 0xa5, 0x4b,
+	0x06, 0x57, 0xaf, 0x65, 0x82, 0xf3, 0x55, 0xbf, 0x80, 0x52, 0xfc, 0x16, 0x7a, 0x69, 0xa0, 0xa7,
+	0x98, 0x85, 0xba, 0x96, 0xd5, 0x82, 0x2f, 0xff, 0x39, 0x4c, 0xc7, 0xee, 0x46, 0x95, 0xd4, 0xbe,
+	0x82, 0xc4, 0x5f, 0xcf, 0x68, 0x20, 0x12, 0x1e, 0xb9, 0x59, 0xac, 0xa6, 0x70, 0x14, 0xc2, 0xd5,
+	0x6b, 0x99, 0xe0, 0x7c, 0xd5, 0xcf, 0x60, 0x52, 0x9c, 0xfe, 0x5f, 0x4d, 0xe7, 0x25, 0xc8, 0xf5,
+	0xb5, 0x2c, 0x68, 0xbe, 0xe4, 0x0f, 0x12, 0x9c, 0xee, 0x33, 0x2d, 0xa4, 0x21, 0x2f, 0xc9, 0x50,
+	0xbd, 0x31, 0xa4, 0x21, 0x0f, 0xea, 0x27, 0x09, 0xe6, 0xfb, 0xdd, 0x2d, 0xd6, 0x32, 0x3a, 0xe7,
+	0x96, 0xea, 0x3b, 0xc3, 0x5a, 0x46, 0xc8, 0xea, 0x33, 0xa9, 0x0f, 0x26, 0x2b, 0xd9, 0x50, 0xbd,
+	0x31, 0xa4, 0x61, 0xac, 0x82, 0x89, 0xf3, 0x6f, 0x9a, 0x0a, 0x26, 0x19, 0xaa, 0x37, 0x86, 0x34,
+	0x14, 0x5b, 0x47, 0xfc, 0x33, 0xea, 0xe0, 0xd6, 0x11, 0xb3, 0x50, 0xd7, 0xb2, 0x5a, 0x88, 0xcb,
+	0xc7, 0xe7, 0xb8, 0xc1, 0xcb, 0xc7, 0x2c, 0xd4, 0xb5, 0xac, 0x16, 0x62, 0xe7, 0x8a, 0x8d, 0x43,
+	0x95, 0xd4, 0xbe, 0x52, 0x77, 0xae, 0xe4, 0x09, 0xa3, 0xfa, 0xde, 0xa3, 0xfd, 0x45, 0xe9, 0xf1,
+	0xfe, 0xa2, 0xf4, 0xcf, 0xfe, 0xa2, 0xf4, 0xfd, 0x93, 0xc5, 0x91, 0xc7, 0x4f, 0x16, 0x47, 0xfe,
+	0x7c, 0xb2, 0x38, 0x72, 0x7f, 0xb5, 0x61, 0xb8, 0x9f, 0xec, 0x6c, 0x97, 0x6b, 0x56, 0xab, 0x62,
+	0x58, 0xbb, 0xab, 0x96, 0x49, 0xf8, 0xff, 0x50, 0xf5, 0xca, 0x43, 0xfe, 0xcc, 0xfe, 0x8b, 0xda,
+	0x1e, 0xa3, 0x7f, 0x46, 0x5d, 0xfd, 0x7f, 0x00, 0x5c, 0x24, 0x43, 0x73, 0x03, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1562,6 +2086,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// AddAccountCertificate adds a certificate to an Account
 	AddAccountCertificate(ctx context.Context, in *MsgAddAccountCertificate, opts ...grpc.CallOption) (*MsgAddAccountCertificateResponse, error)
+	// CommitRegistration commits to a future domain or account registration
+	CommitRegistration(ctx context.Context, in *MsgCommitRegistration, opts ...grpc.CallOption) (*MsgCommitRegistrationResponse, error)
 	// DeleteAccount registers a Domain
 	DeleteAccount(ctx context.Context, in *MsgDeleteAccount, opts ...grpc.CallOption) (*MsgDeleteAccountResponse, error)
 	// DeleteAccountCertificate deletes a certificate from an account
@@ -1580,6 +2106,12 @@ type MsgClient interface {
 	ReplaceAccountMetadata(ctx context.Context, in *MsgReplaceAccountMetadata, opts ...grpc.CallOption) (*MsgReplaceAccountMetadataResponse, error)
 	// ReplaceAccountResources registers a Domain
 	ReplaceAccountResources(ctx context.Context, in *MsgReplaceAccountResources, opts ...grpc.CallOption) (*MsgReplaceAccountResourcesResponse, error)
+	// UpsertAccountResources adds or updates single resources of an account
+	UpsertAccountResources(ctx context.Context, in *MsgUpsertAccountResources, opts ...grpc.CallOption) (*MsgUpsertAccountResourcesResponse, error)
+	// RemoveAccountResources removes single resources of an account
+	RemoveAccountResources(ctx context.Context, in *MsgRemoveAccountResources, opts ...grpc.CallOption) (*MsgRemoveAccountResourcesResponse, error)
+	// SetDomainPolicy sets the policy of a domain
+	SetDomainPolicy(ctx context.Context, in *MsgSetDomainPolicy, opts ...grpc.CallOption) (*MsgSetDomainPolicyResponse, error)
 	// TransferAccount registers a Domain
 	TransferAccount(ctx context.Context, in *MsgTransferAccount, opts ...grpc.CallOption) (*MsgTransferAccountResponse, error)
 	// TransferDomain registers a Domain
@@ -1603,6 +2135,15 @@ func (c *msgClient) AddAccountCertificate(ctx context.Context, in *MsgAddAccount
 	return out, nil
 }
 
+func (c *msgClient) CommitRegistration(ctx context.Context, in *MsgCommitRegistration, opts ...grpc.CallOption) (*MsgCommitRegistrationResponse, error) {
+	out := new(MsgCommitRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Msg/CommitRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DeleteAccount(ctx context.Context, in *MsgDeleteAccount, opts ...grpc.CallOption) (*MsgDeleteAccountResponse, error) {
 	out := new(MsgDeleteAccountResponse)
 	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Msg/DeleteAccount", in, out, opts...)
@@ -1684,6 +2225,33 @@ func (c *msgClient) ReplaceAccountResources(ctx context.Context, in *MsgReplaceA
 	return out, nil
 }
 
+func (c *msgClient) UpsertAccountResources(ctx context.Context, in *MsgUpsertAccountResources, opts ...grpc.CallOption) (*MsgUpsertAccountResourcesResponse, error) {
+	out := new(MsgUpsertAccountResourcesResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Msg/UpsertAccountResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAccountResources(ctx context.Context, in *MsgRemoveAccountResources, opts ...grpc.CallOption) (*MsgRemoveAccountResourcesResponse, error) {
+	out := new(MsgRemoveAccountResourcesResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Msg/RemoveAccountResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDomainPolicy(ctx context.Context, in *MsgSetDomainPolicy, opts ...grpc.CallOption) (*MsgSetDomainPolicyResponse, error) {
+	out := new(MsgSetDomainPolicyResponse)
+	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Msg/SetDomainPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) TransferAccount(ctx context.Context, in *MsgTransferAccount, opts ...grpc.CallOption) (*MsgTransferAccountResponse, error) {
 	out := new(MsgTransferAccountResponse)
 	err := c.cc.Invoke(ctx, "/starnamed.x.starname.v1beta1.Msg/TransferAccount", in, out, opts...)
@@ -1706,6 +2274,8 @@ func (c *msgClient) TransferDomain(ctx context.Context, in *MsgTransferDomain, o
 type MsgServer interface {
 	// AddAccountCertificate adds a certificate to an Account
 	AddAccountCertificate(context.Context, *MsgAddAccountCertificate) (*MsgAddAccountCertificateResponse, error)
+	// CommitRegistration commits to a future domain or account registration
+	CommitRegistration(context.Context, *MsgCommitRegistration) (*MsgCommitRegistrationResponse, error)
 	// DeleteAccount registers a Domain
 	DeleteAccount(context.Context, *MsgDeleteAccount) (*MsgDeleteAccountResponse, error)
 	// DeleteAccountCertificate deletes a certificate from an account
@@ -1724,6 +2294,12 @@ type MsgServer interface {
 	ReplaceAccountMetadata(context.Context, *MsgReplaceAccountMetadata) (*MsgReplaceAccountMetadataResponse, error)
 	// ReplaceAccountResources registers a Domain
 	ReplaceAccountResources(context.Context, *MsgReplaceAccountResources) (*MsgReplaceAccountResourcesResponse, error)
+	// UpsertAccountResources adds or updates single resources of an account
+	UpsertAccountResources(context.Context, *MsgUpsertAccountResources) (*MsgUpsertAccountResourcesResponse, error)
+	// RemoveAccountResources removes single resources of an account
+	RemoveAccountResources(context.Context, *MsgRemoveAccountResources) (*MsgRemoveAccountResourcesResponse, error)
+	// SetDomainPolicy sets the policy of a domain
+	SetDomainPolicy(context.Context, *MsgSetDomainPolicy) (*MsgSetDomainPolicyResponse, error)
 	// TransferAccount registers a Domain
 	TransferAccount(context.Context, *MsgTransferAccount) (*MsgTransferAccountResponse, error)
 	// TransferDomain registers a Domain
@@ -1737,6 +2313,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AddAccountCertificate(ctx context.Context, req *MsgAddAccountCertificate) (*MsgAddAccountCertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAccountCertificate not implemented")
 }
+func (*UnimplementedMsgServer) CommitRegistration(ctx context.Context, req *MsgCommitRegistration) (*MsgCommitRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitRegistration not implemented")
+}
 func (*UnimplementedMsgServer) DeleteAccount(ctx context.Context, req *MsgDeleteAccount) (*MsgDeleteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
@@ -1764,6 +2343,15 @@ func (*UnimplementedMsgServer) ReplaceAccountMetadata(ctx context.Context, req *
 func (*UnimplementedMsgServer) ReplaceAccountResources(ctx context.Context, req *MsgReplaceAccountResources) (*MsgReplaceAccountResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceAccountResources not implemented")
 }
+func (*UnimplementedMsgServer) UpsertAccountResources(ctx context.Context, req *MsgUpsertAccountResources) (*MsgUpsertAccountResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertAccountResources not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAccountResources(ctx context.Context, req *MsgRemoveAccountResources) (*MsgRemoveAccountResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccountResources not implemented")
+}
+func (*UnimplementedMsgServer) SetDomainPolicy(ctx context.Context, req *MsgSetDomainPolicy) (*MsgSetDomainPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainPolicy not implemented")
+}
 func (*UnimplementedMsgServer) TransferAccount(ctx context.Context, req *MsgTransferAccount) (*MsgTransferAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferAccount not implemented")
 }
@@ -1793,25 +2381,43 @@ func _Msg_AddAccountCertificate_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAccount)
+func _Msg_CommitRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitRegistration)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DeleteAccount(ctx, in)
+		return srv.(MsgServer).CommitRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/starnamed.x.starname.v1beta1.Msg/DeleteAccount",
+		FullMethod: "/starnamed.x.starname.v1beta1.Msg/CommitRegistration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAccount(ctx, req.(*MsgDeleteAccount))
+		return srv.(MsgServer).CommitRegistration(ctx, req.(*MsgCommitRegistration))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DeleteAccountCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Msg_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Msg/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAccount(ctx, req.(*MsgDeleteAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAccountCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeleteAccountCertificate)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -1955,6 +2561,60 @@ func _Msg_ReplaceAccountResources_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpsertAccountResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertAccountResources)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertAccountResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Msg/UpsertAccountResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertAccountResources(ctx, req.(*MsgUpsertAccountResources))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAccountResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAccountResources)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAccountResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Msg/RemoveAccountResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAccountResources(ctx, req.(*MsgRemoveAccountResources))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDomainPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDomainPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDomainPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/starnamed.x.starname.v1beta1.Msg/SetDomainPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDomainPolicy(ctx, req.(*MsgSetDomainPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_TransferAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgTransferAccount)
 	if err := dec(in); err != nil {
@@ -1999,6 +2659,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddAccountCertificate",
 			Handler:    _Msg_AddAccountCertificate_Handler,
 		},
+		{
+			MethodName: "CommitRegistration",
+			Handler:    _Msg_CommitRegistration_Handler,
+		},
 		{
 			MethodName: "DeleteAccount",
 			Handler:    _Msg_DeleteAccount_Handler,
@@ -2035,6 +2699,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReplaceAccountResources",
 			Handler:    _Msg_ReplaceAccountResources_Handler,
 		},
+		{
+			MethodName: "UpsertAccountResources",
+			Handler:    _Msg_UpsertAccountResources_Handler,
+		},
+		{
+			MethodName: "RemoveAccountResources",
+			Handler:    _Msg_RemoveAccountResources_Handler,
+		},
+		{
+			MethodName: "SetDomainPolicy",
+			Handler:    _Msg_SetDomainPolicy_Handler,
+		},
 		{
 			MethodName: "TransferAccount",
 			Handler:    _Msg_TransferAccount_Handler,
@@ -2068,6 +2744,13 @@ func (m *MsgAddAccountCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.CertificateType) > 0 {
+		i -= len(m.CertificateType)
+		copy(dAtA[i:], m.CertificateType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CertificateType)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.NewCertificate) > 0 {
 		i -= len(m.NewCertificate)
 		copy(dAtA[i:], m.NewCertificate)
@@ -2210,6 +2893,150 @@ func (m *MsgDeleteAccountCertificateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCommitRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeleteAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2371,6 +3198,23 @@ func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Wildcard {
+		i--
+		if m.Wildcard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2473,6 +3317,13 @@ func (m *MsgRegisterDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DomainType) > 0 {
 		i -= len(m.DomainType)
 		copy(dAtA[i:], m.DomainType)
@@ -2763,7 +3614,7 @@ func (m *MsgReplaceAccountResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgReplaceAccountMetadata) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpsertAccountResources) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2773,22 +3624,29 @@ func (m *MsgReplaceAccountMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgReplaceAccountMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpsertAccountResources) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReplaceAccountMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpsertAccountResources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewMetadataURI) > 0 {
-		i -= len(m.NewMetadataURI)
-		copy(dAtA[i:], m.NewMetadataURI)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewMetadataURI)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
 	if len(m.Payer) > 0 {
 		i -= len(m.Payer)
@@ -2821,7 +3679,7 @@ func (m *MsgReplaceAccountMetadata) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgReplaceAccountMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpsertAccountResourcesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2831,12 +3689,12 @@ func (m *MsgReplaceAccountMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgReplaceAccountMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpsertAccountResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReplaceAccountMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpsertAccountResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2844,7 +3702,7 @@ func (m *MsgReplaceAccountMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferAccount) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveAccountResources) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2854,32 +3712,24 @@ func (m *MsgTransferAccount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTransferAccount) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveAccountResources) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveAccountResources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ToReset {
-		i--
-		if m.ToReset {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.URIs) > 0 {
+		for iNdEx := len(m.URIs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URIs[iNdEx])
+			copy(dAtA[i:], m.URIs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.URIs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x2a
 	}
 	if len(m.Payer) > 0 {
 		i -= len(m.Payer)
@@ -2912,7 +3762,7 @@ func (m *MsgTransferAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveAccountResourcesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2922,12 +3772,12 @@ func (m *MsgTransferAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTransferAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveAccountResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveAccountResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2935,7 +3785,7 @@ func (m *MsgTransferAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferDomain) Marshal() (dAtA []byte, err error) {
+func (m *MsgReplaceAccountMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2945,12 +3795,184 @@ func (m *MsgTransferDomain) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTransferDomain) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplaceAccountMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplaceAccountMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewMetadataURI) > 0 {
+		i -= len(m.NewMetadataURI)
+		copy(dAtA[i:], m.NewMetadataURI)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewMetadataURI)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceAccountMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceAccountMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceAccountMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToReset {
+		i--
+		if m.ToReset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3051,6 +4073,10 @@ func (m *MsgAddAccountCertificate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.CertificateType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3101,6 +4127,68 @@ func (m *MsgDeleteAccountCertificateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCommitRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDomainPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDomainPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeleteAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3201,6 +4289,13 @@ func (m *MsgRegisterAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Wildcard {
+		n += 2
+	}
 	return n
 }
 
@@ -3239,6 +4334,10 @@ func (m *MsgRegisterDomain) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3355,7 +4454,7 @@ func (m *MsgReplaceAccountResourcesResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgReplaceAccountMetadata) Size() (n int) {
+func (m *MsgUpsertAccountResources) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3377,14 +4476,16 @@ func (m *MsgReplaceAccountMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.NewMetadataURI)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MsgReplaceAccountMetadataResponse) Size() (n int) {
+func (m *MsgUpsertAccountResourcesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3393,7 +4494,7 @@ func (m *MsgReplaceAccountMetadataResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgTransferAccount) Size() (n int) {
+func (m *MsgRemoveAccountResources) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3415,12 +4516,90 @@ func (m *MsgTransferAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.URIs) > 0 {
+		for _, s := range m.URIs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	if m.ToReset {
-		n += 2
+	return n
+}
+
+func (m *MsgRemoveAccountResourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceAccountMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewMetadataURI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceAccountMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToReset {
+		n += 2
 	}
 	return n
 }
@@ -3668,6 +4847,38 @@ func (m *MsgAddAccountCertificate) Unmarshal(dAtA []byte) error {
 				m.NewCertificate = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertificateType = CertificateType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4001,7 +5212,7 @@ func (m *MsgDeleteAccountCertificateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4024,17 +5235,17 @@ func (m *MsgDeleteAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4044,57 +5255,27 @@ func (m *MsgDeleteAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -4126,7 +5307,7 @@ func (m *MsgDeleteAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
@@ -4179,7 +5360,7 @@ func (m *MsgDeleteAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitRegistrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,10 +5383,10 @@ func (m *MsgDeleteAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitRegistrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4229,7 +5410,7 @@ func (m *MsgDeleteAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDomainPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4252,10 +5433,10 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDomainPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDomainPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4292,7 +5473,7 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4320,7 +5501,7 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4354,6 +5535,39 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 			}
 			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4375,7 +5589,7 @@ func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDomainPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4398,10 +5612,10 @@ func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDomainPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDomainPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4425,7 +5639,7 @@ func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4448,10 +5662,10 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4582,41 +5796,1043 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Broker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registerer", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registerer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registerer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &Resource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wildcard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wildcard = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainType = DomainType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4644,13 +6860,13 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Registerer = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4660,25 +6876,23 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &Resource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4701,7 +6915,7 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4724,10 +6938,10 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4751,7 +6965,7 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4774,15 +6988,15 @@ func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewDomain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewDomain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4810,11 +7024,11 @@ func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4842,7 +7056,7 @@ func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4876,70 +7090,6 @@ func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
 			}
 			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Broker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainType = DomainType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4961,7 +7111,7 @@ func (m *MsgRegisterDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4984,10 +7134,10 @@ func (m *MsgRegisterDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5011,7 +7161,7 @@ func (m *MsgRegisterDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceAccountResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5034,10 +7184,10 @@ func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceAccountResources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceAccountResources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5106,7 +7256,7 @@ func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5134,7 +7284,7 @@ func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -5168,6 +7318,40 @@ func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewResources = append(m.NewResources, &Resource{})
+			if err := m.NewResources[len(m.NewResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5189,7 +7373,7 @@ func (m *MsgRenewAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceAccountResourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5212,10 +7396,10 @@ func (m *MsgRenewAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceAccountResourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceAccountResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5239,7 +7423,7 @@ func (m *MsgRenewAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertAccountResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5262,10 +7446,10 @@ func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertAccountResources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertAccountResources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5302,7 +7486,7 @@ func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5330,9 +7514,41 @@ func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
@@ -5364,6 +7580,40 @@ func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
 			}
 			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &Resource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5385,7 +7635,7 @@ func (m *MsgRenewDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertAccountResourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5408,10 +7658,10 @@ func (m *MsgRenewDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertAccountResourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertAccountResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5435,7 +7685,7 @@ func (m *MsgRenewDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceAccountResources) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAccountResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5458,10 +7708,10 @@ func (m *MsgReplaceAccountResources) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceAccountResources: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAccountResources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceAccountResources: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAccountResources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5594,9 +7844,9 @@ func (m *MsgReplaceAccountResources) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URIs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5606,25 +7856,23 @@ func (m *MsgReplaceAccountResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewResources = append(m.NewResources, &Resource{})
-			if err := m.NewResources[len(m.NewResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URIs = append(m.URIs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5647,7 +7895,7 @@ func (m *MsgReplaceAccountResources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceAccountResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAccountResourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5670,10 +7918,10 @@ func (m *MsgReplaceAccountResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceAccountResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAccountResourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceAccountResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAccountResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18,6 +18,8 @@ const AccountAdminIndex crud.IndexID = 0x1
 const AccountDomainIndex crud.IndexID = 0x2
 const AccountResourcesIndex crud.IndexID = 0x3
 const AccountBrokerIndex crud.IndexID = 0x4
+const AccountCertificateIssuerIndex crud.IndexID = 0x5
+const AccountWildcardIndex crud.IndexID = 0x6
 
 // Type IDs used by the escrow module
 const (
@@ -25,7 +27,9 @@ const (
 	AccountTypeID escrowtypes.TypeID = 0x2
 )
 
-// Ensure that Account and Domain implement crud.Object, escrowtypes.TransferableObject and escrowtypes.ObjectWithTimeConstraint
+// Ensure that Account and Domain implement crud.Object, escrowtypes.TransferableObject, escrowtypes.ObjectWithTimeConstraint
+// escrowtypes.ObjectWithSaleRecord and escrowtypes.ObjectWithDomain, and that Account implements escrowtypes.ObjectWithRoyalties
+// and escrowtypes.ObjectWithTransferDependency
 
 var _ escrowtypes.TransferableObject = &Account{}
 var _ escrowtypes.TransferableObject = &Domain{}
@@ -36,6 +40,16 @@ var _ crud.Object = &Domain{}
 var _ escrowtypes.ObjectWithTimeConstraint = &Account{}
 var _ escrowtypes.ObjectWithTimeConstraint = &Domain{}
 
+var _ escrowtypes.ObjectWithSaleRecord = &Account{}
+var _ escrowtypes.ObjectWithSaleRecord = &Domain{}
+
+var _ escrowtypes.ObjectWithRoyalties = &Account{}
+
+var _ escrowtypes.ObjectWithDomain = &Account{}
+var _ escrowtypes.ObjectWithDomain = &Domain{}
+
+var _ escrowtypes.ObjectWithTransferDependency = &Account{}
+
 // Delimit the uri and resource in GetResourceKey() with an ineligible
 // character since, technically, it'd be possible to have uri "d" and
 // resource "ave" collide with uri "da" and resource "ve" without a
@@ -56,6 +70,9 @@ type expectedTransferKeeper interface {
 	DoAccountTransfer(ctx sdk.Context, name string, domain string, currentOwner sdk.AccAddress, newOwner sdk.AccAddress, toReset bool) (*Account, *Domain, error)
 	DoDomainTransfer(ctx sdk.Context, domain string, currentOwner sdk.AccAddress, newOwner sdk.AccAddress, transferFlag TransferFlag) error
 
+	RecordProvenance(ctx sdk.Context, starname string, event ProvenanceEvent, from, to sdk.AccAddress, price sdk.Coins)
+	GetRoyalty(ctx sdk.Context, domain string) (sdk.AccAddress, sdk.Dec)
+
 	AccountStore(ctx sdk.Context) crud.Store
 	DomainStore(ctx sdk.Context) crud.Store
 }
@@ -123,6 +140,20 @@ func (m *Domain) Transfer(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddres
 	return k.DomainStore(ctx).Read(m.PrimaryKey(), m)
 }
 
+// Make Domain implement escrowtypes.ObjectWithSaleRecord
+
+// RecordSale implements escrowtypes.ObjectWithSaleRecord
+func (m *Domain) RecordSale(ctx sdk.Context, seller, buyer sdk.AccAddress, price sdk.Coins, data escrowtypes.CustomData) {
+	extractTransferKeeper(data).RecordProvenance(ctx, m.Name, ProvenanceEvent_Sale, seller, buyer, price)
+}
+
+// Make Domain implement escrowtypes.ObjectWithDomain
+
+// GetDomain implements escrowtypes.ObjectWithDomain
+func (m *Domain) GetDomain() string {
+	return m.Name
+}
+
 // Make Domain implement escrowtypes.ObjectWithTimeConstraint
 
 // ValidateDeadline implements escrowtypes.TransferableObject
@@ -189,6 +220,19 @@ func (m *Account) SecondaryKeys() []crud.SecondaryKey {
 		brokerIndex := crud.SecondaryKey{AccountBrokerIndex, m.Broker}
 		sk = append(sk, brokerIndex)
 	}
+	// index by certificate issuers, an issuer is indexed once even if it signed several certificates
+	issuers := make(map[string]struct{}, len(m.TypedCertificates))
+	for _, cert := range m.TypedCertificates {
+		if _, ok := issuers[cert.Issuer]; ok || cert.Issuer == "" {
+			continue
+		}
+		issuers[cert.Issuer] = struct{}{}
+		sk = append(sk, crud.SecondaryKey{ID: AccountCertificateIssuerIndex, Value: []byte(cert.Issuer)})
+	}
+	// index the wildcard account by its domain
+	if m.Wildcard {
+		sk = append(sk, crud.SecondaryKey{ID: AccountWildcardIndex, Value: []byte(m.Domain)})
+	}
 	// index by resources
 	for _, res := range m.Resources {
 		// exclude empty resources
@@ -235,6 +279,35 @@ func (m *Account) Transfer(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddre
 	return k.AccountStore(ctx).Read(m.PrimaryKey(), m)
 }
 
+// Make Account implement escrowtypes.ObjectWithSaleRecord
+
+// RecordSale implements escrowtypes.ObjectWithSaleRecord
+func (m *Account) RecordSale(ctx sdk.Context, seller, buyer sdk.AccAddress, price sdk.Coins, data escrowtypes.CustomData) {
+	starname := strings.Join([]string{*m.Name, m.Domain}, StarnameSeparator)
+	extractTransferKeeper(data).RecordProvenance(ctx, starname, ProvenanceEvent_Sale, seller, buyer, price)
+}
+
+// Make Account implement escrowtypes.ObjectWithRoyalties
+
+// GetRoyalties implements escrowtypes.ObjectWithRoyalties, the royalty is the one set by the policy of the domain
+func (m *Account) GetRoyalties(ctx sdk.Context, data escrowtypes.CustomData) []escrowtypes.Royalty {
+	recipient, rate := extractTransferKeeper(data).GetRoyalty(ctx, m.Domain)
+	if !rate.IsPositive() {
+		return nil
+	}
+	return []escrowtypes.Royalty{{Recipient: recipient.String(), Rate: rate}}
+}
+
+// Make Account implement escrowtypes.ObjectWithTransferDependency
+
+// MustTransferBefore implements escrowtypes.ObjectWithTransferDependency, an account must be transferred before its
+// domain: the accounts of a closed domain can only be transferred by the domain admin and the domain transfer moves
+// the empty account as well
+func (m *Account) MustTransferBefore(other escrowtypes.TransferableObject) bool {
+	domain, ok := other.(*Domain)
+	return ok && domain.Name == m.Domain
+}
+
 // Make Account implement escrowtypes.ObjectWithTimeConstraint
 
 // ValidateDeadline implements escrowtypes.TransferableObject
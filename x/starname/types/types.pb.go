@@ -7,19 +7,23 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProvenanceEvent defines the kind of a provenance entry
+type ProvenanceEvent int32
+
+const (
+	// PROVENANCE_EVENT_UNSPECIFIED defines an unknown event.
+	ProvenanceEvent_Unspecified ProvenanceEvent = 0
+	// PROVENANCE_EVENT_REGISTER defines the registration of the starname.
+	ProvenanceEvent_Register ProvenanceEvent = 1
+	// PROVENANCE_EVENT_TRANSFER defines a transfer of the starname.
+	ProvenanceEvent_Transfer ProvenanceEvent = 2
+	// PROVENANCE_EVENT_SALE defines the sale of the starname through an escrow.
+	ProvenanceEvent_Sale ProvenanceEvent = 3
+	// PROVENANCE_EVENT_DELETE defines the deletion of the starname.
+	ProvenanceEvent_Delete ProvenanceEvent = 4
+)
+
+var ProvenanceEvent_name = map[int32]string{
+	0: "PROVENANCE_EVENT_UNSPECIFIED",
+	1: "PROVENANCE_EVENT_REGISTER",
+	2: "PROVENANCE_EVENT_TRANSFER",
+	3: "PROVENANCE_EVENT_SALE",
+	4: "PROVENANCE_EVENT_DELETE",
+}
+
+var ProvenanceEvent_value = map[string]int32{
+	"PROVENANCE_EVENT_UNSPECIFIED": 0,
+	"PROVENANCE_EVENT_REGISTER":    1,
+	"PROVENANCE_EVENT_TRANSFER":    2,
+	"PROVENANCE_EVENT_SALE":        3,
+	"PROVENANCE_EVENT_DELETE":      4,
+}
+
+func (x ProvenanceEvent) String() string {
+	return proto.EnumName(ProvenanceEvent_name, int32(x))
+}
+
+func (ProvenanceEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6581bc28766f3e48, []int{0}
+}
+
 // Resource defines a resource owned by an account
 type Resource struct {
 	// URI defines the ID of the resource
@@ -187,6 +231,12 @@ type Account struct {
 	Certificates [][]byte `protobuf:"bytes,7,rep,name=certificates,proto3" json:"certificates,omitempty" yaml:"certificates"`
 	// MetadataURI contains a link to extra information regarding the account
 	MetadataURI string `protobuf:"bytes,8,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty" yaml:"metadata_uri"`
+	// TypedCertificates contains the certificates whose type is known and whose
+	// signature was verified when they were added to the account
+	TypedCertificates []*TypedCertificate `protobuf:"bytes,9,rep,name=typed_certificates,json=typedCertificates,proto3" json:"typed_certificates,omitempty" yaml:"typed_certificates"`
+	// Wildcard defines if the account is the catch-all account of its domain,
+	// the names of the domain which are not registered resolve to it
+	Wildcard bool `protobuf:"varint,10,opt,name=wildcard,proto3" json:"wildcard,omitempty" yaml:"wildcard"`
 }
 
 func (m *Account) Reset()         { *m = Account{} }
@@ -278,500 +328,3205 @@ func (m *Account) GetMetadataURI() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Resource)(nil), "starnamed.x.starname.v1beta1.Resource")
-	proto.RegisterType((*Domain)(nil), "starnamed.x.starname.v1beta1.Domain")
-	proto.RegisterType((*Account)(nil), "starnamed.x.starname.v1beta1.Account")
+func (m *Account) GetTypedCertificates() []*TypedCertificate {
+	if m != nil {
+		return m.TypedCertificates
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("iov/starname/v1beta1/types.proto", fileDescriptor_6581bc28766f3e48) }
-
-var fileDescriptor_6581bc28766f3e48 = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6f, 0xd3, 0x3e,
-	0x14, 0x5f, 0xd6, 0xae, 0xdb, 0xdc, 0xfe, 0xb5, 0x3f, 0xde, 0x80, 0x68, 0x1a, 0x49, 0x65, 0xa4,
-	0x51, 0x0e, 0x4b, 0xb4, 0x71, 0x40, 0x82, 0x53, 0x23, 0x38, 0x4c, 0x88, 0x8b, 0x61, 0x20, 0xed,
-	0x32, 0xb9, 0x89, 0x57, 0xac, 0x35, 0x71, 0xe5, 0x38, 0xdd, 0xf6, 0x2d, 0xf8, 0x08, 0x1c, 0xf9,
-	0x28, 0x5c, 0x40, 0x3b, 0x72, 0x0a, 0x28, 0xfb, 0x06, 0x39, 0x72, 0x42, 0xb1, 0x9d, 0x36, 0xbb,
-	0x20, 0x34, 0x89, 0x53, 0xf3, 0xde, 0xfb, 0xbd, 0x9f, 0xdd, 0xdf, 0xef, 0x3d, 0x83, 0x3e, 0xe3,
-	0x33, 0x3f, 0x95, 0x44, 0x24, 0x24, 0xa6, 0xfe, 0x6c, 0x7f, 0x44, 0x25, 0xd9, 0xf7, 0xe5, 0xe5,
-	0x94, 0xa6, 0xde, 0x54, 0x70, 0xc9, 0xe1, 0x4e, 0x5d, 0x8d, 0xbc, 0x0b, 0xaf, 0xfe, 0xf6, 0x0c,
-	0x72, 0x7b, 0x6b, 0xcc, 0xc7, 0x5c, 0x01, 0xfd, 0xea, 0x4b, 0xf7, 0x6c, 0x3b, 0x63, 0xce, 0xc7,
-	0x13, 0xea, 0xab, 0x68, 0x94, 0x9d, 0xfa, 0xe7, 0x82, 0x4c, 0xa7, 0x54, 0x18, 0x4e, 0x14, 0x81,
-	0x35, 0x4c, 0x53, 0x9e, 0x89, 0x90, 0xc2, 0x47, 0xa0, 0x95, 0x09, 0x66, 0x5b, 0x7d, 0x6b, 0xb0,
-	0x1e, 0xdc, 0x2d, 0x72, 0xb7, 0x75, 0x84, 0x0f, 0xcb, 0xdc, 0x05, 0x97, 0x24, 0x9e, 0x3c, 0x43,
-	0x99, 0x60, 0x08, 0x57, 0x08, 0xe8, 0x83, 0x35, 0x61, 0x9a, 0xec, 0x65, 0x85, 0xde, 0x2c, 0x73,
-	0x77, 0x43, 0xc3, 0xea, 0x0a, 0xc2, 0x73, 0x10, 0xfa, 0xb6, 0x0c, 0x3a, 0x2f, 0x78, 0x4c, 0x58,
-	0x02, 0x1f, 0x82, 0x76, 0x75, 0x6d, 0x73, 0xca, 0x46, 0x99, 0xbb, 0x5d, 0xdd, 0x57, 0x65, 0x11,
-	0x56, 0x45, 0xf8, 0x1e, 0xac, 0x90, 0x28, 0x66, 0x89, 0x62, 0xef, 0x05, 0xc3, 0x32, 0x77, 0x7b,
-	0x1a, 0xa5, 0xd2, 0xe8, 0x57, 0xee, 0xee, 0x8d, 0x99, 0xfc, 0x90, 0x8d, 0xbc, 0x90, 0xc7, 0x7e,
-	0xc8, 0xd3, 0x98, 0xa7, 0xe6, 0x67, 0x2f, 0x8d, 0xce, 0x8c, 0x6c, 0xc3, 0x30, 0x1c, 0x46, 0x91,
-	0xa0, 0x69, 0x8a, 0x35, 0x1f, 0x3c, 0x06, 0x9d, 0x91, 0xe0, 0x67, 0x54, 0xd8, 0x2d, 0xc5, 0x1c,
-	0x94, 0xb9, 0xfb, 0x9f, 0x66, 0xd6, 0xf9, 0x5b, 0x50, 0x1b, 0x46, 0xf8, 0x14, 0x74, 0x67, 0x64,
-	0xc2, 0xa2, 0x93, 0x2c, 0x91, 0x6c, 0x62, 0xb7, 0xfb, 0xd6, 0xa0, 0x15, 0xdc, 0x2b, 0x73, 0x17,
-	0xea, 0x03, 0x1a, 0x45, 0x84, 0x81, 0x8a, 0x8e, 0xaa, 0x00, 0xee, 0x83, 0x76, 0x45, 0x6a, 0xaf,
-	0x28, 0x49, 0x1e, 0x2c, 0x24, 0xa9, 0xb2, 0xd5, 0x85, 0x80, 0xd6, 0xee, 0xed, 0xe5, 0x94, 0x62,
-	0x05, 0x45, 0x5f, 0xdb, 0x60, 0x75, 0x18, 0x86, 0x3c, 0x4b, 0x24, 0x7c, 0x0c, 0x3a, 0x91, 0xaa,
-	0x1b, 0x4d, 0xef, 0x2c, 0xfe, 0x93, 0xce, 0x23, 0x6c, 0x00, 0xf0, 0xa5, 0x11, 0xbf, 0x92, 0xb5,
-	0x7b, 0xb0, 0xe3, 0xe9, 0xe1, 0xf0, 0xea, 0xe1, 0xf0, 0xde, 0x48, 0xc1, 0x92, 0xf1, 0x3b, 0x32,
-	0xc9, 0xa8, 0xb2, 0xb4, 0x69, 0xcd, 0xa7, 0x1f, 0xae, 0xb5, 0xb0, 0x87, 0x9f, 0x27, 0x73, 0x11,
-	0x1b, 0xf6, 0xa8, 0xf4, 0x6d, 0xec, 0x51, 0x8d, 0x0d, 0x7b, 0xda, 0xff, 0xda, 0x9e, 0x95, 0xbf,
-	0xb6, 0xe7, 0x18, 0xac, 0xd7, 0x83, 0x9c, 0xda, 0x9d, 0x7e, 0x6b, 0xd0, 0x3d, 0xd8, 0xf5, 0xfe,
-	0xb4, 0x8a, 0x5e, 0xbd, 0x51, 0xc1, 0x56, 0x99, 0xbb, 0xff, 0xdf, 0x5c, 0x8b, 0x14, 0xe1, 0x05,
-	0x1d, 0x7c, 0x0e, 0x7a, 0x21, 0x15, 0x92, 0x9d, 0xb2, 0x90, 0x48, 0x9a, 0xda, 0xab, 0xfd, 0xd6,
-	0xa0, 0x17, 0xdc, 0x2f, 0x73, 0x77, 0x53, 0xb7, 0x35, 0xab, 0x08, 0xdf, 0x00, 0xc3, 0x43, 0xd0,
-	0x8b, 0xa9, 0x24, 0x11, 0x91, 0xe4, 0xa4, 0x5a, 0xdc, 0x35, 0x65, 0xff, 0x6e, 0x91, 0xbb, 0xdd,
-	0xd7, 0x26, 0xaf, 0x17, 0xd8, 0x70, 0x35, 0xc1, 0x08, 0x77, 0xeb, 0xf0, 0x48, 0xb0, 0xe0, 0xd5,
-	0xe7, 0xc2, 0xb1, 0xbe, 0x14, 0x8e, 0x75, 0x55, 0x38, 0xd6, 0xcf, 0xc2, 0xb1, 0x3e, 0x5e, 0x3b,
-	0x4b, 0x57, 0xd7, 0xce, 0xd2, 0xf7, 0x6b, 0x67, 0xe9, 0xb8, 0xa9, 0x38, 0xe3, 0xb3, 0x3d, 0x9e,
-	0xd0, 0xf9, 0x4b, 0x15, 0xf9, 0x17, 0x8b, 0x57, 0x4b, 0x89, 0x3f, 0xea, 0xa8, 0x79, 0x7a, 0xf2,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x76, 0xb1, 0x47, 0x44, 0xd2, 0x04, 0x00, 0x00,
+func (m *Account) GetWildcard() bool {
+	if m != nil {
+		return m.Wildcard
+	}
+	return false
 }
 
-func (this *Resource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// TypedCertificate defines a certificate whose format is known and whose
+// signature was verified on insertion
+type TypedCertificate struct {
+	// Type is the format of the certificate
+	Type CertificateType `protobuf:"bytes,1,opt,name=type,proto3,casttype=CertificateType" json:"type,omitempty" yaml:"type"`
+	// Data is the raw certificate
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	// Issuer identifies the key that signed the certificate: "jwk:" and the
+	// RFC 7638 thumbprint of a JWS key, or "x509:" and the SHA-256 of the subject
+	// public key info of the root of an X.509 chain
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty" yaml:"issuer"`
+	// ExpiresAt is a unix timestamp in seconds after which the certificate is no
+	// longer valid, zero means the certificate never expires
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty" yaml:"expires_at"`
+}
 
-	that1, ok := that.(*Resource)
-	if !ok {
-		that2, ok := that.(Resource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *TypedCertificate) Reset()         { *m = TypedCertificate{} }
+func (m *TypedCertificate) String() string { return proto.CompactTextString(m) }
+func (*TypedCertificate) ProtoMessage()    {}
+func (*TypedCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6581bc28766f3e48, []int{3}
+}
+func (m *TypedCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypedCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypedCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *TypedCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedCertificate.Merge(m, src)
+}
+func (m *TypedCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypedCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedCertificate proto.InternalMessageInfo
+
+func (m *TypedCertificate) GetType() CertificateType {
+	if m != nil {
+		return m.Type
 	}
-	if this.URI != that1.URI {
-		return false
+	return ""
+}
+
+func (m *TypedCertificate) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if this.Resource != that1.Resource {
-		return false
+	return nil
+}
+
+func (m *TypedCertificate) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return true
+	return ""
 }
-func (this *Domain) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TypedCertificate) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
+	return 0
+}
 
-	that1, ok := that.(*Domain)
-	if !ok {
-		that2, ok := that.(Domain)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Commitment is a registration commitment, it hides the name that is going to
+// be registered until the registration is revealed
+type Commitment struct {
+	// Hash is the hash of the starname, the owner and a salt
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	// Owner is the address that made the commitment and that can reveal it
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty" yaml:"owner"`
+	// CreatedAt is the unix timestamp of the block the commitment was made in
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" yaml:"created_at"`
+}
+
+func (m *Commitment) Reset()         { *m = Commitment{} }
+func (m *Commitment) String() string { return proto.CompactTextString(m) }
+func (*Commitment) ProtoMessage()    {}
+func (*Commitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6581bc28766f3e48, []int{4}
+}
+func (m *Commitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Commitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Commitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if !bytes.Equal(this.Admin, that1.Admin) {
-		return false
-	}
-	if !bytes.Equal(this.Broker, that1.Broker) {
-		return false
-	}
-	if this.ValidUntil != that1.ValidUntil {
-		return false
+}
+func (m *Commitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commitment.Merge(m, src)
+}
+func (m *Commitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Commitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Commitment proto.InternalMessageInfo
+
+func (m *Commitment) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	if this.Type != that1.Type {
-		return false
+	return nil
+}
+
+func (m *Commitment) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
 	}
-	return true
+	return nil
 }
-func (this *Account) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Commitment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
+	return 0
+}
 
-	that1, ok := that.(*Account)
-	if !ok {
-		that2, ok := that.(Account)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DomainPolicy defines the registration policy that the admin of an open
+// domain applies to the accounts of the domain
+type DomainPolicy struct {
+	// Domain is the name of the domain the policy applies to
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
+	// RegistrationPrice is the price paid to the domain admin, on top of the
+	// product fees, to register an account in the domain
+	RegistrationPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=registration_price,json=registrationPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_price" yaml:"registration_price"`
+	// RenewalPrice is the price paid to the domain admin, on top of the product
+	// fees, to renew an account in the domain
+	RenewalPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=renewal_price,json=renewalPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_price" yaml:"renewal_price"`
+	// Allowlist, if not empty, contains the only addresses allowed to register
+	// accounts in the domain
+	Allowlist []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=allowlist,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"allowlist,omitempty" yaml:"allowlist"`
+	// Denylist contains the addresses not allowed to register accounts in the
+	// domain
+	Denylist []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,rep,name=denylist,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"denylist,omitempty" yaml:"denylist"`
+	// AccountRenewalPeriod, if set, overrides the configured account renewal
+	// period with a shorter one
+	AccountRenewalPeriod *time.Duration `protobuf:"bytes,6,opt,name=account_renewal_period,json=accountRenewalPeriod,proto3,stdduration" json:"account_renewal_period,omitempty" yaml:"account_renewal_period"`
+	// AccountGracePeriod, if set, overrides the configured account grace period
+	// with a shorter one
+	AccountGracePeriod *time.Duration `protobuf:"bytes,7,opt,name=account_grace_period,json=accountGracePeriod,proto3,stdduration" json:"account_grace_period,omitempty" yaml:"account_grace_period"`
+	// ResourcesMax, if not zero, overrides the configured maximum number of
+	// resources of an account with a lower one
+	ResourcesMax uint32 `protobuf:"varint,8,opt,name=resources_max,json=resourcesMax,proto3" json:"resources_max,omitempty" yaml:"resources_max"`
+	// CertificateCountMax, if not zero, overrides the configured maximum number
+	// of certificates of an account with a lower one
+	CertificateCountMax uint32 `protobuf:"varint,9,opt,name=certificate_count_max,json=certificateCountMax,proto3" json:"certificate_count_max,omitempty" yaml:"certificate_count_max"`
+	// MetadataSizeMax, if not zero, overrides the configured maximum size of the
+	// metadata of an account with a lower one
+	MetadataSizeMax uint64 `protobuf:"varint,10,opt,name=metadata_size_max,json=metadataSizeMax,proto3" json:"metadata_size_max,omitempty" yaml:"metadata_size_max"`
+	// ValidAccountName, if not empty, is a regular expression that account names
+	// must match in addition to the configured one
+	ValidAccountName string `protobuf:"bytes,11,opt,name=valid_account_name,json=validAccountName,proto3" json:"valid_account_name,omitempty" yaml:"valid_account_name"`
+	// RoyaltyRecipient is the address receiving the royalties on the escrow
+	// sales of the accounts of the domain
+	RoyaltyRecipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,12,opt,name=royalty_recipient,json=royaltyRecipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"royalty_recipient,omitempty" yaml:"royalty_recipient"`
+	// RoyaltyRate, if positive, is the share of the price of the escrow sales of
+	// the accounts of the domain paid to the royalty recipient, it is capped by
+	// the configured maximum royalty rate
+	RoyaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate" yaml:"royalty_rate"`
+}
+
+func (m *DomainPolicy) Reset()         { *m = DomainPolicy{} }
+func (m *DomainPolicy) String() string { return proto.CompactTextString(m) }
+func (*DomainPolicy) ProtoMessage()    {}
+func (*DomainPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6581bc28766f3e48, []int{5}
+}
+func (m *DomainPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Domain != that1.Domain {
-		return false
-	}
-	if this.Name != nil && that1.Name != nil {
-		if *this.Name != *that1.Name {
-			return false
-		}
-	} else if this.Name != nil {
-		return false
-	} else if that1.Name != nil {
-		return false
+}
+func (m *DomainPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainPolicy.Merge(m, src)
+}
+func (m *DomainPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainPolicy proto.InternalMessageInfo
+
+func (m *DomainPolicy) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if !bytes.Equal(this.Owner, that1.Owner) {
-		return false
+	return ""
+}
+
+func (m *DomainPolicy) GetRegistrationPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationPrice
 	}
-	if !bytes.Equal(this.Broker, that1.Broker) {
-		return false
+	return nil
+}
+
+func (m *DomainPolicy) GetRenewalPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalPrice
 	}
-	if this.ValidUntil != that1.ValidUntil {
-		return false
+	return nil
+}
+
+func (m *DomainPolicy) GetAllowlist() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Allowlist
 	}
-	if len(this.Resources) != len(that1.Resources) {
-		return false
+	return nil
+}
+
+func (m *DomainPolicy) GetDenylist() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Denylist
 	}
-	for i := range this.Resources {
-		if !this.Resources[i].Equal(that1.Resources[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *DomainPolicy) GetAccountRenewalPeriod() *time.Duration {
+	if m != nil {
+		return m.AccountRenewalPeriod
 	}
-	if len(this.Certificates) != len(that1.Certificates) {
-		return false
+	return nil
+}
+
+func (m *DomainPolicy) GetAccountGracePeriod() *time.Duration {
+	if m != nil {
+		return m.AccountGracePeriod
 	}
-	for i := range this.Certificates {
-		if !bytes.Equal(this.Certificates[i], that1.Certificates[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *DomainPolicy) GetResourcesMax() uint32 {
+	if m != nil {
+		return m.ResourcesMax
 	}
-	if this.MetadataURI != that1.MetadataURI {
-		return false
+	return 0
+}
+
+func (m *DomainPolicy) GetCertificateCountMax() uint32 {
+	if m != nil {
+		return m.CertificateCountMax
 	}
-	return true
+	return 0
 }
-func (m *Resource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DomainPolicy) GetMetadataSizeMax() uint64 {
+	if m != nil {
+		return m.MetadataSizeMax
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DomainPolicy) GetValidAccountName() string {
+	if m != nil {
+		return m.ValidAccountName
+	}
+	return ""
 }
 
-func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.URI)))
-		i--
-		dAtA[i] = 0xa
+func (m *DomainPolicy) GetRoyaltyRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.RoyaltyRecipient
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Domain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// AccountHistoryEntry is the state an account resolved to from a point in time
+// until the next entry of the same account
+type AccountHistoryEntry struct {
+	// Domain is the domain of the account
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty" yaml:"domain"`
+	// Name is the name of the account
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Time is the unix timestamp of the block the state was recorded in
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty" yaml:"time"`
+	// Owner is the owner of the account
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty" yaml:"owner"`
+	// Resources are the resources of the account
+	Resources []*Resource `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty" yaml:"resources"`
+	// ValidUntil is the expiration unix timestamp of the account
+	ValidUntil int64 `protobuf:"varint,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty" yaml:"valid_until"`
+	// Deleted defines if the account was deleted
+	Deleted bool `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty" yaml:"deleted"`
+}
+
+func (m *AccountHistoryEntry) Reset()         { *m = AccountHistoryEntry{} }
+func (m *AccountHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryEntry) ProtoMessage()    {}
+func (*AccountHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6581bc28766f3e48, []int{6}
+}
+func (m *AccountHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AccountHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryEntry.Merge(m, src)
+}
+func (m *AccountHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryEntry.DiscardUnknown(m)
 }
 
-func (m *Domain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AccountHistoryEntry proto.InternalMessageInfo
+
+func (m *AccountHistoryEntry) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x2a
+func (m *AccountHistoryEntry) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.ValidUntil != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidUntil))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.Broker) > 0 {
-		i -= len(m.Broker)
-		copy(dAtA[i:], m.Broker)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Broker)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *AccountHistoryEntry) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *AccountHistoryEntry) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *AccountHistoryEntry) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Account) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountHistoryEntry) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Account) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProvenanceEntry is an entry of the chain of custody of a domain or an account
+type ProvenanceEntry struct {
+	// Starname is the domain name or the account of the form account*domain
+	Starname string `protobuf:"bytes,1,opt,name=starname,proto3" json:"starname,omitempty" yaml:"starname"`
+	// Event is the kind of the entry
+	Event ProvenanceEvent `protobuf:"varint,2,opt,name=event,proto3,enum=starnamed.x.starname.v1beta1.ProvenanceEvent" json:"event,omitempty" yaml:"event"`
+	// From is the owner before the event, empty for a registration
+	From github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=from,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"from,omitempty" yaml:"from"`
+	// To is the owner after the event, empty for a deletion
+	To github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=to,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to,omitempty" yaml:"to"`
+	// Price is the price the starname was sold for, empty unless it is a sale
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price" yaml:"price"`
+	// Time is the unix timestamp of the block the event happened in
+	Time int64 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty" yaml:"time"`
+	// Height is the height of the block the event happened in
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
 }
 
-func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetadataURI) > 0 {
-		i -= len(m.MetadataURI)
-		copy(dAtA[i:], m.MetadataURI)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataURI)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Certificates) > 0 {
-		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Certificates[iNdEx])
-			copy(dAtA[i:], m.Certificates[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Certificates[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Resources) > 0 {
-		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.ValidUntil != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidUntil))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Broker) > 0 {
-		i -= len(m.Broker)
-		copy(dAtA[i:], m.Broker)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Broker)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Name != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdStringMarshalTo(*m.Name, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdString(*m.Name):])
-		if err1 != nil {
-			return 0, err1
+func (m *ProvenanceEntry) Reset()         { *m = ProvenanceEntry{} }
+func (m *ProvenanceEntry) String() string { return proto.CompactTextString(m) }
+func (*ProvenanceEntry) ProtoMessage()    {}
+func (*ProvenanceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6581bc28766f3e48, []int{7}
+}
+func (m *ProvenanceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvenanceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvenanceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintTypes(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProvenanceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvenanceEntry.Merge(m, src)
+}
+func (m *ProvenanceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvenanceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvenanceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvenanceEntry proto.InternalMessageInfo
+
+func (m *ProvenanceEntry) GetStarname() string {
+	if m != nil {
+		return m.Starname
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProvenanceEntry) GetEvent() ProvenanceEvent {
+	if m != nil {
+		return m.Event
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ProvenanceEvent_Unspecified
 }
-func (m *Resource) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ProvenanceEntry) GetFrom() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.From
 	}
-	var l int
-	_ = l
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *ProvenanceEntry) GetTo() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.To
 	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *ProvenanceEntry) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	return n
+	return nil
 }
 
-func (m *Domain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProvenanceEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *ProvenanceEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("starnamed.x.starname.v1beta1.ProvenanceEvent", ProvenanceEvent_name, ProvenanceEvent_value)
+	proto.RegisterType((*Resource)(nil), "starnamed.x.starname.v1beta1.Resource")
+	proto.RegisterType((*Domain)(nil), "starnamed.x.starname.v1beta1.Domain")
+	proto.RegisterType((*Account)(nil), "starnamed.x.starname.v1beta1.Account")
+	proto.RegisterType((*TypedCertificate)(nil), "starnamed.x.starname.v1beta1.TypedCertificate")
+	proto.RegisterType((*Commitment)(nil), "starnamed.x.starname.v1beta1.Commitment")
+	proto.RegisterType((*DomainPolicy)(nil), "starnamed.x.starname.v1beta1.DomainPolicy")
+	proto.RegisterType((*AccountHistoryEntry)(nil), "starnamed.x.starname.v1beta1.AccountHistoryEntry")
+	proto.RegisterType((*ProvenanceEntry)(nil), "starnamed.x.starname.v1beta1.ProvenanceEntry")
+}
+
+func init() { proto.RegisterFile("iov/starname/v1beta1/types.proto", fileDescriptor_6581bc28766f3e48) }
+
+var fileDescriptor_6581bc28766f3e48 = []byte{
+	// 1593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x4f, 0x23, 0xc9,
+	0x15, 0xa7, 0xf1, 0x07, 0x76, 0xd9, 0x2c, 0xa6, 0x80, 0x5d, 0x43, 0x58, 0xb7, 0x55, 0x28, 0x13,
+	0x36, 0x09, 0xb6, 0x98, 0xac, 0x12, 0x29, 0x51, 0xa2, 0xb5, 0xa1, 0x77, 0x07, 0xed, 0x42, 0x50,
+	0x61, 0x26, 0xd2, 0x5c, 0x9c, 0xa6, 0xbb, 0x30, 0xa5, 0xb5, 0xbb, 0x9d, 0xea, 0x36, 0xe0, 0x51,
+	0x4e, 0x39, 0xad, 0x38, 0xe5, 0x96, 0x5c, 0x90, 0x12, 0x45, 0x8a, 0xa2, 0xfc, 0x15, 0x39, 0xee,
+	0x29, 0x9a, 0x63, 0x94, 0x43, 0x6f, 0xc4, 0xfc, 0x07, 0x7d, 0x8c, 0x72, 0x88, 0xea, 0xa3, 0x3f,
+	0xc0, 0x33, 0x0c, 0x63, 0xcd, 0x9e, 0xe8, 0x7a, 0xef, 0xd5, 0xef, 0xbd, 0x7a, 0xdf, 0x18, 0xd4,
+	0xa9, 0x7b, 0xde, 0xf4, 0x7c, 0x93, 0x39, 0xe6, 0x80, 0x34, 0xcf, 0xb7, 0x4f, 0x88, 0x6f, 0x6e,
+	0x37, 0xfd, 0xf1, 0x90, 0x78, 0x8d, 0x21, 0x73, 0x7d, 0x17, 0xae, 0x47, 0x5c, 0xbb, 0x71, 0xd9,
+	0x88, 0xbe, 0x1b, 0x4a, 0x72, 0x6d, 0xb9, 0xe7, 0xf6, 0x5c, 0x21, 0xd8, 0xe4, 0x5f, 0xf2, 0xce,
+	0x5a, 0xcd, 0x72, 0xbd, 0x81, 0xeb, 0x35, 0x4f, 0x4c, 0x2f, 0x01, 0xb5, 0x5c, 0xea, 0x44, 0xfc,
+	0x9e, 0xeb, 0xf6, 0xfa, 0xa4, 0x29, 0x4e, 0x27, 0xa3, 0xd3, 0xa6, 0x3d, 0x62, 0xa6, 0x4f, 0xdd,
+	0xd7, 0xf2, 0x2f, 0x98, 0x39, 0x1c, 0x12, 0xa6, 0x6c, 0x42, 0x36, 0x28, 0x60, 0xe2, 0xb9, 0x23,
+	0x66, 0x11, 0xf8, 0x3d, 0x90, 0x19, 0x31, 0x5a, 0xd5, 0xea, 0xda, 0x66, 0xb1, 0xbd, 0x72, 0x13,
+	0xe8, 0x99, 0x63, 0xbc, 0x17, 0x06, 0x3a, 0x18, 0x9b, 0x83, 0xfe, 0x4f, 0xd1, 0x88, 0x51, 0x84,
+	0xb9, 0x04, 0x6c, 0x82, 0x02, 0x53, 0x97, 0xaa, 0xb3, 0x42, 0x7a, 0x29, 0x0c, 0xf4, 0x05, 0x29,
+	0x16, 0x71, 0x10, 0x8e, 0x85, 0xd0, 0x3f, 0x67, 0x41, 0x7e, 0xd7, 0x1d, 0x98, 0xd4, 0x81, 0x1b,
+	0x20, 0xcb, 0x9f, 0xad, 0xb4, 0x2c, 0x84, 0x81, 0x5e, 0x92, 0xf7, 0x38, 0x15, 0x61, 0xc1, 0x84,
+	0xbf, 0x02, 0x39, 0xd3, 0x1e, 0x50, 0x47, 0xa0, 0x97, 0xdb, 0xad, 0x30, 0xd0, 0xcb, 0x52, 0x4a,
+	0x90, 0xd1, 0x7f, 0x03, 0x7d, 0xab, 0x47, 0xfd, 0xb3, 0xd1, 0x49, 0xc3, 0x72, 0x07, 0x4d, 0xe5,
+	0x23, 0xf9, 0x67, 0xcb, 0xb3, 0xbf, 0x54, 0x6e, 0x6f, 0x59, 0x56, 0xcb, 0xb6, 0x19, 0xf1, 0x3c,
+	0x2c, 0xf1, 0xe0, 0x33, 0x90, 0x3f, 0x61, 0xee, 0x97, 0x84, 0x55, 0x33, 0x02, 0xb9, 0x1d, 0x06,
+	0xfa, 0xbc, 0x44, 0x96, 0xf4, 0x29, 0xa0, 0x15, 0x22, 0xfc, 0x09, 0x28, 0x9d, 0x9b, 0x7d, 0x6a,
+	0x77, 0x47, 0x8e, 0x4f, 0xfb, 0xd5, 0x6c, 0x5d, 0xdb, 0xcc, 0xb4, 0xdf, 0x0f, 0x03, 0x1d, 0x4a,
+	0x05, 0x29, 0x26, 0xc2, 0x40, 0x9c, 0x8e, 0xf9, 0x01, 0x6e, 0x83, 0x2c, 0x07, 0xad, 0xe6, 0x84,
+	0x4b, 0x3e, 0x4c, 0x5c, 0xc2, 0xa9, 0xdc, 0x20, 0x20, 0x7d, 0xd7, 0x19, 0x0f, 0x09, 0x16, 0xa2,
+	0xe8, 0x7f, 0x39, 0x30, 0xd7, 0xb2, 0x2c, 0x77, 0xe4, 0xf8, 0xf0, 0x23, 0x90, 0xb7, 0x05, 0x5f,
+	0xf9, 0x74, 0x31, 0x79, 0x93, 0xa4, 0x23, 0xac, 0x04, 0xa0, 0xa1, 0x9c, 0xcf, 0xdd, 0x5a, 0x7a,
+	0xbc, 0xde, 0x90, 0xc9, 0xd1, 0x88, 0x92, 0xa3, 0x71, 0xe4, 0x33, 0xea, 0xf4, 0x9e, 0x9a, 0xfd,
+	0x11, 0x69, 0x2f, 0x25, 0x76, 0x88, 0xd0, 0xfc, 0xe9, 0x1b, 0x5d, 0x4b, 0xc2, 0xe3, 0x5e, 0x38,
+	0xb1, 0x13, 0x53, 0xe1, 0x11, 0xe4, 0x69, 0xc2, 0x23, 0x2e, 0xa6, 0xc2, 0x93, 0xfd, 0xb6, 0xc3,
+	0x93, 0x7b, 0x70, 0x78, 0x9e, 0x81, 0x62, 0x94, 0xc8, 0x5e, 0x35, 0x5f, 0xcf, 0x6c, 0x96, 0x1e,
+	0x3f, 0x6a, 0xdc, 0x57, 0xca, 0x8d, 0xa8, 0xa2, 0xda, 0xcb, 0x61, 0xa0, 0x57, 0x6e, 0x97, 0x85,
+	0x87, 0x70, 0x02, 0x07, 0x7f, 0x06, 0xca, 0x16, 0x61, 0x3e, 0x3d, 0xa5, 0x96, 0xe9, 0x13, 0xaf,
+	0x3a, 0x57, 0xcf, 0x6c, 0x96, 0xdb, 0x1f, 0x84, 0x81, 0xbe, 0x24, 0xaf, 0xa5, 0xb9, 0x08, 0xdf,
+	0x12, 0x86, 0x7b, 0xa0, 0x3c, 0x20, 0xbe, 0x69, 0x9b, 0xbe, 0xd9, 0xe5, 0x85, 0x5b, 0x10, 0xe1,
+	0x7f, 0x74, 0x13, 0xe8, 0xa5, 0x7d, 0x45, 0x97, 0x05, 0xac, 0xb0, 0xd2, 0xc2, 0x08, 0x97, 0xa2,
+	0xe3, 0x31, 0xa3, 0xf0, 0xb7, 0x00, 0x72, 0xc7, 0xd9, 0xdd, 0x5b, 0xd6, 0x14, 0xc5, 0x63, 0x1b,
+	0xf7, 0x3f, 0x96, 0x67, 0xa5, 0xbd, 0x93, 0x5c, 0x13, 0x09, 0xbc, 0x9a, 0x24, 0xf0, 0x6d, 0x4c,
+	0x84, 0x17, 0xfd, 0x3b, 0x17, 0x3c, 0xde, 0x4f, 0x2e, 0x68, 0xdf, 0xb6, 0x4c, 0x66, 0x57, 0x41,
+	0x5d, 0xdb, 0x2c, 0xa4, 0xfb, 0x49, 0xc4, 0x41, 0x38, 0x16, 0x42, 0x2f, 0x34, 0x50, 0xb9, 0xab,
+	0x17, 0xfe, 0x58, 0x95, 0x91, 0xac, 0x02, 0x34, 0x59, 0x46, 0x0b, 0x29, 0xe9, 0xa4, 0x96, 0x78,
+	0x47, 0xe2, 0x6e, 0x50, 0xbd, 0x26, 0xd5, 0x91, 0x38, 0x15, 0x61, 0xc1, 0xe4, 0x45, 0x46, 0x3d,
+	0x6f, 0xa4, 0x72, 0xfe, 0x56, 0x91, 0x49, 0x3a, 0xc2, 0x4a, 0x00, 0x7e, 0x0c, 0x00, 0xb9, 0x1c,
+	0x52, 0x46, 0xbc, 0xae, 0xe9, 0xab, 0x36, 0xb0, 0x12, 0x06, 0xfa, 0xa2, 0x14, 0x4f, 0x78, 0x08,
+	0x17, 0xd5, 0xa1, 0xe5, 0xa3, 0x7f, 0x68, 0x00, 0xec, 0xb8, 0x83, 0x01, 0xf5, 0x07, 0xc4, 0xf1,
+	0xb9, 0x51, 0x67, 0xa6, 0x77, 0x56, 0xd5, 0xee, 0x1a, 0xc5, 0xa9, 0x08, 0x0b, 0x66, 0x52, 0x87,
+	0xb3, 0xef, 0xb8, 0x0e, 0x3f, 0x06, 0xc0, 0x62, 0xc4, 0xf4, 0x89, 0xcd, 0x9f, 0x90, 0xb9, 0xfb,
+	0x84, 0x84, 0x87, 0x70, 0x51, 0x1d, 0x5a, 0x3e, 0xfa, 0x33, 0x00, 0x65, 0xd9, 0xa9, 0x0e, 0xdd,
+	0x3e, 0xb5, 0xc6, 0x6f, 0xd3, 0x99, 0xfe, 0xa0, 0x01, 0xc8, 0x48, 0x8f, 0x7a, 0xbe, 0x1c, 0x5f,
+	0xdd, 0x21, 0xa3, 0x62, 0xba, 0xf0, 0x0c, 0x5c, 0x6d, 0x48, 0x9b, 0x1b, 0x7c, 0x0a, 0xc6, 0x89,
+	0xb7, 0xe3, 0x52, 0xa7, 0xbd, 0xff, 0x75, 0xa0, 0xcf, 0x24, 0x09, 0x37, 0x09, 0x81, 0xfe, 0xfe,
+	0x8d, 0xbe, 0xf9, 0x00, 0x27, 0x70, 0x34, 0x0f, 0x2f, 0xa6, 0x01, 0x0e, 0xf9, 0x7d, 0xf8, 0x95,
+	0x06, 0xe6, 0x19, 0x71, 0xc8, 0x85, 0xd9, 0x57, 0x46, 0x65, 0xde, 0x64, 0xd4, 0x13, 0x65, 0xd4,
+	0x72, 0x64, 0x54, 0xea, 0xf6, 0xdb, 0xd9, 0x53, 0x56, 0x77, 0xa5, 0x29, 0x16, 0x28, 0x9a, 0xfd,
+	0xbe, 0x7b, 0xd1, 0xa7, 0x1e, 0x4f, 0x2c, 0xde, 0x2a, 0x8c, 0xa4, 0xc3, 0xc4, 0xac, 0x29, 0xe2,
+	0x9e, 0xe0, 0xc2, 0x5f, 0x83, 0x82, 0x4d, 0x9c, 0xb1, 0xd0, 0x91, 0x13, 0x3a, 0x76, 0x93, 0x62,
+	0x8c, 0x38, 0x53, 0xa8, 0x88, 0x51, 0xe1, 0x39, 0x78, 0xdf, 0x94, 0xb3, 0xab, 0x1b, 0xbb, 0x86,
+	0x30, 0xea, 0xda, 0xd5, 0xbc, 0x98, 0x4b, 0xab, 0x13, 0x73, 0x69, 0x57, 0x2d, 0x35, 0xed, 0xef,
+	0x86, 0x81, 0xfe, 0xa1, 0x7a, 0xee, 0x2b, 0x21, 0xd0, 0x1f, 0xf9, 0x98, 0x5a, 0x56, 0x4c, 0xac,
+	0xbc, 0x27, 0x58, 0x70, 0x08, 0x22, 0x7a, 0xb7, 0xc7, 0x4c, 0x8b, 0x44, 0x5a, 0xe7, 0xde, 0xa4,
+	0x75, 0x23, 0x0c, 0xf4, 0xef, 0xdc, 0xd6, 0x9a, 0x06, 0x90, 0x3a, 0xa1, 0x62, 0x7d, 0xc6, 0x39,
+	0x4a, 0xe3, 0xcf, 0xc1, 0x7c, 0xdc, 0xeb, 0xbb, 0x03, 0xf3, 0x52, 0xb4, 0xe8, 0xf9, 0x76, 0x35,
+	0x9d, 0x1b, 0x29, 0x36, 0xc2, 0xe5, 0xf8, 0xbc, 0x6f, 0x5e, 0xc2, 0x0e, 0x58, 0x49, 0xf5, 0xce,
+	0xae, 0xd4, 0xcc, 0x61, 0x8a, 0x02, 0xa6, 0x1e, 0x06, 0xfa, 0xfa, 0xc4, 0x98, 0x48, 0xc4, 0x10,
+	0x5e, 0x4a, 0xd1, 0x77, 0x38, 0x99, 0xa3, 0x3e, 0x01, 0x8b, 0xf1, 0x24, 0xf0, 0xe8, 0x73, 0x22,
+	0x10, 0x79, 0xdb, 0xcd, 0xb6, 0xd7, 0xc3, 0x40, 0xaf, 0xde, 0x19, 0x16, 0x91, 0x08, 0xc2, 0x0b,
+	0x11, 0xed, 0x88, 0x3e, 0x27, 0x1c, 0xe9, 0x73, 0x00, 0xe5, 0xd4, 0x8c, 0xbc, 0x22, 0x96, 0x8b,
+	0x52, 0xbc, 0xc6, 0xac, 0xa6, 0x27, 0x6b, 0x5a, 0x06, 0xe1, 0x8a, 0x20, 0xaa, 0x15, 0xe6, 0x80,
+	0x2f, 0x15, 0xcf, 0xc1, 0x22, 0x73, 0xc7, 0x66, 0xdf, 0x1f, 0x77, 0x19, 0xb1, 0xe8, 0x90, 0x12,
+	0xc7, 0xaf, 0x96, 0x45, 0x63, 0xdb, 0x4f, 0xcc, 0x9a, 0x10, 0x99, 0x22, 0x13, 0x2b, 0x0a, 0x04,
+	0x47, 0x18, 0xf0, 0x0c, 0x94, 0x63, 0x60, 0xd3, 0x27, 0xd5, 0x79, 0xf1, 0x04, 0x83, 0x97, 0xf1,
+	0xbf, 0x03, 0xfd, 0xd1, 0x03, 0xe0, 0x77, 0x89, 0x95, 0x0c, 0xda, 0x34, 0x16, 0xc2, 0xa5, 0x48,
+	0x1d, 0x3f, 0xfd, 0x35, 0x03, 0x96, 0xd4, 0xab, 0x9f, 0x50, 0xcf, 0x77, 0xd9, 0xd8, 0x70, 0x7c,
+	0xf6, 0x56, 0xad, 0x72, 0x23, 0xb5, 0xc4, 0xbd, 0x76, 0x83, 0xde, 0x00, 0x59, 0x9f, 0x0e, 0x88,
+	0xea, 0xdd, 0x29, 0x21, 0x4e, 0x45, 0x58, 0x30, 0x93, 0xf9, 0x91, 0x7d, 0xe7, 0x7b, 0x5c, 0x6a,
+	0x65, 0xca, 0xbd, 0xdb, 0x95, 0xe9, 0xce, 0x1e, 0x97, 0x7f, 0xf0, 0x1e, 0xf7, 0x43, 0x30, 0x67,
+	0x93, 0x3e, 0xf1, 0x89, 0xac, 0xf8, 0x42, 0x1b, 0x86, 0x81, 0xfe, 0x5e, 0xd4, 0xd7, 0x04, 0x03,
+	0xe1, 0x48, 0x04, 0xfd, 0x2e, 0x0b, 0x16, 0x0e, 0x99, 0x7b, 0x4e, 0x1c, 0xd3, 0xb1, 0x88, 0x0c,
+	0x52, 0x13, 0x14, 0x22, 0xc3, 0x55, 0x98, 0x52, 0x7b, 0x4a, 0xc4, 0x41, 0x38, 0x16, 0x82, 0xc7,
+	0x20, 0x47, 0xce, 0x79, 0x1e, 0xf3, 0x58, 0xbd, 0xf7, 0x78, 0xeb, 0x7e, 0x1f, 0xa4, 0xd4, 0xf1,
+	0x4b, 0xed, 0x4a, 0x12, 0x0f, 0x81, 0x82, 0xb0, 0x44, 0x83, 0x1d, 0x90, 0x3d, 0x65, 0xee, 0x40,
+	0xad, 0xdf, 0x9f, 0x24, 0xc1, 0xe5, 0xd4, 0x29, 0xa2, 0x26, 0xd0, 0xe0, 0x01, 0x98, 0xf5, 0x5d,
+	0x95, 0x0a, 0xbf, 0x08, 0x03, 0xbd, 0xa8, 0x12, 0xc6, 0x9d, 0x02, 0x71, 0xd6, 0x77, 0xe1, 0x6f,
+	0x40, 0x4e, 0xce, 0xcb, 0xdc, 0x9b, 0xe6, 0xe5, 0x27, 0x6a, 0x5e, 0xaa, 0xc7, 0x4e, 0x31, 0x27,
+	0xa5, 0xa6, 0x38, 0xeb, 0xf3, 0xf7, 0x65, 0xfd, 0x47, 0x20, 0x7f, 0x46, 0x68, 0xef, 0xcc, 0x17,
+	0x69, 0x90, 0x49, 0x97, 0x9a, 0xa4, 0x23, 0xac, 0x04, 0xbe, 0x1f, 0x6a, 0xb7, 0x92, 0x40, 0x38,
+	0x7f, 0x1b, 0xac, 0x1f, 0xe2, 0x5f, 0x3e, 0x35, 0x0e, 0x5a, 0x07, 0x3b, 0x46, 0xd7, 0x78, 0x6a,
+	0x1c, 0x74, 0xba, 0xc7, 0x07, 0x47, 0x87, 0xc6, 0xce, 0xde, 0xa7, 0x7b, 0xc6, 0x6e, 0x65, 0x66,
+	0x6d, 0xe1, 0xea, 0xba, 0x5e, 0x3a, 0x76, 0xbc, 0x21, 0xb1, 0xe8, 0x29, 0x25, 0x36, 0xfc, 0x01,
+	0x58, 0x9d, 0xb8, 0x82, 0x8d, 0xcf, 0xf6, 0x8e, 0x3a, 0x06, 0xae, 0x68, 0x6b, 0xe5, 0xab, 0xeb,
+	0x7a, 0x01, 0x8b, 0xc5, 0x83, 0xb0, 0x57, 0x0a, 0x77, 0x70, 0xeb, 0xe0, 0xe8, 0x53, 0x03, 0x57,
+	0x66, 0xa5, 0x70, 0x87, 0x99, 0x8e, 0x77, 0x4a, 0x18, 0xdc, 0x00, 0x2b, 0x13, 0xc2, 0x47, 0xad,
+	0x2f, 0x8c, 0x4a, 0x66, 0xad, 0x70, 0x75, 0x5d, 0xcf, 0x1e, 0x99, 0x7d, 0xfe, 0x7f, 0xfd, 0x07,
+	0x13, 0x42, 0xbb, 0xc6, 0x17, 0x46, 0xc7, 0xa8, 0x64, 0xd7, 0xc0, 0xd5, 0x75, 0x3d, 0xbf, 0x2b,
+	0x92, 0x7e, 0x2d, 0xfb, 0xd5, 0x5f, 0x6a, 0x5a, 0xfb, 0xf3, 0xbf, 0xdd, 0xd4, 0xb4, 0xaf, 0x6f,
+	0x6a, 0xda, 0x8b, 0x9b, 0x9a, 0xf6, 0x9f, 0x9b, 0x9a, 0xf6, 0xfb, 0x97, 0xb5, 0x99, 0x17, 0x2f,
+	0x6b, 0x33, 0xff, 0x7a, 0x59, 0x9b, 0x79, 0x96, 0x4e, 0x04, 0xea, 0x9e, 0x6f, 0xb9, 0x0e, 0x89,
+	0x7f, 0xf5, 0xb0, 0x9b, 0x97, 0xf1, 0xb7, 0x0c, 0xcf, 0x49, 0x5e, 0x4c, 0xd3, 0x1f, 0xfd, 0x7f,
+	0x00, 0x1c, 0x3d, 0x0e, 0x6e, 0x1e, 0x11, 0x00, 0x00,
+}
+
+func (this *Resource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Broker)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*Resource)
+	if !ok {
+		that2, ok := that.(Resource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ValidUntil != 0 {
-		n += 1 + sovTypes(uint64(m.ValidUntil))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.URI != that1.URI {
+		return false
 	}
-	return n
+	if this.Resource != that1.Resource {
+		return false
+	}
+	return true
 }
+func (this *Domain) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Domain)
+	if !ok {
+		that2, ok := that.(Domain)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Name != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdString(*m.Name)
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Name != that1.Name {
+		return false
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Admin, that1.Admin) {
+		return false
 	}
-	l = len(m.Broker)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Broker, that1.Broker) {
+		return false
 	}
-	if m.ValidUntil != 0 {
-		n += 1 + sovTypes(uint64(m.ValidUntil))
+	if this.ValidUntil != that1.ValidUntil {
+		return false
 	}
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if this.Type != that1.Type {
+		return false
+	}
+	return true
+}
+func (this *Account) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Account)
+	if !ok {
+		that2, ok := that.(Account)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Certificates) > 0 {
-		for _, b := range m.Certificates {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Name != nil && that1.Name != nil {
+		if *this.Name != *that1.Name {
+			return false
+		}
+	} else if this.Name != nil {
+		return false
+	} else if that1.Name != nil {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if !bytes.Equal(this.Broker, that1.Broker) {
+		return false
+	}
+	if this.ValidUntil != that1.ValidUntil {
+		return false
+	}
+	if len(this.Resources) != len(that1.Resources) {
+		return false
+	}
+	for i := range this.Resources {
+		if !this.Resources[i].Equal(that1.Resources[i]) {
+			return false
 		}
 	}
-	l = len(m.MetadataURI)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(this.Certificates) != len(that1.Certificates) {
+		return false
 	}
-	return n
+	for i := range this.Certificates {
+		if !bytes.Equal(this.Certificates[i], that1.Certificates[i]) {
+			return false
+		}
+	}
+	if this.MetadataURI != that1.MetadataURI {
+		return false
+	}
+	if len(this.TypedCertificates) != len(that1.TypedCertificates) {
+		return false
+	}
+	for i := range this.TypedCertificates {
+		if !this.TypedCertificates[i].Equal(that1.TypedCertificates[i]) {
+			return false
+		}
+	}
+	if this.Wildcard != that1.Wildcard {
+		return false
+	}
+	return true
 }
+func (this *TypedCertificate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	that1, ok := that.(*TypedCertificate)
+	if !ok {
+		that2, ok := that.(TypedCertificate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	return true
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (this *Commitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Commitment)
+	if !ok {
+		that2, ok := that.(Commitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	return true
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+func (this *DomainPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainPolicy)
+	if !ok {
+		that2, ok := that.(DomainPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.RegistrationPrice) != len(that1.RegistrationPrice) {
+		return false
+	}
+	for i := range this.RegistrationPrice {
+		if !this.RegistrationPrice[i].Equal(&that1.RegistrationPrice[i]) {
+			return false
+		}
+	}
+	if len(this.RenewalPrice) != len(that1.RenewalPrice) {
+		return false
+	}
+	for i := range this.RenewalPrice {
+		if !this.RenewalPrice[i].Equal(&that1.RenewalPrice[i]) {
+			return false
+		}
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if !bytes.Equal(this.Allowlist[i], that1.Allowlist[i]) {
+			return false
+		}
+	}
+	if len(this.Denylist) != len(that1.Denylist) {
+		return false
+	}
+	for i := range this.Denylist {
+		if !bytes.Equal(this.Denylist[i], that1.Denylist[i]) {
+			return false
+		}
+	}
+	if this.AccountRenewalPeriod != nil && that1.AccountRenewalPeriod != nil {
+		if *this.AccountRenewalPeriod != *that1.AccountRenewalPeriod {
+			return false
+		}
+	} else if this.AccountRenewalPeriod != nil {
+		return false
+	} else if that1.AccountRenewalPeriod != nil {
+		return false
+	}
+	if this.AccountGracePeriod != nil && that1.AccountGracePeriod != nil {
+		if *this.AccountGracePeriod != *that1.AccountGracePeriod {
+			return false
+		}
+	} else if this.AccountGracePeriod != nil {
+		return false
+	} else if that1.AccountGracePeriod != nil {
+		return false
+	}
+	if this.ResourcesMax != that1.ResourcesMax {
+		return false
+	}
+	if this.CertificateCountMax != that1.CertificateCountMax {
+		return false
+	}
+	if this.MetadataSizeMax != that1.MetadataSizeMax {
+		return false
+	}
+	if this.ValidAccountName != that1.ValidAccountName {
+		return false
+	}
+	if !bytes.Equal(this.RoyaltyRecipient, that1.RoyaltyRecipient) {
+		return false
+	}
+	if !this.RoyaltyRate.Equal(that1.RoyaltyRate) {
+		return false
+	}
+	return true
+}
+func (this *AccountHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountHistoryEntry)
+	if !ok {
+		that2, ok := that.(AccountHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if len(this.Resources) != len(that1.Resources) {
+		return false
+	}
+	for i := range this.Resources {
+		if !this.Resources[i].Equal(that1.Resources[i]) {
+			return false
+		}
+	}
+	if this.ValidUntil != that1.ValidUntil {
+		return false
+	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
+	return true
+}
+func (this *ProvenanceEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProvenanceEntry)
+	if !ok {
+		that2, ok := that.(ProvenanceEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Starname != that1.Starname {
+		return false
+	}
+	if this.Event != that1.Event {
+		return false
+	}
+	if !bytes.Equal(this.From, that1.From) {
+		return false
+	}
+	if !bytes.Equal(this.To, that1.To) {
+		return false
+	}
+	if len(this.Price) != len(that1.Price) {
+		return false
+	}
+	for i := range this.Price {
+		if !this.Price[i].Equal(&that1.Price[i]) {
+			return false
+		}
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Domain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Domain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Wildcard {
+		i--
+		if m.Wildcard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TypedCertificates) > 0 {
+		for iNdEx := len(m.TypedCertificates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TypedCertificates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MetadataURI) > 0 {
+		i -= len(m.MetadataURI)
+		copy(dAtA[i:], m.MetadataURI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataURI)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Certificates) > 0 {
+		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Certificates[iNdEx])
+			copy(dAtA[i:], m.Certificates[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Certificates[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Name != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdStringMarshalTo(*m.Name, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdString(*m.Name):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TypedCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypedCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypedCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Commitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RoyaltyRate.Size()
+		i -= size
+		if _, err := m.RoyaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ValidAccountName) > 0 {
+		i -= len(m.ValidAccountName)
+		copy(dAtA[i:], m.ValidAccountName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidAccountName)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MetadataSizeMax != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MetadataSizeMax))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CertificateCountMax != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CertificateCountMax))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ResourcesMax != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResourcesMax))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AccountGracePeriod != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.AccountGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AccountGracePeriod):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AccountRenewalPeriod != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.AccountRenewalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AccountRenewalPeriod):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RenewalPrice) > 0 {
+		for iNdEx := len(m.RenewalPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegistrationPrice) > 0 {
+		for iNdEx := len(m.RegistrationPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvenanceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvenanceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvenanceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Time != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Event != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Event))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Starname) > 0 {
+		i -= len(m.Starname)
+		copy(dAtA[i:], m.Starname)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Starname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Domain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTypes(uint64(m.ValidUntil))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Name != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdString(*m.Name)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTypes(uint64(m.ValidUntil))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Certificates) > 0 {
+		for _, b := range m.Certificates {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.MetadataURI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TypedCertificates) > 0 {
+		for _, e := range m.TypedCertificates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Wildcard {
+		n += 2
+	}
+	return n
+}
+
+func (m *TypedCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *Commitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *DomainPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.RegistrationPrice) > 0 {
+		for _, e := range m.RegistrationPrice {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RenewalPrice) > 0 {
+		for _, e := range m.RenewalPrice {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, b := range m.Allowlist {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, b := range m.Denylist {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AccountRenewalPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AccountRenewalPeriod)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AccountGracePeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AccountGracePeriod)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ResourcesMax != 0 {
+		n += 1 + sovTypes(uint64(m.ResourcesMax))
+	}
+	if m.CertificateCountMax != 0 {
+		n += 1 + sovTypes(uint64(m.CertificateCountMax))
+	}
+	if m.MetadataSizeMax != 0 {
+		n += 1 + sovTypes(uint64(m.MetadataSizeMax))
+	}
+	l = len(m.ValidAccountName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.RoyaltyRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *AccountHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTypes(uint64(m.Time))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTypes(uint64(m.ValidUntil))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProvenanceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Starname)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Event != 0 {
+		n += 1 + sovTypes(uint64(m.Event))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Time != 0 {
+		n += 1 + sovTypes(uint64(m.Time))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Resource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Domain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Domain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Domain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = append(m.Broker[:0], dAtA[iNdEx:postIndex]...)
+			if m.Broker == nil {
+				m.Broker = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = DomainType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Name == nil {
+				m.Name = new(string)
+			}
+			if err := github_com_gogo_protobuf_types.StdStringUnmarshal(m.Name, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = append(m.Broker[:0], dAtA[iNdEx:postIndex]...)
+			if m.Broker == nil {
+				m.Broker = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &Resource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificates = append(m.Certificates, make([]byte, postIndex-iNdEx))
+			copy(m.Certificates[len(m.Certificates)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedCertificates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypedCertificates = append(m.TypedCertificates, &TypedCertificate{})
+			if err := m.TypedCertificates[len(m.TypedCertificates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wildcard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wildcard = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TypedCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypedCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypedCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = CertificateType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationPrice = append(m.RegistrationPrice, types.Coin{})
+			if err := m.RegistrationPrice[len(m.RegistrationPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalPrice = append(m.RenewalPrice, types.Coin{})
+			if err := m.RenewalPrice[len(m.RenewalPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, make([]byte, postIndex-iNdEx))
+			copy(m.Allowlist[len(m.Allowlist)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, make([]byte, postIndex-iNdEx))
+			copy(m.Denylist[len(m.Denylist)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewalPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountRenewalPeriod == nil {
+				m.AccountRenewalPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.AccountRenewalPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountGracePeriod == nil {
+				m.AccountGracePeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.AccountGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesMax", wireType)
+			}
+			m.ResourcesMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourcesMax |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateCountMax", wireType)
+			}
+			m.CertificateCountMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CertificateCountMax |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSizeMax", wireType)
+			}
+			m.MetadataSizeMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataSizeMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -799,11 +3554,45 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.ValidAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = append(m.RoyaltyRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.RoyaltyRecipient == nil {
+				m.RoyaltyRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -831,7 +3620,9 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -854,7 +3645,7 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Domain) Unmarshal(dAtA []byte) error {
+func (m *AccountHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,13 +3668,45 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Domain: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Domain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -915,9 +3738,28 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -944,16 +3786,16 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -963,27 +3805,27 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Broker = append(m.Broker[:0], dAtA[iNdEx:postIndex]...)
-			if m.Broker == nil {
-				m.Broker = []byte{}
+			m.Resources = append(m.Resources, &Resource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
 			}
@@ -1002,11 +3844,11 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1016,24 +3858,12 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = DomainType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1055,7 +3885,7 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Account) Unmarshal(dAtA []byte) error {
+func (m *ProvenanceEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +3908,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvenanceEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvenanceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Starname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1114,13 +3944,13 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Starname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var msglen int
+			m.Event = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1130,31 +3960,14 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Event |= ProvenanceEvent(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Name == nil {
-				m.Name = new(string)
-			}
-			if err := github_com_gogo_protobuf_types.StdStringUnmarshal(m.Name, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1181,14 +3994,14 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1215,33 +4028,14 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Broker = append(m.Broker[:0], dAtA[iNdEx:postIndex]...)
-			if m.Broker == nil {
-				m.Broker = []byte{}
+			m.To = append(m.To[:0], dAtA[iNdEx:postIndex]...)
+			if m.To == nil {
+				m.To = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
-			}
-			m.ValidUntil = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidUntil |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1268,16 +4062,16 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &Resource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var byteLen int
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1287,29 +4081,16 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Certificates = append(m.Certificates, make([]byte, postIndex-iNdEx))
-			copy(m.Certificates[len(m.Certificates)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataURI", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1319,24 +4100,11 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetadataURI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])